@@ -0,0 +1,163 @@
+package extensionapiparser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	numberLiteralRe      = regexp.MustCompile(`^-?\d+(\.\d+)?(e[+-]?\d+)?$`)
+	constructorLiteralRe = regexp.MustCompile(`^(\w+)\((.*)\)$`)
+)
+
+// defaultValueConstructors maps the builtin class constructors found in
+// default values to the Go constructor taking the same components.
+var defaultValueConstructors = map[string]map[int]string{
+	"Vector2":    {2: "NewVector2WithFloat32Float32"},
+	"Vector2i":   {2: "NewVector2iWithInt64Int64"},
+	"Vector3":    {3: "NewVector3WithFloat32Float32Float32"},
+	"Vector3i":   {3: "NewVector3iWithInt64Int64Int64"},
+	"Vector4":    {4: "NewVector4WithFloat32Float32Float32Float32"},
+	"Vector4i":   {4: "NewVector4iWithInt64Int64Int64Int64"},
+	"Rect2":      {4: "NewRect2WithFloat32Float32Float32Float32"},
+	"Rect2i":     {4: "NewRect2iWithInt64Int64Int64Int64"},
+	"Quaternion": {4: "NewQuaternionWithFloat32Float32Float32Float32"},
+	"Color": {
+		3: "NewColorWithFloat32Float32Float32",
+		4: "NewColorWithFloat32Float32Float32Float32",
+	},
+}
+
+// GoDefaultValue returns the Go expression of the default value of the
+// argument, or "" when there is none or it has no Go equivalent yet, e.g.
+// typed arrays.
+func (a Argument) GoDefaultValue() string {
+	v := a.DefaultValue
+	switch {
+	case v == "":
+		return ""
+	case numberLiteralRe.MatchString(v):
+		if !a.isNumeric() || (strings.HasPrefix(a.Meta, "uint") && strings.HasPrefix(v, "-")) {
+			return ""
+		}
+		return v
+	case v == "true" || v == "false":
+		if a.Type != "bool" {
+			return ""
+		}
+		return v
+	case v == "null":
+		switch {
+		case a.Type == "Variant":
+			return "NewVariantNil()"
+		case a.isObject():
+			return "nil"
+		}
+		return ""
+	case a.Type == "String" && isQuoted(v):
+		return fmt.Sprintf("NewStringWithUtf8Chars(%s)", v)
+	case a.Type == "StringName" && strings.HasPrefix(v, "&") && isQuoted(v[1:]):
+		return fmt.Sprintf("NewStringNameWithUtf8Chars(%s)", v[1:])
+	case a.Type == "NodePath" && v == `NodePath("")`:
+		return "NewNodePath()"
+	case a.Type == "Array" && v == "[]":
+		return "NewArray()"
+	case a.Type == "Dictionary" && v == "{}":
+		return "NewDictionary()"
+	case v == a.Type+"()":
+		switch {
+		case strings.HasPrefix(a.Type, "Packed"), a.Type == "Callable", a.Type == "RID":
+			return fmt.Sprintf("New%s()", a.Type)
+		}
+		return ""
+	}
+
+	m := constructorLiteralRe.FindStringSubmatch(v)
+	if m == nil || m[1] != a.Type {
+		return ""
+	}
+	components := strings.Split(m[2], ",")
+	for i := range components {
+		components[i] = strings.TrimSpace(components[i])
+		if !numberLiteralRe.MatchString(components[i]) {
+			return ""
+		}
+	}
+	fn, ok := defaultValueConstructors[a.Type][len(components)]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s(%s)", fn, strings.Join(components, ", "))
+}
+
+// DefaultValueHasDestroy reports whether the value built by GoDefaultValue
+// has to be destroyed after the call.
+func (a Argument) DefaultValueHasDestroy() bool {
+	switch {
+	case a.GoDefaultValue() == "", a.GoDefaultValue() == "nil":
+		return false
+	case a.HasDestroy(), strings.HasPrefix(a.Type, "Packed"):
+		return true
+	}
+	switch a.Type {
+	case "NodePath", "Array", "Dictionary", "Callable", "Variant":
+		return true
+	}
+	return false
+}
+
+func (a Argument) isNumeric() bool {
+	switch {
+	case strings.HasPrefix(a.Type, "enum::"), strings.HasPrefix(a.Type, "bitfield::"):
+		return true
+	}
+	switch a.Type {
+	case "int", "float":
+		return true
+	}
+	return false
+}
+
+// isObject reports whether the argument is an engine class, passed as an
+// interface that can be nil.
+func (a Argument) isObject() bool {
+	if a.Type == "" || a.Type[0] < 'A' || a.Type[0] > 'Z' || strings.Contains(a.Type, "::") {
+		return false
+	}
+	switch a.Type {
+	case "Variant", "String", "StringName", "NodePath", "Array", "Dictionary",
+		"Callable", "Signal", "RID", "Color", "Vector2", "Vector2i", "Vector3",
+		"Vector3i", "Vector4", "Vector4i", "Rect2", "Rect2i", "Transform2D",
+		"Transform3D", "Plane", "Quaternion", "AABB", "Basis", "Projection":
+		return false
+	}
+	return !strings.HasPrefix(a.Type, "Packed")
+}
+
+func isQuoted(v string) bool {
+	_, err := strconv.Unquote(v)
+	return err == nil && strings.HasPrefix(v, `"`)
+}
+
+// DefaultArgumentIndex returns the index of the first argument of the
+// trailing run of arguments with a GoDefaultValue, or len(m.Arguments) when
+// the last argument has none. Vararg methods get no defaults, so that the
+// short form cannot be confused with the variadic arguments.
+func (m ClassMethod) DefaultArgumentIndex() int {
+	i := len(m.Arguments)
+	if m.IsVararg || m.IsVirtual {
+		return i
+	}
+	for i > 0 && m.Arguments[i-1].GoDefaultValue() != "" {
+		i--
+	}
+	return i
+}
+
+// HasDefaultArguments reports whether the method gets a short form leaving
+// out the arguments from DefaultArgumentIndex on.
+func (m ClassMethod) HasDefaultArguments() bool {
+	return m.DefaultArgumentIndex() < len(m.Arguments)
+}
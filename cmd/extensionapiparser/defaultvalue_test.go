@@ -0,0 +1,60 @@
+package extensionapiparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoDefaultValue(t *testing.T) {
+	tests := []struct {
+		arg      Argument
+		expected string
+		destroy  bool
+	}{
+		{Argument{Type: "int", DefaultValue: "-1"}, "-1", false},
+		{Argument{Type: "int", Meta: "uint32", DefaultValue: "-1"}, "", false},
+		{Argument{Type: "float", DefaultValue: "0.08"}, "0.08", false},
+		{Argument{Type: "float", DefaultValue: "inf"}, "", false},
+		{Argument{Type: "enum::Node.InternalMode", DefaultValue: "0"}, "0", false},
+		{Argument{Type: "bool", DefaultValue: "true"}, "true", false},
+		{Argument{Type: "String", DefaultValue: `""`}, `NewStringWithUtf8Chars("")`, true},
+		{Argument{Type: "StringName", DefaultValue: `&"idle"`}, `NewStringNameWithUtf8Chars("idle")`, true},
+		{Argument{Type: "NodePath", DefaultValue: `NodePath("")`}, "NewNodePath()", true},
+		{Argument{Type: "Variant", DefaultValue: "null"}, "NewVariantNil()", true},
+		{Argument{Type: "Node", DefaultValue: "null"}, "nil", false},
+		{Argument{Type: "Vector2", DefaultValue: "Vector2(0, 0)"}, "NewVector2WithFloat32Float32(0, 0)", false},
+		{Argument{Type: "Color", DefaultValue: "Color(1, 1, 1, 1)"}, "NewColorWithFloat32Float32Float32Float32(1, 1, 1, 1)", false},
+		{Argument{Type: "Transform2D", DefaultValue: "Transform2D(1, 0, 0, 1, 0, 0)"}, "", false},
+		{Argument{Type: "Array", DefaultValue: "[]"}, "NewArray()", true},
+		{Argument{Type: "typedarray::StringName", DefaultValue: "Array[StringName]([])"}, "", false},
+		{Argument{Type: "PackedStringArray", DefaultValue: "PackedStringArray()"}, "NewPackedStringArray()", true},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.expected, tt.arg.GoDefaultValue(), "%s = %s", tt.arg.Type, tt.arg.DefaultValue)
+		require.Equal(t, tt.destroy, tt.arg.DefaultValueHasDestroy(), "%s = %s", tt.arg.Type, tt.arg.DefaultValue)
+	}
+}
+
+func TestDefaultArgumentIndex(t *testing.T) {
+	m := ClassMethod{
+		Name: "move_and_collide",
+		Arguments: []Argument{
+			{Name: "motion", Type: "Vector2"},
+			{Name: "test_only", Type: "bool", DefaultValue: "false"},
+			{Name: "safe_margin", Type: "float", DefaultValue: "0.08"},
+			{Name: "recovery_as_collision", Type: "bool", DefaultValue: "false"},
+		},
+	}
+	require.Equal(t, 1, m.DefaultArgumentIndex())
+	require.True(t, m.HasDefaultArguments())
+
+	// the trailing run stops at a default without a Go equivalent
+	m.Arguments[2].DefaultValue = "inf"
+	require.Equal(t, 3, m.DefaultArgumentIndex())
+
+	m.IsVararg = true
+	require.Equal(t, 4, m.DefaultArgumentIndex())
+	require.False(t, m.HasDefaultArguments())
+}
@@ -73,6 +73,12 @@ func (e Enum) GoName() string {
 	return strings.Replace(e.Name, ".", "", -1)
 }
 
+// Bitfield reports whether the values of the enum are flags meant to be
+// combined with |.
+func (e Enum) Bitfield() bool {
+	return e.IsBitfield != nil && *e.IsBitfield
+}
+
 type Argument struct {
 	Name         string `json:"name"`
 	Type         string `json:"type"`
@@ -317,6 +323,22 @@ func (a ExtensionApi) Float64BuiltinClassSize() *BuiltinClassSize {
 	return nil
 }
 
+// FindMemberOffsets returns the builtin_class_member_offsets entry of the
+// selected build configuration for name, or nil when the class has none.
+func (a ExtensionApi) FindMemberOffsets(name string) []BuiltinClassMemberOffsetClassMember {
+	for _, bc := range a.BuiltinClassMemberOffsets {
+		if bc.BuildConfiguration != a.BuildConfig {
+			continue
+		}
+		for _, c := range bc.Classes {
+			if c.Name == name {
+				return c.Members
+			}
+		}
+	}
+	return nil
+}
+
 func (a ExtensionApi) ContainsClassName(name string) bool {
 	for _, c := range a.Classes {
 		if c.Name == name {
@@ -337,6 +359,25 @@ func (a ExtensionApi) IsRefcountedClassName(name string) bool {
 	return false
 }
 
+// InheritsClassName reports whether the engine class name is base or derives
+// from it.
+func (a ExtensionApi) InheritsClassName(name, base string) bool {
+	for name != "" {
+		if name == base {
+			return true
+		}
+		parent := ""
+		for _, c := range a.Classes {
+			if c.Name == name {
+				parent = c.Inherits
+				break
+			}
+		}
+		name = parent
+	}
+	return false
+}
+
 func (a ExtensionApi) FilteredClasses() []Class {
 	values := make([]Class, 0, len(a.Classes))
 
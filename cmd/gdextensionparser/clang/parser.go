@@ -140,6 +140,29 @@ type TypedefFunction struct {
 	ReturnType PrimativeType `parser:" 'typedef' @@                " json:",omitempty"`
 	Name       string        `parser:" '(' '*' @Ident ')'          " json:",omitempty"`
 	Arguments  []Argument    `parser:" '(' ( @@ ( ',' @@ )* )? ')' " json:",omitempty"`
+	// Since is the Godot version that added the function, from the @since
+	// tag of its doc comment, e.g. "4.2".
+	Since string `parser:"" json:",omitempty"`
+	// Deprecated is the text of the @deprecated tag of the doc comment, e.g.
+	// "in Godot 4.5. Use `get_godot_version2` instead.".
+	Deprecated string `parser:"" json:",omitempty"`
+}
+
+// applyDocComment reads the tags of the doc comment preceding the typedef:
+//
+//	/**
+//	 * @name get_godot_version2
+//	 * @since 4.5
+//	 */
+func (f *TypedefFunction) applyDocComment(comment string) {
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimLeft(strings.TrimSpace(line), "/* ")
+		if v, ok := strings.CutPrefix(line, "@since "); ok {
+			f.Since = strings.TrimSpace(v)
+		} else if v, ok := strings.CutPrefix(line, "@deprecated "); ok {
+			f.Deprecated = strings.TrimSpace(v)
+		}
+	}
 }
 
 type TypedefStruct struct {
@@ -313,5 +336,11 @@ func ParseCString(s string) (CHeaderFileAST, error) {
 		return CHeaderFileAST{}, err
 	}
 
+	for i := 1; i < len(ast.Expr); i++ {
+		if ast.Expr[i].Function != nil && ast.Expr[i-1].Comment != "" {
+			ast.Expr[i].Function.applyDocComment(ast.Expr[i-1].Comment)
+		}
+	}
+
 	return *ast, nil
 }
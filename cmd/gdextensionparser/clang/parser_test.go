@@ -382,3 +382,34 @@ func TestParseTypedefFunctionNoArgumentNames(t *testing.T) {
 
 	require.Len(t, f.Expr[0].Function.Arguments, 2)
 }
+
+func TestParseTypedefFunctionDocTags(t *testing.T) {
+	content := `
+/**
+ * @name get_godot_version
+ * @since 4.1
+ * @deprecated in Godot 4.5. Use ` + "`get_godot_version2`" + ` instead.
+ *
+ * Gets the Godot version that the GDExtension was loaded into.
+ */
+typedef void (*GDExtensionInterfaceGetGodotVersion)(GDExtensionGodotVersion *r_godot_version);
+
+typedef void (*GDExtensionInterfaceMemFree)(void *p_ptr);
+`
+
+	f, err := ParseCString(content)
+
+	require.NoError(t, err)
+
+	fns := f.CollectFunctions()
+
+	require.Len(t, fns, 2)
+
+	require.Equal(t, "4.1", fns[0].Since)
+
+	require.Equal(t, "in Godot 4.5. Use `get_godot_version2` instead.", fns[0].Deprecated)
+
+	require.Empty(t, fns[1].Since)
+
+	require.Empty(t, fns[1].Deprecated)
+}
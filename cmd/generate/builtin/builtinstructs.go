@@ -0,0 +1,82 @@
+package builtin
+
+import (
+	"github.com/godot-go/godot-go/cmd/extensionapiparser"
+)
+
+// builtinStructField is a field of a built-in class that is generated as a Go
+// struct. Every built-in class listed in builtin_class_member_offsets for the
+// selected build configuration gets one field per member offset.
+type builtinStructField struct {
+	Name   string
+	Type   string
+	Offset int
+}
+
+// builtinStructs maps built-in class names to their struct fields.
+type builtinStructs map[string][]builtinStructField
+
+func newBuiltinStructs(extensionApi extensionapiparser.ExtensionApi) builtinStructs {
+	structs := builtinStructs{}
+	for _, c := range extensionApi.BuiltinClasses {
+		members := extensionApi.FindMemberOffsets(c.Name)
+		if len(members) == 0 {
+			continue
+		}
+		if fields, ok := builtinStructOverrides[c.Name]; ok {
+			structs[c.Name] = fields
+			continue
+		}
+		fields := make([]builtinStructField, len(members))
+		for i, m := range members {
+			fields[i] = builtinStructField{
+				Name:   upperFirstChar(m.Member),
+				Type:   goMemberOffsetType(m.Meta),
+				Offset: m.Offset,
+			}
+		}
+		structs[c.Name] = fields
+	}
+	return structs
+}
+
+func (s builtinStructs) IsStruct(className string) bool {
+	_, ok := s[className]
+	return ok
+}
+
+func (s builtinStructs) Fields(className string) []builtinStructField {
+	return s[className]
+}
+
+// goMemberOffsetType maps the meta of a member offset to the Go type of the
+// struct field. Anything that is not a scalar is another built-in class.
+func goMemberOffsetType(meta string) string {
+	switch meta {
+	case "float":
+		return "float32"
+	case "double":
+		return "float64"
+	case "int32":
+		return "int32"
+	default:
+		return meta
+	}
+}
+
+// builtinStructOverrides replace the fields generated from the member offsets
+// where the member names would be misleading. The members x, y and z of Basis
+// are the rows of the matrix, while basis.x in GDScript is a column.
+var builtinStructOverrides = map[string][]builtinStructField{
+	"Basis": {{Name: "Rows", Type: "[3]Vector3", Offset: 0}},
+}
+
+// builtinStructNotes are appended to the doc comment of the generated struct
+// where the memory layout differs from what the member names suggest.
+var builtinStructNotes = map[string]string{
+	"Basis": "Rows holds the rows of the matrix. X, Y and Z return its columns, the\n// same as the Variant members x, y and z.",
+}
+
+func builtinStructNote(className string) string {
+	return builtinStructNotes[className]
+}
@@ -21,6 +21,15 @@ var (
 	//go:embed builtinclasses.go.tmpl
 	builtinClassesText string
 
+	//go:embed builtinclasses.methods.go.tmpl
+	builtinClassesMethodsText string
+
+	//go:embed builtinclasses.native.go.tmpl
+	builtinClassesNativeText string
+
+	//go:embed builtinclasses.cgo.go.tmpl
+	builtinClassesCgoText string
+
 	//go:embed variant.go.tmpl
 	variantGoText string
 
@@ -35,6 +44,12 @@ func Generate(projectPath string, ast clang.CHeaderFileAST, eapi extensionapipar
 	if err := GenerateBuiltinClasses(projectPath, eapi); err != nil {
 		return fmt.Errorf("builtin classes: %w", err)
 	}
+	if err := GenerateBuiltinClassesNative(projectPath, eapi); err != nil {
+		return fmt.Errorf("builtin classes native: %w", err)
+	}
+	if err := GenerateBuiltinClassesCgo(projectPath, eapi); err != nil {
+		return fmt.Errorf("builtin classes cgo: %w", err)
+	}
 	if err := GenerateBuiltinClassBindings(projectPath, eapi); err != nil {
 		return fmt.Errorf("builtin class bindings: %w", err)
 	}
@@ -51,26 +66,26 @@ func Generate(projectPath string, ast clang.CHeaderFileAST, eapi extensionapipar
 }
 
 func GenerateBuiltinClasses(projectPath string, extensionApi extensionapiparser.ExtensionApi) error {
+	nm, err := newNativeMath(extensionApi)
+	if err != nil {
+		return err
+	}
+
+	funcs := nm.funcMap()
+	funcs["upper"] = strings.ToUpper
+	funcs["snakeCase"] = snakeCase
+	funcs["goDecodeNumberType"] = goDecodeNumberType
+	funcs["typeHasPtr"] = typeHasPtr
+
 	tmpl, err := template.New("builtinclasses.gen.go").
-		Funcs(template.FuncMap{
-			"upper":                    strings.ToUpper,
-			"upperFirstChar":           upperFirstChar,
-			"snakeCase":                snakeCase,
-			"goMethodName":             goMethodName,
-			"goArgumentName":           goArgumentName,
-			"goArgumentType":           goArgumentType,
-			"goHasArgumentTypeEncoder": goHasArgumentTypeEncoder,
-			"goReturnType":             goReturnType,
-			"goDecodeNumberType":       goDecodeNumberType,
-			"getOperatorIdName":        getOperatorIdName,
-			"typeHasPtr":               typeHasPtr,
-			"goEncoder":                goEncoder,
-			"goEncodeIsReference":      goEncodeIsReference,
-		}).
+		Funcs(funcs).
 		Parse(builtinClassesText)
 	if err != nil {
 		return fmt.Errorf("parse template builtinclasses.gen.go: %w", err)
 	}
+	if _, err := tmpl.Parse(builtinClassesMethodsText); err != nil {
+		return fmt.Errorf("parse template builtinclasses.methods.go.tmpl: %w", err)
+	}
 
 	var b bytes.Buffer
 
@@ -83,6 +98,62 @@ func GenerateBuiltinClasses(projectPath string, extensionApi extensionapiparser.
 	return writeGeneratedFile(filename, b.Bytes())
 }
 
+// GenerateBuiltinClassesNative writes the pure Go implementations of the
+// methods and operators that have a body in builtinclasses.native.go.tmpl.
+func GenerateBuiltinClassesNative(projectPath string, extensionApi extensionapiparser.ExtensionApi) error {
+	nm, err := newNativeMath(extensionApi)
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Join(projectPath, "pkg", "builtin", "builtinclasses.native.gen.go")
+
+	if nm.bodies == nil {
+		return removeGeneratedFile(filename)
+	}
+
+	var b bytes.Buffer
+
+	if err := nm.bodies.Execute(&b, extensionApi); err != nil {
+		return fmt.Errorf("execute template builtinclasses.native.gen.go: %w", err)
+	}
+
+	return writeGeneratedFile(filename, b.Bytes())
+}
+
+// GenerateBuiltinClassesCgo writes the engine backed versions of the native
+// methods and operators, selected with the cgomath build tag.
+func GenerateBuiltinClassesCgo(projectPath string, extensionApi extensionapiparser.ExtensionApi) error {
+	nm, err := newNativeMath(extensionApi)
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Join(projectPath, "pkg", "builtin", "builtinclasses.cgo.gen.go")
+
+	if nm.bodies == nil {
+		return removeGeneratedFile(filename)
+	}
+
+	tmpl, err := template.New("builtinclasses.cgo.gen.go").
+		Funcs(nm.funcMap()).
+		Parse(builtinClassesCgoText)
+	if err != nil {
+		return fmt.Errorf("parse template builtinclasses.cgo.gen.go: %w", err)
+	}
+	if _, err := tmpl.Parse(builtinClassesMethodsText); err != nil {
+		return fmt.Errorf("parse template builtinclasses.methods.go.tmpl: %w", err)
+	}
+
+	var b bytes.Buffer
+
+	if err := tmpl.Execute(&b, extensionApi); err != nil {
+		return fmt.Errorf("execute template builtinclasses.cgo.gen.go: %w", err)
+	}
+
+	return writeGeneratedFile(filename, b.Bytes())
+}
+
 func GenerateBuiltinClassBindings(projectPath string, extensionApi extensionapiparser.ExtensionApi) error {
 	tmpl, err := template.New("builtinclasses.bindings.gen.go").
 		Funcs(template.FuncMap{
@@ -168,6 +239,15 @@ func writeGeneratedFile(path string, data []byte) error {
 	return nil
 }
 
+// removeGeneratedFile drops a generated file that the selected build
+// configuration does not produce, so a stale copy cannot end up in the build.
+func removeGeneratedFile(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove %s: %w", path, err)
+	}
+	return nil
+}
+
 func GenerateVariantGoFile(projectPath string, ast clang.CHeaderFileAST) error {
 	funcs := template.FuncMap{
 		"snakeCase":          strcase.ToSnake,
@@ -0,0 +1,169 @@
+package builtin
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/godot-go/godot-go/cmd/extensionapiparser"
+)
+
+// nativeMathClasses are the built-in classes that get pure Go method and
+// operator bodies from builtinclasses.native.go.tmpl. Their bodies work on the
+// struct fields generated from builtin_class_member_offsets, so every class
+// listed here needs offsets in the selected build configuration.
+var nativeMathClasses = []string{
+	"Vector2",
+	"Rect2",
+	"Vector3",
+	"Transform2D",
+	"Vector4",
+	"Quaternion",
+	"Basis",
+	"Color",
+}
+
+// builtinClassMember is the pipeline handed to the shared method and
+// operator blocks in builtinclasses.methods.go.tmpl.
+type builtinClassMember struct {
+	Class    extensionapiparser.BuiltinClass
+	Method   extensionapiparser.BuiltInClassMethod
+	Operator extensionapiparser.ClassOperator
+}
+
+func classMethod(c extensionapiparser.BuiltinClass, m extensionapiparser.BuiltInClassMethod) builtinClassMember {
+	return builtinClassMember{Class: c, Method: m}
+}
+
+func classOperator(c extensionapiparser.BuiltinClass, op extensionapiparser.ClassOperator) builtinClassMember {
+	return builtinClassMember{Class: c, Operator: op}
+}
+
+// nativeMath decides which built-in methods and operators are implemented in
+// Go. The bodies live as named blocks in builtinclasses.native.go.tmpl; a
+// method is native exactly when a block for it exists.
+type nativeMath struct {
+	bodies  *template.Template
+	structs builtinStructs
+}
+
+func newNativeMath(extensionApi extensionapiparser.ExtensionApi) (*nativeMath, error) {
+	nm := &nativeMath{
+		structs: newBuiltinStructs(extensionApi),
+	}
+
+	// the bodies are written against single precision real_t; double builds
+	// keep calling into the engine for everything
+	if !nm.supported() {
+		return nm, nil
+	}
+
+	tmpl, err := template.New("builtinclasses.native.gen.go").
+		Funcs(nm.funcMap()).
+		Parse(builtinClassesMethodsText)
+	if err != nil {
+		return nil, fmt.Errorf("parse template builtinclasses.methods.go.tmpl: %w", err)
+	}
+	if tmpl, err = tmpl.Parse(builtinClassesNativeText); err != nil {
+		return nil, fmt.Errorf("parse template builtinclasses.native.gen.go: %w", err)
+	}
+	nm.bodies = tmpl
+
+	return nm, nil
+}
+
+func (nm *nativeMath) supported() bool {
+	fields := nm.structs.Fields("Vector2")
+	if len(fields) == 0 {
+		return false
+	}
+	for _, f := range fields {
+		if f.Type != "float32" {
+			return false
+		}
+	}
+	return true
+}
+
+func (nm *nativeMath) funcMap() template.FuncMap {
+	return template.FuncMap{
+		"upperFirstChar":           upperFirstChar,
+		"goMethodName":             goMethodName,
+		"goArgumentName":           goArgumentName,
+		"goArgumentType":           goArgumentType,
+		"goHasArgumentTypeEncoder": goHasArgumentTypeEncoder,
+		"goReturnType":             goReturnType,
+		"getOperatorIdName":        getOperatorIdName,
+		"goEncoder":                goEncoder,
+		"goEncodeIsReference":      goEncodeIsReference,
+		"classMethod":              classMethod,
+		"classOperator":            classOperator,
+		"hasNativeMath":            nm.HasClass,
+		"isNativeMethod":           nm.HasMethod,
+		"isNativeOperator":         nm.HasOperator,
+		"isBuiltinStruct":          nm.structs.IsStruct,
+		"builtinStructFields":      nm.structs.Fields,
+		"builtinStructNote":        builtinStructNote,
+		"nativeHelpers":            nm.Helpers,
+		"nativeMethodBody":         nm.MethodBody,
+		"nativeOperatorBody":       nm.OperatorBody,
+	}
+}
+
+func (nm *nativeMath) HasClass(className string) bool {
+	if nm.bodies == nil {
+		return false
+	}
+	for _, n := range nativeMathClasses {
+		if n == className {
+			return nm.structs.IsStruct(className)
+		}
+	}
+	return false
+}
+
+func (nm *nativeMath) HasMethod(className, methodName string) bool {
+	return nm.HasClass(className) &&
+		nm.bodies.Lookup(nativeMethodBlockName(className, methodName)) != nil
+}
+
+func (nm *nativeMath) HasOperator(className, op, rightType string) bool {
+	return nm.HasClass(className) &&
+		nm.bodies.Lookup(nativeOperatorBlockName(className, op, rightType)) != nil
+}
+
+func (nm *nativeMath) Helpers(className string) (string, error) {
+	name := className + ".helpers"
+	if nm.bodies.Lookup(name) == nil {
+		return "", nil
+	}
+	return nm.execute(name)
+}
+
+func (nm *nativeMath) MethodBody(className, methodName string) (string, error) {
+	return nm.execute(nativeMethodBlockName(className, methodName))
+}
+
+func (nm *nativeMath) OperatorBody(className, op, rightType string) (string, error) {
+	return nm.execute(nativeOperatorBlockName(className, op, rightType))
+}
+
+func (nm *nativeMath) execute(name string) (string, error) {
+	var b bytes.Buffer
+	if err := nm.bodies.ExecuteTemplate(&b, name, nil); err != nil {
+		return "", fmt.Errorf("execute block %s: %w", name, err)
+	}
+	return b.String(), nil
+}
+
+func nativeMethodBlockName(className, methodName string) string {
+	return fmt.Sprintf("%s.method_%s", className, methodName)
+}
+
+func nativeOperatorBlockName(className, op, rightType string) string {
+	name := fmt.Sprintf("%s.operator_%s", className, getOperatorIdName(op))
+	if rightType != "" {
+		name += "_" + rightType
+	}
+	return name
+}
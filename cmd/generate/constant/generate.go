@@ -52,6 +52,7 @@ func GenerateClassConstants(projectPath string, extensionApi extensionapiparser.
 			"goReturnType":         goReturnType,
 			"goClassEnumName":      goClassEnumName,
 			"goClassConstantName":  goClassConstantName,
+			"goClassConstantType":  goClassConstantType,
 			"goClassStructName":    goClassStructName,
 			"goClassInterfaceName": goClassInterfaceName,
 			"coalesce":             coalesce,
@@ -73,6 +74,11 @@ func GenerateClassConstants(projectPath string, extensionApi extensionapiparser.
 }
 
 func GenerateClassEnums(projectPath string, extensionApi extensionapiparser.ExtensionApi) error {
+	aliases := newEnumAliases(enumTypeNames(extensionApi))
+	// global enums keep their aliases when a class enum alias collides
+	for _, e := range extensionApi.GlobalEnums {
+		aliases.globalEnum(e)
+	}
 	tmpl, err := template.New("classes.enums.gen.go").
 		Funcs(template.FuncMap{
 			"goVariantConstructor": goVariantConstructor,
@@ -81,6 +87,8 @@ func GenerateClassEnums(projectPath string, extensionApi extensionapiparser.Exte
 			"goArgumentType":       goArgumentType,
 			"goReturnType":         goReturnType,
 			"goClassEnumName":      goClassEnumName,
+			"goClassEnumAliases":   aliases.classEnum,
+			"goEnumNamesVar":       goEnumNamesVar,
 			"goClassStructName":    goClassStructName,
 			"goClassInterfaceName": goClassInterfaceName,
 			"coalesce":             coalesce,
@@ -128,7 +136,12 @@ func GenerateGlobalEnums(projectPath string, extensionApi extensionapiparser.Ext
 		return nil
 	}
 
+	aliases := newEnumAliases(enumTypeNames(extensionApi))
 	tmpl, err := template.New("globalenums.gen.go").
+		Funcs(template.FuncMap{
+			"goGlobalEnumAliases": aliases.globalEnum,
+			"goEnumNamesVar":      goEnumNamesVar,
+		}).
 		Parse(globalEnumsText)
 	if err != nil {
 		return fmt.Errorf("parse template globalenums.gen.go: %w", err)
@@ -145,6 +158,21 @@ func GenerateGlobalEnums(projectPath string, extensionApi extensionapiparser.Ext
 	return writeGeneratedFile(filename, b.Bytes())
 }
 
+// enumTypeNames returns the names of the generated enum types, which enum
+// value aliases must not take.
+func enumTypeNames(extensionApi extensionapiparser.ExtensionApi) []string {
+	var names []string
+	for _, c := range extensionApi.Classes {
+		for _, e := range c.FilteredEnums() {
+			names = append(names, c.Name+e.GoName())
+		}
+	}
+	for _, e := range extensionApi.GlobalEnums {
+		names = append(names, e.GoName())
+	}
+	return names
+}
+
 func writeGeneratedFile(path string, data []byte) error {
 	f, err := os.Create(path)
 	if err != nil {
@@ -360,6 +360,15 @@ func goClassConstantName(c string, n string) string {
 	)
 }
 
+// goClassConstantType types notification constants as Notification; other
+// class constants are plain int32 values.
+func goClassConstantType(n string) string {
+	if strings.HasPrefix(n, "NOTIFICATION_") {
+		return "Notification"
+	}
+	return "int32"
+}
+
 func goMethodName(n string) string {
 	if strings.HasPrefix(n, "_") {
 		return fmt.Sprintf("Internal_%s", strcase.ToCamel(n))
@@ -523,3 +532,108 @@ func isRefcounted(goType string) bool {
 func isSetterMethodName(methodName string) bool {
 	return strings.HasPrefix(methodName, "Set")
 }
+
+// enumAlias is the Go style name of an enum value, declared as an alias of
+// the generated constant Const.
+type enumAlias struct {
+	Name  string
+	Const string
+}
+
+// enumAliases names the values of enums the Go way, e.g.
+// RigidBody2DCCDModeCastShape for RIGID_BODY_2D_CCD_MODE_CCD_MODE_CAST_SHAPE.
+// Names already taken by an enum type or an earlier alias are skipped.
+type enumAliases struct {
+	used map[string]struct{}
+}
+
+func newEnumAliases(typeNames []string) *enumAliases {
+	a := &enumAliases{used: make(map[string]struct{}, len(typeNames))}
+	for _, n := range typeNames {
+		a.used[n] = struct{}{}
+	}
+	return a
+}
+
+func (a *enumAliases) classEnum(c string, e extensionapiparser.Enum) []enumAlias {
+	return a.enum(c+e.GoName(), e, func(v string) string {
+		return goClassEnumName(c, e.GoName(), v)
+	})
+}
+
+func (a *enumAliases) globalEnum(e extensionapiparser.Enum) []enumAlias {
+	return a.enum(e.GoName(), e, func(v string) string {
+		return v
+	})
+}
+
+func (a *enumAliases) enum(typeName string, e extensionapiparser.Enum, constName func(string) string) []enumAlias {
+	prefixes := enumValuePrefixes(e)
+	aliases := make([]enumAlias, 0, len(e.Values))
+	for _, v := range e.Values {
+		name := typeName + goEnumValueName(v.Name, prefixes)
+		if _, ok := a.used[name]; ok {
+			continue
+		}
+		a.used[name] = struct{}{}
+		aliases = append(aliases, enumAlias{Name: name, Const: constName(v.Name)})
+	}
+	return aliases
+}
+
+// enumValuePrefixes returns the prefixes repeating the enum name that Godot
+// puts on enum values, e.g. CCD_MODE_ for RigidBody2D.CCDMode, in the order
+// they are tried.
+func enumValuePrefixes(e extensionapiparser.Enum) []string {
+	segments := strings.Split(e.Name, ".")
+	prefixes := []string{
+		screamingSnake(e.GoName()) + "_",
+		screamingSnake(segments[len(segments)-1]) + "_",
+	}
+	// values sharing a first word, like KEY_ in KeyModifierMask
+	if len(e.Values) > 1 {
+		common, _, ok := strings.Cut(e.Values[0].Name, "_")
+		for _, v := range e.Values[1:] {
+			if !strings.HasPrefix(v.Name, common+"_") {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			prefixes = append(prefixes, common+"_")
+		}
+	}
+	return prefixes
+}
+
+var digitDimensionRe = regexp.MustCompile(`^\d+D$`)
+
+// goEnumValueName returns the camel case name of an enum value without the
+// first of prefixes it starts with, e.g. CastShape for CCD_MODE_CAST_SHAPE.
+func goEnumValueName(n string, prefixes []string) string {
+	for _, p := range prefixes {
+		if trimmed, ok := strings.CutPrefix(n, p); ok && trimmed != "" {
+			n = trimmed
+			break
+		}
+	}
+	var sb strings.Builder
+	for _, word := range strings.Split(n, "_") {
+		if word == "" {
+			continue
+		}
+		if digitDimensionRe.MatchString(word) {
+			sb.WriteString(word)
+			continue
+		}
+		sb.WriteString(word[:1])
+		sb.WriteString(strings.ToLower(word[1:]))
+	}
+	return sb.String()
+}
+
+// goEnumNamesVar returns the name of the table listing the values of an
+// enum type by name.
+func goEnumNamesVar(typeName string) string {
+	return lowerFirstChar(typeName) + "Names"
+}
@@ -94,8 +94,8 @@ func GenerateGDExtensionWrapperGoFile(projectPath string, ast clang.CHeaderFileA
 		"add":                add,
 		"cgoCastArgument":    cgoCastArgument,
 		"cgoCastReturnType":  cgoCastReturnType,
-		"cgoPinReturnType":   cgoPinReturnType,
 		"cgoCleanUpArgument": cgoCleanUpArgument,
+		"procAddressName":    procAddressName,
 	}
 
 	tmpl, err := template.New("ffi_wrapper.gen.go").
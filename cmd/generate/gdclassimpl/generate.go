@@ -46,6 +46,7 @@ func GenerateClasses(projectPath string, extensionApi extensionapiparser.Extensi
 			"goEncoder":            goEncoder,
 			"goEncodeIsReference":  goEncodeIsReference,
 			"coalesce":             coalesce,
+			"lowerFirstChar":       lowerFirstChar,
 		}).
 		Parse(classesText)
 	if err != nil {
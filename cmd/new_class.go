@@ -47,23 +47,17 @@ func (c *{{.ClassName}}) GetParentClassName() string {
 }
 
 {{- if .WithReady }}
+
 func (c *{{.ClassName}}) V_Ready() {
 	// TODO: initialize
 }
 
 {{- end }}
-func New{{.ClassName}}FromOwnerObject(owner *GodotObject) GDClass {
-	obj := &{{.ClassName}}{}
-	obj.SetGodotObjectOwner(owner)
-	return obj
-}
 
+// RegisterClass{{.ClassName}} binds exported methods, V_ virtuals, fields
+// tagged godot:"export" and signal fields of {{.ClassName}}.
 func RegisterClass{{.ClassName}}() {
-	ClassDBRegisterClass(New{{.ClassName}}FromOwnerObject, nil, nil, func(t *{{.ClassName}}) {
-		{{- if .WithReady }}
-		ClassDBBindMethodVirtual(t, "V_Ready", "_ready", nil, nil)
-		{{- end }}
-	})
+	AutoRegister[*{{.ClassName}}]()
 }
 
 func UnregisterClass{{.ClassName}}() {
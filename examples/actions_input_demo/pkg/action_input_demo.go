@@ -39,11 +39,11 @@ func (a *ActionInputDemo) V_Ready() {
 
 func (a *ActionInputDemo) V_Process(delta float64) {
 	input := GetInputSingleton()
-	if input.IsActionJustPressed(a.actionJump, true) {
+	if input.IsActionJustPressedOpt(a.actionJump, true) {
 		a.score += 10
 		a.updateLabel()
 	}
-	if input.IsActionJustPressed(a.actionClick, true) {
+	if input.IsActionJustPressedOpt(a.actionClick, true) {
 		a.score += 1
 		a.updateLabel()
 	}
@@ -66,14 +66,14 @@ func (a *ActionInputDemo) setupActions() {
 
 	inputMap := getInputMapSingleton()
 	if !inputMap.HasAction(a.actionJump) {
-		inputMap.AddAction(a.actionJump, 0.5)
+		inputMap.AddActionOpt(a.actionJump, 0.5)
 		keyEvent := newInputEventKey(KEY_SPACE)
 		if keyEvent != nil {
 			inputMap.ActionAddEvent(a.actionJump, NewRefInputEvent(keyEvent))
 		}
 	}
 	if !inputMap.HasAction(a.actionClick) {
-		inputMap.AddAction(a.actionClick, 0.2)
+		inputMap.AddActionOpt(a.actionClick, 0.2)
 		mouseEvent := newInputEventMouseButton(MOUSE_BUTTON_LEFT)
 		if mouseEvent != nil {
 			inputMap.ActionAddEvent(a.actionClick, NewRefInputEvent(mouseEvent))
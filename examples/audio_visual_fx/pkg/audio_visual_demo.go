@@ -79,7 +79,7 @@ func (d *AudioVisualDemo) setupAudio() {
 	player.SetMaxDistance(520)
 	player.SetAttenuation(2.2)
 	player.SetPanningStrength(1.0)
-	player.Play(0)
+	player.Play()
 
 	pos := player.GetPosition()
 	d.sfxBaseX = pos.MemberGetx()
@@ -95,7 +95,7 @@ func (d *AudioVisualDemo) updateAudioMotion() {
 	d.sfxPlayer.SetPosition(pos)
 
 	if !d.sfxPlayer.IsPlaying() && d.time >= d.nextPlayAt {
-		d.sfxPlayer.Play(0)
+		d.sfxPlayer.Play()
 		d.nextPlayAt = d.time + 0.85
 	}
 }
@@ -215,8 +215,8 @@ func (d *AudioVisualDemo) createSpriteFrames() RefSpriteFrames {
 	if texA == nil || texB == nil {
 		return nil
 	}
-	frames.AddFrame(anim, texA, 0.0, -1)
-	frames.AddFrame(anim, texB, 0.0, -1)
+	frames.AddFrameOpt(anim, texA, 0.0, -1)
+	frames.AddFrameOpt(anim, texB, 0.0, -1)
 	return NewRefSpriteFrames(frames)
 }
 
@@ -263,7 +263,7 @@ func (d *AudioVisualDemo) loadAudioStream(path string) RefAudioStream {
 	defer pathStr.Destroy()
 	typeHint := NewStringWithUtf8Chars("AudioStream")
 	defer typeHint.Destroy()
-	resource := loader.Load(pathStr, typeHint, RESOURCE_LOADER_CACHE_MODE_CACHE_MODE_REUSE)
+	resource := loader.LoadOpt(pathStr, typeHint, RESOURCE_LOADER_CACHE_MODE_CACHE_MODE_REUSE)
 	if resource == nil {
 		return nil
 	}
@@ -306,23 +306,13 @@ func getClassDBSingleton() ClassDB {
 }
 
 func createSolidTexture(size int32, color Color) RefTexture2D {
-	imageFactoryObj := instantiateObject("Image")
-	imageFactory, ok := ObjectCastTo(imageFactoryObj, "Image").(Image)
-	if !ok || imageFactory == nil {
-		return nil
-	}
-	image := imageFactory.Create(size, size, false, IMAGE_FORMAT_FORMAT_RGBA_8)
+	image := ImageCreate(size, size, false, IMAGE_FORMAT_FORMAT_RGBA_8)
 	if image == nil {
 		return nil
 	}
 	image.TypedPtr().Fill(color)
 
-	textureFactoryObj := instantiateObject("ImageTexture")
-	textureFactory, ok := ObjectCastTo(textureFactoryObj, "ImageTexture").(ImageTexture)
-	if !ok || textureFactory == nil {
-		return nil
-	}
-	texture := textureFactory.CreateFromImage(image)
+	texture := ImageTextureCreateFromImage(image)
 	if texture == nil {
 		return nil
 	}
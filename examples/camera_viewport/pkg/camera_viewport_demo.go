@@ -141,7 +141,7 @@ func (d *CameraViewportDemo) connectViewportSignal() {
 	defer method.Destroy()
 	callable := NewCallableWithObjectStringName(d, method)
 	defer callable.Destroy()
-	viewport.Connect(signal, callable, 0)
+	viewport.Connect(signal, callable)
 }
 
 func NewCameraViewportDemoFromOwnerObject(owner *GodotObject) GDClass {
@@ -184,23 +184,13 @@ func getClassDBSingleton() ClassDB {
 }
 
 func createSolidTexture(size int32, color Color) RefTexture2D {
-	imageFactoryObj := instantiateObject("Image")
-	imageFactory, ok := ObjectCastTo(imageFactoryObj, "Image").(Image)
-	if !ok || imageFactory == nil {
-		return nil
-	}
-	image := imageFactory.Create(size, size, false, IMAGE_FORMAT_FORMAT_RGBA_8)
+	image := ImageCreate(size, size, false, IMAGE_FORMAT_FORMAT_RGBA_8)
 	if image == nil {
 		return nil
 	}
 	image.TypedPtr().Fill(color)
 
-	textureFactoryObj := instantiateObject("ImageTexture")
-	textureFactory, ok := ObjectCastTo(textureFactoryObj, "ImageTexture").(ImageTexture)
-	if !ok || textureFactory == nil {
-		return nil
-	}
-	texture := textureFactory.CreateFromImage(image)
+	texture := ImageTextureCreateFromImage(image)
 	if texture == nil {
 		return nil
 	}
@@ -28,16 +28,8 @@ func (h *HelloWorld) V_Ready() {
 	Print(msg)
 }
 
-func NewHelloWorldFromOwnerObject(owner *GodotObject) GDClass {
-	obj := &HelloWorld{}
-	obj.SetGodotObjectOwner(owner)
-	return obj
-}
-
 func RegisterClassHelloWorld() {
-	ClassDBRegisterClass(NewHelloWorldFromOwnerObject, nil, nil, func(t *HelloWorld) {
-		ClassDBBindMethodVirtual(t, "V_Ready", "_ready", nil, nil)
-	})
+	AutoRegister[*HelloWorld]()
 }
 
 func UnregisterClassHelloWorld() {
@@ -24,7 +24,7 @@ func (b *BouncingBall) GetParentClassName() string {
 
 func (b *BouncingBall) V_Ready() {
 	b.SetContinuousCollisionDetectionMode(RigidBody2DCCDModeContinuous)
-	b.ApplyCentralImpulse(NewVector2WithFloat32Float32(180, -240))
+	b.ApplyCentralImpulseOpt(NewVector2WithFloat32Float32(180, -240))
 }
 
 func NewBouncingBallFromOwnerObject(owner *GodotObject) GDClass {
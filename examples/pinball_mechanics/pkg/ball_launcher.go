@@ -40,7 +40,7 @@ func (b *BallLauncher) V_PhysicsProcess(_delta float64) {
 	if input == nil {
 		return
 	}
-	if input.IsActionJustPressed(b.actionName, true) {
+	if input.IsActionJustPressedOpt(b.actionName, true) {
 		b.launchBall()
 	}
 }
@@ -77,7 +77,7 @@ func (b *BallLauncher) cacheBall() {
 
 func (b *BallLauncher) launchBall() {
 	impulse := NewVector2WithFloat32Float32(0, -220)
-	b.ball.ApplyCentralImpulse(impulse)
+	b.ball.ApplyCentralImpulseOpt(impulse)
 }
 
 func (b *BallLauncher) resetBall() {
@@ -34,7 +34,7 @@ func (b *Bumper) V_Ready() {
 	defer method.Destroy()
 	callable := NewCallableWithObjectStringName(b, method)
 	defer callable.Destroy()
-	b.Connect(signal, callable, 0)
+	b.Connect(signal, callable)
 }
 
 func (b *Bumper) OnBodyEntered(body Node2D) {
@@ -49,7 +49,7 @@ func (b *Bumper) OnBodyEntered(body Node2D) {
 	to := rigidBody.GetGlobalPosition()
 	dir := from.DirectionTo(to)
 	impulse := NewVector2WithFloat32Float32(dir.MemberGetx()*b.strength, dir.MemberGety()*b.strength)
-	rigidBody.ApplyCentralImpulse(impulse)
+	rigidBody.ApplyCentralImpulseOpt(impulse)
 	b.emitBumped()
 }
 
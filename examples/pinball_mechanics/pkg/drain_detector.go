@@ -29,7 +29,7 @@ func (d *DrainDetector) V_Ready() {
 	defer method.Destroy()
 	callable := NewCallableWithObjectStringName(d, method)
 	defer callable.Destroy()
-	d.Connect(signal, callable, 0)
+	d.Connect(signal, callable)
 }
 
 func (d *DrainDetector) OnBodyEntered(body Node2D) {
@@ -43,7 +43,7 @@ func (f *FlipperController) V_PhysicsProcess(_delta float64) {
 	if input == nil {
 		return
 	}
-	if input.IsActionPressed(f.actionName, true) {
+	if input.IsActionPressedOpt(f.actionName, true) {
 		f.joint.SetMotorTargetVelocity(f.motorSpeed)
 	} else {
 		f.joint.SetMotorTargetVelocity(0)
@@ -87,9 +87,9 @@ func (f *FlipperController) cacheNodes() {
 		return
 	}
 
-	anchorPath := f.joint.GetPathTo(anchor, false)
+	anchorPath := f.joint.GetPathTo(anchor)
 	defer anchorPath.Destroy()
-	bodyPath := f.joint.GetPathTo(f.flipperBody, false)
+	bodyPath := f.joint.GetPathTo(f.flipperBody)
 	defer bodyPath.Destroy()
 	f.joint.SetNodeA(anchorPath)
 	f.joint.SetNodeB(bodyPath)
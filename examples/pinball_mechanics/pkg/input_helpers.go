@@ -66,7 +66,7 @@ func ensureAction(inputMap InputMap, name string, eventFactory func() RefInputEv
 	if inputMap.HasAction(action) {
 		return
 	}
-	inputMap.AddAction(action, 0.5)
+	inputMap.AddActionOpt(action, 0.5)
 	event := eventFactory()
 	if event != nil && event.IsValid() {
 		inputMap.ActionAddEvent(action, event)
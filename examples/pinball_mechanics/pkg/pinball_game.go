@@ -74,7 +74,7 @@ func connectSignalTo(target Object, node Node, signalName string, methodName str
 	defer method.Destroy()
 	callable := NewCallableWithObjectStringName(target, method)
 	defer callable.Destroy()
-	node.Connect(signal, callable, 0)
+	node.Connect(signal, callable)
 }
 
 func NewPinballGameFromOwnerObject(owner *GodotObject) GDClass {
@@ -1,8 +1,9 @@
 package pkg
 
 import (
+	"fmt"
+
 	. "github.com/godot-go/godot-go/pkg/builtin"
-	. "github.com/godot-go/godot-go/pkg/constant"
 	. "github.com/godot-go/godot-go/pkg/core"
 	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
 	. "github.com/godot-go/godot-go/pkg/gdutilfunc"
@@ -13,6 +14,7 @@ var _ GDClass = (*SignalEmitter)(nil)
 
 type SignalEmitter struct {
 	NodeImpl
+	DemoSignal Signal2[string, int64] `signal:"demo_signal,message,count"`
 }
 
 func (s *SignalEmitter) GetClassName() string {
@@ -30,20 +32,19 @@ func (s *SignalEmitter) V_Ready() {
 		return
 	}
 
-	signalName := NewStringNameWithLatin1Chars("demo_signal")
-	defer signalName.Destroy()
 	methodName := NewStringNameWithLatin1Chars("_on_demo_signal")
 	defer methodName.Destroy()
 	callable := NewCallableWithObjectStringName(listener, methodName)
 	defer callable.Destroy()
+	signalName := NewStringNameWithLatin1Chars(s.DemoSignal.Name())
+	defer signalName.Destroy()
+	s.Connect(signalName, callable)
 
-	s.Connect(signalName, callable, 0)
+	s.DemoSignal.Connect(func(message string, count int64) {
+		printLine(fmt.Sprintf("SignalEmitter: closure received %s (%d)", message, count))
+	})
 
-	arg0 := NewVariantGoString("hello")
-	defer arg0.Destroy()
-	arg1 := NewVariantInt64(1)
-	defer arg1.Destroy()
-	s.EmitSignal(signalName, arg0, arg1)
+	s.DemoSignal.Emit("hello", 1)
 }
 
 func (s *SignalEmitter) getListener() Node {
@@ -60,10 +61,6 @@ func NewSignalEmitterFromOwnerObject(owner *GodotObject) GDClass {
 
 func RegisterClassSignalEmitter() {
 	ClassDBRegisterClass(NewSignalEmitterFromOwnerObject, nil, nil, func(t *SignalEmitter) {
-		ClassDBAddSignal(t, "demo_signal",
-			SignalParam{Type: GDEXTENSION_VARIANT_TYPE_STRING, Name: "message"},
-			SignalParam{Type: GDEXTENSION_VARIANT_TYPE_INT, Name: "count"},
-		)
 		ClassDBBindMethodVirtual(t, "V_Ready", "_ready", nil, nil)
 	})
 }
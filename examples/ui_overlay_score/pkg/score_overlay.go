@@ -40,8 +40,8 @@ func (s *ScoreOverlay) V_Ready() {
 	defer method.Destroy()
 	callable := NewCallableWithObjectStringName(s, method)
 	defer callable.Destroy()
-	timerNode.Connect(signal, callable, 0)
-	timerNode.Start(1)
+	timerNode.Connect(signal, callable)
+	timerNode.StartOpt(1)
 }
 
 func (s *ScoreOverlay) OnTimerTimeout() {
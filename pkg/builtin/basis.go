@@ -0,0 +1,33 @@
+package builtin
+
+// X returns the first column of the basis, its x axis, like basis.x in
+// GDScript.
+func (cx *Basis) X() Vector3 {
+	return Vector3{cx.Rows[0].X, cx.Rows[1].X, cx.Rows[2].X}
+}
+
+// Y returns the second column of the basis, its y axis.
+func (cx *Basis) Y() Vector3 {
+	return Vector3{cx.Rows[0].Y, cx.Rows[1].Y, cx.Rows[2].Y}
+}
+
+// Z returns the third column of the basis, its z axis.
+func (cx *Basis) Z() Vector3 {
+	return Vector3{cx.Rows[0].Z, cx.Rows[1].Z, cx.Rows[2].Z}
+}
+
+// SetX sets the first column of the basis, like assigning basis.x in
+// GDScript.
+func (cx *Basis) SetX(v Vector3) {
+	cx.Rows[0].X, cx.Rows[1].X, cx.Rows[2].X = v.X, v.Y, v.Z
+}
+
+// SetY sets the second column of the basis.
+func (cx *Basis) SetY(v Vector3) {
+	cx.Rows[0].Y, cx.Rows[1].Y, cx.Rows[2].Y = v.X, v.Y, v.Z
+}
+
+// SetZ sets the third column of the basis.
+func (cx *Basis) SetZ(v Vector3) {
+	cx.Rows[0].Z, cx.Rows[1].Z, cx.Rows[2].Z = v.X, v.Y, v.Z
+}
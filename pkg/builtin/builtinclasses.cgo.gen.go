@@ -0,0 +1,3452 @@
+//go:build cgomath
+
+package builtin
+
+/*------------------------------------------------------------------------------
+//   This code was generated by template builtinclasses.cgo.go.tmpl.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "builtinclasses.cgo.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+//revive:disable
+
+// Methods and operators that have a pure Go implementation in
+// builtinclasses.native.gen.go. Building with the cgomath tag routes them
+// through the engine instead.
+
+// #include <godot/gdextension_interface.h>
+// #include <stdio.h>
+// #include <stdlib.h>
+import "C"
+import (
+	"runtime"
+
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+)
+
+/*
+ * Vector2
+ */
+
+/* DirectionTo : direction_to
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) DirectionTo(to Vector2) Vector2 {
+	mb := globalVector2MethodBindings.method_direction_to
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* DistanceTo : distance_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) DistanceTo(to Vector2) float32 {
+	mb := globalVector2MethodBindings.method_distance_to
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* DistanceSquaredTo : distance_squared_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) DistanceSquaredTo(to Vector2) float32 {
+	mb := globalVector2MethodBindings.method_distance_squared_to
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Length : length
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) Length() float32 {
+	mb := globalVector2MethodBindings.method_length
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* LengthSquared : length_squared
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) LengthSquared() float32 {
+	mb := globalVector2MethodBindings.method_length_squared
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* LimitLength : limit_length
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) LimitLength(length float32) Vector2 {
+	mb := globalVector2MethodBindings.method_limit_length
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(length)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Normalized : normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Normalized() Vector2 {
+	mb := globalVector2MethodBindings.method_normalized
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* IsNormalized : is_normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector2) IsNormalized() bool {
+	mb := globalVector2MethodBindings.method_is_normalized
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector2) IsEqualApprox(to Vector2) bool {
+	mb := globalVector2MethodBindings.method_is_equal_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* IsZeroApprox : is_zero_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector2) IsZeroApprox() bool {
+	mb := globalVector2MethodBindings.method_is_zero_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector2) IsFinite() bool {
+	mb := globalVector2MethodBindings.method_is_finite
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* Project : project
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Project(b Vector2) Vector2 {
+	mb := globalVector2MethodBindings.method_project
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&b)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Lerp : lerp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Lerp(to Vector2, weight float32) Vector2 {
+	mb := globalVector2MethodBindings.method_lerp
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	args[1] = Float32Encoder.EncodeTypePtr(weight)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* MoveToward : move_toward
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) MoveToward(to Vector2, delta float32) Vector2 {
+	mb := globalVector2MethodBindings.method_move_toward
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	args[1] = Float32Encoder.EncodeTypePtr(delta)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Orthogonal : orthogonal
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Orthogonal() Vector2 {
+	mb := globalVector2MethodBindings.method_orthogonal
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* Floor : floor
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Floor() Vector2 {
+	mb := globalVector2MethodBindings.method_floor
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* Ceil : ceil
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Ceil() Vector2 {
+	mb := globalVector2MethodBindings.method_ceil
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* Round : round
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Round() Vector2 {
+	mb := globalVector2MethodBindings.method_round
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* Aspect : aspect
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) Aspect() float32 {
+	mb := globalVector2MethodBindings.method_aspect
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* Dot : dot
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) Dot(with Vector2) float32 {
+	mb := globalVector2MethodBindings.method_dot
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Cross : cross
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) Cross(with Vector2) float32 {
+	mb := globalVector2MethodBindings.method_cross
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Abs : abs
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Abs() Vector2 {
+	mb := globalVector2MethodBindings.method_abs
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* Sign : sign
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Sign() Vector2 {
+	mb := globalVector2MethodBindings.method_sign
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* Clamp : clamp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Clamp(min Vector2, max Vector2) Vector2 {
+	mb := globalVector2MethodBindings.method_clamp
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&min)
+
+	args[1] = (GDExtensionTypePtr)(&max)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Clampf : clampf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Clampf(min float32, max float32) Vector2 {
+	mb := globalVector2MethodBindings.method_clampf
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(min)
+
+	args[1] = Float32Encoder.EncodeTypePtr(max)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Min : min
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Min(with Vector2) Vector2 {
+	mb := globalVector2MethodBindings.method_min
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Minf : minf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Minf(with float32) Vector2 {
+	mb := globalVector2MethodBindings.method_minf
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(with)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Max : max
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Max(with Vector2) Vector2 {
+	mb := globalVector2MethodBindings.method_max
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Maxf : maxf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Maxf(with float32) Vector2 {
+	mb := globalVector2MethodBindings.method_maxf
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(with)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+// Negate operator
+func (cx *Vector2) Negate() Vector2 {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_negate, lt, rt)
+}
+
+// Positive operator
+func (cx *Vector2) Positive() Vector2 {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_positive, lt, rt)
+}
+
+// Multiply_int operator
+func (cx *Vector2) Multiply_int(right int64) Vector2 {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_multiply_int, lt, rt)
+}
+
+// Divide_int operator
+func (cx *Vector2) Divide_int(right int64) Vector2 {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_divide_int, lt, rt)
+}
+
+// Multiply_float operator
+func (cx *Vector2) Multiply_float(right float32) Vector2 {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_multiply_float, lt, rt)
+}
+
+// Divide_float operator
+func (cx *Vector2) Divide_float(right float32) Vector2 {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_divide_float, lt, rt)
+}
+
+// Equal_Vector2 operator
+func (cx *Vector2) Equal_Vector2(right Vector2) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_equal_Vector2, lt, rt)
+}
+
+// Not_equal_Vector2 operator
+func (cx *Vector2) Not_equal_Vector2(right Vector2) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_not_equal_Vector2, lt, rt)
+}
+
+// Less_Vector2 operator
+func (cx *Vector2) Less_Vector2(right Vector2) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_less_Vector2, lt, rt)
+}
+
+// Less_equal_Vector2 operator
+func (cx *Vector2) Less_equal_Vector2(right Vector2) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_less_equal_Vector2, lt, rt)
+}
+
+// Greater_Vector2 operator
+func (cx *Vector2) Greater_Vector2(right Vector2) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_greater_Vector2, lt, rt)
+}
+
+// Greater_equal_Vector2 operator
+func (cx *Vector2) Greater_equal_Vector2(right Vector2) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_greater_equal_Vector2, lt, rt)
+}
+
+// Add_Vector2 operator
+func (cx *Vector2) Add_Vector2(right Vector2) Vector2 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_add_Vector2, lt, rt)
+}
+
+// Subtract_Vector2 operator
+func (cx *Vector2) Subtract_Vector2(right Vector2) Vector2 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_subtract_Vector2, lt, rt)
+}
+
+// Multiply_Vector2 operator
+func (cx *Vector2) Multiply_Vector2(right Vector2) Vector2 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_multiply_Vector2, lt, rt)
+}
+
+// Divide_Vector2 operator
+func (cx *Vector2) Divide_Vector2(right Vector2) Vector2 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_divide_Vector2, lt, rt)
+}
+
+// Multiply_Transform2D operator
+func (cx *Vector2) Multiply_Transform2D(right Transform2D) Vector2 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_multiply_Transform2D, lt, rt)
+}
+
+/*
+ * Rect2
+ */
+
+/* GetCenter : get_center
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Rect2) GetCenter() Vector2 {
+	mb := globalRect2MethodBindings.method_get_center
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* GetArea : get_area
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Rect2) GetArea() float32 {
+	mb := globalRect2MethodBindings.method_get_area
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* HasArea : has_area
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) HasArea() bool {
+	mb := globalRect2MethodBindings.method_has_area
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* HasPoint : has_point
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) HasPoint(point Vector2) bool {
+	mb := globalRect2MethodBindings.method_has_point
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&point)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) IsEqualApprox(rect Rect2) bool {
+	mb := globalRect2MethodBindings.method_is_equal_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Rect2Encoder.EncodeTypePtr(rect)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) IsFinite() bool {
+	mb := globalRect2MethodBindings.method_is_finite
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* Intersects : intersects
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) Intersects(b Rect2, include_borders bool) bool {
+	mb := globalRect2MethodBindings.method_intersects
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Rect2Encoder.EncodeTypePtr(b)
+
+	args[1] = BoolEncoder.EncodeTypePtr(include_borders)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Encloses : encloses
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) Encloses(b Rect2) bool {
+	mb := globalRect2MethodBindings.method_encloses
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Rect2Encoder.EncodeTypePtr(b)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Intersection : intersection
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) Intersection(b Rect2) Rect2 {
+	mb := globalRect2MethodBindings.method_intersection
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Rect2Encoder.EncodeTypePtr(b)
+
+	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Merge : merge
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) Merge(b Rect2) Rect2 {
+	mb := globalRect2MethodBindings.method_merge
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Rect2Encoder.EncodeTypePtr(b)
+
+	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Expand : expand
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) Expand(to Vector2) Rect2 {
+	mb := globalRect2MethodBindings.method_expand
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Grow : grow
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) Grow(amount float32) Rect2 {
+	mb := globalRect2MethodBindings.method_grow
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(amount)
+
+	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* GrowIndividual : grow_individual
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) GrowIndividual(left float32, top float32, right float32, bottom float32) Rect2 {
+	mb := globalRect2MethodBindings.method_grow_individual
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 4
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(left)
+
+	args[1] = Float32Encoder.EncodeTypePtr(top)
+
+	args[2] = Float32Encoder.EncodeTypePtr(right)
+
+	args[3] = Float32Encoder.EncodeTypePtr(bottom)
+
+	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Abs : abs
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) Abs() Rect2 {
+	mb := globalRect2MethodBindings.method_abs
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, nil)
+	return ret
+}
+
+// Equal_Rect2 operator
+func (cx *Rect2) Equal_Rect2(right Rect2) bool {
+	lt := cx.NativeConstPtr()
+	eRight := Rect2Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_equal_Rect2, lt, rt)
+}
+
+// Not_equal_Rect2 operator
+func (cx *Rect2) Not_equal_Rect2(right Rect2) bool {
+	lt := cx.NativeConstPtr()
+	eRight := Rect2Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_not_equal_Rect2, lt, rt)
+}
+
+/*
+ * Vector3
+ */
+
+/* DirectionTo : direction_to
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) DirectionTo(to Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_direction_to
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* DistanceTo : distance_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector3) DistanceTo(to Vector3) float32 {
+	mb := globalVector3MethodBindings.method_distance_to
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* DistanceSquaredTo : distance_squared_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector3) DistanceSquaredTo(to Vector3) float32 {
+	mb := globalVector3MethodBindings.method_distance_squared_to
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Length : length
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector3) Length() float32 {
+	mb := globalVector3MethodBindings.method_length
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* LengthSquared : length_squared
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector3) LengthSquared() float32 {
+	mb := globalVector3MethodBindings.method_length_squared
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* LimitLength : limit_length
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) LimitLength(length float32) Vector3 {
+	mb := globalVector3MethodBindings.method_limit_length
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(length)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Normalized : normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Normalized() Vector3 {
+	mb := globalVector3MethodBindings.method_normalized
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
+	return ret
+}
+
+/* IsNormalized : is_normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector3) IsNormalized() bool {
+	mb := globalVector3MethodBindings.method_is_normalized
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector3) IsEqualApprox(to Vector3) bool {
+	mb := globalVector3MethodBindings.method_is_equal_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* IsZeroApprox : is_zero_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector3) IsZeroApprox() bool {
+	mb := globalVector3MethodBindings.method_is_zero_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector3) IsFinite() bool {
+	mb := globalVector3MethodBindings.method_is_finite
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* Inverse : inverse
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Inverse() Vector3 {
+	mb := globalVector3MethodBindings.method_inverse
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
+	return ret
+}
+
+/* Clamp : clamp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Clamp(min Vector3, max Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_clamp
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&min)
+
+	args[1] = (GDExtensionTypePtr)(&max)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Clampf : clampf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Clampf(min float32, max float32) Vector3 {
+	mb := globalVector3MethodBindings.method_clampf
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(min)
+
+	args[1] = Float32Encoder.EncodeTypePtr(max)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Lerp : lerp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Lerp(to Vector3, weight float32) Vector3 {
+	mb := globalVector3MethodBindings.method_lerp
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	args[1] = Float32Encoder.EncodeTypePtr(weight)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* MoveToward : move_toward
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) MoveToward(to Vector3, delta float32) Vector3 {
+	mb := globalVector3MethodBindings.method_move_toward
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	args[1] = Float32Encoder.EncodeTypePtr(delta)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Dot : dot
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector3) Dot(with Vector3) float32 {
+	mb := globalVector3MethodBindings.method_dot
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Cross : cross
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Cross(with Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_cross
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Outer : outer
+ * is_vararg = false, is_static = false
+ * goReturnType(Basis) -> Basis
+ */
+func (cx *Vector3) Outer(with Vector3) Basis {
+	mb := globalVector3MethodBindings.method_outer
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Abs : abs
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Abs() Vector3 {
+	mb := globalVector3MethodBindings.method_abs
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
+	return ret
+}
+
+/* Floor : floor
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Floor() Vector3 {
+	mb := globalVector3MethodBindings.method_floor
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
+	return ret
+}
+
+/* Ceil : ceil
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Ceil() Vector3 {
+	mb := globalVector3MethodBindings.method_ceil
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
+	return ret
+}
+
+/* Round : round
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Round() Vector3 {
+	mb := globalVector3MethodBindings.method_round
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
+	return ret
+}
+
+/* Project : project
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Project(b Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_project
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&b)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Sign : sign
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Sign() Vector3 {
+	mb := globalVector3MethodBindings.method_sign
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
+	return ret
+}
+
+/* Min : min
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Min(with Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_min
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Minf : minf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Minf(with float32) Vector3 {
+	mb := globalVector3MethodBindings.method_minf
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(with)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Max : max
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Max(with Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_max
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Maxf : maxf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Maxf(with float32) Vector3 {
+	mb := globalVector3MethodBindings.method_maxf
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(with)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+// Negate operator
+func (cx *Vector3) Negate() Vector3 {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_negate, lt, rt)
+}
+
+// Positive operator
+func (cx *Vector3) Positive() Vector3 {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_positive, lt, rt)
+}
+
+// Multiply_int operator
+func (cx *Vector3) Multiply_int(right int64) Vector3 {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_int, lt, rt)
+}
+
+// Divide_int operator
+func (cx *Vector3) Divide_int(right int64) Vector3 {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_divide_int, lt, rt)
+}
+
+// Multiply_float operator
+func (cx *Vector3) Multiply_float(right float32) Vector3 {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_float, lt, rt)
+}
+
+// Divide_float operator
+func (cx *Vector3) Divide_float(right float32) Vector3 {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_divide_float, lt, rt)
+}
+
+// Equal_Vector3 operator
+func (cx *Vector3) Equal_Vector3(right Vector3) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_equal_Vector3, lt, rt)
+}
+
+// Not_equal_Vector3 operator
+func (cx *Vector3) Not_equal_Vector3(right Vector3) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_not_equal_Vector3, lt, rt)
+}
+
+// Less_Vector3 operator
+func (cx *Vector3) Less_Vector3(right Vector3) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_less_Vector3, lt, rt)
+}
+
+// Less_equal_Vector3 operator
+func (cx *Vector3) Less_equal_Vector3(right Vector3) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_less_equal_Vector3, lt, rt)
+}
+
+// Greater_Vector3 operator
+func (cx *Vector3) Greater_Vector3(right Vector3) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_greater_Vector3, lt, rt)
+}
+
+// Greater_equal_Vector3 operator
+func (cx *Vector3) Greater_equal_Vector3(right Vector3) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_greater_equal_Vector3, lt, rt)
+}
+
+// Add_Vector3 operator
+func (cx *Vector3) Add_Vector3(right Vector3) Vector3 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_add_Vector3, lt, rt)
+}
+
+// Subtract_Vector3 operator
+func (cx *Vector3) Subtract_Vector3(right Vector3) Vector3 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_subtract_Vector3, lt, rt)
+}
+
+// Multiply_Vector3 operator
+func (cx *Vector3) Multiply_Vector3(right Vector3) Vector3 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_Vector3, lt, rt)
+}
+
+// Divide_Vector3 operator
+func (cx *Vector3) Divide_Vector3(right Vector3) Vector3 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_divide_Vector3, lt, rt)
+}
+
+// Multiply_Basis operator
+func (cx *Vector3) Multiply_Basis(right Basis) Vector3 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_Basis, lt, rt)
+}
+
+/*
+ * Transform2D
+ */
+
+/* Inverse : inverse
+ * is_vararg = false, is_static = false
+ * goReturnType(Transform2D) -> Transform2D
+ */
+func (cx *Transform2D) Inverse() Transform2D {
+	mb := globalTransform2DMethodBindings.method_inverse
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, nil)
+	return ret
+}
+
+/* GetOrigin : get_origin
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Transform2D) GetOrigin() Vector2 {
+	mb := globalTransform2DMethodBindings.method_get_origin
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* GetScale : get_scale
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Transform2D) GetScale() Vector2 {
+	mb := globalTransform2DMethodBindings.method_get_scale
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* Scaled : scaled
+ * is_vararg = false, is_static = false
+ * goReturnType(Transform2D) -> Transform2D
+ */
+func (cx *Transform2D) Scaled(scale Vector2) Transform2D {
+	mb := globalTransform2DMethodBindings.method_scaled
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&scale)
+
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* ScaledLocal : scaled_local
+ * is_vararg = false, is_static = false
+ * goReturnType(Transform2D) -> Transform2D
+ */
+func (cx *Transform2D) ScaledLocal(scale Vector2) Transform2D {
+	mb := globalTransform2DMethodBindings.method_scaled_local
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&scale)
+
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Translated : translated
+ * is_vararg = false, is_static = false
+ * goReturnType(Transform2D) -> Transform2D
+ */
+func (cx *Transform2D) Translated(offset Vector2) Transform2D {
+	mb := globalTransform2DMethodBindings.method_translated
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&offset)
+
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* TranslatedLocal : translated_local
+ * is_vararg = false, is_static = false
+ * goReturnType(Transform2D) -> Transform2D
+ */
+func (cx *Transform2D) TranslatedLocal(offset Vector2) Transform2D {
+	mb := globalTransform2DMethodBindings.method_translated_local
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&offset)
+
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Determinant : determinant
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Transform2D) Determinant() float32 {
+	mb := globalTransform2DMethodBindings.method_determinant
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* BasisXform : basis_xform
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Transform2D) BasisXform(v Vector2) Vector2 {
+	mb := globalTransform2DMethodBindings.method_basis_xform
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&v)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* BasisXformInv : basis_xform_inv
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Transform2D) BasisXformInv(v Vector2) Vector2 {
+	mb := globalTransform2DMethodBindings.method_basis_xform_inv
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&v)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Transform2D) IsEqualApprox(xform Transform2D) bool {
+	mb := globalTransform2DMethodBindings.method_is_equal_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&xform)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Transform2D) IsFinite() bool {
+	mb := globalTransform2DMethodBindings.method_is_finite
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+// Multiply_int operator
+func (cx *Transform2D) Multiply_int(right int64) Transform2D {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Transform2D](globalTransform2DMethodBindings.operator_multiply_int, lt, rt)
+}
+
+// Divide_int operator
+func (cx *Transform2D) Divide_int(right int64) Transform2D {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Transform2D](globalTransform2DMethodBindings.operator_divide_int, lt, rt)
+}
+
+// Multiply_float operator
+func (cx *Transform2D) Multiply_float(right float32) Transform2D {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Transform2D](globalTransform2DMethodBindings.operator_multiply_float, lt, rt)
+}
+
+// Divide_float operator
+func (cx *Transform2D) Divide_float(right float32) Transform2D {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Transform2D](globalTransform2DMethodBindings.operator_divide_float, lt, rt)
+}
+
+// Multiply_Vector2 operator
+func (cx *Transform2D) Multiply_Vector2(right Vector2) Vector2 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector2](globalTransform2DMethodBindings.operator_multiply_Vector2, lt, rt)
+}
+
+// Equal_Transform2D operator
+func (cx *Transform2D) Equal_Transform2D(right Transform2D) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_equal_Transform2D, lt, rt)
+}
+
+// Not_equal_Transform2D operator
+func (cx *Transform2D) Not_equal_Transform2D(right Transform2D) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_not_equal_Transform2D, lt, rt)
+}
+
+// Multiply_Transform2D operator
+func (cx *Transform2D) Multiply_Transform2D(right Transform2D) Transform2D {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Transform2D](globalTransform2DMethodBindings.operator_multiply_Transform2D, lt, rt)
+}
+
+/*
+ * Vector4
+ */
+
+/* Length : length
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector4) Length() float32 {
+	mb := globalVector4MethodBindings.method_length
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* LengthSquared : length_squared
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector4) LengthSquared() float32 {
+	mb := globalVector4MethodBindings.method_length_squared
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* Abs : abs
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Abs() Vector4 {
+	mb := globalVector4MethodBindings.method_abs
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	return ret
+}
+
+/* Sign : sign
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Sign() Vector4 {
+	mb := globalVector4MethodBindings.method_sign
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	return ret
+}
+
+/* Floor : floor
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Floor() Vector4 {
+	mb := globalVector4MethodBindings.method_floor
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	return ret
+}
+
+/* Ceil : ceil
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Ceil() Vector4 {
+	mb := globalVector4MethodBindings.method_ceil
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	return ret
+}
+
+/* Round : round
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Round() Vector4 {
+	mb := globalVector4MethodBindings.method_round
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	return ret
+}
+
+/* Lerp : lerp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Lerp(to Vector4, weight float32) Vector4 {
+	mb := globalVector4MethodBindings.method_lerp
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	args[1] = Float32Encoder.EncodeTypePtr(weight)
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Clamp : clamp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Clamp(min Vector4, max Vector4) Vector4 {
+	mb := globalVector4MethodBindings.method_clamp
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&min)
+
+	args[1] = (GDExtensionTypePtr)(&max)
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Clampf : clampf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Clampf(min float32, max float32) Vector4 {
+	mb := globalVector4MethodBindings.method_clampf
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(min)
+
+	args[1] = Float32Encoder.EncodeTypePtr(max)
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Normalized : normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Normalized() Vector4 {
+	mb := globalVector4MethodBindings.method_normalized
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	return ret
+}
+
+/* IsNormalized : is_normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector4) IsNormalized() bool {
+	mb := globalVector4MethodBindings.method_is_normalized
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* DirectionTo : direction_to
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) DirectionTo(to Vector4) Vector4 {
+	mb := globalVector4MethodBindings.method_direction_to
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* DistanceTo : distance_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector4) DistanceTo(to Vector4) float32 {
+	mb := globalVector4MethodBindings.method_distance_to
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* DistanceSquaredTo : distance_squared_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector4) DistanceSquaredTo(to Vector4) float32 {
+	mb := globalVector4MethodBindings.method_distance_squared_to
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Dot : dot
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector4) Dot(with Vector4) float32 {
+	mb := globalVector4MethodBindings.method_dot
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Inverse : inverse
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Inverse() Vector4 {
+	mb := globalVector4MethodBindings.method_inverse
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	return ret
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector4) IsEqualApprox(to Vector4) bool {
+	mb := globalVector4MethodBindings.method_is_equal_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* IsZeroApprox : is_zero_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector4) IsZeroApprox() bool {
+	mb := globalVector4MethodBindings.method_is_zero_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector4) IsFinite() bool {
+	mb := globalVector4MethodBindings.method_is_finite
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* Min : min
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Min(with Vector4) Vector4 {
+	mb := globalVector4MethodBindings.method_min
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Minf : minf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Minf(with float32) Vector4 {
+	mb := globalVector4MethodBindings.method_minf
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(with)
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Max : max
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Max(with Vector4) Vector4 {
+	mb := globalVector4MethodBindings.method_max
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Maxf : maxf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Maxf(with float32) Vector4 {
+	mb := globalVector4MethodBindings.method_maxf
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(with)
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+// Negate operator
+func (cx *Vector4) Negate() Vector4 {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_negate, lt, rt)
+}
+
+// Positive operator
+func (cx *Vector4) Positive() Vector4 {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_positive, lt, rt)
+}
+
+// Multiply_int operator
+func (cx *Vector4) Multiply_int(right int64) Vector4 {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_multiply_int, lt, rt)
+}
+
+// Divide_int operator
+func (cx *Vector4) Divide_int(right int64) Vector4 {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_divide_int, lt, rt)
+}
+
+// Multiply_float operator
+func (cx *Vector4) Multiply_float(right float32) Vector4 {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_multiply_float, lt, rt)
+}
+
+// Divide_float operator
+func (cx *Vector4) Divide_float(right float32) Vector4 {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_divide_float, lt, rt)
+}
+
+// Equal_Vector4 operator
+func (cx *Vector4) Equal_Vector4(right Vector4) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_equal_Vector4, lt, rt)
+}
+
+// Not_equal_Vector4 operator
+func (cx *Vector4) Not_equal_Vector4(right Vector4) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_not_equal_Vector4, lt, rt)
+}
+
+// Less_Vector4 operator
+func (cx *Vector4) Less_Vector4(right Vector4) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_less_Vector4, lt, rt)
+}
+
+// Less_equal_Vector4 operator
+func (cx *Vector4) Less_equal_Vector4(right Vector4) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_less_equal_Vector4, lt, rt)
+}
+
+// Greater_Vector4 operator
+func (cx *Vector4) Greater_Vector4(right Vector4) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_greater_Vector4, lt, rt)
+}
+
+// Greater_equal_Vector4 operator
+func (cx *Vector4) Greater_equal_Vector4(right Vector4) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_greater_equal_Vector4, lt, rt)
+}
+
+// Add_Vector4 operator
+func (cx *Vector4) Add_Vector4(right Vector4) Vector4 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_add_Vector4, lt, rt)
+}
+
+// Subtract_Vector4 operator
+func (cx *Vector4) Subtract_Vector4(right Vector4) Vector4 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_subtract_Vector4, lt, rt)
+}
+
+// Multiply_Vector4 operator
+func (cx *Vector4) Multiply_Vector4(right Vector4) Vector4 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_multiply_Vector4, lt, rt)
+}
+
+// Divide_Vector4 operator
+func (cx *Vector4) Divide_Vector4(right Vector4) Vector4 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_divide_Vector4, lt, rt)
+}
+
+/*
+ * Quaternion
+ */
+
+/* Length : length
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Quaternion) Length() float32 {
+	mb := globalQuaternionMethodBindings.method_length
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* LengthSquared : length_squared
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Quaternion) LengthSquared() float32 {
+	mb := globalQuaternionMethodBindings.method_length_squared
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* Normalized : normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(Quaternion) -> Quaternion
+ */
+func (cx *Quaternion) Normalized() Quaternion {
+	mb := globalQuaternionMethodBindings.method_normalized
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Quaternion](mb, bx, nil)
+	return ret
+}
+
+/* IsNormalized : is_normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Quaternion) IsNormalized() bool {
+	mb := globalQuaternionMethodBindings.method_is_normalized
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Quaternion) IsEqualApprox(to Quaternion) bool {
+	mb := globalQuaternionMethodBindings.method_is_equal_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Quaternion) IsFinite() bool {
+	mb := globalQuaternionMethodBindings.method_is_finite
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+/* Dot : dot
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Quaternion) Dot(with Quaternion) float32 {
+	mb := globalQuaternionMethodBindings.method_dot
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* GetAxis : get_axis
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Quaternion) GetAxis() Vector3 {
+	mb := globalQuaternionMethodBindings.method_get_axis
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
+	return ret
+}
+
+// Negate operator
+func (cx *Quaternion) Negate() Quaternion {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_negate, lt, rt)
+}
+
+// Positive operator
+func (cx *Quaternion) Positive() Quaternion {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_positive, lt, rt)
+}
+
+// Multiply_int operator
+func (cx *Quaternion) Multiply_int(right int64) Quaternion {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_multiply_int, lt, rt)
+}
+
+// Divide_int operator
+func (cx *Quaternion) Divide_int(right int64) Quaternion {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_divide_int, lt, rt)
+}
+
+// Multiply_float operator
+func (cx *Quaternion) Multiply_float(right float32) Quaternion {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_multiply_float, lt, rt)
+}
+
+// Divide_float operator
+func (cx *Quaternion) Divide_float(right float32) Quaternion {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_divide_float, lt, rt)
+}
+
+// Equal_Quaternion operator
+func (cx *Quaternion) Equal_Quaternion(right Quaternion) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalQuaternionMethodBindings.operator_equal_Quaternion, lt, rt)
+}
+
+// Not_equal_Quaternion operator
+func (cx *Quaternion) Not_equal_Quaternion(right Quaternion) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalQuaternionMethodBindings.operator_not_equal_Quaternion, lt, rt)
+}
+
+// Add_Quaternion operator
+func (cx *Quaternion) Add_Quaternion(right Quaternion) Quaternion {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_add_Quaternion, lt, rt)
+}
+
+// Subtract_Quaternion operator
+func (cx *Quaternion) Subtract_Quaternion(right Quaternion) Quaternion {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_subtract_Quaternion, lt, rt)
+}
+
+// Multiply_Quaternion operator
+func (cx *Quaternion) Multiply_Quaternion(right Quaternion) Quaternion {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_multiply_Quaternion, lt, rt)
+}
+
+/*
+ * Basis
+ */
+
+/* Transposed : transposed
+ * is_vararg = false, is_static = false
+ * goReturnType(Basis) -> Basis
+ */
+func (cx *Basis) Transposed() Basis {
+	mb := globalBasisMethodBindings.method_transposed
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, nil)
+	return ret
+}
+
+/* Determinant : determinant
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Basis) Determinant() float32 {
+	mb := globalBasisMethodBindings.method_determinant
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* Scaled : scaled
+ * is_vararg = false, is_static = false
+ * goReturnType(Basis) -> Basis
+ */
+func (cx *Basis) Scaled(scale Vector3) Basis {
+	mb := globalBasisMethodBindings.method_scaled
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&scale)
+
+	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* GetScale : get_scale
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Basis) GetScale() Vector3 {
+	mb := globalBasisMethodBindings.method_get_scale
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
+	return ret
+}
+
+/* Tdotx : tdotx
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Basis) Tdotx(with Vector3) float32 {
+	mb := globalBasisMethodBindings.method_tdotx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Tdoty : tdoty
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Basis) Tdoty(with Vector3) float32 {
+	mb := globalBasisMethodBindings.method_tdoty
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Tdotz : tdotz
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Basis) Tdotz(with Vector3) float32 {
+	mb := globalBasisMethodBindings.method_tdotz
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Basis) IsEqualApprox(b Basis) bool {
+	mb := globalBasisMethodBindings.method_is_equal_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&b)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Basis) IsFinite() bool {
+	mb := globalBasisMethodBindings.method_is_finite
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	return ret
+}
+
+// Multiply_int operator
+func (cx *Basis) Multiply_int(right int64) Basis {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Basis](globalBasisMethodBindings.operator_multiply_int, lt, rt)
+}
+
+// Divide_int operator
+func (cx *Basis) Divide_int(right int64) Basis {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Basis](globalBasisMethodBindings.operator_divide_int, lt, rt)
+}
+
+// Multiply_float operator
+func (cx *Basis) Multiply_float(right float32) Basis {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Basis](globalBasisMethodBindings.operator_multiply_float, lt, rt)
+}
+
+// Divide_float operator
+func (cx *Basis) Divide_float(right float32) Basis {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Basis](globalBasisMethodBindings.operator_divide_float, lt, rt)
+}
+
+// Multiply_Vector3 operator
+func (cx *Basis) Multiply_Vector3(right Vector3) Vector3 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3](globalBasisMethodBindings.operator_multiply_Vector3, lt, rt)
+}
+
+// Equal_Basis operator
+func (cx *Basis) Equal_Basis(right Basis) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalBasisMethodBindings.operator_equal_Basis, lt, rt)
+}
+
+// Not_equal_Basis operator
+func (cx *Basis) Not_equal_Basis(right Basis) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalBasisMethodBindings.operator_not_equal_Basis, lt, rt)
+}
+
+// Multiply_Basis operator
+func (cx *Basis) Multiply_Basis(right Basis) Basis {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Basis](globalBasisMethodBindings.operator_multiply_Basis, lt, rt)
+}
+
+/*
+ * Color
+ */
+
+/* Clamp : clamp
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Clamp(min Color, max Color) Color {
+	mb := globalColorMethodBindings.method_clamp
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&min)
+
+	args[1] = (GDExtensionTypePtr)(&max)
+
+	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Inverted : inverted
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Inverted() Color {
+	mb := globalColorMethodBindings.method_inverted
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[Color](mb, bx, nil)
+	return ret
+}
+
+/* Lerp : lerp
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Lerp(to Color, weight float32) Color {
+	mb := globalColorMethodBindings.method_lerp
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	args[1] = Float32Encoder.EncodeTypePtr(weight)
+
+	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Lightened : lightened
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Lightened(amount float32) Color {
+	mb := globalColorMethodBindings.method_lightened
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(amount)
+
+	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Darkened : darkened
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Darkened(amount float32) Color {
+	mb := globalColorMethodBindings.method_darkened
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(amount)
+
+	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* Blend : blend
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Blend(over Color) Color {
+	mb := globalColorMethodBindings.method_blend
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&over)
+
+	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+/* GetLuminance : get_luminance
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Color) GetLuminance() float32 {
+	mb := globalColorMethodBindings.method_get_luminance
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	return ret
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Color) IsEqualApprox(to Color) bool {
+	mb := globalColorMethodBindings.method_is_equal_approx
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
+
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+// Negate operator
+func (cx *Color) Negate() Color {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_negate, lt, rt)
+}
+
+// Positive operator
+func (cx *Color) Positive() Color {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_positive, lt, rt)
+}
+
+// Multiply_int operator
+func (cx *Color) Multiply_int(right int64) Color {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_multiply_int, lt, rt)
+}
+
+// Divide_int operator
+func (cx *Color) Divide_int(right int64) Color {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_divide_int, lt, rt)
+}
+
+// Multiply_float operator
+func (cx *Color) Multiply_float(right float32) Color {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_multiply_float, lt, rt)
+}
+
+// Divide_float operator
+func (cx *Color) Divide_float(right float32) Color {
+	lt := cx.NativeConstPtr()
+	eRight := Float32Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_divide_float, lt, rt)
+}
+
+// Equal_Color operator
+func (cx *Color) Equal_Color(right Color) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalColorMethodBindings.operator_equal_Color, lt, rt)
+}
+
+// Not_equal_Color operator
+func (cx *Color) Not_equal_Color(right Color) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalColorMethodBindings.operator_not_equal_Color, lt, rt)
+}
+
+// Add_Color operator
+func (cx *Color) Add_Color(right Color) Color {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_add_Color, lt, rt)
+}
+
+// Subtract_Color operator
+func (cx *Color) Subtract_Color(right Color) Color {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_subtract_Color, lt, rt)
+}
+
+// Multiply_Color operator
+func (cx *Color) Multiply_Color(right Color) Color {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_multiply_Color, lt, rt)
+}
+
+// Divide_Color operator
+func (cx *Color) Divide_Color(right Color) Color {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_divide_Color, lt, rt)
+}
@@ -17,6 +17,7 @@ package builtin
 import "C"
 import (
 	"runtime"
+	"unsafe"
 
 	. "github.com/godot-go/godot-go/pkg/ffi"
 	"github.com/godot-go/godot-go/pkg/log"
@@ -169,7 +170,6 @@ func (cx *String) NativePtr() GDExtensionTypePtr {
 func NewString() String {
 	cx := String{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalStringMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalStringMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -179,13 +179,11 @@ func NewString() String {
 func NewStringWithStringName(from StringName) String {
 	cx := String{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// StringName
 	// StringNameEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalStringMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalStringMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -195,13 +193,11 @@ func NewStringWithStringName(from StringName) String {
 func NewStringWithNodePath(from NodePath) String {
 	cx := String{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// NodePath
 	// NodePathEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalStringMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalStringMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -210,7 +206,6 @@ func NewStringWithNodePath(from NodePath) String {
 func (cx *String) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalStringMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -226,7 +221,6 @@ func (cx *String) CasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -234,8 +228,6 @@ func (cx *String) CasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -251,7 +243,6 @@ func (cx *String) NocasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -259,8 +250,6 @@ func (cx *String) NocasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -276,7 +265,6 @@ func (cx *String) NaturalcasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -284,8 +272,6 @@ func (cx *String) NaturalcasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -301,7 +287,6 @@ func (cx *String) NaturalnocasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -309,8 +294,6 @@ func (cx *String) NaturalnocasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -326,7 +309,6 @@ func (cx *String) FilecasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -334,8 +316,6 @@ func (cx *String) FilecasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -351,7 +331,6 @@ func (cx *String) FilenocasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -359,8 +338,6 @@ func (cx *String) FilenocasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -376,7 +353,6 @@ func (cx *String) Length() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -395,7 +371,6 @@ func (cx *String) Substr(from int64, len int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -403,11 +378,8 @@ func (cx *String) Substr(from int64, len int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(len)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -423,7 +395,6 @@ func (cx *String) GetSlice(delimiter String, slice int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -431,11 +402,8 @@ func (cx *String) GetSlice(delimiter String, slice int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&delimiter)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(slice)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -451,7 +419,6 @@ func (cx *String) GetSlicec(delimiter int64, slice int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -459,11 +426,8 @@ func (cx *String) GetSlicec(delimiter int64, slice int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(delimiter)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(slice)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -479,7 +443,6 @@ func (cx *String) GetSliceCount(delimiter String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -487,8 +450,6 @@ func (cx *String) GetSliceCount(delimiter String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&delimiter)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -504,7 +465,6 @@ func (cx *String) Find(what String, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -512,11 +472,8 @@ func (cx *String) Find(what String, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -532,7 +489,6 @@ func (cx *String) Findn(what String, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -540,11 +496,8 @@ func (cx *String) Findn(what String, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -560,7 +513,6 @@ func (cx *String) Count(what String, from int64, to int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -568,14 +520,10 @@ func (cx *String) Count(what String, from int64, to int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
 	args[2] = Int64Encoder.EncodeTypePtr(to)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -591,7 +539,6 @@ func (cx *String) Countn(what String, from int64, to int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -599,14 +546,10 @@ func (cx *String) Countn(what String, from int64, to int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
 	args[2] = Int64Encoder.EncodeTypePtr(to)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -622,7 +565,6 @@ func (cx *String) Rfind(what String, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -630,11 +572,8 @@ func (cx *String) Rfind(what String, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -650,7 +589,6 @@ func (cx *String) Rfindn(what String, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -658,11 +596,8 @@ func (cx *String) Rfindn(what String, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -678,7 +613,6 @@ func (cx *String) Match(expr String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -686,8 +620,6 @@ func (cx *String) Match(expr String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&expr)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -703,7 +635,6 @@ func (cx *String) Matchn(expr String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -711,8 +642,6 @@ func (cx *String) Matchn(expr String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&expr)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -728,7 +657,6 @@ func (cx *String) BeginsWith(text String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -736,8 +664,6 @@ func (cx *String) BeginsWith(text String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&text)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -753,7 +679,6 @@ func (cx *String) EndsWith(text String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -761,8 +686,6 @@ func (cx *String) EndsWith(text String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&text)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -778,7 +701,6 @@ func (cx *String) IsSubsequenceOf(text String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -786,8 +708,6 @@ func (cx *String) IsSubsequenceOf(text String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&text)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -803,7 +723,6 @@ func (cx *String) IsSubsequenceOfn(text String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -811,8 +730,6 @@ func (cx *String) IsSubsequenceOfn(text String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&text)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -828,7 +745,6 @@ func (cx *String) Bigrams() PackedStringArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -847,7 +763,6 @@ func (cx *String) Similarity(text String) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -855,8 +770,6 @@ func (cx *String) Similarity(text String) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&text)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -872,7 +785,6 @@ func (cx *String) Format(values Variant, placeholder String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -880,11 +792,8 @@ func (cx *String) Format(values Variant, placeholder String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&values)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&placeholder)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -900,7 +809,6 @@ func (cx *String) Replace(what String, forwhat String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -908,11 +816,8 @@ func (cx *String) Replace(what String, forwhat String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&forwhat)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -928,7 +833,6 @@ func (cx *String) Replacen(what String, forwhat String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -936,11 +840,8 @@ func (cx *String) Replacen(what String, forwhat String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&forwhat)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -956,7 +857,6 @@ func (cx *String) ReplaceChar(key int64, with int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -964,11 +864,8 @@ func (cx *String) ReplaceChar(key int64, with int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(key)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(with)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -984,7 +881,6 @@ func (cx *String) ReplaceChars(keys String, with int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -992,11 +888,8 @@ func (cx *String) ReplaceChars(keys String, with int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&keys)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(with)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1012,7 +905,6 @@ func (cx *String) RemoveChar(what int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1020,8 +912,6 @@ func (cx *String) RemoveChar(what int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(what)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1037,7 +927,6 @@ func (cx *String) RemoveChars(chars String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1045,8 +934,6 @@ func (cx *String) RemoveChars(chars String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&chars)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1062,7 +949,6 @@ func (cx *String) Repeat(count int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1070,8 +956,6 @@ func (cx *String) Repeat(count int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(count)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1087,7 +971,6 @@ func (cx *String) Reverse() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1106,7 +989,6 @@ func (cx *String) Insert(position int64, what String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1114,11 +996,8 @@ func (cx *String) Insert(position int64, what String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(position)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1134,7 +1013,6 @@ func (cx *String) Erase(position int64, chars int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1142,11 +1020,8 @@ func (cx *String) Erase(position int64, chars int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(position)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(chars)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1162,7 +1037,6 @@ func (cx *String) Capitalize() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1181,7 +1055,6 @@ func (cx *String) ToCamelCase() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1200,7 +1073,6 @@ func (cx *String) ToPascalCase() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1219,7 +1091,6 @@ func (cx *String) ToSnakeCase() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1238,7 +1109,6 @@ func (cx *String) ToKebabCase() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1257,7 +1127,6 @@ func (cx *String) Split(delimiter String, allow_empty bool, maxsplit int64) Pack
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1265,14 +1134,10 @@ func (cx *String) Split(delimiter String, allow_empty bool, maxsplit int64) Pack
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&delimiter)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(allow_empty)
 
-	pnr.Pin(args[1])
 	args[2] = Int64Encoder.EncodeTypePtr(maxsplit)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[PackedStringArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1288,7 +1153,6 @@ func (cx *String) Rsplit(delimiter String, allow_empty bool, maxsplit int64) Pac
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1296,14 +1160,10 @@ func (cx *String) Rsplit(delimiter String, allow_empty bool, maxsplit int64) Pac
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&delimiter)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(allow_empty)
 
-	pnr.Pin(args[1])
 	args[2] = Int64Encoder.EncodeTypePtr(maxsplit)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[PackedStringArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1319,7 +1179,6 @@ func (cx *String) SplitFloats(delimiter String, allow_empty bool) PackedFloat64A
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1327,11 +1186,8 @@ func (cx *String) SplitFloats(delimiter String, allow_empty bool) PackedFloat64A
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&delimiter)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(allow_empty)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedFloat64Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1347,7 +1203,6 @@ func (cx *String) Join(parts PackedStringArray) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1355,8 +1210,6 @@ func (cx *String) Join(parts PackedStringArray) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&parts)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1372,7 +1225,6 @@ func (cx *String) ToUpper() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1391,7 +1243,6 @@ func (cx *String) ToLower() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1410,7 +1261,6 @@ func (cx *String) Left(length int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1418,8 +1268,6 @@ func (cx *String) Left(length int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(length)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1435,7 +1283,6 @@ func (cx *String) Right(length int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1443,8 +1290,6 @@ func (cx *String) Right(length int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(length)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1460,7 +1305,6 @@ func (cx *String) StripEdges(left bool, right bool) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1468,11 +1312,8 @@ func (cx *String) StripEdges(left bool, right bool) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = BoolEncoder.EncodeTypePtr(left)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(right)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1488,7 +1329,6 @@ func (cx *String) StripEscapes() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1507,7 +1347,6 @@ func (cx *String) Lstrip(chars String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1515,8 +1354,6 @@ func (cx *String) Lstrip(chars String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&chars)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1532,7 +1369,6 @@ func (cx *String) Rstrip(chars String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1540,8 +1376,6 @@ func (cx *String) Rstrip(chars String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&chars)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1557,7 +1391,6 @@ func (cx *String) GetExtension() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1576,7 +1409,6 @@ func (cx *String) GetBasename() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1595,7 +1427,6 @@ func (cx *String) PathJoin(path String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1603,8 +1434,6 @@ func (cx *String) PathJoin(path String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&path)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1620,7 +1449,6 @@ func (cx *String) UnicodeAt(at int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1628,8 +1456,6 @@ func (cx *String) UnicodeAt(at int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1645,7 +1471,6 @@ func (cx *String) Indent(prefix String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1653,8 +1478,6 @@ func (cx *String) Indent(prefix String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&prefix)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1670,7 +1493,6 @@ func (cx *String) Dedent() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1689,7 +1511,6 @@ func (cx *String) Hash() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1708,7 +1529,6 @@ func (cx *String) Md5Text() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1727,7 +1547,6 @@ func (cx *String) Sha1Text() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1746,7 +1565,6 @@ func (cx *String) Sha256Text() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1765,7 +1583,6 @@ func (cx *String) Md5Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1784,7 +1601,6 @@ func (cx *String) Sha1Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1803,7 +1619,6 @@ func (cx *String) Sha256Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1822,7 +1637,6 @@ func (cx *String) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1841,7 +1655,6 @@ func (cx *String) Contains(what String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1849,8 +1662,6 @@ func (cx *String) Contains(what String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1866,7 +1677,6 @@ func (cx *String) Containsn(what String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1874,8 +1684,6 @@ func (cx *String) Containsn(what String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -1891,7 +1699,6 @@ func (cx *String) IsAbsolutePath() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1910,7 +1717,6 @@ func (cx *String) IsRelativePath() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1929,7 +1735,6 @@ func (cx *String) SimplifyPath() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1948,7 +1753,6 @@ func (cx *String) GetBaseDir() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1967,7 +1771,6 @@ func (cx *String) GetFile() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1986,7 +1789,6 @@ func (cx *String) XmlEscape(escape_quotes bool) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -1994,8 +1796,6 @@ func (cx *String) XmlEscape(escape_quotes bool) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = BoolEncoder.EncodeTypePtr(escape_quotes)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2011,7 +1811,6 @@ func (cx *String) XmlUnescape() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2030,7 +1829,6 @@ func (cx *String) UriEncode() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2049,7 +1847,6 @@ func (cx *String) UriDecode() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2068,7 +1865,6 @@ func (cx *String) UriFileDecode() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2087,7 +1883,6 @@ func (cx *String) CEscape() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2106,7 +1901,6 @@ func (cx *String) CUnescape() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2125,7 +1919,6 @@ func (cx *String) JsonEscape() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2144,7 +1937,6 @@ func (cx *String) ValidateNodeName() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2163,7 +1955,6 @@ func (cx *String) ValidateFilename() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2182,7 +1973,6 @@ func (cx *String) IsValidAsciiIdentifier() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2201,7 +1991,6 @@ func (cx *String) IsValidUnicodeIdentifier() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2220,7 +2009,6 @@ func (cx *String) IsValidIdentifier() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2239,7 +2027,6 @@ func (cx *String) IsValidInt() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2258,7 +2045,6 @@ func (cx *String) IsValidFloat() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2277,7 +2063,6 @@ func (cx *String) IsValidHexNumber(with_prefix bool) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2285,8 +2070,6 @@ func (cx *String) IsValidHexNumber(with_prefix bool) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = BoolEncoder.EncodeTypePtr(with_prefix)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2302,7 +2085,6 @@ func (cx *String) IsValidHtmlColor() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2321,7 +2103,6 @@ func (cx *String) IsValidIpAddress() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2340,7 +2121,6 @@ func (cx *String) IsValidFilename() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2359,7 +2139,6 @@ func (cx *String) ToInt() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2378,7 +2157,6 @@ func (cx *String) ToFloat() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2397,7 +2175,6 @@ func (cx *String) HexToInt() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2416,7 +2193,6 @@ func (cx *String) BinToInt() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2435,7 +2211,6 @@ func (cx *String) Lpad(min_length int64, character String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2443,11 +2218,8 @@ func (cx *String) Lpad(min_length int64, character String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(min_length)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&character)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2463,7 +2235,6 @@ func (cx *String) Rpad(min_length int64, character String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2471,11 +2242,8 @@ func (cx *String) Rpad(min_length int64, character String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(min_length)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&character)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2491,7 +2259,6 @@ func (cx *String) PadDecimals(digits int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2499,8 +2266,6 @@ func (cx *String) PadDecimals(digits int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(digits)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2516,7 +2281,6 @@ func (cx *String) PadZeros(digits int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2524,8 +2288,6 @@ func (cx *String) PadZeros(digits int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(digits)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2541,7 +2303,6 @@ func (cx *String) TrimPrefix(prefix String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2549,8 +2310,6 @@ func (cx *String) TrimPrefix(prefix String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&prefix)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2566,7 +2325,6 @@ func (cx *String) TrimSuffix(suffix String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2574,8 +2332,6 @@ func (cx *String) TrimSuffix(suffix String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&suffix)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2591,7 +2347,6 @@ func (cx *String) ToAsciiBuffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2610,7 +2365,6 @@ func (cx *String) ToUtf8Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2629,7 +2383,6 @@ func (cx *String) ToUtf16Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2648,7 +2401,6 @@ func (cx *String) ToUtf32Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2667,7 +2419,6 @@ func (cx *String) ToWcharBuffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2686,7 +2437,6 @@ func (cx *String) ToMultibyteCharBuffer(encoding String) PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2694,8 +2444,6 @@ func (cx *String) ToMultibyteCharBuffer(encoding String) PackedByteArray {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&encoding)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[PackedByteArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2711,7 +2459,6 @@ func (cx *String) HexDecode() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2730,7 +2477,6 @@ func (cx *String) NumScientific(number float32) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2738,8 +2484,6 @@ func (cx *String) NumScientific(number float32) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(number)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2755,7 +2499,6 @@ func (cx *String) Num(number float32, decimals int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2763,11 +2506,8 @@ func (cx *String) Num(number float32, decimals int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(number)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(decimals)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2783,7 +2523,6 @@ func (cx *String) NumInt64(number int64, base int64, capitalize_hex bool) String
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2791,14 +2530,10 @@ func (cx *String) NumInt64(number int64, base int64, capitalize_hex bool) String
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(number)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(base)
 
-	pnr.Pin(args[1])
 	args[2] = BoolEncoder.EncodeTypePtr(capitalize_hex)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2814,7 +2549,6 @@ func (cx *String) NumUint64(number int64, base int64, capitalize_hex bool) Strin
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2822,14 +2556,10 @@ func (cx *String) NumUint64(number int64, base int64, capitalize_hex bool) Strin
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(number)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(base)
 
-	pnr.Pin(args[1])
 	args[2] = BoolEncoder.EncodeTypePtr(capitalize_hex)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2845,7 +2575,6 @@ func (cx *String) Chr(code int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2853,8 +2582,6 @@ func (cx *String) Chr(code int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(code)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -2870,7 +2597,6 @@ func (cx *String) HumanizeSize(size int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -2878,8 +2604,6 @@ func (cx *String) HumanizeSize(size int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -3328,7 +3052,19 @@ var _ GDBuiltInClass = (*Vector2)(nil)
  * isKeyed: false
  * hasDestructor: false
  */
-type Vector2 [8]uint8
+type Vector2 struct {
+	X float32
+	Y float32
+}
+
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Vector2{})-Vector2Size]
+	_ = x[unsafe.Offsetof(Vector2{}.X)-0]
+	_ = x[unsafe.Offsetof(Vector2{}.Y)-4]
+}
 
 func (cx *Vector2) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
@@ -3343,7 +3079,6 @@ func (cx *Vector2) NativePtr() GDExtensionTypePtr {
 func NewVector2() Vector2 {
 	cx := Vector2{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalVector2MethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2MethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -3353,13 +3088,11 @@ func NewVector2() Vector2 {
 func NewVector2WithVector2(from Vector2) Vector2 {
 	cx := Vector2{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Vector2
 	// Vector2Encoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalVector2MethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2MethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -3369,13 +3102,11 @@ func NewVector2WithVector2(from Vector2) Vector2 {
 func NewVector2WithVector2i(from Vector2i) Vector2 {
 	cx := Vector2{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Vector2i
 	// Vector2iEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalVector2MethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2MethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -3385,14 +3116,11 @@ func NewVector2WithVector2i(from Vector2i) Vector2 {
 func NewVector2WithFloat32Float32(x float32, y float32) Vector2 {
 	cx := Vector2{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// float
 	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(x))
-	pnr.Pin(args[0])
 	// float
 	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(y))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalVector2MethodBindings.constructor_3, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2MethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
@@ -3410,7 +3138,6 @@ func (cx *Vector2) Angle() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -3429,7 +3156,6 @@ func (cx *Vector2) AngleTo(to Vector2) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -3437,8 +3163,6 @@ func (cx *Vector2) AngleTo(to Vector2) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -3454,7 +3178,6 @@ func (cx *Vector2) AngleToPoint(to Vector2) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -3462,525 +3185,564 @@ func (cx *Vector2) AngleToPoint(to Vector2) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* DirectionTo : direction_to
+/* Posmod : posmod
  * is_vararg = false, is_static = false
  * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) DirectionTo(to Vector2) Vector2 {
-	mb := globalVector2MethodBindings.method_direction_to
+func (cx *Vector2) Posmod(mod float32) Vector2 {
+	mb := globalVector2MethodBindings.method_posmod
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
+	args[0] = Float32Encoder.EncodeTypePtr(mod)
 
 	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* DistanceTo : distance_to
+/* Posmodv : posmodv
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) DistanceTo(to Vector2) float32 {
-	mb := globalVector2MethodBindings.method_distance_to
+func (cx *Vector2) Posmodv(modv Vector2) Vector2 {
+	mb := globalVector2MethodBindings.method_posmodv
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&modv)
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* DistanceSquaredTo : distance_squared_to
+/* Slerp : slerp
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) DistanceSquaredTo(to Vector2) float32 {
-	mb := globalVector2MethodBindings.method_distance_squared_to
+func (cx *Vector2) Slerp(to Vector2, weight float32) Vector2 {
+	mb := globalVector2MethodBindings.method_slerp
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 2
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
+	args[1] = Float32Encoder.EncodeTypePtr(weight)
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Length : length
+/* CubicInterpolate : cubic_interpolate
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) Length() float32 {
-	mb := globalVector2MethodBindings.method_length
+func (cx *Vector2) CubicInterpolate(b Vector2, pre_a Vector2, post_b Vector2, weight float32) Vector2 {
+	mb := globalVector2MethodBindings.method_cubic_interpolate
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 4
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&b)
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	args[1] = (GDExtensionTypePtr)(&pre_a)
+
+	args[2] = (GDExtensionTypePtr)(&post_b)
+
+	args[3] = Float32Encoder.EncodeTypePtr(weight)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* LengthSquared : length_squared
+/* CubicInterpolateInTime : cubic_interpolate_in_time
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) LengthSquared() float32 {
-	mb := globalVector2MethodBindings.method_length_squared
+func (cx *Vector2) CubicInterpolateInTime(b Vector2, pre_a Vector2, post_b Vector2, weight float32, b_t float32, pre_a_t float32, post_b_t float32) Vector2 {
+	mb := globalVector2MethodBindings.method_cubic_interpolate_in_time
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 7
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&b)
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	args[1] = (GDExtensionTypePtr)(&pre_a)
+
+	args[2] = (GDExtensionTypePtr)(&post_b)
+
+	args[3] = Float32Encoder.EncodeTypePtr(weight)
+
+	args[4] = Float32Encoder.EncodeTypePtr(b_t)
+
+	args[5] = Float32Encoder.EncodeTypePtr(pre_a_t)
+
+	args[6] = Float32Encoder.EncodeTypePtr(post_b_t)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* LimitLength : limit_length
+/* BezierInterpolate : bezier_interpolate
  * is_vararg = false, is_static = false
  * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) LimitLength(length float32) Vector2 {
-	mb := globalVector2MethodBindings.method_limit_length
+func (cx *Vector2) BezierInterpolate(control_1 Vector2, control_2 Vector2, end Vector2, t float32) Vector2 {
+	mb := globalVector2MethodBindings.method_bezier_interpolate
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 4
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(length)
+	args[0] = (GDExtensionTypePtr)(&control_1)
+
+	args[1] = (GDExtensionTypePtr)(&control_2)
+
+	args[2] = (GDExtensionTypePtr)(&end)
 
-	pnr.Pin(args[0])
+	args[3] = Float32Encoder.EncodeTypePtr(t)
 
 	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Normalized : normalized
+/* BezierDerivative : bezier_derivative
  * is_vararg = false, is_static = false
  * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) Normalized() Vector2 {
-	mb := globalVector2MethodBindings.method_normalized
+func (cx *Vector2) BezierDerivative(control_1 Vector2, control_2 Vector2, end Vector2, t float32) Vector2 {
+	mb := globalVector2MethodBindings.method_bezier_derivative
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 4
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&control_1)
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	args[1] = (GDExtensionTypePtr)(&control_2)
+
+	args[2] = (GDExtensionTypePtr)(&end)
+
+	args[3] = Float32Encoder.EncodeTypePtr(t)
+
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* IsNormalized : is_normalized
+/* MaxAxisIndex : max_axis_index
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(int) -> int64
  */
-func (cx *Vector2) IsNormalized() bool {
-	mb := globalVector2MethodBindings.method_is_normalized
+func (cx *Vector2) MaxAxisIndex() int64 {
+	mb := globalVector2MethodBindings.method_max_axis_index
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* IsEqualApprox : is_equal_approx
+/* MinAxisIndex : min_axis_index
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(int) -> int64
  */
-func (cx *Vector2) IsEqualApprox(to Vector2) bool {
-	mb := globalVector2MethodBindings.method_is_equal_approx
+func (cx *Vector2) MinAxisIndex() int64 {
+	mb := globalVector2MethodBindings.method_min_axis_index
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* IsZeroApprox : is_zero_approx
+/* Rotated : rotated
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) IsZeroApprox() bool {
-	mb := globalVector2MethodBindings.method_is_zero_approx
+func (cx *Vector2) Rotated(angle float32) Vector2 {
+	mb := globalVector2MethodBindings.method_rotated
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(angle)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* IsFinite : is_finite
+/* Slide : slide
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) IsFinite() bool {
-	mb := globalVector2MethodBindings.method_is_finite
+func (cx *Vector2) Slide(n Vector2) Vector2 {
+	mb := globalVector2MethodBindings.method_slide
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&n)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Posmod : posmod
+/* Bounce : bounce
  * is_vararg = false, is_static = false
  * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) Posmod(mod float32) Vector2 {
-	mb := globalVector2MethodBindings.method_posmod
+func (cx *Vector2) Bounce(n Vector2) Vector2 {
+	mb := globalVector2MethodBindings.method_bounce
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(mod)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&n)
 
 	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Posmodv : posmodv
+/* Reflect : reflect
  * is_vararg = false, is_static = false
  * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) Posmodv(modv Vector2) Vector2 {
-	mb := globalVector2MethodBindings.method_posmodv
+func (cx *Vector2) Reflect(line Vector2) Vector2 {
+	mb := globalVector2MethodBindings.method_reflect
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&modv)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&line)
 
 	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Project : project
+/* Snapped : snapped
  * is_vararg = false, is_static = false
  * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) Project(b Vector2) Vector2 {
-	mb := globalVector2MethodBindings.method_project
+func (cx *Vector2) Snapped(step Vector2) Vector2 {
+	mb := globalVector2MethodBindings.method_snapped
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&b)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&step)
 
 	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Lerp : lerp
+/* Snappedf : snappedf
  * is_vararg = false, is_static = false
  * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) Lerp(to Vector2, weight float32) Vector2 {
-	mb := globalVector2MethodBindings.method_lerp
+func (cx *Vector2) Snappedf(step float32) Vector2 {
+	mb := globalVector2MethodBindings.method_snappedf
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 2
+	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(weight)
-
-	pnr.Pin(args[1])
+	args[0] = Float32Encoder.EncodeTypePtr(step)
 
 	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Slerp : slerp
- * is_vararg = false, is_static = false
+/* FromAngle : from_angle
+ * is_vararg = false, is_static = true
  * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2) Slerp(to Vector2, weight float32) Vector2 {
-	mb := globalVector2MethodBindings.method_slerp
+func (cx *Vector2) FromAngle(angle float32) Vector2 {
+	mb := globalVector2MethodBindings.method_from_angle
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
+	bx := (GDExtensionTypePtr)(nullptr)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 2
+	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(weight)
-
-	pnr.Pin(args[1])
+	args[0] = Float32Encoder.EncodeTypePtr(angle)
 
 	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* CubicInterpolate : cubic_interpolate
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector2) CubicInterpolate(b Vector2, pre_a Vector2, post_b Vector2, weight float32) Vector2 {
-	mb := globalVector2MethodBindings.method_cubic_interpolate
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 4
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&b)
+func (cx *Vector2) GetIndexed(i int64) float32 {
+	var ret float32
+	CallFunc_GDExtensionPtrIndexedGetter(
+		globalVector2MethodBindings.indexed_getter,
+		cx.NativeConstPtr(),
+		(GDExtensionInt)(i),
+		(GDExtensionTypePtr)(&ret),
+	)
+	return ret
+}
 
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&pre_a)
+func (cx *Vector2) SetIndexed(i int64, value float32) {
+	CallFunc_GDExtensionPtrIndexedSetter(
+		globalVector2MethodBindings.indexed_setter,
+		cx.NativePtr(),
+		(GDExtensionInt)(i),
+		(GDExtensionConstTypePtr)(&value),
+	)
+}
 
-	pnr.Pin(args[1])
-	args[2] = (GDExtensionTypePtr)(&post_b)
+// members
 
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(weight)
+func (cx *Vector2) MemberGetx() float32 {
+	bx := cx.NativeConstPtr()
+	ret := CallBuiltinPtrGetter[float64](globalVector2MethodBindings.member_x_getter, bx)
+	return Float32Encoder.DecodeTypePtr((GDExtensionConstTypePtr)(&ret))
 
-	pnr.Pin(args[3])
+}
+
+func (cx *Vector2) MemberGety() float32 {
+	bx := cx.NativeConstPtr()
+	ret := CallBuiltinPtrGetter[float64](globalVector2MethodBindings.member_y_getter, bx)
+	return Float32Encoder.DecodeTypePtr((GDExtensionConstTypePtr)(&ret))
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
 }
 
-/* CubicInterpolateInTime : cubic_interpolate_in_time
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector2) CubicInterpolateInTime(b Vector2, pre_a Vector2, post_b Vector2, weight float32, b_t float32, pre_a_t float32, post_b_t float32) Vector2 {
-	mb := globalVector2MethodBindings.method_cubic_interpolate_in_time
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 7
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&b)
+// Equal_Variant operator
+func (cx *Vector2) Equal_Variant(right Variant) bool {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_equal_Variant, lt, rt)
+}
 
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&pre_a)
+// Not_equal_Variant operator
+func (cx *Vector2) Not_equal_Variant(right Variant) bool {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_not_equal_Variant, lt, rt)
+}
 
-	pnr.Pin(args[1])
-	args[2] = (GDExtensionTypePtr)(&post_b)
+// Not operator
+func (cx *Vector2) Not() bool {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_not, lt, rt)
+}
 
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(weight)
+// In_Dictionary operator
+func (cx *Vector2) In_Dictionary(right Dictionary) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_in_Dictionary, lt, rt)
+}
 
-	pnr.Pin(args[3])
-	args[4] = Float32Encoder.EncodeTypePtr(b_t)
+// In_Array operator
+func (cx *Vector2) In_Array(right Array) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_in_Array, lt, rt)
+}
 
-	pnr.Pin(args[4])
-	args[5] = Float32Encoder.EncodeTypePtr(pre_a_t)
+// In_PackedVector2Array operator
+func (cx *Vector2) In_PackedVector2Array(right PackedVector2Array) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_in_PackedVector2Array, lt, rt)
+}
 
-	pnr.Pin(args[5])
-	args[6] = Float32Encoder.EncodeTypePtr(post_b_t)
+var _ GDBuiltInClass = (*Vector2i)(nil)
 
-	pnr.Pin(args[6])
+/*
+ * Vector2i
+ * indexingReturnType: int
+ * isKeyed: false
+ * hasDestructor: false
+ */
+type Vector2i struct {
+	X int32
+	Y int32
+}
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Vector2i{})-Vector2iSize]
+	_ = x[unsafe.Offsetof(Vector2i{}.X)-0]
+	_ = x[unsafe.Offsetof(Vector2i{}.Y)-4]
 }
 
-/* BezierInterpolate : bezier_interpolate
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector2) BezierInterpolate(control_1 Vector2, control_2 Vector2, end Vector2, t float32) Vector2 {
-	mb := globalVector2MethodBindings.method_bezier_interpolate
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 4
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&control_1)
+func (cx *Vector2i) NativeConstPtr() GDExtensionConstTypePtr {
+	return (GDExtensionConstTypePtr)(cx)
+}
 
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&control_2)
+func (cx *Vector2i) NativePtr() GDExtensionTypePtr {
+	return (GDExtensionTypePtr)(cx)
+}
 
-	pnr.Pin(args[1])
-	args[2] = (GDExtensionTypePtr)(&end)
+// constructors
+// NewVector2i, index: 0
+func NewVector2i() Vector2i {
+	cx := Vector2i{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	CallBuiltinConstructor(globalVector2iMethodBindings.constructor_0, ptr)
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2iMethodBindings.constructor_0"), zap.Any("cx", cx))
+	return cx
+}
 
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(t)
+// NewVector2i, index: 1
+func NewVector2iWithVector2i(from Vector2i) Vector2i {
+	cx := Vector2i{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [1]GDExtensionConstTypePtr
+	// Vector2i
+	// Vector2iEncoder
+	args[0] = from.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
+	CallBuiltinConstructor(globalVector2iMethodBindings.constructor_1, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2iMethodBindings.constructor_1"), zap.Any("cx", cx))
+	return cx
+}
 
-	pnr.Pin(args[3])
+// NewVector2i, index: 2
+func NewVector2iWithVector2(from Vector2) Vector2i {
+	cx := Vector2i{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [1]GDExtensionConstTypePtr
+	// Vector2
+	// Vector2Encoder
+	args[0] = from.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
+	CallBuiltinConstructor(globalVector2iMethodBindings.constructor_2, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2iMethodBindings.constructor_2"), zap.Any("cx", cx))
+	return cx
+}
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
+// NewVector2i, index: 3
+func NewVector2iWithInt64Int64(x int64, y int64) Vector2i {
+	cx := Vector2i{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [2]GDExtensionConstTypePtr
+	// int
+	args[0] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(x))
+	// int
+	args[1] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(y))
+	CallBuiltinConstructor(globalVector2iMethodBindings.constructor_3, ptr, args[0], args[1])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2iMethodBindings.constructor_3"), zap.Any("cx", cx))
+	return cx
 }
 
-/* BezierDerivative : bezier_derivative
+// methods
+
+/* Aspect : aspect
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(float) -> float32
  */
-func (cx *Vector2) BezierDerivative(control_1 Vector2, control_2 Vector2, end Vector2, t float32) Vector2 {
-	mb := globalVector2MethodBindings.method_bezier_derivative
+func (cx *Vector2i) Aspect() float32 {
+	mb := globalVector2iMethodBindings.method_aspect
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 4
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&control_1)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&control_2)
-
-	pnr.Pin(args[1])
-	args[2] = (GDExtensionTypePtr)(&end)
-
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(t)
-
-	pnr.Pin(args[3])
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
 	return ret
 }
 
@@ -3988,13 +3750,12 @@ func (cx *Vector2) BezierDerivative(control_1 Vector2, control_2 Vector2, end Ve
  * is_vararg = false, is_static = false
  * goReturnType(int) -> int64
  */
-func (cx *Vector2) MaxAxisIndex() int64 {
-	mb := globalVector2MethodBindings.method_max_axis_index
+func (cx *Vector2i) MaxAxisIndex() int64 {
+	mb := globalVector2iMethodBindings.method_max_axis_index
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -4007,13 +3768,12 @@ func (cx *Vector2) MaxAxisIndex() int64 {
  * is_vararg = false, is_static = false
  * goReturnType(int) -> int64
  */
-func (cx *Vector2) MinAxisIndex() int64 {
-	mb := globalVector2MethodBindings.method_min_axis_index
+func (cx *Vector2i) MinAxisIndex() int64 {
+	mb := globalVector2iMethodBindings.method_min_axis_index
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -4022,146 +3782,60 @@ func (cx *Vector2) MinAxisIndex() int64 {
 	return ret
 }
 
-/* MoveToward : move_toward
+/* DistanceTo : distance_to
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(float) -> float32
  */
-func (cx *Vector2) MoveToward(to Vector2, delta float32) Vector2 {
-	mb := globalVector2MethodBindings.method_move_toward
+func (cx *Vector2i) DistanceTo(to Vector2i) float32 {
+	mb := globalVector2iMethodBindings.method_distance_to
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 2
+	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(delta)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Rotated : rotated
+/* DistanceSquaredTo : distance_squared_to
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(int) -> int64
  */
-func (cx *Vector2) Rotated(angle float32) Vector2 {
-	mb := globalVector2MethodBindings.method_rotated
+func (cx *Vector2i) DistanceSquaredTo(to Vector2i) int64 {
+	mb := globalVector2iMethodBindings.method_distance_squared_to
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(angle)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&to)
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Orthogonal : orthogonal
+/* Length : length
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(float) -> float32
  */
-func (cx *Vector2) Orthogonal() Vector2 {
-	mb := globalVector2MethodBindings.method_orthogonal
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
-	return ret
-}
-
-/* Floor : floor
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector2) Floor() Vector2 {
-	mb := globalVector2MethodBindings.method_floor
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
-	return ret
-}
-
-/* Ceil : ceil
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector2) Ceil() Vector2 {
-	mb := globalVector2MethodBindings.method_ceil
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
-	return ret
-}
-
-/* Round : round
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector2) Round() Vector2 {
-	mb := globalVector2MethodBindings.method_round
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
-	return ret
-}
-
-/* Aspect : aspect
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector2) Aspect() float32 {
-	mb := globalVector2MethodBindings.method_aspect
+func (cx *Vector2i) Length() float32 {
+	mb := globalVector2iMethodBindings.method_length
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -4170,180 +3844,70 @@ func (cx *Vector2) Aspect() float32 {
 	return ret
 }
 
-/* Dot : dot
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector2) Dot(with Vector2) float32 {
-	mb := globalVector2MethodBindings.method_dot
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Slide : slide
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector2) Slide(n Vector2) Vector2 {
-	mb := globalVector2MethodBindings.method_slide
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&n)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Bounce : bounce
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector2) Bounce(n Vector2) Vector2 {
-	mb := globalVector2MethodBindings.method_bounce
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&n)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Reflect : reflect
+/* LengthSquared : length_squared
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(int) -> int64
  */
-func (cx *Vector2) Reflect(line Vector2) Vector2 {
-	mb := globalVector2MethodBindings.method_reflect
+func (cx *Vector2i) LengthSquared() int64 {
+	mb := globalVector2iMethodBindings.method_length_squared
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&line)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* Cross : cross
+/* Sign : sign
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2) Cross(with Vector2) float32 {
-	mb := globalVector2MethodBindings.method_cross
+func (cx *Vector2i) Sign() Vector2i {
+	mb := globalVector2iMethodBindings.method_sign
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, nil)
 	return ret
 }
 
 /* Abs : abs
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector2) Abs() Vector2 {
-	mb := globalVector2MethodBindings.method_abs
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
-	return ret
-}
-
-/* Sign : sign
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2) Sign() Vector2 {
-	mb := globalVector2MethodBindings.method_sign
+func (cx *Vector2i) Abs() Vector2i {
+	mb := globalVector2iMethodBindings.method_abs
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, nil)
 	return ret
 }
 
 /* Clamp : clamp
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2) Clamp(min Vector2, max Vector2) Vector2 {
-	mb := globalVector2MethodBindings.method_clamp
+func (cx *Vector2i) Clamp(min Vector2i, max Vector2i) Vector2i {
+	mb := globalVector2iMethodBindings.method_clamp
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -4351,55 +3915,47 @@ func (cx *Vector2) Clamp(min Vector2, max Vector2) Vector2 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&min)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&max)
 
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Clampf : clampf
+/* Clampi : clampi
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2) Clampf(min float32, max float32) Vector2 {
-	mb := globalVector2MethodBindings.method_clampf
+func (cx *Vector2i) Clampi(min int64, max int64) Vector2i {
+	mb := globalVector2iMethodBindings.method_clampi
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 2
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(min)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(max)
+	args[0] = Int64Encoder.EncodeTypePtr(min)
 
-	pnr.Pin(args[1])
+	args[1] = Int64Encoder.EncodeTypePtr(max)
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
 /* Snapped : snapped
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2) Snapped(step Vector2) Vector2 {
-	mb := globalVector2MethodBindings.method_snapped
+func (cx *Vector2i) Snapped(step Vector2i) Vector2i {
+	mb := globalVector2iMethodBindings.method_snapped
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -4407,49 +3963,43 @@ func (cx *Vector2) Snapped(step Vector2) Vector2 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&step)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Snappedf : snappedf
+/* Snappedi : snappedi
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2) Snappedf(step float32) Vector2 {
-	mb := globalVector2MethodBindings.method_snappedf
+func (cx *Vector2i) Snappedi(step int64) Vector2i {
+	mb := globalVector2iMethodBindings.method_snappedi
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(step)
-
-	pnr.Pin(args[0])
+	args[0] = Int64Encoder.EncodeTypePtr(step)
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
 /* Min : min
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2) Min(with Vector2) Vector2 {
-	mb := globalVector2MethodBindings.method_min
+func (cx *Vector2i) Min(with Vector2i) Vector2i {
+	mb := globalVector2iMethodBindings.method_min
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -4457,49 +4007,43 @@ func (cx *Vector2) Min(with Vector2) Vector2 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Minf : minf
+/* Mini : mini
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2) Minf(with float32) Vector2 {
-	mb := globalVector2MethodBindings.method_minf
+func (cx *Vector2i) Mini(with int64) Vector2i {
+	mb := globalVector2iMethodBindings.method_mini
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(with)
-
-	pnr.Pin(args[0])
+	args[0] = Int64Encoder.EncodeTypePtr(with)
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
 /* Max : max
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2) Max(with Vector2) Vector2 {
-	mb := globalVector2MethodBindings.method_max
+func (cx *Vector2i) Max(with Vector2i) Vector2i {
+	mb := globalVector2iMethodBindings.method_max
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -4507,67 +4051,37 @@ func (cx *Vector2) Max(with Vector2) Vector2 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Maxf : maxf
+/* Maxi : maxi
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2) Maxf(with float32) Vector2 {
-	mb := globalVector2MethodBindings.method_maxf
+func (cx *Vector2i) Maxi(with int64) Vector2i {
+	mb := globalVector2iMethodBindings.method_maxi
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(with)
-
-	pnr.Pin(args[0])
+	args[0] = Int64Encoder.EncodeTypePtr(with)
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* FromAngle : from_angle
- * is_vararg = false, is_static = true
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector2) FromAngle(angle float32) Vector2 {
-	mb := globalVector2MethodBindings.method_from_angle
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(angle)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-func (cx *Vector2) GetIndexed(i int64) float32 {
-	var ret float32
+func (cx *Vector2i) GetIndexed(i int64) int64 {
+	var ret int64
 	CallFunc_GDExtensionPtrIndexedGetter(
-		globalVector2MethodBindings.indexed_getter,
+		globalVector2iMethodBindings.indexed_getter,
 		cx.NativeConstPtr(),
 		(GDExtensionInt)(i),
 		(GDExtensionTypePtr)(&ret),
@@ -4575,9 +4089,9 @@ func (cx *Vector2) GetIndexed(i int64) float32 {
 	return ret
 }
 
-func (cx *Vector2) SetIndexed(i int64, value float32) {
+func (cx *Vector2i) SetIndexed(i int64, value int64) {
 	CallFunc_GDExtensionPtrIndexedSetter(
-		globalVector2MethodBindings.indexed_setter,
+		globalVector2iMethodBindings.indexed_setter,
 		cx.NativePtr(),
 		(GDExtensionInt)(i),
 		(GDExtensionConstTypePtr)(&value),
@@ -4586,402 +4100,537 @@ func (cx *Vector2) SetIndexed(i int64, value float32) {
 
 // members
 
-func (cx *Vector2) MemberGetx() float32 {
+func (cx *Vector2i) MemberGetx() int64 {
 	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[float64](globalVector2MethodBindings.member_x_getter, bx)
-	return Float32Encoder.DecodeTypePtr((GDExtensionConstTypePtr)(&ret))
+	ret := CallBuiltinPtrGetter[int64](globalVector2iMethodBindings.member_x_getter, bx)
+	return ret
 
 }
 
-func (cx *Vector2) MemberGety() float32 {
+func (cx *Vector2i) MemberGety() int64 {
 	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[float64](globalVector2MethodBindings.member_y_getter, bx)
-	return Float32Encoder.DecodeTypePtr((GDExtensionConstTypePtr)(&ret))
+	ret := CallBuiltinPtrGetter[int64](globalVector2iMethodBindings.member_y_getter, bx)
+	return ret
 
 }
 
 // Equal_Variant operator
-func (cx *Vector2) Equal_Variant(right Variant) bool {
+func (cx *Vector2i) Equal_Variant(right Variant) bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_equal_Variant, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_equal_Variant, lt, rt)
 }
 
 // Not_equal_Variant operator
-func (cx *Vector2) Not_equal_Variant(right Variant) bool {
+func (cx *Vector2i) Not_equal_Variant(right Variant) bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_not_equal_Variant, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_not_equal_Variant, lt, rt)
 }
 
 // Negate operator
-func (cx *Vector2) Negate() Vector2 {
+func (cx *Vector2i) Negate() Vector2i {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_negate, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_negate, lt, rt)
 }
 
 // Positive operator
-func (cx *Vector2) Positive() Vector2 {
+func (cx *Vector2i) Positive() Vector2i {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_positive, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_positive, lt, rt)
 }
 
 // Not operator
-func (cx *Vector2) Not() bool {
+func (cx *Vector2i) Not() bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_not, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_not, lt, rt)
 }
 
 // Multiply_int operator
-func (cx *Vector2) Multiply_int(right int64) Vector2 {
+func (cx *Vector2i) Multiply_int(right int64) Vector2i {
 	lt := cx.NativeConstPtr()
 	eRight := Int64Encoder.EncodeTypePtr(right)
 	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_multiply_int, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_multiply_int, lt, rt)
 }
 
 // Divide_int operator
-func (cx *Vector2) Divide_int(right int64) Vector2 {
+func (cx *Vector2i) Divide_int(right int64) Vector2i {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_divide_int, lt, rt)
+}
+
+// Module_int operator
+func (cx *Vector2i) Module_int(right int64) Vector2i {
 	lt := cx.NativeConstPtr()
 	eRight := Int64Encoder.EncodeTypePtr(right)
 	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_divide_int, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_module_int, lt, rt)
 }
 
 // Multiply_float operator
-func (cx *Vector2) Multiply_float(right float32) Vector2 {
+func (cx *Vector2i) Multiply_float(right float32) Vector2 {
 	lt := cx.NativeConstPtr()
 	eRight := Float32Encoder.EncodeTypePtr(right)
 	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_multiply_float, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2](globalVector2iMethodBindings.operator_multiply_float, lt, rt)
 }
 
 // Divide_float operator
-func (cx *Vector2) Divide_float(right float32) Vector2 {
+func (cx *Vector2i) Divide_float(right float32) Vector2 {
 	lt := cx.NativeConstPtr()
 	eRight := Float32Encoder.EncodeTypePtr(right)
 	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_divide_float, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2](globalVector2iMethodBindings.operator_divide_float, lt, rt)
 }
 
-// Equal_Vector2 operator
-func (cx *Vector2) Equal_Vector2(right Vector2) bool {
+// Equal_Vector2i operator
+func (cx *Vector2i) Equal_Vector2i(right Vector2i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_equal_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_equal_Vector2i, lt, rt)
 }
 
-// Not_equal_Vector2 operator
-func (cx *Vector2) Not_equal_Vector2(right Vector2) bool {
+// Not_equal_Vector2i operator
+func (cx *Vector2i) Not_equal_Vector2i(right Vector2i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_not_equal_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_not_equal_Vector2i, lt, rt)
 }
 
-// Less_Vector2 operator
-func (cx *Vector2) Less_Vector2(right Vector2) bool {
+// Less_Vector2i operator
+func (cx *Vector2i) Less_Vector2i(right Vector2i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_less_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_less_Vector2i, lt, rt)
 }
 
-// Less_equal_Vector2 operator
-func (cx *Vector2) Less_equal_Vector2(right Vector2) bool {
+// Less_equal_Vector2i operator
+func (cx *Vector2i) Less_equal_Vector2i(right Vector2i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_less_equal_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_less_equal_Vector2i, lt, rt)
 }
 
-// Greater_Vector2 operator
-func (cx *Vector2) Greater_Vector2(right Vector2) bool {
+// Greater_Vector2i operator
+func (cx *Vector2i) Greater_Vector2i(right Vector2i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_greater_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_greater_Vector2i, lt, rt)
 }
 
-// Greater_equal_Vector2 operator
-func (cx *Vector2) Greater_equal_Vector2(right Vector2) bool {
+// Greater_equal_Vector2i operator
+func (cx *Vector2i) Greater_equal_Vector2i(right Vector2i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_greater_equal_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_greater_equal_Vector2i, lt, rt)
 }
 
-// Add_Vector2 operator
-func (cx *Vector2) Add_Vector2(right Vector2) Vector2 {
+// Add_Vector2i operator
+func (cx *Vector2i) Add_Vector2i(right Vector2i) Vector2i {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_add_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_add_Vector2i, lt, rt)
 }
 
-// Subtract_Vector2 operator
-func (cx *Vector2) Subtract_Vector2(right Vector2) Vector2 {
+// Subtract_Vector2i operator
+func (cx *Vector2i) Subtract_Vector2i(right Vector2i) Vector2i {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_subtract_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_subtract_Vector2i, lt, rt)
 }
 
-// Multiply_Vector2 operator
-func (cx *Vector2) Multiply_Vector2(right Vector2) Vector2 {
+// Multiply_Vector2i operator
+func (cx *Vector2i) Multiply_Vector2i(right Vector2i) Vector2i {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_multiply_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_multiply_Vector2i, lt, rt)
 }
 
-// Divide_Vector2 operator
-func (cx *Vector2) Divide_Vector2(right Vector2) Vector2 {
+// Divide_Vector2i operator
+func (cx *Vector2i) Divide_Vector2i(right Vector2i) Vector2i {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_divide_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_divide_Vector2i, lt, rt)
 }
 
-// Multiply_Transform2D operator
-func (cx *Vector2) Multiply_Transform2D(right Transform2D) Vector2 {
+// Module_Vector2i operator
+func (cx *Vector2i) Module_Vector2i(right Vector2i) Vector2i {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2](globalVector2MethodBindings.operator_multiply_Transform2D, lt, rt)
+	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_module_Vector2i, lt, rt)
 }
 
 // In_Dictionary operator
-func (cx *Vector2) In_Dictionary(right Dictionary) bool {
+func (cx *Vector2i) In_Dictionary(right Dictionary) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_in_Dictionary, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_in_Dictionary, lt, rt)
 }
 
 // In_Array operator
-func (cx *Vector2) In_Array(right Array) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_in_Array, lt, rt)
-}
-
-// In_PackedVector2Array operator
-func (cx *Vector2) In_PackedVector2Array(right PackedVector2Array) bool {
+func (cx *Vector2i) In_Array(right Array) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2MethodBindings.operator_in_PackedVector2Array, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_in_Array, lt, rt)
 }
 
-var _ GDBuiltInClass = (*Vector2i)(nil)
+var _ GDBuiltInClass = (*Rect2)(nil)
 
 /*
- * Vector2i
- * indexingReturnType: int
+ * Rect2
+ * indexingReturnType:
  * isKeyed: false
  * hasDestructor: false
  */
-type Vector2i [8]uint8
+type Rect2 struct {
+	Position Vector2
+	Size     Vector2
+}
 
-func (cx *Vector2i) NativeConstPtr() GDExtensionConstTypePtr {
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Rect2{})-Rect2Size]
+	_ = x[unsafe.Offsetof(Rect2{}.Position)-0]
+	_ = x[unsafe.Offsetof(Rect2{}.Size)-8]
+}
+
+func (cx *Rect2) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
 }
 
-func (cx *Vector2i) NativePtr() GDExtensionTypePtr {
+func (cx *Rect2) NativePtr() GDExtensionTypePtr {
 	return (GDExtensionTypePtr)(cx)
 }
 
 // constructors
-// NewVector2i, index: 0
-func NewVector2i() Vector2i {
-	cx := Vector2i{}
+// NewRect2, index: 0
+func NewRect2() Rect2 {
+	cx := Rect2{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	CallBuiltinConstructor(globalVector2iMethodBindings.constructor_0, ptr)
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2iMethodBindings.constructor_0"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalRect2MethodBindings.constructor_0, ptr)
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2MethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewVector2i, index: 1
-func NewVector2iWithVector2i(from Vector2i) Vector2i {
-	cx := Vector2i{}
+// NewRect2, index: 1
+func NewRect2WithRect2(from Rect2) Rect2 {
+	cx := Rect2{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
-	// Vector2i
-	// Vector2iEncoder
+	// Rect2
+	// Rect2Encoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalVector2iMethodBindings.constructor_1, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2iMethodBindings.constructor_1"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalRect2MethodBindings.constructor_1, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2MethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewVector2i, index: 2
-func NewVector2iWithVector2(from Vector2) Vector2i {
-	cx := Vector2i{}
+// NewRect2, index: 2
+func NewRect2WithRect2i(from Rect2i) Rect2 {
+	cx := Rect2{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
-	// Vector2
-	// Vector2Encoder
+	// Rect2i
+	// Rect2iEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalVector2iMethodBindings.constructor_2, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2iMethodBindings.constructor_2"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalRect2MethodBindings.constructor_2, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2MethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewVector2i, index: 3
-func NewVector2iWithInt64Int64(x int64, y int64) Vector2i {
-	cx := Vector2i{}
+// NewRect2, index: 3
+func NewRect2WithVector2Vector2(position Vector2, size Vector2) Rect2 {
+	cx := Rect2{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
-	// int
-	args[0] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(x))
-	pnr.Pin(args[0])
-	// int
-	args[1] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(y))
-	pnr.Pin(args[1])
-	CallBuiltinConstructor(globalVector2iMethodBindings.constructor_3, ptr, args[0], args[1])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector2iMethodBindings.constructor_3"), zap.Any("cx", cx))
+	// Vector2
+	// Vector2Encoder
+	args[0] = position.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("position", position))
+	// Vector2
+	// Vector2Encoder
+	args[1] = size.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("size", size))
+	CallBuiltinConstructor(globalRect2MethodBindings.constructor_3, ptr, args[0], args[1])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2MethodBindings.constructor_3"), zap.Any("cx", cx))
+	return cx
+}
+
+// NewRect2, index: 4
+func NewRect2WithFloat32Float32Float32Float32(x float32, y float32, width float32, height float32) Rect2 {
+	cx := Rect2{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [4]GDExtensionConstTypePtr
+	// float
+	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(x))
+	// float
+	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(y))
+	// float
+	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(width))
+	// float
+	args[3] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(height))
+	CallBuiltinConstructor(globalRect2MethodBindings.constructor_4, ptr, args[0], args[1], args[2], args[3])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2MethodBindings.constructor_4"), zap.Any("cx", cx))
 	return cx
 }
 
 // methods
 
-/* Aspect : aspect
+/* GetSupport : get_support
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Vector2) -> Vector2
  */
-func (cx *Vector2i) Aspect() float32 {
-	mb := globalVector2iMethodBindings.method_aspect
+func (cx *Rect2) GetSupport(direction Vector2) Vector2 {
+	mb := globalRect2MethodBindings.method_get_support
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&direction)
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* MaxAxisIndex : max_axis_index
+/* GrowSide : grow_side
  * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
+ * goReturnType(Rect2) -> Rect2
  */
-func (cx *Vector2i) MaxAxisIndex() int64 {
-	mb := globalVector2iMethodBindings.method_max_axis_index
+func (cx *Rect2) GrowSide(side int64, amount float32) Rect2 {
+	mb := globalRect2MethodBindings.method_grow_side
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Int64Encoder.EncodeTypePtr(side)
 
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* MinAxisIndex : min_axis_index
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Vector2i) MinAxisIndex() int64 {
-	mb := globalVector2iMethodBindings.method_min_axis_index
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
+	args[1] = Float32Encoder.EncodeTypePtr(amount)
 
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* DistanceTo : distance_to
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector2i) DistanceTo(to Vector2i) float32 {
-	mb := globalVector2iMethodBindings.method_distance_to
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
+// members
 
-	pnr.Pin(args[0])
+func (cx *Rect2) MemberGetposition() Vector2 {
+	bx := cx.NativeConstPtr()
+	ret := CallBuiltinPtrGetter[Vector2](globalRect2MethodBindings.member_position_getter, bx)
+	return ret
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
+}
+
+func (cx *Rect2) MemberGetsize() Vector2 {
+	bx := cx.NativeConstPtr()
+	ret := CallBuiltinPtrGetter[Vector2](globalRect2MethodBindings.member_size_getter, bx)
 	return ret
+
 }
 
-/* DistanceSquaredTo : distance_squared_to
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
+func (cx *Rect2) MemberGetend() Vector2 {
+	bx := cx.NativeConstPtr()
+	ret := CallBuiltinPtrGetter[Vector2](globalRect2MethodBindings.member_end_getter, bx)
+	return ret
+
+}
+
+// Equal_Variant operator
+func (cx *Rect2) Equal_Variant(right Variant) bool {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
+	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_equal_Variant, lt, rt)
+}
+
+// Not_equal_Variant operator
+func (cx *Rect2) Not_equal_Variant(right Variant) bool {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
+	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_not_equal_Variant, lt, rt)
+}
+
+// Not operator
+func (cx *Rect2) Not() bool {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_not, lt, rt)
+}
+
+// Multiply_Transform2D operator
+func (cx *Rect2) Multiply_Transform2D(right Transform2D) Rect2 {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Rect2](globalRect2MethodBindings.operator_multiply_Transform2D, lt, rt)
+}
+
+// In_Dictionary operator
+func (cx *Rect2) In_Dictionary(right Dictionary) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_in_Dictionary, lt, rt)
+}
+
+// In_Array operator
+func (cx *Rect2) In_Array(right Array) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_in_Array, lt, rt)
+}
+
+var _ GDBuiltInClass = (*Rect2i)(nil)
+
+/*
+ * Rect2i
+ * indexingReturnType:
+ * isKeyed: false
+ * hasDestructor: false
  */
-func (cx *Vector2i) DistanceSquaredTo(to Vector2i) int64 {
-	mb := globalVector2iMethodBindings.method_distance_squared_to
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
+type Rect2i struct {
+	Position Vector2i
+	Size     Vector2i
+}
 
-	pnr.Pin(args[0])
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Rect2i{})-Rect2iSize]
+	_ = x[unsafe.Offsetof(Rect2i{}.Position)-0]
+	_ = x[unsafe.Offsetof(Rect2i{}.Size)-8]
+}
 
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
+func (cx *Rect2i) NativeConstPtr() GDExtensionConstTypePtr {
+	return (GDExtensionConstTypePtr)(cx)
 }
 
-/* Length : length
+func (cx *Rect2i) NativePtr() GDExtensionTypePtr {
+	return (GDExtensionTypePtr)(cx)
+}
+
+// constructors
+// NewRect2i, index: 0
+func NewRect2i() Rect2i {
+	cx := Rect2i{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	CallBuiltinConstructor(globalRect2iMethodBindings.constructor_0, ptr)
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2iMethodBindings.constructor_0"), zap.Any("cx", cx))
+	return cx
+}
+
+// NewRect2i, index: 1
+func NewRect2iWithRect2i(from Rect2i) Rect2i {
+	cx := Rect2i{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [1]GDExtensionConstTypePtr
+	// Rect2i
+	// Rect2iEncoder
+	args[0] = from.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
+	CallBuiltinConstructor(globalRect2iMethodBindings.constructor_1, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2iMethodBindings.constructor_1"), zap.Any("cx", cx))
+	return cx
+}
+
+// NewRect2i, index: 2
+func NewRect2iWithRect2(from Rect2) Rect2i {
+	cx := Rect2i{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [1]GDExtensionConstTypePtr
+	// Rect2
+	// Rect2Encoder
+	args[0] = from.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
+	CallBuiltinConstructor(globalRect2iMethodBindings.constructor_2, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2iMethodBindings.constructor_2"), zap.Any("cx", cx))
+	return cx
+}
+
+// NewRect2i, index: 3
+func NewRect2iWithVector2iVector2i(position Vector2i, size Vector2i) Rect2i {
+	cx := Rect2i{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [2]GDExtensionConstTypePtr
+	// Vector2i
+	// Vector2iEncoder
+	args[0] = position.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("position", position))
+	// Vector2i
+	// Vector2iEncoder
+	args[1] = size.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("size", size))
+	CallBuiltinConstructor(globalRect2iMethodBindings.constructor_3, ptr, args[0], args[1])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2iMethodBindings.constructor_3"), zap.Any("cx", cx))
+	return cx
+}
+
+// NewRect2i, index: 4
+func NewRect2iWithInt64Int64Int64Int64(x int64, y int64, width int64, height int64) Rect2i {
+	cx := Rect2i{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [4]GDExtensionConstTypePtr
+	// int
+	args[0] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(x))
+	// int
+	args[1] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(y))
+	// int
+	args[2] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(width))
+	// int
+	args[3] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(height))
+	CallBuiltinConstructor(globalRect2iMethodBindings.constructor_4, ptr, args[0], args[1], args[2], args[3])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2iMethodBindings.constructor_4"), zap.Any("cx", cx))
+	return cx
+}
+
+// methods
+
+/* GetCenter : get_center
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Vector2i) -> Vector2i
  */
-func (cx *Vector2i) Length() float32 {
-	mb := globalVector2iMethodBindings.method_length
+func (cx *Rect2i) GetCenter() Vector2i {
+	mb := globalRect2iMethodBindings.method_get_center
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, nil)
 	return ret
 }
 
-/* LengthSquared : length_squared
+/* GetArea : get_area
  * is_vararg = false, is_static = false
  * goReturnType(int) -> int64
  */
-func (cx *Vector2i) LengthSquared() int64 {
-	mb := globalVector2iMethodBindings.method_length_squared
+func (cx *Rect2i) GetArea() int64 {
+	mb := globalRect2iMethodBindings.method_get_area
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -4990,4253 +4639,1375 @@ func (cx *Vector2i) LengthSquared() int64 {
 	return ret
 }
 
-/* Sign : sign
+/* HasArea : has_area
  * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
+ * goReturnType(bool) -> bool
  */
-func (cx *Vector2i) Sign() Vector2i {
-	mb := globalVector2iMethodBindings.method_sign
+func (cx *Rect2i) HasArea() bool {
+	mb := globalRect2iMethodBindings.method_has_area
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
 	return ret
 }
 
-/* Abs : abs
+/* HasPoint : has_point
  * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
+ * goReturnType(bool) -> bool
  */
-func (cx *Vector2i) Abs() Vector2i {
-	mb := globalVector2iMethodBindings.method_abs
+func (cx *Rect2i) HasPoint(point Vector2i) bool {
+	mb := globalRect2iMethodBindings.method_has_point
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&point)
 
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Clamp : clamp
+/* Intersects : intersects
  * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
+ * goReturnType(bool) -> bool
  */
-func (cx *Vector2i) Clamp(min Vector2i, max Vector2i) Vector2i {
-	mb := globalVector2iMethodBindings.method_clamp
+func (cx *Rect2i) Intersects(b Rect2i) bool {
+	mb := globalRect2iMethodBindings.method_intersects
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 2
+	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&min)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&max)
-
-	pnr.Pin(args[1])
+	args[0] = Rect2iEncoder.EncodeTypePtr(b)
 
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Clampi : clampi
+/* Encloses : encloses
  * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
+ * goReturnType(bool) -> bool
  */
-func (cx *Vector2i) Clampi(min int64, max int64) Vector2i {
-	mb := globalVector2iMethodBindings.method_clampi
+func (cx *Rect2i) Encloses(b Rect2i) bool {
+	mb := globalRect2iMethodBindings.method_encloses
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 2
+	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(min)
+	args[0] = Rect2iEncoder.EncodeTypePtr(b)
 
-	pnr.Pin(args[0])
-	args[1] = Int64Encoder.EncodeTypePtr(max)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Snapped : snapped
+/* Intersection : intersection
  * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
+ * goReturnType(Rect2i) -> Rect2i
  */
-func (cx *Vector2i) Snapped(step Vector2i) Vector2i {
-	mb := globalVector2iMethodBindings.method_snapped
+func (cx *Rect2i) Intersection(b Rect2i) Rect2i {
+	mb := globalRect2iMethodBindings.method_intersection
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&step)
-
-	pnr.Pin(args[0])
+	args[0] = Rect2iEncoder.EncodeTypePtr(b)
 
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Snappedi : snappedi
+/* Merge : merge
  * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
+ * goReturnType(Rect2i) -> Rect2i
  */
-func (cx *Vector2i) Snappedi(step int64) Vector2i {
-	mb := globalVector2iMethodBindings.method_snappedi
+func (cx *Rect2i) Merge(b Rect2i) Rect2i {
+	mb := globalRect2iMethodBindings.method_merge
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(step)
-
-	pnr.Pin(args[0])
+	args[0] = Rect2iEncoder.EncodeTypePtr(b)
 
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Min : min
+/* Expand : expand
  * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
+ * goReturnType(Rect2i) -> Rect2i
  */
-func (cx *Vector2i) Min(with Vector2i) Vector2i {
-	mb := globalVector2iMethodBindings.method_min
+func (cx *Rect2i) Expand(to Vector2i) Rect2i {
+	mb := globalRect2iMethodBindings.method_expand
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&to)
 
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Mini : mini
+/* Grow : grow
  * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
+ * goReturnType(Rect2i) -> Rect2i
  */
-func (cx *Vector2i) Mini(with int64) Vector2i {
-	mb := globalVector2iMethodBindings.method_mini
+func (cx *Rect2i) Grow(amount int64) Rect2i {
+	mb := globalRect2iMethodBindings.method_grow
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(with)
-
-	pnr.Pin(args[0])
+	args[0] = Int64Encoder.EncodeTypePtr(amount)
 
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Max : max
+/* GrowSide : grow_side
  * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
+ * goReturnType(Rect2i) -> Rect2i
  */
-func (cx *Vector2i) Max(with Vector2i) Vector2i {
-	mb := globalVector2iMethodBindings.method_max
+func (cx *Rect2i) GrowSide(side int64, amount int64) Rect2i {
+	mb := globalRect2iMethodBindings.method_grow_side
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 2
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
+	args[0] = Int64Encoder.EncodeTypePtr(side)
 
-	pnr.Pin(args[0])
+	args[1] = Int64Encoder.EncodeTypePtr(amount)
 
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Maxi : maxi
+/* GrowIndividual : grow_individual
  * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
+ * goReturnType(Rect2i) -> Rect2i
  */
-func (cx *Vector2i) Maxi(with int64) Vector2i {
-	mb := globalVector2iMethodBindings.method_maxi
+func (cx *Rect2i) GrowIndividual(left int64, top int64, right int64, bottom int64) Rect2i {
+	mb := globalRect2iMethodBindings.method_grow_individual
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 4
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(with)
+	args[0] = Int64Encoder.EncodeTypePtr(left)
 
-	pnr.Pin(args[0])
+	args[1] = Int64Encoder.EncodeTypePtr(top)
 
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, args...)
+	args[2] = Int64Encoder.EncodeTypePtr(right)
+
+	args[3] = Int64Encoder.EncodeTypePtr(bottom)
+
+	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-func (cx *Vector2i) GetIndexed(i int64) int64 {
-	var ret int64
-	CallFunc_GDExtensionPtrIndexedGetter(
-		globalVector2iMethodBindings.indexed_getter,
-		cx.NativeConstPtr(),
-		(GDExtensionInt)(i),
-		(GDExtensionTypePtr)(&ret),
-	)
-	return ret
-}
+/* Abs : abs
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2i) -> Rect2i
+ */
+func (cx *Rect2i) Abs() Rect2i {
+	mb := globalRect2iMethodBindings.method_abs
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
 
-func (cx *Vector2i) SetIndexed(i int64, value int64) {
-	CallFunc_GDExtensionPtrIndexedSetter(
-		globalVector2iMethodBindings.indexed_setter,
-		cx.NativePtr(),
-		(GDExtensionInt)(i),
-		(GDExtensionConstTypePtr)(&value),
-	)
+	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, nil)
+	return ret
 }
 
 // members
 
-func (cx *Vector2i) MemberGetx() int64 {
+func (cx *Rect2i) MemberGetposition() Vector2i {
 	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[int64](globalVector2iMethodBindings.member_x_getter, bx)
+	ret := CallBuiltinPtrGetter[Vector2i](globalRect2iMethodBindings.member_position_getter, bx)
 	return ret
 
 }
 
-func (cx *Vector2i) MemberGety() int64 {
+func (cx *Rect2i) MemberGetsize() Vector2i {
 	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[int64](globalVector2iMethodBindings.member_y_getter, bx)
+	ret := CallBuiltinPtrGetter[Vector2i](globalRect2iMethodBindings.member_size_getter, bx)
+	return ret
+
+}
+
+func (cx *Rect2i) MemberGetend() Vector2i {
+	bx := cx.NativeConstPtr()
+	ret := CallBuiltinPtrGetter[Vector2i](globalRect2iMethodBindings.member_end_getter, bx)
 	return ret
 
 }
 
 // Equal_Variant operator
-func (cx *Vector2i) Equal_Variant(right Variant) bool {
+func (cx *Rect2i) Equal_Variant(right Variant) bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_equal_Variant, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_equal_Variant, lt, rt)
 }
 
 // Not_equal_Variant operator
-func (cx *Vector2i) Not_equal_Variant(right Variant) bool {
+func (cx *Rect2i) Not_equal_Variant(right Variant) bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_not_equal_Variant, lt, rt)
-}
-
-// Negate operator
-func (cx *Vector2i) Negate() Vector2i {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_negate, lt, rt)
-}
-
-// Positive operator
-func (cx *Vector2i) Positive() Vector2i {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_positive, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_not_equal_Variant, lt, rt)
 }
 
 // Not operator
-func (cx *Vector2i) Not() bool {
+func (cx *Rect2i) Not() bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_not, lt, rt)
-}
-
-// Multiply_int operator
-func (cx *Vector2i) Multiply_int(right int64) Vector2i {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_multiply_int, lt, rt)
-}
-
-// Divide_int operator
-func (cx *Vector2i) Divide_int(right int64) Vector2i {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_divide_int, lt, rt)
-}
-
-// Module_int operator
-func (cx *Vector2i) Module_int(right int64) Vector2i {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_module_int, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_not, lt, rt)
 }
 
-// Multiply_float operator
-func (cx *Vector2i) Multiply_float(right float32) Vector2 {
+// Equal_Rect2i operator
+func (cx *Rect2i) Equal_Rect2i(right Rect2i) bool {
 	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
+	eRight := Rect2iEncoder.EncodeTypePtr(right)
 	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector2](globalVector2iMethodBindings.operator_multiply_float, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_equal_Rect2i, lt, rt)
 }
 
-// Divide_float operator
-func (cx *Vector2i) Divide_float(right float32) Vector2 {
+// Not_equal_Rect2i operator
+func (cx *Rect2i) Not_equal_Rect2i(right Rect2i) bool {
 	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
+	eRight := Rect2iEncoder.EncodeTypePtr(right)
 	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector2](globalVector2iMethodBindings.operator_divide_float, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_not_equal_Rect2i, lt, rt)
 }
 
-// Equal_Vector2i operator
-func (cx *Vector2i) Equal_Vector2i(right Vector2i) bool {
+// In_Dictionary operator
+func (cx *Rect2i) In_Dictionary(right Dictionary) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_equal_Vector2i, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_in_Dictionary, lt, rt)
 }
 
-// Not_equal_Vector2i operator
-func (cx *Vector2i) Not_equal_Vector2i(right Vector2i) bool {
+// In_Array operator
+func (cx *Rect2i) In_Array(right Array) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_not_equal_Vector2i, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_in_Array, lt, rt)
 }
 
-// Less_Vector2i operator
-func (cx *Vector2i) Less_Vector2i(right Vector2i) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_less_Vector2i, lt, rt)
-}
+var _ GDBuiltInClass = (*Vector3)(nil)
 
-// Less_equal_Vector2i operator
-func (cx *Vector2i) Less_equal_Vector2i(right Vector2i) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_less_equal_Vector2i, lt, rt)
+/*
+ * Vector3
+ * indexingReturnType: float
+ * isKeyed: false
+ * hasDestructor: false
+ */
+type Vector3 struct {
+	X float32
+	Y float32
+	Z float32
 }
 
-// Greater_Vector2i operator
-func (cx *Vector2i) Greater_Vector2i(right Vector2i) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_greater_Vector2i, lt, rt)
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Vector3{})-Vector3Size]
+	_ = x[unsafe.Offsetof(Vector3{}.X)-0]
+	_ = x[unsafe.Offsetof(Vector3{}.Y)-4]
+	_ = x[unsafe.Offsetof(Vector3{}.Z)-8]
 }
 
-// Greater_equal_Vector2i operator
-func (cx *Vector2i) Greater_equal_Vector2i(right Vector2i) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_greater_equal_Vector2i, lt, rt)
+func (cx *Vector3) NativeConstPtr() GDExtensionConstTypePtr {
+	return (GDExtensionConstTypePtr)(cx)
 }
 
-// Add_Vector2i operator
-func (cx *Vector2i) Add_Vector2i(right Vector2i) Vector2i {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_add_Vector2i, lt, rt)
-}
-
-// Subtract_Vector2i operator
-func (cx *Vector2i) Subtract_Vector2i(right Vector2i) Vector2i {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_subtract_Vector2i, lt, rt)
-}
-
-// Multiply_Vector2i operator
-func (cx *Vector2i) Multiply_Vector2i(right Vector2i) Vector2i {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_multiply_Vector2i, lt, rt)
-}
-
-// Divide_Vector2i operator
-func (cx *Vector2i) Divide_Vector2i(right Vector2i) Vector2i {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_divide_Vector2i, lt, rt)
-}
-
-// Module_Vector2i operator
-func (cx *Vector2i) Module_Vector2i(right Vector2i) Vector2i {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2i](globalVector2iMethodBindings.operator_module_Vector2i, lt, rt)
-}
-
-// In_Dictionary operator
-func (cx *Vector2i) In_Dictionary(right Dictionary) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_in_Dictionary, lt, rt)
-}
-
-// In_Array operator
-func (cx *Vector2i) In_Array(right Array) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector2iMethodBindings.operator_in_Array, lt, rt)
-}
-
-var _ GDBuiltInClass = (*Rect2)(nil)
-
-/*
- * Rect2
- * indexingReturnType:
- * isKeyed: false
- * hasDestructor: false
- */
-type Rect2 [16]uint8
-
-func (cx *Rect2) NativeConstPtr() GDExtensionConstTypePtr {
-	return (GDExtensionConstTypePtr)(cx)
-}
-
-func (cx *Rect2) NativePtr() GDExtensionTypePtr {
-	return (GDExtensionTypePtr)(cx)
+func (cx *Vector3) NativePtr() GDExtensionTypePtr {
+	return (GDExtensionTypePtr)(cx)
 }
 
 // constructors
-// NewRect2, index: 0
-func NewRect2() Rect2 {
-	cx := Rect2{}
+// NewVector3, index: 0
+func NewVector3() Vector3 {
+	cx := Vector3{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	CallBuiltinConstructor(globalRect2MethodBindings.constructor_0, ptr)
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2MethodBindings.constructor_0"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalVector3MethodBindings.constructor_0, ptr)
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3MethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewRect2, index: 1
-func NewRect2WithRect2(from Rect2) Rect2 {
-	cx := Rect2{}
+// NewVector3, index: 1
+func NewVector3WithVector3(from Vector3) Vector3 {
+	cx := Vector3{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
-	// Rect2
-	// Rect2Encoder
+	// Vector3
+	// Vector3Encoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalRect2MethodBindings.constructor_1, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2MethodBindings.constructor_1"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalVector3MethodBindings.constructor_1, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3MethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewRect2, index: 2
-func NewRect2WithRect2i(from Rect2i) Rect2 {
-	cx := Rect2{}
+// NewVector3, index: 2
+func NewVector3WithVector3i(from Vector3i) Vector3 {
+	cx := Vector3{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
-	// Rect2i
-	// Rect2iEncoder
+	// Vector3i
+	// Vector3iEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalRect2MethodBindings.constructor_2, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2MethodBindings.constructor_2"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewRect2, index: 3
-func NewRect2WithVector2Vector2(position Vector2, size Vector2) Rect2 {
-	cx := Rect2{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [2]GDExtensionConstTypePtr
-	// Vector2
-	// Vector2Encoder
-	args[0] = position.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("position", position))
-	pnr.Pin(args[0])
-	// Vector2
-	// Vector2Encoder
-	args[1] = size.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("size", size))
-	pnr.Pin(args[1])
-	CallBuiltinConstructor(globalRect2MethodBindings.constructor_3, ptr, args[0], args[1])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2MethodBindings.constructor_3"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalVector3MethodBindings.constructor_2, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3MethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewRect2, index: 4
-func NewRect2WithFloat32Float32Float32Float32(x float32, y float32, width float32, height float32) Rect2 {
-	cx := Rect2{}
+// NewVector3, index: 3
+func NewVector3WithFloat32Float32Float32(x float32, y float32, z float32) Vector3 {
+	cx := Vector3{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [4]GDExtensionConstTypePtr
+	var args [3]GDExtensionConstTypePtr
 	// float
 	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(x))
-	pnr.Pin(args[0])
 	// float
 	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(y))
-	pnr.Pin(args[1])
 	// float
-	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(width))
-	pnr.Pin(args[2])
-	// float
-	args[3] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(height))
-	pnr.Pin(args[3])
-	CallBuiltinConstructor(globalRect2MethodBindings.constructor_4, ptr, args[0], args[1], args[2], args[3])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2MethodBindings.constructor_4"), zap.Any("cx", cx))
+	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(z))
+	CallBuiltinConstructor(globalVector3MethodBindings.constructor_3, ptr, args[0], args[1], args[2])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3MethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
 }
 
 // methods
 
-/* GetCenter : get_center
+/* MinAxisIndex : min_axis_index
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(int) -> int64
  */
-func (cx *Rect2) GetCenter() Vector2 {
-	mb := globalRect2MethodBindings.method_get_center
+func (cx *Vector3) MinAxisIndex() int64 {
+	mb := globalVector3MethodBindings.method_min_axis_index
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* GetArea : get_area
+/* MaxAxisIndex : max_axis_index
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(int) -> int64
  */
-func (cx *Rect2) GetArea() float32 {
-	mb := globalRect2MethodBindings.method_get_area
+func (cx *Vector3) MaxAxisIndex() int64 {
+	mb := globalVector3MethodBindings.method_max_axis_index
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* HasArea : has_area
+/* AngleTo : angle_to
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(float) -> float32
  */
-func (cx *Rect2) HasArea() bool {
-	mb := globalRect2MethodBindings.method_has_area
+func (cx *Vector3) AngleTo(to Vector3) float32 {
+	mb := globalVector3MethodBindings.method_angle_to
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&to)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* HasPoint : has_point
+/* SignedAngleTo : signed_angle_to
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(float) -> float32
  */
-func (cx *Rect2) HasPoint(point Vector2) bool {
-	mb := globalRect2MethodBindings.method_has_point
+func (cx *Vector3) SignedAngleTo(to Vector3, axis Vector3) float32 {
+	mb := globalVector3MethodBindings.method_signed_angle_to
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 2
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&point)
+	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
+	args[1] = (GDExtensionTypePtr)(&axis)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* IsEqualApprox : is_equal_approx
+/* Snapped : snapped
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) IsEqualApprox(rect Rect2) bool {
-	mb := globalRect2MethodBindings.method_is_equal_approx
+func (cx *Vector3) Snapped(step Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_snapped
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Rect2Encoder.EncodeTypePtr(rect)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&step)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* IsFinite : is_finite
+/* Snappedf : snappedf
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) IsFinite() bool {
-	mb := globalRect2MethodBindings.method_is_finite
+func (cx *Vector3) Snappedf(step float32) Vector3 {
+	mb := globalVector3MethodBindings.method_snappedf
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(step)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Intersects : intersects
+/* Rotated : rotated
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) Intersects(b Rect2, include_borders bool) bool {
-	mb := globalRect2MethodBindings.method_intersects
+func (cx *Vector3) Rotated(axis Vector3, angle float32) Vector3 {
+	mb := globalVector3MethodBindings.method_rotated
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 2
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Rect2Encoder.EncodeTypePtr(b)
-
-	pnr.Pin(args[0])
-	args[1] = BoolEncoder.EncodeTypePtr(include_borders)
+	args[0] = (GDExtensionTypePtr)(&axis)
 
-	pnr.Pin(args[1])
+	args[1] = Float32Encoder.EncodeTypePtr(angle)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Encloses : encloses
+/* Slerp : slerp
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) Encloses(b Rect2) bool {
-	mb := globalRect2MethodBindings.method_encloses
+func (cx *Vector3) Slerp(to Vector3, weight float32) Vector3 {
+	mb := globalVector3MethodBindings.method_slerp
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 2
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Rect2Encoder.EncodeTypePtr(b)
+	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
+	args[1] = Float32Encoder.EncodeTypePtr(weight)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Intersection : intersection
+/* CubicInterpolate : cubic_interpolate
  * is_vararg = false, is_static = false
- * goReturnType(Rect2) -> Rect2
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) Intersection(b Rect2) Rect2 {
-	mb := globalRect2MethodBindings.method_intersection
+func (cx *Vector3) CubicInterpolate(b Vector3, pre_a Vector3, post_b Vector3, weight float32) Vector3 {
+	mb := globalVector3MethodBindings.method_cubic_interpolate
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 4
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Rect2Encoder.EncodeTypePtr(b)
+	args[0] = (GDExtensionTypePtr)(&b)
 
-	pnr.Pin(args[0])
+	args[1] = (GDExtensionTypePtr)(&pre_a)
 
-	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	args[2] = (GDExtensionTypePtr)(&post_b)
+
+	args[3] = Float32Encoder.EncodeTypePtr(weight)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Merge : merge
+/* CubicInterpolateInTime : cubic_interpolate_in_time
  * is_vararg = false, is_static = false
- * goReturnType(Rect2) -> Rect2
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) Merge(b Rect2) Rect2 {
-	mb := globalRect2MethodBindings.method_merge
+func (cx *Vector3) CubicInterpolateInTime(b Vector3, pre_a Vector3, post_b Vector3, weight float32, b_t float32, pre_a_t float32, post_b_t float32) Vector3 {
+	mb := globalVector3MethodBindings.method_cubic_interpolate_in_time
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 7
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Rect2Encoder.EncodeTypePtr(b)
+	args[0] = (GDExtensionTypePtr)(&b)
 
-	pnr.Pin(args[0])
+	args[1] = (GDExtensionTypePtr)(&pre_a)
 
-	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	args[2] = (GDExtensionTypePtr)(&post_b)
+
+	args[3] = Float32Encoder.EncodeTypePtr(weight)
+
+	args[4] = Float32Encoder.EncodeTypePtr(b_t)
+
+	args[5] = Float32Encoder.EncodeTypePtr(pre_a_t)
+
+	args[6] = Float32Encoder.EncodeTypePtr(post_b_t)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Expand : expand
+/* BezierInterpolate : bezier_interpolate
  * is_vararg = false, is_static = false
- * goReturnType(Rect2) -> Rect2
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) Expand(to Vector2) Rect2 {
-	mb := globalRect2MethodBindings.method_expand
+func (cx *Vector3) BezierInterpolate(control_1 Vector3, control_2 Vector3, end Vector3, t float32) Vector3 {
+	mb := globalVector3MethodBindings.method_bezier_interpolate
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 4
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
+	args[0] = (GDExtensionTypePtr)(&control_1)
+
+	args[1] = (GDExtensionTypePtr)(&control_2)
 
-	pnr.Pin(args[0])
+	args[2] = (GDExtensionTypePtr)(&end)
 
-	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	args[3] = Float32Encoder.EncodeTypePtr(t)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* GetSupport : get_support
+/* BezierDerivative : bezier_derivative
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) GetSupport(direction Vector2) Vector2 {
-	mb := globalRect2MethodBindings.method_get_support
+func (cx *Vector3) BezierDerivative(control_1 Vector3, control_2 Vector3, end Vector3, t float32) Vector3 {
+	mb := globalVector3MethodBindings.method_bezier_derivative
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 4
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&direction)
+	args[0] = (GDExtensionTypePtr)(&control_1)
 
-	pnr.Pin(args[0])
+	args[1] = (GDExtensionTypePtr)(&control_2)
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	args[2] = (GDExtensionTypePtr)(&end)
+
+	args[3] = Float32Encoder.EncodeTypePtr(t)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Grow : grow
+/* Posmod : posmod
  * is_vararg = false, is_static = false
- * goReturnType(Rect2) -> Rect2
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) Grow(amount float32) Rect2 {
-	mb := globalRect2MethodBindings.method_grow
+func (cx *Vector3) Posmod(mod float32) Vector3 {
+	mb := globalVector3MethodBindings.method_posmod
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(amount)
-
-	pnr.Pin(args[0])
+	args[0] = Float32Encoder.EncodeTypePtr(mod)
 
-	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* GrowSide : grow_side
+/* Posmodv : posmodv
  * is_vararg = false, is_static = false
- * goReturnType(Rect2) -> Rect2
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) GrowSide(side int64, amount float32) Rect2 {
-	mb := globalRect2MethodBindings.method_grow_side
+func (cx *Vector3) Posmodv(modv Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_posmodv
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 2
+	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(side)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(amount)
-
-	pnr.Pin(args[1])
+	args[0] = (GDExtensionTypePtr)(&modv)
 
-	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* GrowIndividual : grow_individual
+/* Slide : slide
  * is_vararg = false, is_static = false
- * goReturnType(Rect2) -> Rect2
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Rect2) GrowIndividual(left float32, top float32, right float32, bottom float32) Rect2 {
-	mb := globalRect2MethodBindings.method_grow_individual
+func (cx *Vector3) Slide(n Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_slide
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 4
+	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(left)
+	args[0] = (GDExtensionTypePtr)(&n)
 
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(top)
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
 
-	pnr.Pin(args[1])
-	args[2] = Float32Encoder.EncodeTypePtr(right)
+/* Bounce : bounce
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Bounce(n Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_bounce
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&n)
 
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(bottom)
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
 
-	pnr.Pin(args[3])
+/* Reflect : reflect
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Reflect(n Vector3) Vector3 {
+	mb := globalVector3MethodBindings.method_reflect
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := cx.NativePtr()
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&n)
 
-	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Abs : abs
+/* OctahedronEncode : octahedron_encode
  * is_vararg = false, is_static = false
- * goReturnType(Rect2) -> Rect2
+ * goReturnType(Vector2) -> Vector2
  */
-func (cx *Rect2) Abs() Rect2 {
-	mb := globalRect2MethodBindings.method_abs
+func (cx *Vector3) OctahedronEncode() Vector2 {
+	mb := globalVector3MethodBindings.method_octahedron_encode
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[Rect2](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
+	return ret
+}
+
+/* OctahedronDecode : octahedron_decode
+ * is_vararg = false, is_static = true
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) OctahedronDecode(uv Vector2) Vector3 {
+	mb := globalVector3MethodBindings.method_octahedron_decode
+	if mb == nil {
+		log.Panic("method bind cannot be nil")
+	}
+	bx := (GDExtensionTypePtr)(nullptr)
+	if bx == nil {
+		log.Panic("object cannot be nil")
+	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&uv)
+
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
+	return ret
+}
+
+func (cx *Vector3) GetIndexed(i int64) float32 {
+	var ret float32
+	CallFunc_GDExtensionPtrIndexedGetter(
+		globalVector3MethodBindings.indexed_getter,
+		cx.NativeConstPtr(),
+		(GDExtensionInt)(i),
+		(GDExtensionTypePtr)(&ret),
+	)
 	return ret
 }
 
+func (cx *Vector3) SetIndexed(i int64, value float32) {
+	CallFunc_GDExtensionPtrIndexedSetter(
+		globalVector3MethodBindings.indexed_setter,
+		cx.NativePtr(),
+		(GDExtensionInt)(i),
+		(GDExtensionConstTypePtr)(&value),
+	)
+}
+
 // members
 
-func (cx *Rect2) MemberGetposition() Vector2 {
+func (cx *Vector3) MemberGetx() float32 {
 	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[Vector2](globalRect2MethodBindings.member_position_getter, bx)
-	return ret
+	ret := CallBuiltinPtrGetter[float64](globalVector3MethodBindings.member_x_getter, bx)
+	return Float32Encoder.DecodeTypePtr((GDExtensionConstTypePtr)(&ret))
 
 }
 
-func (cx *Rect2) MemberGetsize() Vector2 {
+func (cx *Vector3) MemberGety() float32 {
 	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[Vector2](globalRect2MethodBindings.member_size_getter, bx)
-	return ret
+	ret := CallBuiltinPtrGetter[float64](globalVector3MethodBindings.member_y_getter, bx)
+	return Float32Encoder.DecodeTypePtr((GDExtensionConstTypePtr)(&ret))
 
 }
 
-func (cx *Rect2) MemberGetend() Vector2 {
+func (cx *Vector3) MemberGetz() float32 {
 	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[Vector2](globalRect2MethodBindings.member_end_getter, bx)
-	return ret
+	ret := CallBuiltinPtrGetter[float64](globalVector3MethodBindings.member_z_getter, bx)
+	return Float32Encoder.DecodeTypePtr((GDExtensionConstTypePtr)(&ret))
 
 }
 
 // Equal_Variant operator
-func (cx *Rect2) Equal_Variant(right Variant) bool {
+func (cx *Vector3) Equal_Variant(right Variant) bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_equal_Variant, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_equal_Variant, lt, rt)
 }
 
 // Not_equal_Variant operator
-func (cx *Rect2) Not_equal_Variant(right Variant) bool {
+func (cx *Vector3) Not_equal_Variant(right Variant) bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_not_equal_Variant, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_not_equal_Variant, lt, rt)
 }
 
 // Not operator
-func (cx *Rect2) Not() bool {
+func (cx *Vector3) Not() bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_not, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_not, lt, rt)
 }
 
-// Equal_Rect2 operator
-func (cx *Rect2) Equal_Rect2(right Rect2) bool {
+// Multiply_Quaternion operator
+func (cx *Vector3) Multiply_Quaternion(right Quaternion) Vector3 {
 	lt := cx.NativeConstPtr()
-	eRight := Rect2Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_equal_Rect2, lt, rt)
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_Quaternion, lt, rt)
 }
 
-// Not_equal_Rect2 operator
-func (cx *Rect2) Not_equal_Rect2(right Rect2) bool {
+// Multiply_Transform3D operator
+func (cx *Vector3) Multiply_Transform3D(right Transform3D) Vector3 {
 	lt := cx.NativeConstPtr()
-	eRight := Rect2Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_not_equal_Rect2, lt, rt)
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_Transform3D, lt, rt)
 }
 
-// Multiply_Transform2D operator
-func (cx *Rect2) Multiply_Transform2D(right Transform2D) Rect2 {
+// In_Dictionary operator
+func (cx *Vector3) In_Dictionary(right Dictionary) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Rect2](globalRect2MethodBindings.operator_multiply_Transform2D, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_in_Dictionary, lt, rt)
 }
 
-// In_Dictionary operator
-func (cx *Rect2) In_Dictionary(right Dictionary) bool {
+// In_Array operator
+func (cx *Vector3) In_Array(right Array) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_in_Dictionary, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_in_Array, lt, rt)
 }
 
-// In_Array operator
-func (cx *Rect2) In_Array(right Array) bool {
+// In_PackedVector3Array operator
+func (cx *Vector3) In_PackedVector3Array(right PackedVector3Array) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalRect2MethodBindings.operator_in_Array, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_in_PackedVector3Array, lt, rt)
 }
 
-var _ GDBuiltInClass = (*Rect2i)(nil)
+var _ GDBuiltInClass = (*Vector3i)(nil)
 
 /*
- * Rect2i
- * indexingReturnType:
+ * Vector3i
+ * indexingReturnType: int
  * isKeyed: false
  * hasDestructor: false
  */
-type Rect2i [16]uint8
+type Vector3i struct {
+	X int32
+	Y int32
+	Z int32
+}
 
-func (cx *Rect2i) NativeConstPtr() GDExtensionConstTypePtr {
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Vector3i{})-Vector3iSize]
+	_ = x[unsafe.Offsetof(Vector3i{}.X)-0]
+	_ = x[unsafe.Offsetof(Vector3i{}.Y)-4]
+	_ = x[unsafe.Offsetof(Vector3i{}.Z)-8]
+}
+
+func (cx *Vector3i) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
 }
 
-func (cx *Rect2i) NativePtr() GDExtensionTypePtr {
+func (cx *Vector3i) NativePtr() GDExtensionTypePtr {
 	return (GDExtensionTypePtr)(cx)
 }
 
 // constructors
-// NewRect2i, index: 0
-func NewRect2i() Rect2i {
-	cx := Rect2i{}
+// NewVector3i, index: 0
+func NewVector3i() Vector3i {
+	cx := Vector3i{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	CallBuiltinConstructor(globalRect2iMethodBindings.constructor_0, ptr)
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2iMethodBindings.constructor_0"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalVector3iMethodBindings.constructor_0, ptr)
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3iMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewRect2i, index: 1
-func NewRect2iWithRect2i(from Rect2i) Rect2i {
-	cx := Rect2i{}
+// NewVector3i, index: 1
+func NewVector3iWithVector3i(from Vector3i) Vector3i {
+	cx := Vector3i{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
-	// Rect2i
-	// Rect2iEncoder
+	// Vector3i
+	// Vector3iEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalRect2iMethodBindings.constructor_1, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2iMethodBindings.constructor_1"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalVector3iMethodBindings.constructor_1, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3iMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewRect2i, index: 2
-func NewRect2iWithRect2(from Rect2) Rect2i {
-	cx := Rect2i{}
+// NewVector3i, index: 2
+func NewVector3iWithVector3(from Vector3) Vector3i {
+	cx := Vector3i{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
-	// Rect2
-	// Rect2Encoder
+	// Vector3
+	// Vector3Encoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalRect2iMethodBindings.constructor_2, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2iMethodBindings.constructor_2"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewRect2i, index: 3
-func NewRect2iWithVector2iVector2i(position Vector2i, size Vector2i) Rect2i {
-	cx := Rect2i{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [2]GDExtensionConstTypePtr
-	// Vector2i
-	// Vector2iEncoder
-	args[0] = position.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("position", position))
-	pnr.Pin(args[0])
-	// Vector2i
-	// Vector2iEncoder
-	args[1] = size.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("size", size))
-	pnr.Pin(args[1])
-	CallBuiltinConstructor(globalRect2iMethodBindings.constructor_3, ptr, args[0], args[1])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2iMethodBindings.constructor_3"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalVector3iMethodBindings.constructor_2, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3iMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewRect2i, index: 4
-func NewRect2iWithInt64Int64Int64Int64(x int64, y int64, width int64, height int64) Rect2i {
-	cx := Rect2i{}
+// NewVector3i, index: 3
+func NewVector3iWithInt64Int64Int64(x int64, y int64, z int64) Vector3i {
+	cx := Vector3i{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [4]GDExtensionConstTypePtr
+	var args [3]GDExtensionConstTypePtr
 	// int
 	args[0] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(x))
-	pnr.Pin(args[0])
 	// int
 	args[1] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(y))
-	pnr.Pin(args[1])
-	// int
-	args[2] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(width))
-	pnr.Pin(args[2])
 	// int
-	args[3] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(height))
-	pnr.Pin(args[3])
-	CallBuiltinConstructor(globalRect2iMethodBindings.constructor_4, ptr, args[0], args[1], args[2], args[3])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRect2iMethodBindings.constructor_4"), zap.Any("cx", cx))
-	return cx
-}
-
-// methods
-
-/* GetCenter : get_center
- * is_vararg = false, is_static = false
- * goReturnType(Vector2i) -> Vector2i
- */
-func (cx *Rect2i) GetCenter() Vector2i {
-	mb := globalRect2iMethodBindings.method_get_center
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector2i](mb, bx, nil)
-	return ret
-}
-
-/* GetArea : get_area
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Rect2i) GetArea() int64 {
-	mb := globalRect2iMethodBindings.method_get_area
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* HasArea : has_area
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Rect2i) HasArea() bool {
-	mb := globalRect2iMethodBindings.method_has_area
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
-	return ret
-}
-
-/* HasPoint : has_point
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Rect2i) HasPoint(point Vector2i) bool {
-	mb := globalRect2iMethodBindings.method_has_point
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&point)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Intersects : intersects
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Rect2i) Intersects(b Rect2i) bool {
-	mb := globalRect2iMethodBindings.method_intersects
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Rect2iEncoder.EncodeTypePtr(b)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Encloses : encloses
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Rect2i) Encloses(b Rect2i) bool {
-	mb := globalRect2iMethodBindings.method_encloses
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Rect2iEncoder.EncodeTypePtr(b)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Intersection : intersection
- * is_vararg = false, is_static = false
- * goReturnType(Rect2i) -> Rect2i
- */
-func (cx *Rect2i) Intersection(b Rect2i) Rect2i {
-	mb := globalRect2iMethodBindings.method_intersection
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Rect2iEncoder.EncodeTypePtr(b)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Merge : merge
- * is_vararg = false, is_static = false
- * goReturnType(Rect2i) -> Rect2i
- */
-func (cx *Rect2i) Merge(b Rect2i) Rect2i {
-	mb := globalRect2iMethodBindings.method_merge
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Rect2iEncoder.EncodeTypePtr(b)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Expand : expand
- * is_vararg = false, is_static = false
- * goReturnType(Rect2i) -> Rect2i
- */
-func (cx *Rect2i) Expand(to Vector2i) Rect2i {
-	mb := globalRect2iMethodBindings.method_expand
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Grow : grow
- * is_vararg = false, is_static = false
- * goReturnType(Rect2i) -> Rect2i
- */
-func (cx *Rect2i) Grow(amount int64) Rect2i {
-	mb := globalRect2iMethodBindings.method_grow
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(amount)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* GrowSide : grow_side
- * is_vararg = false, is_static = false
- * goReturnType(Rect2i) -> Rect2i
- */
-func (cx *Rect2i) GrowSide(side int64, amount int64) Rect2i {
-	mb := globalRect2iMethodBindings.method_grow_side
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(side)
-
-	pnr.Pin(args[0])
-	args[1] = Int64Encoder.EncodeTypePtr(amount)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* GrowIndividual : grow_individual
- * is_vararg = false, is_static = false
- * goReturnType(Rect2i) -> Rect2i
- */
-func (cx *Rect2i) GrowIndividual(left int64, top int64, right int64, bottom int64) Rect2i {
-	mb := globalRect2iMethodBindings.method_grow_individual
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 4
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(left)
-
-	pnr.Pin(args[0])
-	args[1] = Int64Encoder.EncodeTypePtr(top)
-
-	pnr.Pin(args[1])
-	args[2] = Int64Encoder.EncodeTypePtr(right)
-
-	pnr.Pin(args[2])
-	args[3] = Int64Encoder.EncodeTypePtr(bottom)
-
-	pnr.Pin(args[3])
-
-	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Abs : abs
- * is_vararg = false, is_static = false
- * goReturnType(Rect2i) -> Rect2i
- */
-func (cx *Rect2i) Abs() Rect2i {
-	mb := globalRect2iMethodBindings.method_abs
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Rect2i](mb, bx, nil)
-	return ret
-}
-
-// members
-
-func (cx *Rect2i) MemberGetposition() Vector2i {
-	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[Vector2i](globalRect2iMethodBindings.member_position_getter, bx)
-	return ret
-
-}
-
-func (cx *Rect2i) MemberGetsize() Vector2i {
-	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[Vector2i](globalRect2iMethodBindings.member_size_getter, bx)
-	return ret
-
-}
-
-func (cx *Rect2i) MemberGetend() Vector2i {
-	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[Vector2i](globalRect2iMethodBindings.member_end_getter, bx)
-	return ret
-
-}
-
-// Equal_Variant operator
-func (cx *Rect2i) Equal_Variant(right Variant) bool {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_equal_Variant, lt, rt)
-}
-
-// Not_equal_Variant operator
-func (cx *Rect2i) Not_equal_Variant(right Variant) bool {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_not_equal_Variant, lt, rt)
-}
-
-// Not operator
-func (cx *Rect2i) Not() bool {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_not, lt, rt)
-}
-
-// Equal_Rect2i operator
-func (cx *Rect2i) Equal_Rect2i(right Rect2i) bool {
-	lt := cx.NativeConstPtr()
-	eRight := Rect2iEncoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_equal_Rect2i, lt, rt)
-}
-
-// Not_equal_Rect2i operator
-func (cx *Rect2i) Not_equal_Rect2i(right Rect2i) bool {
-	lt := cx.NativeConstPtr()
-	eRight := Rect2iEncoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_not_equal_Rect2i, lt, rt)
-}
-
-// In_Dictionary operator
-func (cx *Rect2i) In_Dictionary(right Dictionary) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_in_Dictionary, lt, rt)
-}
-
-// In_Array operator
-func (cx *Rect2i) In_Array(right Array) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalRect2iMethodBindings.operator_in_Array, lt, rt)
-}
-
-var _ GDBuiltInClass = (*Vector3)(nil)
-
-/*
- * Vector3
- * indexingReturnType: float
- * isKeyed: false
- * hasDestructor: false
- */
-type Vector3 [12]uint8
-
-func (cx *Vector3) NativeConstPtr() GDExtensionConstTypePtr {
-	return (GDExtensionConstTypePtr)(cx)
-}
-
-func (cx *Vector3) NativePtr() GDExtensionTypePtr {
-	return (GDExtensionTypePtr)(cx)
-}
-
-// constructors
-// NewVector3, index: 0
-func NewVector3() Vector3 {
-	cx := Vector3{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	CallBuiltinConstructor(globalVector3MethodBindings.constructor_0, ptr)
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3MethodBindings.constructor_0"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewVector3, index: 1
-func NewVector3WithVector3(from Vector3) Vector3 {
-	cx := Vector3{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [1]GDExtensionConstTypePtr
-	// Vector3
-	// Vector3Encoder
-	args[0] = from.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalVector3MethodBindings.constructor_1, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3MethodBindings.constructor_1"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewVector3, index: 2
-func NewVector3WithVector3i(from Vector3i) Vector3 {
-	cx := Vector3{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [1]GDExtensionConstTypePtr
-	// Vector3i
-	// Vector3iEncoder
-	args[0] = from.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalVector3MethodBindings.constructor_2, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3MethodBindings.constructor_2"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewVector3, index: 3
-func NewVector3WithFloat32Float32Float32(x float32, y float32, z float32) Vector3 {
-	cx := Vector3{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [3]GDExtensionConstTypePtr
-	// float
-	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(x))
-	pnr.Pin(args[0])
-	// float
-	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(y))
-	pnr.Pin(args[1])
-	// float
-	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(z))
-	pnr.Pin(args[2])
-	CallBuiltinConstructor(globalVector3MethodBindings.constructor_3, ptr, args[0], args[1], args[2])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3MethodBindings.constructor_3"), zap.Any("cx", cx))
-	return cx
-}
-
-// methods
-
-/* MinAxisIndex : min_axis_index
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Vector3) MinAxisIndex() int64 {
-	mb := globalVector3MethodBindings.method_min_axis_index
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* MaxAxisIndex : max_axis_index
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Vector3) MaxAxisIndex() int64 {
-	mb := globalVector3MethodBindings.method_max_axis_index
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* AngleTo : angle_to
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector3) AngleTo(to Vector3) float32 {
-	mb := globalVector3MethodBindings.method_angle_to
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* SignedAngleTo : signed_angle_to
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector3) SignedAngleTo(to Vector3, axis Vector3) float32 {
-	mb := globalVector3MethodBindings.method_signed_angle_to
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&axis)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* DirectionTo : direction_to
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) DirectionTo(to Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_direction_to
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* DistanceTo : distance_to
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector3) DistanceTo(to Vector3) float32 {
-	mb := globalVector3MethodBindings.method_distance_to
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* DistanceSquaredTo : distance_squared_to
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector3) DistanceSquaredTo(to Vector3) float32 {
-	mb := globalVector3MethodBindings.method_distance_squared_to
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Length : length
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector3) Length() float32 {
-	mb := globalVector3MethodBindings.method_length
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
-	return ret
-}
-
-/* LengthSquared : length_squared
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector3) LengthSquared() float32 {
-	mb := globalVector3MethodBindings.method_length_squared
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
-	return ret
-}
-
-/* LimitLength : limit_length
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) LimitLength(length float32) Vector3 {
-	mb := globalVector3MethodBindings.method_limit_length
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(length)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Normalized : normalized
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Normalized() Vector3 {
-	mb := globalVector3MethodBindings.method_normalized
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
-	return ret
-}
-
-/* IsNormalized : is_normalized
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Vector3) IsNormalized() bool {
-	mb := globalVector3MethodBindings.method_is_normalized
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
-	return ret
-}
-
-/* IsEqualApprox : is_equal_approx
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Vector3) IsEqualApprox(to Vector3) bool {
-	mb := globalVector3MethodBindings.method_is_equal_approx
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* IsZeroApprox : is_zero_approx
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Vector3) IsZeroApprox() bool {
-	mb := globalVector3MethodBindings.method_is_zero_approx
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
-	return ret
-}
-
-/* IsFinite : is_finite
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Vector3) IsFinite() bool {
-	mb := globalVector3MethodBindings.method_is_finite
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
-	return ret
-}
-
-/* Inverse : inverse
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Inverse() Vector3 {
-	mb := globalVector3MethodBindings.method_inverse
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
-	return ret
-}
-
-/* Clamp : clamp
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Clamp(min Vector3, max Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_clamp
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&min)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&max)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Clampf : clampf
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Clampf(min float32, max float32) Vector3 {
-	mb := globalVector3MethodBindings.method_clampf
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(min)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(max)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Snapped : snapped
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Snapped(step Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_snapped
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&step)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Snappedf : snappedf
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Snappedf(step float32) Vector3 {
-	mb := globalVector3MethodBindings.method_snappedf
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(step)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Rotated : rotated
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Rotated(axis Vector3, angle float32) Vector3 {
-	mb := globalVector3MethodBindings.method_rotated
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&axis)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(angle)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Lerp : lerp
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Lerp(to Vector3, weight float32) Vector3 {
-	mb := globalVector3MethodBindings.method_lerp
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(weight)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Slerp : slerp
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Slerp(to Vector3, weight float32) Vector3 {
-	mb := globalVector3MethodBindings.method_slerp
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(weight)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* CubicInterpolate : cubic_interpolate
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) CubicInterpolate(b Vector3, pre_a Vector3, post_b Vector3, weight float32) Vector3 {
-	mb := globalVector3MethodBindings.method_cubic_interpolate
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 4
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&b)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&pre_a)
-
-	pnr.Pin(args[1])
-	args[2] = (GDExtensionTypePtr)(&post_b)
-
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(weight)
-
-	pnr.Pin(args[3])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* CubicInterpolateInTime : cubic_interpolate_in_time
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) CubicInterpolateInTime(b Vector3, pre_a Vector3, post_b Vector3, weight float32, b_t float32, pre_a_t float32, post_b_t float32) Vector3 {
-	mb := globalVector3MethodBindings.method_cubic_interpolate_in_time
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 7
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&b)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&pre_a)
-
-	pnr.Pin(args[1])
-	args[2] = (GDExtensionTypePtr)(&post_b)
-
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(weight)
-
-	pnr.Pin(args[3])
-	args[4] = Float32Encoder.EncodeTypePtr(b_t)
-
-	pnr.Pin(args[4])
-	args[5] = Float32Encoder.EncodeTypePtr(pre_a_t)
-
-	pnr.Pin(args[5])
-	args[6] = Float32Encoder.EncodeTypePtr(post_b_t)
-
-	pnr.Pin(args[6])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* BezierInterpolate : bezier_interpolate
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) BezierInterpolate(control_1 Vector3, control_2 Vector3, end Vector3, t float32) Vector3 {
-	mb := globalVector3MethodBindings.method_bezier_interpolate
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 4
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&control_1)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&control_2)
-
-	pnr.Pin(args[1])
-	args[2] = (GDExtensionTypePtr)(&end)
-
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(t)
-
-	pnr.Pin(args[3])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* BezierDerivative : bezier_derivative
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) BezierDerivative(control_1 Vector3, control_2 Vector3, end Vector3, t float32) Vector3 {
-	mb := globalVector3MethodBindings.method_bezier_derivative
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 4
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&control_1)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&control_2)
-
-	pnr.Pin(args[1])
-	args[2] = (GDExtensionTypePtr)(&end)
-
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(t)
-
-	pnr.Pin(args[3])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* MoveToward : move_toward
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) MoveToward(to Vector3, delta float32) Vector3 {
-	mb := globalVector3MethodBindings.method_move_toward
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(delta)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Dot : dot
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector3) Dot(with Vector3) float32 {
-	mb := globalVector3MethodBindings.method_dot
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Cross : cross
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Cross(with Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_cross
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Outer : outer
- * is_vararg = false, is_static = false
- * goReturnType(Basis) -> Basis
- */
-func (cx *Vector3) Outer(with Vector3) Basis {
-	mb := globalVector3MethodBindings.method_outer
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Abs : abs
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Abs() Vector3 {
-	mb := globalVector3MethodBindings.method_abs
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
-	return ret
-}
-
-/* Floor : floor
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Floor() Vector3 {
-	mb := globalVector3MethodBindings.method_floor
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
-	return ret
-}
-
-/* Ceil : ceil
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Ceil() Vector3 {
-	mb := globalVector3MethodBindings.method_ceil
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
-	return ret
-}
-
-/* Round : round
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Round() Vector3 {
-	mb := globalVector3MethodBindings.method_round
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
-	return ret
-}
-
-/* Posmod : posmod
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Posmod(mod float32) Vector3 {
-	mb := globalVector3MethodBindings.method_posmod
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(mod)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Posmodv : posmodv
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Posmodv(modv Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_posmodv
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&modv)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Project : project
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Project(b Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_project
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&b)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Slide : slide
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Slide(n Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_slide
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&n)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Bounce : bounce
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Bounce(n Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_bounce
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&n)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Reflect : reflect
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Reflect(n Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_reflect
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&n)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Sign : sign
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Sign() Vector3 {
-	mb := globalVector3MethodBindings.method_sign
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
-	return ret
-}
-
-/* OctahedronEncode : octahedron_encode
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Vector3) OctahedronEncode() Vector2 {
-	mb := globalVector3MethodBindings.method_octahedron_encode
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
-	return ret
-}
-
-/* Min : min
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Min(with Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_min
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Minf : minf
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Minf(with float32) Vector3 {
-	mb := globalVector3MethodBindings.method_minf
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Max : max
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Max(with Vector3) Vector3 {
-	mb := globalVector3MethodBindings.method_max
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Maxf : maxf
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) Maxf(with float32) Vector3 {
-	mb := globalVector3MethodBindings.method_maxf
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* OctahedronDecode : octahedron_decode
- * is_vararg = false, is_static = true
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Vector3) OctahedronDecode(uv Vector2) Vector3 {
-	mb := globalVector3MethodBindings.method_octahedron_decode
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&uv)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-func (cx *Vector3) GetIndexed(i int64) float32 {
-	var ret float32
-	CallFunc_GDExtensionPtrIndexedGetter(
-		globalVector3MethodBindings.indexed_getter,
-		cx.NativeConstPtr(),
-		(GDExtensionInt)(i),
-		(GDExtensionTypePtr)(&ret),
-	)
-	return ret
-}
-
-func (cx *Vector3) SetIndexed(i int64, value float32) {
-	CallFunc_GDExtensionPtrIndexedSetter(
-		globalVector3MethodBindings.indexed_setter,
-		cx.NativePtr(),
-		(GDExtensionInt)(i),
-		(GDExtensionConstTypePtr)(&value),
-	)
-}
-
-// members
-
-func (cx *Vector3) MemberGetx() float32 {
-	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[float64](globalVector3MethodBindings.member_x_getter, bx)
-	return Float32Encoder.DecodeTypePtr((GDExtensionConstTypePtr)(&ret))
-
-}
-
-func (cx *Vector3) MemberGety() float32 {
-	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[float64](globalVector3MethodBindings.member_y_getter, bx)
-	return Float32Encoder.DecodeTypePtr((GDExtensionConstTypePtr)(&ret))
-
-}
-
-func (cx *Vector3) MemberGetz() float32 {
-	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[float64](globalVector3MethodBindings.member_z_getter, bx)
-	return Float32Encoder.DecodeTypePtr((GDExtensionConstTypePtr)(&ret))
-
-}
-
-// Equal_Variant operator
-func (cx *Vector3) Equal_Variant(right Variant) bool {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_equal_Variant, lt, rt)
-}
-
-// Not_equal_Variant operator
-func (cx *Vector3) Not_equal_Variant(right Variant) bool {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_not_equal_Variant, lt, rt)
-}
-
-// Negate operator
-func (cx *Vector3) Negate() Vector3 {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_negate, lt, rt)
-}
-
-// Positive operator
-func (cx *Vector3) Positive() Vector3 {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_positive, lt, rt)
-}
-
-// Not operator
-func (cx *Vector3) Not() bool {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_not, lt, rt)
-}
-
-// Multiply_int operator
-func (cx *Vector3) Multiply_int(right int64) Vector3 {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_int, lt, rt)
-}
-
-// Divide_int operator
-func (cx *Vector3) Divide_int(right int64) Vector3 {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_divide_int, lt, rt)
-}
-
-// Multiply_float operator
-func (cx *Vector3) Multiply_float(right float32) Vector3 {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_float, lt, rt)
-}
-
-// Divide_float operator
-func (cx *Vector3) Divide_float(right float32) Vector3 {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_divide_float, lt, rt)
-}
-
-// Equal_Vector3 operator
-func (cx *Vector3) Equal_Vector3(right Vector3) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_equal_Vector3, lt, rt)
-}
-
-// Not_equal_Vector3 operator
-func (cx *Vector3) Not_equal_Vector3(right Vector3) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_not_equal_Vector3, lt, rt)
-}
-
-// Less_Vector3 operator
-func (cx *Vector3) Less_Vector3(right Vector3) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_less_Vector3, lt, rt)
-}
-
-// Less_equal_Vector3 operator
-func (cx *Vector3) Less_equal_Vector3(right Vector3) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_less_equal_Vector3, lt, rt)
-}
-
-// Greater_Vector3 operator
-func (cx *Vector3) Greater_Vector3(right Vector3) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_greater_Vector3, lt, rt)
-}
-
-// Greater_equal_Vector3 operator
-func (cx *Vector3) Greater_equal_Vector3(right Vector3) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_greater_equal_Vector3, lt, rt)
-}
-
-// Add_Vector3 operator
-func (cx *Vector3) Add_Vector3(right Vector3) Vector3 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_add_Vector3, lt, rt)
-}
-
-// Subtract_Vector3 operator
-func (cx *Vector3) Subtract_Vector3(right Vector3) Vector3 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_subtract_Vector3, lt, rt)
-}
-
-// Multiply_Vector3 operator
-func (cx *Vector3) Multiply_Vector3(right Vector3) Vector3 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_Vector3, lt, rt)
-}
-
-// Divide_Vector3 operator
-func (cx *Vector3) Divide_Vector3(right Vector3) Vector3 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_divide_Vector3, lt, rt)
-}
-
-// Multiply_Quaternion operator
-func (cx *Vector3) Multiply_Quaternion(right Quaternion) Vector3 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_Quaternion, lt, rt)
-}
-
-// Multiply_Basis operator
-func (cx *Vector3) Multiply_Basis(right Basis) Vector3 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_Basis, lt, rt)
-}
-
-// Multiply_Transform3D operator
-func (cx *Vector3) Multiply_Transform3D(right Transform3D) Vector3 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3](globalVector3MethodBindings.operator_multiply_Transform3D, lt, rt)
-}
-
-// In_Dictionary operator
-func (cx *Vector3) In_Dictionary(right Dictionary) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_in_Dictionary, lt, rt)
-}
-
-// In_Array operator
-func (cx *Vector3) In_Array(right Array) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_in_Array, lt, rt)
-}
-
-// In_PackedVector3Array operator
-func (cx *Vector3) In_PackedVector3Array(right PackedVector3Array) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3MethodBindings.operator_in_PackedVector3Array, lt, rt)
-}
-
-var _ GDBuiltInClass = (*Vector3i)(nil)
-
-/*
- * Vector3i
- * indexingReturnType: int
- * isKeyed: false
- * hasDestructor: false
- */
-type Vector3i [12]uint8
-
-func (cx *Vector3i) NativeConstPtr() GDExtensionConstTypePtr {
-	return (GDExtensionConstTypePtr)(cx)
-}
-
-func (cx *Vector3i) NativePtr() GDExtensionTypePtr {
-	return (GDExtensionTypePtr)(cx)
-}
-
-// constructors
-// NewVector3i, index: 0
-func NewVector3i() Vector3i {
-	cx := Vector3i{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	CallBuiltinConstructor(globalVector3iMethodBindings.constructor_0, ptr)
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3iMethodBindings.constructor_0"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewVector3i, index: 1
-func NewVector3iWithVector3i(from Vector3i) Vector3i {
-	cx := Vector3i{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [1]GDExtensionConstTypePtr
-	// Vector3i
-	// Vector3iEncoder
-	args[0] = from.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalVector3iMethodBindings.constructor_1, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3iMethodBindings.constructor_1"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewVector3i, index: 2
-func NewVector3iWithVector3(from Vector3) Vector3i {
-	cx := Vector3i{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [1]GDExtensionConstTypePtr
-	// Vector3
-	// Vector3Encoder
-	args[0] = from.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalVector3iMethodBindings.constructor_2, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3iMethodBindings.constructor_2"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewVector3i, index: 3
-func NewVector3iWithInt64Int64Int64(x int64, y int64, z int64) Vector3i {
-	cx := Vector3i{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [3]GDExtensionConstTypePtr
-	// int
-	args[0] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(x))
-	pnr.Pin(args[0])
-	// int
-	args[1] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(y))
-	pnr.Pin(args[1])
-	// int
-	args[2] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(z))
-	pnr.Pin(args[2])
-	CallBuiltinConstructor(globalVector3iMethodBindings.constructor_3, ptr, args[0], args[1], args[2])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3iMethodBindings.constructor_3"), zap.Any("cx", cx))
-	return cx
-}
-
-// methods
-
-/* MinAxisIndex : min_axis_index
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Vector3i) MinAxisIndex() int64 {
-	mb := globalVector3iMethodBindings.method_min_axis_index
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* MaxAxisIndex : max_axis_index
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Vector3i) MaxAxisIndex() int64 {
-	mb := globalVector3iMethodBindings.method_max_axis_index
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* DistanceTo : distance_to
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector3i) DistanceTo(to Vector3i) float32 {
-	mb := globalVector3iMethodBindings.method_distance_to
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* DistanceSquaredTo : distance_squared_to
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Vector3i) DistanceSquaredTo(to Vector3i) int64 {
-	mb := globalVector3iMethodBindings.method_distance_squared_to
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Length : length
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector3i) Length() float32 {
-	mb := globalVector3iMethodBindings.method_length
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
-	return ret
-}
-
-/* LengthSquared : length_squared
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Vector3i) LengthSquared() int64 {
-	mb := globalVector3iMethodBindings.method_length_squared
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* Sign : sign
- * is_vararg = false, is_static = false
- * goReturnType(Vector3i) -> Vector3i
- */
-func (cx *Vector3i) Sign() Vector3i {
-	mb := globalVector3iMethodBindings.method_sign
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, nil)
-	return ret
-}
-
-/* Abs : abs
- * is_vararg = false, is_static = false
- * goReturnType(Vector3i) -> Vector3i
- */
-func (cx *Vector3i) Abs() Vector3i {
-	mb := globalVector3iMethodBindings.method_abs
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, nil)
-	return ret
-}
-
-/* Clamp : clamp
- * is_vararg = false, is_static = false
- * goReturnType(Vector3i) -> Vector3i
- */
-func (cx *Vector3i) Clamp(min Vector3i, max Vector3i) Vector3i {
-	mb := globalVector3iMethodBindings.method_clamp
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&min)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&max)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Clampi : clampi
- * is_vararg = false, is_static = false
- * goReturnType(Vector3i) -> Vector3i
- */
-func (cx *Vector3i) Clampi(min int64, max int64) Vector3i {
-	mb := globalVector3iMethodBindings.method_clampi
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(min)
-
-	pnr.Pin(args[0])
-	args[1] = Int64Encoder.EncodeTypePtr(max)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Snapped : snapped
- * is_vararg = false, is_static = false
- * goReturnType(Vector3i) -> Vector3i
- */
-func (cx *Vector3i) Snapped(step Vector3i) Vector3i {
-	mb := globalVector3iMethodBindings.method_snapped
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&step)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Snappedi : snappedi
- * is_vararg = false, is_static = false
- * goReturnType(Vector3i) -> Vector3i
- */
-func (cx *Vector3i) Snappedi(step int64) Vector3i {
-	mb := globalVector3iMethodBindings.method_snappedi
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(step)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Min : min
- * is_vararg = false, is_static = false
- * goReturnType(Vector3i) -> Vector3i
- */
-func (cx *Vector3i) Min(with Vector3i) Vector3i {
-	mb := globalVector3iMethodBindings.method_min
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Mini : mini
- * is_vararg = false, is_static = false
- * goReturnType(Vector3i) -> Vector3i
- */
-func (cx *Vector3i) Mini(with int64) Vector3i {
-	mb := globalVector3iMethodBindings.method_mini
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Max : max
- * is_vararg = false, is_static = false
- * goReturnType(Vector3i) -> Vector3i
- */
-func (cx *Vector3i) Max(with Vector3i) Vector3i {
-	mb := globalVector3iMethodBindings.method_max
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Maxi : maxi
- * is_vararg = false, is_static = false
- * goReturnType(Vector3i) -> Vector3i
- */
-func (cx *Vector3i) Maxi(with int64) Vector3i {
-	mb := globalVector3iMethodBindings.method_maxi
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(with)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-func (cx *Vector3i) GetIndexed(i int64) int64 {
-	var ret int64
-	CallFunc_GDExtensionPtrIndexedGetter(
-		globalVector3iMethodBindings.indexed_getter,
-		cx.NativeConstPtr(),
-		(GDExtensionInt)(i),
-		(GDExtensionTypePtr)(&ret),
-	)
-	return ret
-}
-
-func (cx *Vector3i) SetIndexed(i int64, value int64) {
-	CallFunc_GDExtensionPtrIndexedSetter(
-		globalVector3iMethodBindings.indexed_setter,
-		cx.NativePtr(),
-		(GDExtensionInt)(i),
-		(GDExtensionConstTypePtr)(&value),
-	)
-}
-
-// members
-
-func (cx *Vector3i) MemberGetx() int64 {
-	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[int64](globalVector3iMethodBindings.member_x_getter, bx)
-	return ret
-
-}
-
-func (cx *Vector3i) MemberGety() int64 {
-	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[int64](globalVector3iMethodBindings.member_y_getter, bx)
-	return ret
-
-}
-
-func (cx *Vector3i) MemberGetz() int64 {
-	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[int64](globalVector3iMethodBindings.member_z_getter, bx)
-	return ret
-
-}
-
-// Equal_Variant operator
-func (cx *Vector3i) Equal_Variant(right Variant) bool {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_equal_Variant, lt, rt)
-}
-
-// Not_equal_Variant operator
-func (cx *Vector3i) Not_equal_Variant(right Variant) bool {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_not_equal_Variant, lt, rt)
-}
-
-// Negate operator
-func (cx *Vector3i) Negate() Vector3i {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_negate, lt, rt)
-}
-
-// Positive operator
-func (cx *Vector3i) Positive() Vector3i {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_positive, lt, rt)
-}
-
-// Not operator
-func (cx *Vector3i) Not() bool {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_not, lt, rt)
-}
-
-// Multiply_int operator
-func (cx *Vector3i) Multiply_int(right int64) Vector3i {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_multiply_int, lt, rt)
-}
-
-// Divide_int operator
-func (cx *Vector3i) Divide_int(right int64) Vector3i {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_divide_int, lt, rt)
-}
-
-// Module_int operator
-func (cx *Vector3i) Module_int(right int64) Vector3i {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_module_int, lt, rt)
-}
-
-// Multiply_float operator
-func (cx *Vector3i) Multiply_float(right float32) Vector3 {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector3](globalVector3iMethodBindings.operator_multiply_float, lt, rt)
-}
-
-// Divide_float operator
-func (cx *Vector3i) Divide_float(right float32) Vector3 {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector3](globalVector3iMethodBindings.operator_divide_float, lt, rt)
-}
-
-// Equal_Vector3i operator
-func (cx *Vector3i) Equal_Vector3i(right Vector3i) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_equal_Vector3i, lt, rt)
-}
-
-// Not_equal_Vector3i operator
-func (cx *Vector3i) Not_equal_Vector3i(right Vector3i) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_not_equal_Vector3i, lt, rt)
-}
-
-// Less_Vector3i operator
-func (cx *Vector3i) Less_Vector3i(right Vector3i) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_less_Vector3i, lt, rt)
-}
-
-// Less_equal_Vector3i operator
-func (cx *Vector3i) Less_equal_Vector3i(right Vector3i) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_less_equal_Vector3i, lt, rt)
-}
-
-// Greater_Vector3i operator
-func (cx *Vector3i) Greater_Vector3i(right Vector3i) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_greater_Vector3i, lt, rt)
-}
-
-// Greater_equal_Vector3i operator
-func (cx *Vector3i) Greater_equal_Vector3i(right Vector3i) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_greater_equal_Vector3i, lt, rt)
-}
-
-// Add_Vector3i operator
-func (cx *Vector3i) Add_Vector3i(right Vector3i) Vector3i {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_add_Vector3i, lt, rt)
-}
-
-// Subtract_Vector3i operator
-func (cx *Vector3i) Subtract_Vector3i(right Vector3i) Vector3i {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_subtract_Vector3i, lt, rt)
-}
-
-// Multiply_Vector3i operator
-func (cx *Vector3i) Multiply_Vector3i(right Vector3i) Vector3i {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_multiply_Vector3i, lt, rt)
-}
-
-// Divide_Vector3i operator
-func (cx *Vector3i) Divide_Vector3i(right Vector3i) Vector3i {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_divide_Vector3i, lt, rt)
-}
-
-// Module_Vector3i operator
-func (cx *Vector3i) Module_Vector3i(right Vector3i) Vector3i {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_module_Vector3i, lt, rt)
-}
-
-// In_Dictionary operator
-func (cx *Vector3i) In_Dictionary(right Dictionary) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_in_Dictionary, lt, rt)
-}
-
-// In_Array operator
-func (cx *Vector3i) In_Array(right Array) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_in_Array, lt, rt)
-}
-
-var _ GDBuiltInClass = (*Transform2D)(nil)
-
-/*
- * Transform2D
- * indexingReturnType: Vector2
- * isKeyed: false
- * hasDestructor: false
- */
-type Transform2D [24]uint8
-
-func (cx *Transform2D) NativeConstPtr() GDExtensionConstTypePtr {
-	return (GDExtensionConstTypePtr)(cx)
-}
-
-func (cx *Transform2D) NativePtr() GDExtensionTypePtr {
-	return (GDExtensionTypePtr)(cx)
-}
-
-// constructors
-// NewTransform2D, index: 0
-func NewTransform2D() Transform2D {
-	cx := Transform2D{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	CallBuiltinConstructor(globalTransform2DMethodBindings.constructor_0, ptr)
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform2DMethodBindings.constructor_0"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewTransform2D, index: 1
-func NewTransform2DWithTransform2D(from Transform2D) Transform2D {
-	cx := Transform2D{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [1]GDExtensionConstTypePtr
-	// Transform2D
-	// Transform2DEncoder
-	args[0] = from.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalTransform2DMethodBindings.constructor_1, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform2DMethodBindings.constructor_1"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewTransform2D, index: 2
-func NewTransform2DWithFloat32Vector2(rotation float32, position Vector2) Transform2D {
-	cx := Transform2D{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [2]GDExtensionConstTypePtr
-	// float
-	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(rotation))
-	pnr.Pin(args[0])
-	// Vector2
-	// Vector2Encoder
-	args[1] = position.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("position", position))
-	pnr.Pin(args[1])
-	CallBuiltinConstructor(globalTransform2DMethodBindings.constructor_2, ptr, args[0], args[1])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform2DMethodBindings.constructor_2"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewTransform2D, index: 3
-func NewTransform2DWithFloat32Vector2Float32Vector2(rotation float32, scale Vector2, skew float32, position Vector2) Transform2D {
-	cx := Transform2D{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [4]GDExtensionConstTypePtr
-	// float
-	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(rotation))
-	pnr.Pin(args[0])
-	// Vector2
-	// Vector2Encoder
-	args[1] = scale.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("scale", scale))
-	pnr.Pin(args[1])
-	// float
-	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(skew))
-	pnr.Pin(args[2])
-	// Vector2
-	// Vector2Encoder
-	args[3] = position.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[3]", uintptr(args[3])), zap.Any("position", position))
-	pnr.Pin(args[3])
-	CallBuiltinConstructor(globalTransform2DMethodBindings.constructor_3, ptr, args[0], args[1], args[2], args[3])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform2DMethodBindings.constructor_3"), zap.Any("cx", cx))
-	return cx
-}
-
-// NewTransform2D, index: 4
-func NewTransform2DWithVector2Vector2Vector2(x_axis Vector2, y_axis Vector2, origin Vector2) Transform2D {
-	cx := Transform2D{}
-	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [3]GDExtensionConstTypePtr
-	// Vector2
-	// Vector2Encoder
-	args[0] = x_axis.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("x_axis", x_axis))
-	pnr.Pin(args[0])
-	// Vector2
-	// Vector2Encoder
-	args[1] = y_axis.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("y_axis", y_axis))
-	pnr.Pin(args[1])
-	// Vector2
-	// Vector2Encoder
-	args[2] = origin.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[2]", uintptr(args[2])), zap.Any("origin", origin))
-	pnr.Pin(args[2])
-	CallBuiltinConstructor(globalTransform2DMethodBindings.constructor_4, ptr, args[0], args[1], args[2])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform2DMethodBindings.constructor_4"), zap.Any("cx", cx))
-	return cx
-}
-
-// methods
-
-/* Inverse : inverse
- * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
- */
-func (cx *Transform2D) Inverse() Transform2D {
-	mb := globalTransform2DMethodBindings.method_inverse
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, nil)
-	return ret
-}
-
-/* AffineInverse : affine_inverse
- * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
- */
-func (cx *Transform2D) AffineInverse() Transform2D {
-	mb := globalTransform2DMethodBindings.method_affine_inverse
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, nil)
-	return ret
-}
-
-/* GetRotation : get_rotation
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Transform2D) GetRotation() float32 {
-	mb := globalTransform2DMethodBindings.method_get_rotation
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
-	return ret
-}
-
-/* GetOrigin : get_origin
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Transform2D) GetOrigin() Vector2 {
-	mb := globalTransform2DMethodBindings.method_get_origin
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
-	return ret
+	args[2] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(z))
+	CallBuiltinConstructor(globalVector3iMethodBindings.constructor_3, ptr, args[0], args[1], args[2])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector3iMethodBindings.constructor_3"), zap.Any("cx", cx))
+	return cx
 }
 
-/* GetScale : get_scale
- * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
- */
-func (cx *Transform2D) GetScale() Vector2 {
-	mb := globalTransform2DMethodBindings.method_get_scale
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, nil)
-	return ret
-}
+// methods
 
-/* GetSkew : get_skew
+/* MinAxisIndex : min_axis_index
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(int) -> int64
  */
-func (cx *Transform2D) GetSkew() float32 {
-	mb := globalTransform2DMethodBindings.method_get_skew
+func (cx *Vector3i) MinAxisIndex() int64 {
+	mb := globalVector3iMethodBindings.method_min_axis_index
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* Orthonormalized : orthonormalized
+/* MaxAxisIndex : max_axis_index
  * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
+ * goReturnType(int) -> int64
  */
-func (cx *Transform2D) Orthonormalized() Transform2D {
-	mb := globalTransform2DMethodBindings.method_orthonormalized
+func (cx *Vector3i) MaxAxisIndex() int64 {
+	mb := globalVector3iMethodBindings.method_max_axis_index
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* Rotated : rotated
+/* DistanceTo : distance_to
  * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
+ * goReturnType(float) -> float32
  */
-func (cx *Transform2D) Rotated(angle float32) Transform2D {
-	mb := globalTransform2DMethodBindings.method_rotated
+func (cx *Vector3i) DistanceTo(to Vector3i) float32 {
+	mb := globalVector3iMethodBindings.method_distance_to
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(angle)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&to)
 
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* RotatedLocal : rotated_local
+/* DistanceSquaredTo : distance_squared_to
  * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
+ * goReturnType(int) -> int64
  */
-func (cx *Transform2D) RotatedLocal(angle float32) Transform2D {
-	mb := globalTransform2DMethodBindings.method_rotated_local
+func (cx *Vector3i) DistanceSquaredTo(to Vector3i) int64 {
+	mb := globalVector3iMethodBindings.method_distance_squared_to
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(angle)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&to)
 
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Scaled : scaled
+/* Length : length
  * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
+ * goReturnType(float) -> float32
  */
-func (cx *Transform2D) Scaled(scale Vector2) Transform2D {
-	mb := globalTransform2DMethodBindings.method_scaled
+func (cx *Vector3i) Length() float32 {
+	mb := globalVector3iMethodBindings.method_length
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&scale)
-
-	pnr.Pin(args[0])
 
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
 	return ret
 }
 
-/* ScaledLocal : scaled_local
+/* LengthSquared : length_squared
  * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
+ * goReturnType(int) -> int64
  */
-func (cx *Transform2D) ScaledLocal(scale Vector2) Transform2D {
-	mb := globalTransform2DMethodBindings.method_scaled_local
+func (cx *Vector3i) LengthSquared() int64 {
+	mb := globalVector3iMethodBindings.method_length_squared
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&scale)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* Translated : translated
+/* Sign : sign
  * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
+ * goReturnType(Vector3i) -> Vector3i
  */
-func (cx *Transform2D) Translated(offset Vector2) Transform2D {
-	mb := globalTransform2DMethodBindings.method_translated
+func (cx *Vector3i) Sign() Vector3i {
+	mb := globalVector3iMethodBindings.method_sign
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&offset)
-
-	pnr.Pin(args[0])
 
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, nil)
 	return ret
 }
 
-/* TranslatedLocal : translated_local
+/* Abs : abs
  * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
+ * goReturnType(Vector3i) -> Vector3i
  */
-func (cx *Transform2D) TranslatedLocal(offset Vector2) Transform2D {
-	mb := globalTransform2DMethodBindings.method_translated_local
+func (cx *Vector3i) Abs() Vector3i {
+	mb := globalVector3iMethodBindings.method_abs
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&offset)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, nil)
 	return ret
 }
 
-/* Determinant : determinant
+/* Clamp : clamp
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Vector3i) -> Vector3i
  */
-func (cx *Transform2D) Determinant() float32 {
-	mb := globalTransform2DMethodBindings.method_determinant
+func (cx *Vector3i) Clamp(min Vector3i, max Vector3i) Vector3i {
+	mb := globalVector3iMethodBindings.method_clamp
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 2
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&min)
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	args[1] = (GDExtensionTypePtr)(&max)
+
+	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* BasisXform : basis_xform
+/* Clampi : clampi
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector3i) -> Vector3i
  */
-func (cx *Transform2D) BasisXform(v Vector2) Vector2 {
-	mb := globalTransform2DMethodBindings.method_basis_xform
+func (cx *Vector3i) Clampi(min int64, max int64) Vector3i {
+	mb := globalVector3iMethodBindings.method_clampi
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 2
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&v)
+	args[0] = Int64Encoder.EncodeTypePtr(min)
 
-	pnr.Pin(args[0])
+	args[1] = Int64Encoder.EncodeTypePtr(max)
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* BasisXformInv : basis_xform_inv
+/* Snapped : snapped
  * is_vararg = false, is_static = false
- * goReturnType(Vector2) -> Vector2
+ * goReturnType(Vector3i) -> Vector3i
  */
-func (cx *Transform2D) BasisXformInv(v Vector2) Vector2 {
-	mb := globalTransform2DMethodBindings.method_basis_xform_inv
+func (cx *Vector3i) Snapped(step Vector3i) Vector3i {
+	mb := globalVector3iMethodBindings.method_snapped
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&v)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&step)
 
-	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* InterpolateWith : interpolate_with
+/* Snappedi : snappedi
  * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
+ * goReturnType(Vector3i) -> Vector3i
  */
-func (cx *Transform2D) InterpolateWith(xform Transform2D, weight float32) Transform2D {
-	mb := globalTransform2DMethodBindings.method_interpolate_with
+func (cx *Vector3i) Snappedi(step int64) Vector3i {
+	mb := globalVector3iMethodBindings.method_snappedi
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 2
+	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&xform)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(weight)
-
-	pnr.Pin(args[1])
+	args[0] = Int64Encoder.EncodeTypePtr(step)
 
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* IsConformal : is_conformal
+/* Min : min
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Vector3i) -> Vector3i
  */
-func (cx *Transform2D) IsConformal() bool {
-	mb := globalTransform2DMethodBindings.method_is_conformal
+func (cx *Vector3i) Min(with Vector3i) Vector3i {
+	mb := globalVector3iMethodBindings.method_min
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* IsEqualApprox : is_equal_approx
+/* Mini : mini
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Vector3i) -> Vector3i
  */
-func (cx *Transform2D) IsEqualApprox(xform Transform2D) bool {
-	mb := globalTransform2DMethodBindings.method_is_equal_approx
+func (cx *Vector3i) Mini(with int64) Vector3i {
+	mb := globalVector3iMethodBindings.method_mini
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&xform)
-
-	pnr.Pin(args[0])
+	args[0] = Int64Encoder.EncodeTypePtr(with)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* IsFinite : is_finite
+/* Max : max
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Vector3i) -> Vector3i
  */
-func (cx *Transform2D) IsFinite() bool {
-	mb := globalTransform2DMethodBindings.method_is_finite
+func (cx *Vector3i) Max(with Vector3i) Vector3i {
+	mb := globalVector3iMethodBindings.method_max
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&with)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* LookingAt : looking_at
+/* Maxi : maxi
  * is_vararg = false, is_static = false
- * goReturnType(Transform2D) -> Transform2D
+ * goReturnType(Vector3i) -> Vector3i
  */
-func (cx *Transform2D) LookingAt(target Vector2) Transform2D {
-	mb := globalTransform2DMethodBindings.method_looking_at
+func (cx *Vector3i) Maxi(with int64) Vector3i {
+	mb := globalVector3iMethodBindings.method_maxi
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&target)
-
-	pnr.Pin(args[0])
+	args[0] = Int64Encoder.EncodeTypePtr(with)
 
-	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector3i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-func (cx *Transform2D) GetIndexed(i int64) Vector2 {
-	var ret Vector2
+func (cx *Vector3i) GetIndexed(i int64) int64 {
+	var ret int64
 	CallFunc_GDExtensionPtrIndexedGetter(
-		globalTransform2DMethodBindings.indexed_getter,
+		globalVector3iMethodBindings.indexed_getter,
 		cx.NativeConstPtr(),
 		(GDExtensionInt)(i),
 		(GDExtensionTypePtr)(&ret),
@@ -9244,9 +6015,9 @@ func (cx *Transform2D) GetIndexed(i int64) Vector2 {
 	return ret
 }
 
-func (cx *Transform2D) SetIndexed(i int64, value Vector2) {
+func (cx *Vector3i) SetIndexed(i int64, value int64) {
 	CallFunc_GDExtensionPtrIndexedSetter(
-		globalTransform2DMethodBindings.indexed_setter,
+		globalVector3iMethodBindings.indexed_setter,
 		cx.NativePtr(),
 		(GDExtensionInt)(i),
 		(GDExtensionConstTypePtr)(&value),
@@ -9255,969 +6026,856 @@ func (cx *Transform2D) SetIndexed(i int64, value Vector2) {
 
 // members
 
-func (cx *Transform2D) MemberGetx() Vector2 {
+func (cx *Vector3i) MemberGetx() int64 {
 	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[Vector2](globalTransform2DMethodBindings.member_x_getter, bx)
+	ret := CallBuiltinPtrGetter[int64](globalVector3iMethodBindings.member_x_getter, bx)
 	return ret
 
 }
 
-func (cx *Transform2D) MemberGety() Vector2 {
+func (cx *Vector3i) MemberGety() int64 {
 	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[Vector2](globalTransform2DMethodBindings.member_y_getter, bx)
+	ret := CallBuiltinPtrGetter[int64](globalVector3iMethodBindings.member_y_getter, bx)
 	return ret
 
 }
 
-func (cx *Transform2D) MemberGetorigin() Vector2 {
+func (cx *Vector3i) MemberGetz() int64 {
 	bx := cx.NativeConstPtr()
-	ret := CallBuiltinPtrGetter[Vector2](globalTransform2DMethodBindings.member_origin_getter, bx)
+	ret := CallBuiltinPtrGetter[int64](globalVector3iMethodBindings.member_z_getter, bx)
 	return ret
 
 }
 
 // Equal_Variant operator
-func (cx *Transform2D) Equal_Variant(right Variant) bool {
+func (cx *Vector3i) Equal_Variant(right Variant) bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_equal_Variant, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_equal_Variant, lt, rt)
 }
 
 // Not_equal_Variant operator
-func (cx *Transform2D) Not_equal_Variant(right Variant) bool {
+func (cx *Vector3i) Not_equal_Variant(right Variant) bool {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_not_equal_Variant, lt, rt)
+}
+
+// Negate operator
+func (cx *Vector3i) Negate() Vector3i {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_negate, lt, rt)
+}
+
+// Positive operator
+func (cx *Vector3i) Positive() Vector3i {
 	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
-	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_not_equal_Variant, lt, rt)
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_positive, lt, rt)
 }
 
 // Not operator
-func (cx *Transform2D) Not() bool {
+func (cx *Vector3i) Not() bool {
 	lt := cx.NativeConstPtr()
 	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_not, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_not, lt, rt)
 }
 
 // Multiply_int operator
-func (cx *Transform2D) Multiply_int(right int64) Transform2D {
+func (cx *Vector3i) Multiply_int(right int64) Vector3i {
 	lt := cx.NativeConstPtr()
 	eRight := Int64Encoder.EncodeTypePtr(right)
 	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Transform2D](globalTransform2DMethodBindings.operator_multiply_int, lt, rt)
+	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_multiply_int, lt, rt)
 }
 
 // Divide_int operator
-func (cx *Transform2D) Divide_int(right int64) Transform2D {
+func (cx *Vector3i) Divide_int(right int64) Vector3i {
+	lt := cx.NativeConstPtr()
+	eRight := Int64Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_divide_int, lt, rt)
+}
+
+// Module_int operator
+func (cx *Vector3i) Module_int(right int64) Vector3i {
 	lt := cx.NativeConstPtr()
 	eRight := Int64Encoder.EncodeTypePtr(right)
 	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Transform2D](globalTransform2DMethodBindings.operator_divide_int, lt, rt)
+	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_module_int, lt, rt)
 }
 
 // Multiply_float operator
-func (cx *Transform2D) Multiply_float(right float32) Transform2D {
+func (cx *Vector3i) Multiply_float(right float32) Vector3 {
 	lt := cx.NativeConstPtr()
 	eRight := Float32Encoder.EncodeTypePtr(right)
 	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Transform2D](globalTransform2DMethodBindings.operator_multiply_float, lt, rt)
+	return CallBuiltinOperatorPtr[Vector3](globalVector3iMethodBindings.operator_multiply_float, lt, rt)
 }
 
 // Divide_float operator
-func (cx *Transform2D) Divide_float(right float32) Transform2D {
+func (cx *Vector3i) Divide_float(right float32) Vector3 {
 	lt := cx.NativeConstPtr()
 	eRight := Float32Encoder.EncodeTypePtr(right)
 	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Transform2D](globalTransform2DMethodBindings.operator_divide_float, lt, rt)
+	return CallBuiltinOperatorPtr[Vector3](globalVector3iMethodBindings.operator_divide_float, lt, rt)
 }
 
-// Multiply_Vector2 operator
-func (cx *Transform2D) Multiply_Vector2(right Vector2) Vector2 {
+// Equal_Vector3i operator
+func (cx *Vector3i) Equal_Vector3i(right Vector3i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector2](globalTransform2DMethodBindings.operator_multiply_Vector2, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_equal_Vector3i, lt, rt)
 }
 
-// Multiply_Rect2 operator
-func (cx *Transform2D) Multiply_Rect2(right Rect2) Rect2 {
+// Not_equal_Vector3i operator
+func (cx *Vector3i) Not_equal_Vector3i(right Vector3i) bool {
 	lt := cx.NativeConstPtr()
-	eRight := Rect2Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Rect2](globalTransform2DMethodBindings.operator_multiply_Rect2, lt, rt)
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_not_equal_Vector3i, lt, rt)
 }
 
-// Equal_Transform2D operator
-func (cx *Transform2D) Equal_Transform2D(right Transform2D) bool {
+// Less_Vector3i operator
+func (cx *Vector3i) Less_Vector3i(right Vector3i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_equal_Transform2D, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_less_Vector3i, lt, rt)
 }
 
-// Not_equal_Transform2D operator
-func (cx *Transform2D) Not_equal_Transform2D(right Transform2D) bool {
+// Less_equal_Vector3i operator
+func (cx *Vector3i) Less_equal_Vector3i(right Vector3i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_not_equal_Transform2D, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_less_equal_Vector3i, lt, rt)
 }
 
-// Multiply_Transform2D operator
-func (cx *Transform2D) Multiply_Transform2D(right Transform2D) Transform2D {
+// Greater_Vector3i operator
+func (cx *Vector3i) Greater_Vector3i(right Vector3i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Transform2D](globalTransform2DMethodBindings.operator_multiply_Transform2D, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_greater_Vector3i, lt, rt)
 }
 
-// In_Dictionary operator
-func (cx *Transform2D) In_Dictionary(right Dictionary) bool {
+// Greater_equal_Vector3i operator
+func (cx *Vector3i) Greater_equal_Vector3i(right Vector3i) bool {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_in_Dictionary, lt, rt)
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_greater_equal_Vector3i, lt, rt)
 }
 
-// In_Array operator
-func (cx *Transform2D) In_Array(right Array) bool {
+// Add_Vector3i operator
+func (cx *Vector3i) Add_Vector3i(right Vector3i) Vector3i {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_in_Array, lt, rt)
+	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_add_Vector3i, lt, rt)
 }
 
-// Multiply_PackedVector2Array operator
-func (cx *Transform2D) Multiply_PackedVector2Array(right PackedVector2Array) PackedVector2Array {
+// Subtract_Vector3i operator
+func (cx *Vector3i) Subtract_Vector3i(right Vector3i) Vector3i {
 	lt := cx.NativeConstPtr()
 	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[PackedVector2Array](globalTransform2DMethodBindings.operator_multiply_PackedVector2Array, lt, rt)
+	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_subtract_Vector3i, lt, rt)
 }
 
-var _ GDBuiltInClass = (*Vector4)(nil)
+// Multiply_Vector3i operator
+func (cx *Vector3i) Multiply_Vector3i(right Vector3i) Vector3i {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_multiply_Vector3i, lt, rt)
+}
+
+// Divide_Vector3i operator
+func (cx *Vector3i) Divide_Vector3i(right Vector3i) Vector3i {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_divide_Vector3i, lt, rt)
+}
+
+// Module_Vector3i operator
+func (cx *Vector3i) Module_Vector3i(right Vector3i) Vector3i {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[Vector3i](globalVector3iMethodBindings.operator_module_Vector3i, lt, rt)
+}
+
+// In_Dictionary operator
+func (cx *Vector3i) In_Dictionary(right Dictionary) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_in_Dictionary, lt, rt)
+}
+
+// In_Array operator
+func (cx *Vector3i) In_Array(right Array) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalVector3iMethodBindings.operator_in_Array, lt, rt)
+}
+
+var _ GDBuiltInClass = (*Transform2D)(nil)
 
 /*
- * Vector4
- * indexingReturnType: float
+ * Transform2D
+ * indexingReturnType: Vector2
  * isKeyed: false
  * hasDestructor: false
  */
-type Vector4 [16]uint8
+type Transform2D struct {
+	X      Vector2
+	Y      Vector2
+	Origin Vector2
+}
 
-func (cx *Vector4) NativeConstPtr() GDExtensionConstTypePtr {
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Transform2D{})-Transform2DSize]
+	_ = x[unsafe.Offsetof(Transform2D{}.X)-0]
+	_ = x[unsafe.Offsetof(Transform2D{}.Y)-8]
+	_ = x[unsafe.Offsetof(Transform2D{}.Origin)-16]
+}
+
+func (cx *Transform2D) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
 }
 
-func (cx *Vector4) NativePtr() GDExtensionTypePtr {
+func (cx *Transform2D) NativePtr() GDExtensionTypePtr {
 	return (GDExtensionTypePtr)(cx)
 }
 
 // constructors
-// NewVector4, index: 0
-func NewVector4() Vector4 {
-	cx := Vector4{}
+// NewTransform2D, index: 0
+func NewTransform2D() Transform2D {
+	cx := Transform2D{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	CallBuiltinConstructor(globalVector4MethodBindings.constructor_0, ptr)
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4MethodBindings.constructor_0"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalTransform2DMethodBindings.constructor_0, ptr)
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform2DMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewVector4, index: 1
-func NewVector4WithVector4(from Vector4) Vector4 {
-	cx := Vector4{}
+// NewTransform2D, index: 1
+func NewTransform2DWithTransform2D(from Transform2D) Transform2D {
+	cx := Transform2D{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
-	// Vector4
-	// Vector4Encoder
+	// Transform2D
+	// Transform2DEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalVector4MethodBindings.constructor_1, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4MethodBindings.constructor_1"), zap.Any("cx", cx))
+	CallBuiltinConstructor(globalTransform2DMethodBindings.constructor_1, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform2DMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewVector4, index: 2
-func NewVector4WithVector4i(from Vector4i) Vector4 {
-	cx := Vector4{}
+// NewTransform2D, index: 2
+func NewTransform2DWithFloat32Vector2(rotation float32, position Vector2) Transform2D {
+	cx := Transform2D{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
-	var args [1]GDExtensionConstTypePtr
-	// Vector4i
-	// Vector4iEncoder
-	args[0] = from.NativeConstPtr()
-	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
-	CallBuiltinConstructor(globalVector4MethodBindings.constructor_2, ptr, args[0])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4MethodBindings.constructor_2"), zap.Any("cx", cx))
+	var args [2]GDExtensionConstTypePtr
+	// float
+	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(rotation))
+	// Vector2
+	// Vector2Encoder
+	args[1] = position.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("position", position))
+	CallBuiltinConstructor(globalTransform2DMethodBindings.constructor_2, ptr, args[0], args[1])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform2DMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
 }
 
-// NewVector4, index: 3
-func NewVector4WithFloat32Float32Float32Float32(x float32, y float32, z float32, w float32) Vector4 {
-	cx := Vector4{}
+// NewTransform2D, index: 3
+func NewTransform2DWithFloat32Vector2Float32Vector2(rotation float32, scale Vector2, skew float32, position Vector2) Transform2D {
+	cx := Transform2D{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [4]GDExtensionConstTypePtr
 	// float
-	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(x))
-	pnr.Pin(args[0])
-	// float
-	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(y))
-	pnr.Pin(args[1])
-	// float
-	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(z))
-	pnr.Pin(args[2])
+	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(rotation))
+	// Vector2
+	// Vector2Encoder
+	args[1] = scale.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("scale", scale))
 	// float
-	args[3] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(w))
-	pnr.Pin(args[3])
-	CallBuiltinConstructor(globalVector4MethodBindings.constructor_3, ptr, args[0], args[1], args[2], args[3])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4MethodBindings.constructor_3"), zap.Any("cx", cx))
+	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(skew))
+	// Vector2
+	// Vector2Encoder
+	args[3] = position.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[3]", uintptr(args[3])), zap.Any("position", position))
+	CallBuiltinConstructor(globalTransform2DMethodBindings.constructor_3, ptr, args[0], args[1], args[2], args[3])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform2DMethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
 }
 
-// methods
-
-/* MinAxisIndex : min_axis_index
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Vector4) MinAxisIndex() int64 {
-	mb := globalVector4MethodBindings.method_min_axis_index
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* MaxAxisIndex : max_axis_index
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Vector4) MaxAxisIndex() int64 {
-	mb := globalVector4MethodBindings.method_max_axis_index
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* Length : length
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector4) Length() float32 {
-	mb := globalVector4MethodBindings.method_length
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
-	return ret
+// NewTransform2D, index: 4
+func NewTransform2DWithVector2Vector2Vector2(x_axis Vector2, y_axis Vector2, origin Vector2) Transform2D {
+	cx := Transform2D{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [3]GDExtensionConstTypePtr
+	// Vector2
+	// Vector2Encoder
+	args[0] = x_axis.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("x_axis", x_axis))
+	// Vector2
+	// Vector2Encoder
+	args[1] = y_axis.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("y_axis", y_axis))
+	// Vector2
+	// Vector2Encoder
+	args[2] = origin.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[2]", uintptr(args[2])), zap.Any("origin", origin))
+	CallBuiltinConstructor(globalTransform2DMethodBindings.constructor_4, ptr, args[0], args[1], args[2])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform2DMethodBindings.constructor_4"), zap.Any("cx", cx))
+	return cx
 }
 
-/* LengthSquared : length_squared
+// methods
+
+/* AffineInverse : affine_inverse
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Transform2D) -> Transform2D
  */
-func (cx *Vector4) LengthSquared() float32 {
-	mb := globalVector4MethodBindings.method_length_squared
+func (cx *Transform2D) AffineInverse() Transform2D {
+	mb := globalTransform2DMethodBindings.method_affine_inverse
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, nil)
 	return ret
 }
 
-/* Abs : abs
+/* GetRotation : get_rotation
  * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
+ * goReturnType(float) -> float32
  */
-func (cx *Vector4) Abs() Vector4 {
-	mb := globalVector4MethodBindings.method_abs
+func (cx *Transform2D) GetRotation() float32 {
+	mb := globalTransform2DMethodBindings.method_get_rotation
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
 	return ret
 }
 
-/* Sign : sign
+/* GetSkew : get_skew
  * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
+ * goReturnType(float) -> float32
  */
-func (cx *Vector4) Sign() Vector4 {
-	mb := globalVector4MethodBindings.method_sign
+func (cx *Transform2D) GetSkew() float32 {
+	mb := globalTransform2DMethodBindings.method_get_skew
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
 	return ret
 }
 
-/* Floor : floor
+/* Orthonormalized : orthonormalized
  * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
+ * goReturnType(Transform2D) -> Transform2D
  */
-func (cx *Vector4) Floor() Vector4 {
-	mb := globalVector4MethodBindings.method_floor
+func (cx *Transform2D) Orthonormalized() Transform2D {
+	mb := globalTransform2DMethodBindings.method_orthonormalized
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, nil)
 	return ret
 }
 
-/* Ceil : ceil
+/* Rotated : rotated
  * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
+ * goReturnType(Transform2D) -> Transform2D
  */
-func (cx *Vector4) Ceil() Vector4 {
-	mb := globalVector4MethodBindings.method_ceil
+func (cx *Transform2D) Rotated(angle float32) Transform2D {
+	mb := globalTransform2DMethodBindings.method_rotated
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(angle)
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Round : round
+/* RotatedLocal : rotated_local
  * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
+ * goReturnType(Transform2D) -> Transform2D
  */
-func (cx *Vector4) Round() Vector4 {
-	mb := globalVector4MethodBindings.method_round
+func (cx *Transform2D) RotatedLocal(angle float32) Transform2D {
+	mb := globalTransform2DMethodBindings.method_rotated_local
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Float32Encoder.EncodeTypePtr(angle)
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Lerp : lerp
+/* InterpolateWith : interpolate_with
  * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
+ * goReturnType(Transform2D) -> Transform2D
  */
-func (cx *Vector4) Lerp(to Vector4, weight float32) Vector4 {
-	mb := globalVector4MethodBindings.method_lerp
+func (cx *Transform2D) InterpolateWith(xform Transform2D, weight float32) Transform2D {
+	mb := globalTransform2DMethodBindings.method_interpolate_with
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 2
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
+	args[0] = (GDExtensionTypePtr)(&xform)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(weight)
 
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* CubicInterpolate : cubic_interpolate
+/* IsConformal : is_conformal
  * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
+ * goReturnType(bool) -> bool
  */
-func (cx *Vector4) CubicInterpolate(b Vector4, pre_a Vector4, post_b Vector4, weight float32) Vector4 {
-	mb := globalVector4MethodBindings.method_cubic_interpolate
+func (cx *Transform2D) IsConformal() bool {
+	mb := globalTransform2DMethodBindings.method_is_conformal
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 4
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&b)
 
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&pre_a)
-
-	pnr.Pin(args[1])
-	args[2] = (GDExtensionTypePtr)(&post_b)
-
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(weight)
-
-	pnr.Pin(args[3])
-
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
 	return ret
 }
 
-/* CubicInterpolateInTime : cubic_interpolate_in_time
+/* LookingAt : looking_at
  * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
+ * goReturnType(Transform2D) -> Transform2D
  */
-func (cx *Vector4) CubicInterpolateInTime(b Vector4, pre_a Vector4, post_b Vector4, weight float32, b_t float32, pre_a_t float32, post_b_t float32) Vector4 {
-	mb := globalVector4MethodBindings.method_cubic_interpolate_in_time
+func (cx *Transform2D) LookingAt(target Vector2) Transform2D {
+	mb := globalTransform2DMethodBindings.method_looking_at
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 7
+	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&b)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&pre_a)
-
-	pnr.Pin(args[1])
-	args[2] = (GDExtensionTypePtr)(&post_b)
-
-	pnr.Pin(args[2])
-	args[3] = Float32Encoder.EncodeTypePtr(weight)
-
-	pnr.Pin(args[3])
-	args[4] = Float32Encoder.EncodeTypePtr(b_t)
-
-	pnr.Pin(args[4])
-	args[5] = Float32Encoder.EncodeTypePtr(pre_a_t)
-
-	pnr.Pin(args[5])
-	args[6] = Float32Encoder.EncodeTypePtr(post_b_t)
-
-	pnr.Pin(args[6])
+	args[0] = (GDExtensionTypePtr)(&target)
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Transform2D](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Posmod : posmod
- * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
- */
-func (cx *Vector4) Posmod(mod float32) Vector4 {
-	mb := globalVector4MethodBindings.method_posmod
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(mod)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
-	runtime.KeepAlive(args)
+func (cx *Transform2D) GetIndexed(i int64) Vector2 {
+	var ret Vector2
+	CallFunc_GDExtensionPtrIndexedGetter(
+		globalTransform2DMethodBindings.indexed_getter,
+		cx.NativeConstPtr(),
+		(GDExtensionInt)(i),
+		(GDExtensionTypePtr)(&ret),
+	)
 	return ret
 }
 
-/* Posmodv : posmodv
- * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
- */
-func (cx *Vector4) Posmodv(modv Vector4) Vector4 {
-	mb := globalVector4MethodBindings.method_posmodv
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&modv)
+func (cx *Transform2D) SetIndexed(i int64, value Vector2) {
+	CallFunc_GDExtensionPtrIndexedSetter(
+		globalTransform2DMethodBindings.indexed_setter,
+		cx.NativePtr(),
+		(GDExtensionInt)(i),
+		(GDExtensionConstTypePtr)(&value),
+	)
+}
 
-	pnr.Pin(args[0])
+// members
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
-	runtime.KeepAlive(args)
+func (cx *Transform2D) MemberGetx() Vector2 {
+	bx := cx.NativeConstPtr()
+	ret := CallBuiltinPtrGetter[Vector2](globalTransform2DMethodBindings.member_x_getter, bx)
 	return ret
+
 }
 
-/* Snapped : snapped
- * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
- */
-func (cx *Vector4) Snapped(step Vector4) Vector4 {
-	mb := globalVector4MethodBindings.method_snapped
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&step)
+func (cx *Transform2D) MemberGety() Vector2 {
+	bx := cx.NativeConstPtr()
+	ret := CallBuiltinPtrGetter[Vector2](globalTransform2DMethodBindings.member_y_getter, bx)
+	return ret
 
-	pnr.Pin(args[0])
+}
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
-	runtime.KeepAlive(args)
+func (cx *Transform2D) MemberGetorigin() Vector2 {
+	bx := cx.NativeConstPtr()
+	ret := CallBuiltinPtrGetter[Vector2](globalTransform2DMethodBindings.member_origin_getter, bx)
 	return ret
+
 }
 
-/* Snappedf : snappedf
- * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
- */
-func (cx *Vector4) Snappedf(step float32) Vector4 {
-	mb := globalVector4MethodBindings.method_snappedf
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(step)
+// Equal_Variant operator
+func (cx *Transform2D) Equal_Variant(right Variant) bool {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
+	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_equal_Variant, lt, rt)
+}
 
-	pnr.Pin(args[0])
+// Not_equal_Variant operator
+func (cx *Transform2D) Not_equal_Variant(right Variant) bool {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(right.NativeConstPtr())
+	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_not_equal_Variant, lt, rt)
+}
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
+// Not operator
+func (cx *Transform2D) Not() bool {
+	lt := cx.NativeConstPtr()
+	rt := (GDExtensionConstTypePtr)(nullptr)
+	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_not, lt, rt)
 }
 
-/* Clamp : clamp
- * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
- */
-func (cx *Vector4) Clamp(min Vector4, max Vector4) Vector4 {
-	mb := globalVector4MethodBindings.method_clamp
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&min)
+// Multiply_Rect2 operator
+func (cx *Transform2D) Multiply_Rect2(right Rect2) Rect2 {
+	lt := cx.NativeConstPtr()
+	eRight := Rect2Encoder.EncodeTypePtr(right)
+	rt := (GDExtensionConstTypePtr)(eRight)
+	return CallBuiltinOperatorPtr[Rect2](globalTransform2DMethodBindings.operator_multiply_Rect2, lt, rt)
+}
 
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&max)
+// In_Dictionary operator
+func (cx *Transform2D) In_Dictionary(right Dictionary) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_in_Dictionary, lt, rt)
+}
 
-	pnr.Pin(args[1])
+// In_Array operator
+func (cx *Transform2D) In_Array(right Array) bool {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[bool](globalTransform2DMethodBindings.operator_in_Array, lt, rt)
+}
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
+// Multiply_PackedVector2Array operator
+func (cx *Transform2D) Multiply_PackedVector2Array(right PackedVector2Array) PackedVector2Array {
+	lt := cx.NativeConstPtr()
+	rt := right.NativeConstPtr()
+	return CallBuiltinOperatorPtr[PackedVector2Array](globalTransform2DMethodBindings.operator_multiply_PackedVector2Array, lt, rt)
 }
 
-/* Clampf : clampf
- * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
+var _ GDBuiltInClass = (*Vector4)(nil)
+
+/*
+ * Vector4
+ * indexingReturnType: float
+ * isKeyed: false
+ * hasDestructor: false
  */
-func (cx *Vector4) Clampf(min float32, max float32) Vector4 {
-	mb := globalVector4MethodBindings.method_clampf
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(min)
+type Vector4 struct {
+	X float32
+	Y float32
+	Z float32
+	W float32
+}
 
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(max)
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Vector4{})-Vector4Size]
+	_ = x[unsafe.Offsetof(Vector4{}.X)-0]
+	_ = x[unsafe.Offsetof(Vector4{}.Y)-4]
+	_ = x[unsafe.Offsetof(Vector4{}.Z)-8]
+	_ = x[unsafe.Offsetof(Vector4{}.W)-12]
+}
 
-	pnr.Pin(args[1])
+func (cx *Vector4) NativeConstPtr() GDExtensionConstTypePtr {
+	return (GDExtensionConstTypePtr)(cx)
+}
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
+func (cx *Vector4) NativePtr() GDExtensionTypePtr {
+	return (GDExtensionTypePtr)(cx)
 }
 
-/* Normalized : normalized
- * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
- */
-func (cx *Vector4) Normalized() Vector4 {
-	mb := globalVector4MethodBindings.method_normalized
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
+// constructors
+// NewVector4, index: 0
+func NewVector4() Vector4 {
+	cx := Vector4{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	CallBuiltinConstructor(globalVector4MethodBindings.constructor_0, ptr)
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4MethodBindings.constructor_0"), zap.Any("cx", cx))
+	return cx
+}
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
-	return ret
+// NewVector4, index: 1
+func NewVector4WithVector4(from Vector4) Vector4 {
+	cx := Vector4{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [1]GDExtensionConstTypePtr
+	// Vector4
+	// Vector4Encoder
+	args[0] = from.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
+	CallBuiltinConstructor(globalVector4MethodBindings.constructor_1, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4MethodBindings.constructor_1"), zap.Any("cx", cx))
+	return cx
 }
 
-/* IsNormalized : is_normalized
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Vector4) IsNormalized() bool {
-	mb := globalVector4MethodBindings.method_is_normalized
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
+// NewVector4, index: 2
+func NewVector4WithVector4i(from Vector4i) Vector4 {
+	cx := Vector4{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [1]GDExtensionConstTypePtr
+	// Vector4i
+	// Vector4iEncoder
+	args[0] = from.NativeConstPtr()
+	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
+	CallBuiltinConstructor(globalVector4MethodBindings.constructor_2, ptr, args[0])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4MethodBindings.constructor_2"), zap.Any("cx", cx))
+	return cx
+}
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
-	return ret
+// NewVector4, index: 3
+func NewVector4WithFloat32Float32Float32Float32(x float32, y float32, z float32, w float32) Vector4 {
+	cx := Vector4{}
+	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
+	var args [4]GDExtensionConstTypePtr
+	// float
+	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(x))
+	// float
+	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(y))
+	// float
+	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(z))
+	// float
+	args[3] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(w))
+	CallBuiltinConstructor(globalVector4MethodBindings.constructor_3, ptr, args[0], args[1], args[2], args[3])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4MethodBindings.constructor_3"), zap.Any("cx", cx))
+	return cx
 }
 
-/* DirectionTo : direction_to
+// methods
+
+/* MinAxisIndex : min_axis_index
  * is_vararg = false, is_static = false
- * goReturnType(Vector4) -> Vector4
+ * goReturnType(int) -> int64
  */
-func (cx *Vector4) DirectionTo(to Vector4) Vector4 {
-	mb := globalVector4MethodBindings.method_direction_to
+func (cx *Vector4) MinAxisIndex() int64 {
+	mb := globalVector4MethodBindings.method_min_axis_index
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
 
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* DistanceTo : distance_to
+/* MaxAxisIndex : max_axis_index
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(int) -> int64
  */
-func (cx *Vector4) DistanceTo(to Vector4) float32 {
-	mb := globalVector4MethodBindings.method_distance_to
+func (cx *Vector4) MaxAxisIndex() int64 {
+	mb := globalVector4MethodBindings.method_max_axis_index
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* DistanceSquaredTo : distance_squared_to
+/* CubicInterpolate : cubic_interpolate
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Vector4) -> Vector4
  */
-func (cx *Vector4) DistanceSquaredTo(to Vector4) float32 {
-	mb := globalVector4MethodBindings.method_distance_squared_to
+func (cx *Vector4) CubicInterpolate(b Vector4, pre_a Vector4, post_b Vector4, weight float32) Vector4 {
+	mb := globalVector4MethodBindings.method_cubic_interpolate
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 4
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&b)
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
+	args[1] = (GDExtensionTypePtr)(&pre_a)
 
-/* Dot : dot
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Vector4) Dot(with Vector4) float32 {
-	mb := globalVector4MethodBindings.method_dot
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
+	args[2] = (GDExtensionTypePtr)(&post_b)
 
-	pnr.Pin(args[0])
+	args[3] = Float32Encoder.EncodeTypePtr(weight)
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Inverse : inverse
+/* CubicInterpolateInTime : cubic_interpolate_in_time
  * is_vararg = false, is_static = false
  * goReturnType(Vector4) -> Vector4
  */
-func (cx *Vector4) Inverse() Vector4 {
-	mb := globalVector4MethodBindings.method_inverse
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, nil)
-	return ret
-}
-
-/* IsEqualApprox : is_equal_approx
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Vector4) IsEqualApprox(to Vector4) bool {
-	mb := globalVector4MethodBindings.method_is_equal_approx
+func (cx *Vector4) CubicInterpolateInTime(b Vector4, pre_a Vector4, post_b Vector4, weight float32, b_t float32, pre_a_t float32, post_b_t float32) Vector4 {
+	mb := globalVector4MethodBindings.method_cubic_interpolate_in_time
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 7
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
+	args[0] = (GDExtensionTypePtr)(&b)
 
-	pnr.Pin(args[0])
+	args[1] = (GDExtensionTypePtr)(&pre_a)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
+	args[2] = (GDExtensionTypePtr)(&post_b)
 
-/* IsZeroApprox : is_zero_approx
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Vector4) IsZeroApprox() bool {
-	mb := globalVector4MethodBindings.method_is_zero_approx
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
+	args[3] = Float32Encoder.EncodeTypePtr(weight)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
-	return ret
-}
+	args[4] = Float32Encoder.EncodeTypePtr(b_t)
 
-/* IsFinite : is_finite
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Vector4) IsFinite() bool {
-	mb := globalVector4MethodBindings.method_is_finite
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
+	args[5] = Float32Encoder.EncodeTypePtr(pre_a_t)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	args[6] = Float32Encoder.EncodeTypePtr(post_b_t)
+
+	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Min : min
+/* Posmod : posmod
  * is_vararg = false, is_static = false
  * goReturnType(Vector4) -> Vector4
  */
-func (cx *Vector4) Min(with Vector4) Vector4 {
-	mb := globalVector4MethodBindings.method_min
+func (cx *Vector4) Posmod(mod float32) Vector4 {
+	mb := globalVector4MethodBindings.method_posmod
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
+	args[0] = Float32Encoder.EncodeTypePtr(mod)
 
 	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Minf : minf
+/* Posmodv : posmodv
  * is_vararg = false, is_static = false
  * goReturnType(Vector4) -> Vector4
  */
-func (cx *Vector4) Minf(with float32) Vector4 {
-	mb := globalVector4MethodBindings.method_minf
+func (cx *Vector4) Posmodv(modv Vector4) Vector4 {
+	mb := globalVector4MethodBindings.method_posmodv
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(with)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&modv)
 
 	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Max : max
+/* Snapped : snapped
  * is_vararg = false, is_static = false
  * goReturnType(Vector4) -> Vector4
  */
-func (cx *Vector4) Max(with Vector4) Vector4 {
-	mb := globalVector4MethodBindings.method_max
+func (cx *Vector4) Snapped(step Vector4) Vector4 {
+	mb := globalVector4MethodBindings.method_snapped
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
+	args[0] = (GDExtensionTypePtr)(&step)
 
 	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Maxf : maxf
+/* Snappedf : snappedf
  * is_vararg = false, is_static = false
  * goReturnType(Vector4) -> Vector4
  */
-func (cx *Vector4) Maxf(with float32) Vector4 {
-	mb := globalVector4MethodBindings.method_maxf
+func (cx *Vector4) Snappedf(step float32) Vector4 {
+	mb := globalVector4MethodBindings.method_snappedf
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(with)
-
-	pnr.Pin(args[0])
+	args[0] = Float32Encoder.EncodeTypePtr(step)
 
 	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
 	runtime.KeepAlive(args)
@@ -10288,20 +6946,6 @@ func (cx *Vector4) Not_equal_Variant(right Variant) bool {
 	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_not_equal_Variant, lt, rt)
 }
 
-// Negate operator
-func (cx *Vector4) Negate() Vector4 {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_negate, lt, rt)
-}
-
-// Positive operator
-func (cx *Vector4) Positive() Vector4 {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_positive, lt, rt)
-}
-
 // Not operator
 func (cx *Vector4) Not() bool {
 	lt := cx.NativeConstPtr()
@@ -10309,108 +6953,6 @@ func (cx *Vector4) Not() bool {
 	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_not, lt, rt)
 }
 
-// Multiply_int operator
-func (cx *Vector4) Multiply_int(right int64) Vector4 {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_multiply_int, lt, rt)
-}
-
-// Divide_int operator
-func (cx *Vector4) Divide_int(right int64) Vector4 {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_divide_int, lt, rt)
-}
-
-// Multiply_float operator
-func (cx *Vector4) Multiply_float(right float32) Vector4 {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_multiply_float, lt, rt)
-}
-
-// Divide_float operator
-func (cx *Vector4) Divide_float(right float32) Vector4 {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_divide_float, lt, rt)
-}
-
-// Equal_Vector4 operator
-func (cx *Vector4) Equal_Vector4(right Vector4) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_equal_Vector4, lt, rt)
-}
-
-// Not_equal_Vector4 operator
-func (cx *Vector4) Not_equal_Vector4(right Vector4) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_not_equal_Vector4, lt, rt)
-}
-
-// Less_Vector4 operator
-func (cx *Vector4) Less_Vector4(right Vector4) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_less_Vector4, lt, rt)
-}
-
-// Less_equal_Vector4 operator
-func (cx *Vector4) Less_equal_Vector4(right Vector4) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_less_equal_Vector4, lt, rt)
-}
-
-// Greater_Vector4 operator
-func (cx *Vector4) Greater_Vector4(right Vector4) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_greater_Vector4, lt, rt)
-}
-
-// Greater_equal_Vector4 operator
-func (cx *Vector4) Greater_equal_Vector4(right Vector4) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalVector4MethodBindings.operator_greater_equal_Vector4, lt, rt)
-}
-
-// Add_Vector4 operator
-func (cx *Vector4) Add_Vector4(right Vector4) Vector4 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_add_Vector4, lt, rt)
-}
-
-// Subtract_Vector4 operator
-func (cx *Vector4) Subtract_Vector4(right Vector4) Vector4 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_subtract_Vector4, lt, rt)
-}
-
-// Multiply_Vector4 operator
-func (cx *Vector4) Multiply_Vector4(right Vector4) Vector4 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_multiply_Vector4, lt, rt)
-}
-
-// Divide_Vector4 operator
-func (cx *Vector4) Divide_Vector4(right Vector4) Vector4 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector4](globalVector4MethodBindings.operator_divide_Vector4, lt, rt)
-}
-
 // Multiply_Projection operator
 func (cx *Vector4) Multiply_Projection(right Projection) Vector4 {
 	lt := cx.NativeConstPtr()
@@ -10448,7 +6990,23 @@ var _ GDBuiltInClass = (*Vector4i)(nil)
  * isKeyed: false
  * hasDestructor: false
  */
-type Vector4i [16]uint8
+type Vector4i struct {
+	X int32
+	Y int32
+	Z int32
+	W int32
+}
+
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Vector4i{})-Vector4iSize]
+	_ = x[unsafe.Offsetof(Vector4i{}.X)-0]
+	_ = x[unsafe.Offsetof(Vector4i{}.Y)-4]
+	_ = x[unsafe.Offsetof(Vector4i{}.Z)-8]
+	_ = x[unsafe.Offsetof(Vector4i{}.W)-12]
+}
 
 func (cx *Vector4i) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
@@ -10463,7 +7021,6 @@ func (cx *Vector4i) NativePtr() GDExtensionTypePtr {
 func NewVector4i() Vector4i {
 	cx := Vector4i{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalVector4iMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4iMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -10473,13 +7030,11 @@ func NewVector4i() Vector4i {
 func NewVector4iWithVector4i(from Vector4i) Vector4i {
 	cx := Vector4i{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Vector4i
 	// Vector4iEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalVector4iMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4iMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -10489,13 +7044,11 @@ func NewVector4iWithVector4i(from Vector4i) Vector4i {
 func NewVector4iWithVector4(from Vector4) Vector4i {
 	cx := Vector4i{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Vector4
 	// Vector4Encoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalVector4iMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4iMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -10505,20 +7058,15 @@ func NewVector4iWithVector4(from Vector4) Vector4i {
 func NewVector4iWithInt64Int64Int64Int64(x int64, y int64, z int64, w int64) Vector4i {
 	cx := Vector4i{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [4]GDExtensionConstTypePtr
 	// int
 	args[0] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(x))
-	pnr.Pin(args[0])
 	// int
 	args[1] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(y))
-	pnr.Pin(args[1])
 	// int
 	args[2] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(z))
-	pnr.Pin(args[2])
 	// int
 	args[3] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(w))
-	pnr.Pin(args[3])
 	CallBuiltinConstructor(globalVector4iMethodBindings.constructor_3, ptr, args[0], args[1], args[2], args[3])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalVector4iMethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
@@ -10536,7 +7084,6 @@ func (cx *Vector4i) MinAxisIndex() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10555,7 +7102,6 @@ func (cx *Vector4i) MaxAxisIndex() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10574,7 +7120,6 @@ func (cx *Vector4i) Length() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10593,7 +7138,6 @@ func (cx *Vector4i) LengthSquared() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10612,7 +7156,6 @@ func (cx *Vector4i) Sign() Vector4i {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10631,7 +7174,6 @@ func (cx *Vector4i) Abs() Vector4i {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10650,7 +7192,6 @@ func (cx *Vector4i) Clamp(min Vector4i, max Vector4i) Vector4i {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10658,11 +7199,8 @@ func (cx *Vector4i) Clamp(min Vector4i, max Vector4i) Vector4i {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&min)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&max)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Vector4i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -10678,7 +7216,6 @@ func (cx *Vector4i) Clampi(min int64, max int64) Vector4i {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10686,11 +7223,8 @@ func (cx *Vector4i) Clampi(min int64, max int64) Vector4i {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(min)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(max)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Vector4i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -10706,7 +7240,6 @@ func (cx *Vector4i) Snapped(step Vector4i) Vector4i {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10714,8 +7247,6 @@ func (cx *Vector4i) Snapped(step Vector4i) Vector4i {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&step)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector4i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -10731,7 +7262,6 @@ func (cx *Vector4i) Snappedi(step int64) Vector4i {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10739,8 +7269,6 @@ func (cx *Vector4i) Snappedi(step int64) Vector4i {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(step)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector4i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -10756,7 +7284,6 @@ func (cx *Vector4i) Min(with Vector4i) Vector4i {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10764,8 +7291,6 @@ func (cx *Vector4i) Min(with Vector4i) Vector4i {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector4i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -10781,7 +7306,6 @@ func (cx *Vector4i) Mini(with int64) Vector4i {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10789,8 +7313,6 @@ func (cx *Vector4i) Mini(with int64) Vector4i {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(with)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector4i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -10806,7 +7328,6 @@ func (cx *Vector4i) Max(with Vector4i) Vector4i {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10814,8 +7335,6 @@ func (cx *Vector4i) Max(with Vector4i) Vector4i {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector4i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -10831,7 +7350,6 @@ func (cx *Vector4i) Maxi(with int64) Vector4i {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10839,8 +7357,6 @@ func (cx *Vector4i) Maxi(with int64) Vector4i {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(with)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector4i](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -10856,7 +7372,6 @@ func (cx *Vector4i) DistanceTo(to Vector4i) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10864,8 +7379,6 @@ func (cx *Vector4i) DistanceTo(to Vector4i) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -10881,7 +7394,6 @@ func (cx *Vector4i) DistanceSquaredTo(to Vector4i) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -10889,8 +7401,6 @@ func (cx *Vector4i) DistanceSquaredTo(to Vector4i) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -11120,7 +7630,19 @@ var _ GDBuiltInClass = (*Plane)(nil)
  * isKeyed: false
  * hasDestructor: false
  */
-type Plane [16]uint8
+type Plane struct {
+	Normal Vector3
+	D      float32
+}
+
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Plane{})-PlaneSize]
+	_ = x[unsafe.Offsetof(Plane{}.Normal)-0]
+	_ = x[unsafe.Offsetof(Plane{}.D)-12]
+}
 
 func (cx *Plane) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
@@ -11135,7 +7657,6 @@ func (cx *Plane) NativePtr() GDExtensionTypePtr {
 func NewPlane() Plane {
 	cx := Plane{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPlaneMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPlaneMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -11145,13 +7666,11 @@ func NewPlane() Plane {
 func NewPlaneWithPlane(from Plane) Plane {
 	cx := Plane{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Plane
 	// PlaneEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPlaneMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPlaneMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -11161,13 +7680,11 @@ func NewPlaneWithPlane(from Plane) Plane {
 func NewPlaneWithVector3(normal Vector3) Plane {
 	cx := Plane{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Vector3
 	// Vector3Encoder
 	args[0] = normal.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("normal", normal))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPlaneMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPlaneMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -11177,16 +7694,13 @@ func NewPlaneWithVector3(normal Vector3) Plane {
 func NewPlaneWithVector3Float32(normal Vector3, d float32) Plane {
 	cx := Plane{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// Vector3
 	// Vector3Encoder
 	args[0] = normal.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("normal", normal))
-	pnr.Pin(args[0])
 	// float
 	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(d))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalPlaneMethodBindings.constructor_3, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPlaneMethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
@@ -11196,18 +7710,15 @@ func NewPlaneWithVector3Float32(normal Vector3, d float32) Plane {
 func NewPlaneWithVector3Vector3(normal Vector3, point Vector3) Plane {
 	cx := Plane{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// Vector3
 	// Vector3Encoder
 	args[0] = normal.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("normal", normal))
-	pnr.Pin(args[0])
 	// Vector3
 	// Vector3Encoder
 	args[1] = point.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("point", point))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalPlaneMethodBindings.constructor_4, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPlaneMethodBindings.constructor_4"), zap.Any("cx", cx))
 	return cx
@@ -11217,23 +7728,19 @@ func NewPlaneWithVector3Vector3(normal Vector3, point Vector3) Plane {
 func NewPlaneWithVector3Vector3Vector3(point1 Vector3, point2 Vector3, point3 Vector3) Plane {
 	cx := Plane{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [3]GDExtensionConstTypePtr
 	// Vector3
 	// Vector3Encoder
 	args[0] = point1.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("point1", point1))
-	pnr.Pin(args[0])
 	// Vector3
 	// Vector3Encoder
 	args[1] = point2.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("point2", point2))
-	pnr.Pin(args[1])
 	// Vector3
 	// Vector3Encoder
 	args[2] = point3.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[2]", uintptr(args[2])), zap.Any("point3", point3))
-	pnr.Pin(args[2])
 	CallBuiltinConstructor(globalPlaneMethodBindings.constructor_5, ptr, args[0], args[1], args[2])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPlaneMethodBindings.constructor_5"), zap.Any("cx", cx))
 	return cx
@@ -11243,20 +7750,15 @@ func NewPlaneWithVector3Vector3Vector3(point1 Vector3, point2 Vector3, point3 Ve
 func NewPlaneWithFloat32Float32Float32Float32(a float32, b float32, c float32, d float32) Plane {
 	cx := Plane{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [4]GDExtensionConstTypePtr
 	// float
 	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(a))
-	pnr.Pin(args[0])
 	// float
 	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(b))
-	pnr.Pin(args[1])
 	// float
 	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(c))
-	pnr.Pin(args[2])
 	// float
 	args[3] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(d))
-	pnr.Pin(args[3])
 	CallBuiltinConstructor(globalPlaneMethodBindings.constructor_6, ptr, args[0], args[1], args[2], args[3])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPlaneMethodBindings.constructor_6"), zap.Any("cx", cx))
 	return cx
@@ -11274,7 +7776,6 @@ func (cx *Plane) Normalized() Plane {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11293,7 +7794,6 @@ func (cx *Plane) GetCenter() Vector3 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11312,7 +7812,6 @@ func (cx *Plane) IsEqualApprox(to_plane Plane) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11320,8 +7819,6 @@ func (cx *Plane) IsEqualApprox(to_plane Plane) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to_plane)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -11337,7 +7834,6 @@ func (cx *Plane) IsFinite() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11356,7 +7852,6 @@ func (cx *Plane) IsPointOver(point Vector3) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11364,8 +7859,6 @@ func (cx *Plane) IsPointOver(point Vector3) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&point)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -11381,7 +7874,6 @@ func (cx *Plane) DistanceTo(point Vector3) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11389,8 +7881,6 @@ func (cx *Plane) DistanceTo(point Vector3) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&point)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -11406,7 +7896,6 @@ func (cx *Plane) HasPoint(point Vector3, tolerance float32) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11414,11 +7903,8 @@ func (cx *Plane) HasPoint(point Vector3, tolerance float32) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&point)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(tolerance)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -11434,7 +7920,6 @@ func (cx *Plane) Project(point Vector3) Vector3 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11442,8 +7927,6 @@ func (cx *Plane) Project(point Vector3) Vector3 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&point)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -11459,7 +7942,6 @@ func (cx *Plane) Intersect3(b Plane, c Plane) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11467,11 +7949,8 @@ func (cx *Plane) Intersect3(b Plane, c Plane) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&b)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&c)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -11487,7 +7966,6 @@ func (cx *Plane) IntersectsRay(from Vector3, dir Vector3) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11495,11 +7973,8 @@ func (cx *Plane) IntersectsRay(from Vector3, dir Vector3) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&from)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&dir)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -11515,7 +7990,6 @@ func (cx *Plane) IntersectsSegment(from Vector3, to Vector3) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11523,11 +7997,8 @@ func (cx *Plane) IntersectsSegment(from Vector3, to Vector3) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&from)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -11648,7 +8119,23 @@ var _ GDBuiltInClass = (*Quaternion)(nil)
  * isKeyed: false
  * hasDestructor: false
  */
-type Quaternion [16]uint8
+type Quaternion struct {
+	X float32
+	Y float32
+	Z float32
+	W float32
+}
+
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Quaternion{})-QuaternionSize]
+	_ = x[unsafe.Offsetof(Quaternion{}.X)-0]
+	_ = x[unsafe.Offsetof(Quaternion{}.Y)-4]
+	_ = x[unsafe.Offsetof(Quaternion{}.Z)-8]
+	_ = x[unsafe.Offsetof(Quaternion{}.W)-12]
+}
 
 func (cx *Quaternion) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
@@ -11663,7 +8150,6 @@ func (cx *Quaternion) NativePtr() GDExtensionTypePtr {
 func NewQuaternion() Quaternion {
 	cx := Quaternion{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalQuaternionMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalQuaternionMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -11673,13 +8159,11 @@ func NewQuaternion() Quaternion {
 func NewQuaternionWithQuaternion(from Quaternion) Quaternion {
 	cx := Quaternion{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Quaternion
 	// QuaternionEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalQuaternionMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalQuaternionMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -11689,13 +8173,11 @@ func NewQuaternionWithQuaternion(from Quaternion) Quaternion {
 func NewQuaternionWithBasis(from Basis) Quaternion {
 	cx := Quaternion{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Basis
 	// BasisEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalQuaternionMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalQuaternionMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -11705,16 +8187,13 @@ func NewQuaternionWithBasis(from Basis) Quaternion {
 func NewQuaternionWithVector3Float32(axis Vector3, angle float32) Quaternion {
 	cx := Quaternion{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// Vector3
 	// Vector3Encoder
 	args[0] = axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("axis", axis))
-	pnr.Pin(args[0])
 	// float
 	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(angle))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalQuaternionMethodBindings.constructor_3, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalQuaternionMethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
@@ -11724,18 +8203,15 @@ func NewQuaternionWithVector3Float32(axis Vector3, angle float32) Quaternion {
 func NewQuaternionWithVector3Vector3(arc_from Vector3, arc_to Vector3) Quaternion {
 	cx := Quaternion{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// Vector3
 	// Vector3Encoder
 	args[0] = arc_from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("arc_from", arc_from))
-	pnr.Pin(args[0])
 	// Vector3
 	// Vector3Encoder
 	args[1] = arc_to.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("arc_to", arc_to))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalQuaternionMethodBindings.constructor_4, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalQuaternionMethodBindings.constructor_4"), zap.Any("cx", cx))
 	return cx
@@ -11745,20 +8221,15 @@ func NewQuaternionWithVector3Vector3(arc_from Vector3, arc_to Vector3) Quaternio
 func NewQuaternionWithFloat32Float32Float32Float32(x float32, y float32, z float32, w float32) Quaternion {
 	cx := Quaternion{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [4]GDExtensionConstTypePtr
 	// float
 	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(x))
-	pnr.Pin(args[0])
 	// float
 	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(y))
-	pnr.Pin(args[1])
 	// float
 	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(z))
-	pnr.Pin(args[2])
 	// float
 	args[3] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(w))
-	pnr.Pin(args[3])
 	CallBuiltinConstructor(globalQuaternionMethodBindings.constructor_5, ptr, args[0], args[1], args[2], args[3])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalQuaternionMethodBindings.constructor_5"), zap.Any("cx", cx))
 	return cx
@@ -11766,126 +8237,6 @@ func NewQuaternionWithFloat32Float32Float32Float32(x float32, y float32, z float
 
 // methods
 
-/* Length : length
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Quaternion) Length() float32 {
-	mb := globalQuaternionMethodBindings.method_length
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
-	return ret
-}
-
-/* LengthSquared : length_squared
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Quaternion) LengthSquared() float32 {
-	mb := globalQuaternionMethodBindings.method_length_squared
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
-	return ret
-}
-
-/* Normalized : normalized
- * is_vararg = false, is_static = false
- * goReturnType(Quaternion) -> Quaternion
- */
-func (cx *Quaternion) Normalized() Quaternion {
-	mb := globalQuaternionMethodBindings.method_normalized
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Quaternion](mb, bx, nil)
-	return ret
-}
-
-/* IsNormalized : is_normalized
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Quaternion) IsNormalized() bool {
-	mb := globalQuaternionMethodBindings.method_is_normalized
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
-	return ret
-}
-
-/* IsEqualApprox : is_equal_approx
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Quaternion) IsEqualApprox(to Quaternion) bool {
-	mb := globalQuaternionMethodBindings.method_is_equal_approx
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* IsFinite : is_finite
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Quaternion) IsFinite() bool {
-	mb := globalQuaternionMethodBindings.method_is_finite
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
-	return ret
-}
-
 /* Inverse : inverse
  * is_vararg = false, is_static = false
  * goReturnType(Quaternion) -> Quaternion
@@ -11896,7 +8247,6 @@ func (cx *Quaternion) Inverse() Quaternion {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11915,7 +8265,6 @@ func (cx *Quaternion) Log() Quaternion {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11934,7 +8283,6 @@ func (cx *Quaternion) Exp() Quaternion {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11953,7 +8301,6 @@ func (cx *Quaternion) AngleTo(to Quaternion) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -11961,33 +8308,6 @@ func (cx *Quaternion) AngleTo(to Quaternion) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Dot : dot
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Quaternion) Dot(with Quaternion) float32 {
-	mb := globalQuaternionMethodBindings.method_dot
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
-
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12003,7 +8323,6 @@ func (cx *Quaternion) Slerp(to Quaternion, weight float32) Quaternion {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12011,11 +8330,8 @@ func (cx *Quaternion) Slerp(to Quaternion, weight float32) Quaternion {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(weight)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Quaternion](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12031,7 +8347,6 @@ func (cx *Quaternion) Slerpni(to Quaternion, weight float32) Quaternion {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12039,11 +8354,8 @@ func (cx *Quaternion) Slerpni(to Quaternion, weight float32) Quaternion {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(weight)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Quaternion](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12059,7 +8371,6 @@ func (cx *Quaternion) SphericalCubicInterpolate(b Quaternion, pre_a Quaternion,
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12067,17 +8378,12 @@ func (cx *Quaternion) SphericalCubicInterpolate(b Quaternion, pre_a Quaternion,
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&b)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&pre_a)
 
-	pnr.Pin(args[1])
 	args[2] = (GDExtensionTypePtr)(&post_b)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(weight)
 
-	pnr.Pin(args[3])
-
 	ret := CallBuiltinMethodPtrRet[Quaternion](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12093,7 +8399,6 @@ func (cx *Quaternion) SphericalCubicInterpolateInTime(b Quaternion, pre_a Quater
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12101,26 +8406,18 @@ func (cx *Quaternion) SphericalCubicInterpolateInTime(b Quaternion, pre_a Quater
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&b)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&pre_a)
 
-	pnr.Pin(args[1])
 	args[2] = (GDExtensionTypePtr)(&post_b)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(weight)
 
-	pnr.Pin(args[3])
 	args[4] = Float32Encoder.EncodeTypePtr(b_t)
 
-	pnr.Pin(args[4])
 	args[5] = Float32Encoder.EncodeTypePtr(pre_a_t)
 
-	pnr.Pin(args[5])
 	args[6] = Float32Encoder.EncodeTypePtr(post_b_t)
 
-	pnr.Pin(args[6])
-
 	ret := CallBuiltinMethodPtrRet[Quaternion](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12136,7 +8433,6 @@ func (cx *Quaternion) GetEuler(order int64) Vector3 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12144,8 +8440,6 @@ func (cx *Quaternion) GetEuler(order int64) Vector3 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(order)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12161,37 +8455,15 @@ func (cx *Quaternion) FromEuler(euler Vector3) Quaternion {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&euler)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Quaternion](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* GetAxis : get_axis
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Quaternion) GetAxis() Vector3 {
-	mb := globalQuaternionMethodBindings.method_get_axis
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = (GDExtensionTypePtr)(&euler)
 
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Quaternion](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
@@ -12205,7 +8477,6 @@ func (cx *Quaternion) GetAngle() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12278,20 +8549,6 @@ func (cx *Quaternion) Not_equal_Variant(right Variant) bool {
 	return CallBuiltinOperatorPtr[bool](globalQuaternionMethodBindings.operator_not_equal_Variant, lt, rt)
 }
 
-// Negate operator
-func (cx *Quaternion) Negate() Quaternion {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_negate, lt, rt)
-}
-
-// Positive operator
-func (cx *Quaternion) Positive() Quaternion {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_positive, lt, rt)
-}
-
 // Not operator
 func (cx *Quaternion) Not() bool {
 	lt := cx.NativeConstPtr()
@@ -12299,38 +8556,6 @@ func (cx *Quaternion) Not() bool {
 	return CallBuiltinOperatorPtr[bool](globalQuaternionMethodBindings.operator_not, lt, rt)
 }
 
-// Multiply_int operator
-func (cx *Quaternion) Multiply_int(right int64) Quaternion {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_multiply_int, lt, rt)
-}
-
-// Divide_int operator
-func (cx *Quaternion) Divide_int(right int64) Quaternion {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_divide_int, lt, rt)
-}
-
-// Multiply_float operator
-func (cx *Quaternion) Multiply_float(right float32) Quaternion {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_multiply_float, lt, rt)
-}
-
-// Divide_float operator
-func (cx *Quaternion) Divide_float(right float32) Quaternion {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_divide_float, lt, rt)
-}
-
 // Multiply_Vector3 operator
 func (cx *Quaternion) Multiply_Vector3(right Vector3) Vector3 {
 	lt := cx.NativeConstPtr()
@@ -12338,41 +8563,6 @@ func (cx *Quaternion) Multiply_Vector3(right Vector3) Vector3 {
 	return CallBuiltinOperatorPtr[Vector3](globalQuaternionMethodBindings.operator_multiply_Vector3, lt, rt)
 }
 
-// Equal_Quaternion operator
-func (cx *Quaternion) Equal_Quaternion(right Quaternion) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalQuaternionMethodBindings.operator_equal_Quaternion, lt, rt)
-}
-
-// Not_equal_Quaternion operator
-func (cx *Quaternion) Not_equal_Quaternion(right Quaternion) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalQuaternionMethodBindings.operator_not_equal_Quaternion, lt, rt)
-}
-
-// Add_Quaternion operator
-func (cx *Quaternion) Add_Quaternion(right Quaternion) Quaternion {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_add_Quaternion, lt, rt)
-}
-
-// Subtract_Quaternion operator
-func (cx *Quaternion) Subtract_Quaternion(right Quaternion) Quaternion {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_subtract_Quaternion, lt, rt)
-}
-
-// Multiply_Quaternion operator
-func (cx *Quaternion) Multiply_Quaternion(right Quaternion) Quaternion {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Quaternion](globalQuaternionMethodBindings.operator_multiply_Quaternion, lt, rt)
-}
-
 // In_Dictionary operator
 func (cx *Quaternion) In_Dictionary(right Dictionary) bool {
 	lt := cx.NativeConstPtr()
@@ -12395,7 +8585,19 @@ var _ GDBuiltInClass = (*AABB)(nil)
  * isKeyed: false
  * hasDestructor: false
  */
-type AABB [24]uint8
+type AABB struct {
+	Position Vector3
+	Size     Vector3
+}
+
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(AABB{})-AABBSize]
+	_ = x[unsafe.Offsetof(AABB{}.Position)-0]
+	_ = x[unsafe.Offsetof(AABB{}.Size)-12]
+}
 
 func (cx *AABB) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
@@ -12410,7 +8612,6 @@ func (cx *AABB) NativePtr() GDExtensionTypePtr {
 func NewAABB() AABB {
 	cx := AABB{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalAABBMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalAABBMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -12420,13 +8621,11 @@ func NewAABB() AABB {
 func NewAABBWithAABB(from AABB) AABB {
 	cx := AABB{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// AABB
 	// AABBEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalAABBMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalAABBMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -12436,18 +8635,15 @@ func NewAABBWithAABB(from AABB) AABB {
 func NewAABBWithVector3Vector3(position Vector3, size Vector3) AABB {
 	cx := AABB{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// Vector3
 	// Vector3Encoder
 	args[0] = position.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("position", position))
-	pnr.Pin(args[0])
 	// Vector3
 	// Vector3Encoder
 	args[1] = size.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("size", size))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalAABBMethodBindings.constructor_2, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalAABBMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -12465,7 +8661,6 @@ func (cx *AABB) Abs() AABB {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12484,7 +8679,6 @@ func (cx *AABB) GetCenter() Vector3 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12503,7 +8697,6 @@ func (cx *AABB) GetVolume() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12522,7 +8715,6 @@ func (cx *AABB) HasVolume() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12541,7 +8733,6 @@ func (cx *AABB) HasSurface() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12560,7 +8751,6 @@ func (cx *AABB) HasPoint(point Vector3) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12568,8 +8758,6 @@ func (cx *AABB) HasPoint(point Vector3) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&point)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12585,7 +8773,6 @@ func (cx *AABB) IsEqualApprox(aabb AABB) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12593,8 +8780,6 @@ func (cx *AABB) IsEqualApprox(aabb AABB) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&aabb)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12610,7 +8795,6 @@ func (cx *AABB) IsFinite() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12629,7 +8813,6 @@ func (cx *AABB) Intersects(with AABB) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12637,8 +8820,6 @@ func (cx *AABB) Intersects(with AABB) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12654,7 +8835,6 @@ func (cx *AABB) Encloses(with AABB) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12662,8 +8842,6 @@ func (cx *AABB) Encloses(with AABB) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12679,7 +8857,6 @@ func (cx *AABB) IntersectsPlane(plane Plane) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12687,8 +8864,6 @@ func (cx *AABB) IntersectsPlane(plane Plane) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&plane)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12704,7 +8879,6 @@ func (cx *AABB) Intersection(with AABB) AABB {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12712,8 +8886,6 @@ func (cx *AABB) Intersection(with AABB) AABB {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[AABB](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12729,7 +8901,6 @@ func (cx *AABB) Merge(with AABB) AABB {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12737,8 +8908,6 @@ func (cx *AABB) Merge(with AABB) AABB {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[AABB](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12754,7 +8923,6 @@ func (cx *AABB) Expand(to_point Vector3) AABB {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12762,8 +8930,6 @@ func (cx *AABB) Expand(to_point Vector3) AABB {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to_point)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[AABB](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12779,7 +8945,6 @@ func (cx *AABB) Grow(by float32) AABB {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12787,8 +8952,6 @@ func (cx *AABB) Grow(by float32) AABB {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(by)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[AABB](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12804,7 +8967,6 @@ func (cx *AABB) GetSupport(direction Vector3) Vector3 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12812,8 +8974,6 @@ func (cx *AABB) GetSupport(direction Vector3) Vector3 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&direction)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12829,7 +8989,6 @@ func (cx *AABB) GetLongestAxis() Vector3 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12848,7 +9007,6 @@ func (cx *AABB) GetLongestAxisIndex() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12867,7 +9025,6 @@ func (cx *AABB) GetLongestAxisSize() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12886,7 +9043,6 @@ func (cx *AABB) GetShortestAxis() Vector3 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12905,7 +9061,6 @@ func (cx *AABB) GetShortestAxisIndex() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12924,7 +9079,6 @@ func (cx *AABB) GetShortestAxisSize() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12943,7 +9097,6 @@ func (cx *AABB) GetEndpoint(idx int64) Vector3 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12951,8 +9104,6 @@ func (cx *AABB) GetEndpoint(idx int64) Vector3 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(idx)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12968,7 +9119,6 @@ func (cx *AABB) IntersectsSegment(from Vector3, to Vector3) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -12976,11 +9126,8 @@ func (cx *AABB) IntersectsSegment(from Vector3, to Vector3) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&from)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -12996,7 +9143,6 @@ func (cx *AABB) IntersectsRay(from Vector3, dir Vector3) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -13004,11 +9150,8 @@ func (cx *AABB) IntersectsRay(from Vector3, dir Vector3) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&from)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&dir)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -13101,7 +9244,19 @@ var _ GDBuiltInClass = (*Basis)(nil)
  * isKeyed: false
  * hasDestructor: false
  */
-type Basis [36]uint8
+// Rows holds the rows of the matrix. X, Y and Z return its columns, the
+// same as the Variant members x, y and z.
+type Basis struct {
+	Rows [3]Vector3
+}
+
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Basis{})-BasisSize]
+	_ = x[unsafe.Offsetof(Basis{}.Rows)-0]
+}
 
 func (cx *Basis) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
@@ -13116,7 +9271,6 @@ func (cx *Basis) NativePtr() GDExtensionTypePtr {
 func NewBasis() Basis {
 	cx := Basis{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalBasisMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalBasisMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -13126,13 +9280,11 @@ func NewBasis() Basis {
 func NewBasisWithBasis(from Basis) Basis {
 	cx := Basis{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Basis
 	// BasisEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalBasisMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalBasisMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -13142,13 +9294,11 @@ func NewBasisWithBasis(from Basis) Basis {
 func NewBasisWithQuaternion(from Quaternion) Basis {
 	cx := Basis{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Quaternion
 	// QuaternionEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalBasisMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalBasisMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -13158,16 +9308,13 @@ func NewBasisWithQuaternion(from Quaternion) Basis {
 func NewBasisWithVector3Float32(axis Vector3, angle float32) Basis {
 	cx := Basis{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// Vector3
 	// Vector3Encoder
 	args[0] = axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("axis", axis))
-	pnr.Pin(args[0])
 	// float
 	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(angle))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalBasisMethodBindings.constructor_3, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalBasisMethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
@@ -13177,386 +9324,164 @@ func NewBasisWithVector3Float32(axis Vector3, angle float32) Basis {
 func NewBasisWithVector3Vector3Vector3(x_axis Vector3, y_axis Vector3, z_axis Vector3) Basis {
 	cx := Basis{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [3]GDExtensionConstTypePtr
 	// Vector3
 	// Vector3Encoder
 	args[0] = x_axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("x_axis", x_axis))
-	pnr.Pin(args[0])
 	// Vector3
 	// Vector3Encoder
 	args[1] = y_axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("y_axis", y_axis))
-	pnr.Pin(args[1])
 	// Vector3
 	// Vector3Encoder
 	args[2] = z_axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[2]", uintptr(args[2])), zap.Any("z_axis", z_axis))
-	pnr.Pin(args[2])
 	CallBuiltinConstructor(globalBasisMethodBindings.constructor_4, ptr, args[0], args[1], args[2])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalBasisMethodBindings.constructor_4"), zap.Any("cx", cx))
 	return cx
 }
 
-// methods
-
-/* Inverse : inverse
- * is_vararg = false, is_static = false
- * goReturnType(Basis) -> Basis
- */
-func (cx *Basis) Inverse() Basis {
-	mb := globalBasisMethodBindings.method_inverse
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, nil)
-	return ret
-}
-
-/* Transposed : transposed
- * is_vararg = false, is_static = false
- * goReturnType(Basis) -> Basis
- */
-func (cx *Basis) Transposed() Basis {
-	mb := globalBasisMethodBindings.method_transposed
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, nil)
-	return ret
-}
-
-/* Orthonormalized : orthonormalized
- * is_vararg = false, is_static = false
- * goReturnType(Basis) -> Basis
- */
-func (cx *Basis) Orthonormalized() Basis {
-	mb := globalBasisMethodBindings.method_orthonormalized
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, nil)
-	return ret
-}
-
-/* Determinant : determinant
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Basis) Determinant() float32 {
-	mb := globalBasisMethodBindings.method_determinant
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
-	return ret
-}
-
-/* Rotated : rotated
- * is_vararg = false, is_static = false
- * goReturnType(Basis) -> Basis
- */
-func (cx *Basis) Rotated(axis Vector3, angle float32) Basis {
-	mb := globalBasisMethodBindings.method_rotated
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&axis)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(angle)
-
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Scaled : scaled
- * is_vararg = false, is_static = false
- * goReturnType(Basis) -> Basis
- */
-func (cx *Basis) Scaled(scale Vector3) Basis {
-	mb := globalBasisMethodBindings.method_scaled
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&scale)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* ScaledLocal : scaled_local
- * is_vararg = false, is_static = false
- * goReturnType(Basis) -> Basis
- */
-func (cx *Basis) ScaledLocal(scale Vector3) Basis {
-	mb := globalBasisMethodBindings.method_scaled_local
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&scale)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* GetScale : get_scale
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Basis) GetScale() Vector3 {
-	mb := globalBasisMethodBindings.method_get_scale
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, nil)
-	return ret
-}
-
-/* GetEuler : get_euler
- * is_vararg = false, is_static = false
- * goReturnType(Vector3) -> Vector3
- */
-func (cx *Basis) GetEuler(order int64) Vector3 {
-	mb := globalBasisMethodBindings.method_get_euler
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Int64Encoder.EncodeTypePtr(order)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Tdotx : tdotx
+// methods
+
+/* Inverse : inverse
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Basis) -> Basis
  */
-func (cx *Basis) Tdotx(with Vector3) float32 {
-	mb := globalBasisMethodBindings.method_tdotx
+func (cx *Basis) Inverse() Basis {
+	mb := globalBasisMethodBindings.method_inverse
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, nil)
 	return ret
 }
 
-/* Tdoty : tdoty
+/* Orthonormalized : orthonormalized
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Basis) -> Basis
  */
-func (cx *Basis) Tdoty(with Vector3) float32 {
-	mb := globalBasisMethodBindings.method_tdoty
+func (cx *Basis) Orthonormalized() Basis {
+	mb := globalBasisMethodBindings.method_orthonormalized
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, nil)
 	return ret
 }
 
-/* Tdotz : tdotz
+/* Rotated : rotated
  * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
+ * goReturnType(Basis) -> Basis
  */
-func (cx *Basis) Tdotz(with Vector3) float32 {
-	mb := globalBasisMethodBindings.method_tdotz
+func (cx *Basis) Rotated(axis Vector3, angle float32) Basis {
+	mb := globalBasisMethodBindings.method_rotated
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 2
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&with)
+	args[0] = (GDExtensionTypePtr)(&axis)
 
-	pnr.Pin(args[0])
+	args[1] = Float32Encoder.EncodeTypePtr(angle)
 
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* Slerp : slerp
+/* ScaledLocal : scaled_local
  * is_vararg = false, is_static = false
  * goReturnType(Basis) -> Basis
  */
-func (cx *Basis) Slerp(to Basis, weight float32) Basis {
-	mb := globalBasisMethodBindings.method_slerp
+func (cx *Basis) ScaledLocal(scale Vector3) Basis {
+	mb := globalBasisMethodBindings.method_scaled_local
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 2
+	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(weight)
-
-	pnr.Pin(args[1])
+	args[0] = (GDExtensionTypePtr)(&scale)
 
 	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* IsConformal : is_conformal
+/* GetEuler : get_euler
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Vector3) -> Vector3
  */
-func (cx *Basis) IsConformal() bool {
-	mb := globalBasisMethodBindings.method_is_conformal
+func (cx *Basis) GetEuler(order int64) Vector3 {
+	mb := globalBasisMethodBindings.method_get_euler
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
+	sz := 1
+	args := make([]GDExtensionTypePtr, sz, sz)
+	args[0] = Int64Encoder.EncodeTypePtr(order)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
+	runtime.KeepAlive(args)
 	return ret
 }
 
-/* IsEqualApprox : is_equal_approx
+/* Slerp : slerp
  * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
+ * goReturnType(Basis) -> Basis
  */
-func (cx *Basis) IsEqualApprox(b Basis) bool {
-	mb := globalBasisMethodBindings.method_is_equal_approx
+func (cx *Basis) Slerp(to Basis, weight float32) Basis {
+	mb := globalBasisMethodBindings.method_slerp
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
+	sz := 2
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&b)
+	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
+	args[1] = Float32Encoder.EncodeTypePtr(weight)
 
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* IsFinite : is_finite
+/* IsConformal : is_conformal
  * is_vararg = false, is_static = false
  * goReturnType(bool) -> bool
  */
-func (cx *Basis) IsFinite() bool {
-	mb := globalBasisMethodBindings.method_is_finite
+func (cx *Basis) IsConformal() bool {
+	mb := globalBasisMethodBindings.method_is_conformal
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -13575,7 +9500,6 @@ func (cx *Basis) GetRotationQuaternion() Quaternion {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -13594,7 +9518,6 @@ func (cx *Basis) LookingAt(target Vector3, up Vector3, use_model_front bool) Bas
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -13602,14 +9525,10 @@ func (cx *Basis) LookingAt(target Vector3, up Vector3, use_model_front bool) Bas
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&target)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&up)
 
-	pnr.Pin(args[1])
 	args[2] = BoolEncoder.EncodeTypePtr(use_model_front)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -13625,7 +9544,6 @@ func (cx *Basis) FromScale(scale Vector3) Basis {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -13633,8 +9551,6 @@ func (cx *Basis) FromScale(scale Vector3) Basis {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&scale)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -13650,7 +9566,6 @@ func (cx *Basis) FromEuler(euler Vector3, order int64) Basis {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -13658,11 +9573,8 @@ func (cx *Basis) FromEuler(euler Vector3, order int64) Basis {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&euler)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(order)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Basis](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -13732,66 +9644,6 @@ func (cx *Basis) Not() bool {
 	return CallBuiltinOperatorPtr[bool](globalBasisMethodBindings.operator_not, lt, rt)
 }
 
-// Multiply_int operator
-func (cx *Basis) Multiply_int(right int64) Basis {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Basis](globalBasisMethodBindings.operator_multiply_int, lt, rt)
-}
-
-// Divide_int operator
-func (cx *Basis) Divide_int(right int64) Basis {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Basis](globalBasisMethodBindings.operator_divide_int, lt, rt)
-}
-
-// Multiply_float operator
-func (cx *Basis) Multiply_float(right float32) Basis {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Basis](globalBasisMethodBindings.operator_multiply_float, lt, rt)
-}
-
-// Divide_float operator
-func (cx *Basis) Divide_float(right float32) Basis {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Basis](globalBasisMethodBindings.operator_divide_float, lt, rt)
-}
-
-// Multiply_Vector3 operator
-func (cx *Basis) Multiply_Vector3(right Vector3) Vector3 {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Vector3](globalBasisMethodBindings.operator_multiply_Vector3, lt, rt)
-}
-
-// Equal_Basis operator
-func (cx *Basis) Equal_Basis(right Basis) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalBasisMethodBindings.operator_equal_Basis, lt, rt)
-}
-
-// Not_equal_Basis operator
-func (cx *Basis) Not_equal_Basis(right Basis) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalBasisMethodBindings.operator_not_equal_Basis, lt, rt)
-}
-
-// Multiply_Basis operator
-func (cx *Basis) Multiply_Basis(right Basis) Basis {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Basis](globalBasisMethodBindings.operator_multiply_Basis, lt, rt)
-}
-
 // In_Dictionary operator
 func (cx *Basis) In_Dictionary(right Dictionary) bool {
 	lt := cx.NativeConstPtr()
@@ -13814,7 +9666,19 @@ var _ GDBuiltInClass = (*Transform3D)(nil)
  * isKeyed: false
  * hasDestructor: false
  */
-type Transform3D [48]uint8
+type Transform3D struct {
+	Basis  Basis
+	Origin Vector3
+}
+
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Transform3D{})-Transform3DSize]
+	_ = x[unsafe.Offsetof(Transform3D{}.Basis)-0]
+	_ = x[unsafe.Offsetof(Transform3D{}.Origin)-36]
+}
 
 func (cx *Transform3D) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
@@ -13829,7 +9693,6 @@ func (cx *Transform3D) NativePtr() GDExtensionTypePtr {
 func NewTransform3D() Transform3D {
 	cx := Transform3D{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalTransform3DMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform3DMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -13839,13 +9702,11 @@ func NewTransform3D() Transform3D {
 func NewTransform3DWithTransform3D(from Transform3D) Transform3D {
 	cx := Transform3D{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Transform3D
 	// Transform3DEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalTransform3DMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform3DMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -13855,18 +9716,15 @@ func NewTransform3DWithTransform3D(from Transform3D) Transform3D {
 func NewTransform3DWithBasisVector3(basis Basis, origin Vector3) Transform3D {
 	cx := Transform3D{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// Basis
 	// BasisEncoder
 	args[0] = basis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("basis", basis))
-	pnr.Pin(args[0])
 	// Vector3
 	// Vector3Encoder
 	args[1] = origin.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("origin", origin))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalTransform3DMethodBindings.constructor_2, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform3DMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -13876,28 +9734,23 @@ func NewTransform3DWithBasisVector3(basis Basis, origin Vector3) Transform3D {
 func NewTransform3DWithVector3Vector3Vector3Vector3(x_axis Vector3, y_axis Vector3, z_axis Vector3, origin Vector3) Transform3D {
 	cx := Transform3D{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [4]GDExtensionConstTypePtr
 	// Vector3
 	// Vector3Encoder
 	args[0] = x_axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("x_axis", x_axis))
-	pnr.Pin(args[0])
 	// Vector3
 	// Vector3Encoder
 	args[1] = y_axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("y_axis", y_axis))
-	pnr.Pin(args[1])
 	// Vector3
 	// Vector3Encoder
 	args[2] = z_axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[2]", uintptr(args[2])), zap.Any("z_axis", z_axis))
-	pnr.Pin(args[2])
 	// Vector3
 	// Vector3Encoder
 	args[3] = origin.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[3]", uintptr(args[3])), zap.Any("origin", origin))
-	pnr.Pin(args[3])
 	CallBuiltinConstructor(globalTransform3DMethodBindings.constructor_3, ptr, args[0], args[1], args[2], args[3])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform3DMethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
@@ -13907,13 +9760,11 @@ func NewTransform3DWithVector3Vector3Vector3Vector3(x_axis Vector3, y_axis Vecto
 func NewTransform3DWithProjection(from Projection) Transform3D {
 	cx := Transform3D{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Projection
 	// ProjectionEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalTransform3DMethodBindings.constructor_4, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalTransform3DMethodBindings.constructor_4"), zap.Any("cx", cx))
 	return cx
@@ -13931,7 +9782,6 @@ func (cx *Transform3D) Inverse() Transform3D {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -13950,7 +9800,6 @@ func (cx *Transform3D) AffineInverse() Transform3D {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -13969,7 +9818,6 @@ func (cx *Transform3D) Orthonormalized() Transform3D {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -13988,7 +9836,6 @@ func (cx *Transform3D) Rotated(axis Vector3, angle float32) Transform3D {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -13996,11 +9843,8 @@ func (cx *Transform3D) Rotated(axis Vector3, angle float32) Transform3D {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&axis)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(angle)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Transform3D](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14016,7 +9860,6 @@ func (cx *Transform3D) RotatedLocal(axis Vector3, angle float32) Transform3D {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14024,11 +9867,8 @@ func (cx *Transform3D) RotatedLocal(axis Vector3, angle float32) Transform3D {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&axis)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(angle)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Transform3D](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14044,7 +9884,6 @@ func (cx *Transform3D) Scaled(scale Vector3) Transform3D {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14052,8 +9891,6 @@ func (cx *Transform3D) Scaled(scale Vector3) Transform3D {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&scale)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Transform3D](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14069,7 +9906,6 @@ func (cx *Transform3D) ScaledLocal(scale Vector3) Transform3D {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14077,8 +9913,6 @@ func (cx *Transform3D) ScaledLocal(scale Vector3) Transform3D {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&scale)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Transform3D](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14094,7 +9928,6 @@ func (cx *Transform3D) Translated(offset Vector3) Transform3D {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14102,8 +9935,6 @@ func (cx *Transform3D) Translated(offset Vector3) Transform3D {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Transform3D](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14119,7 +9950,6 @@ func (cx *Transform3D) TranslatedLocal(offset Vector3) Transform3D {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14127,8 +9957,6 @@ func (cx *Transform3D) TranslatedLocal(offset Vector3) Transform3D {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Transform3D](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14144,7 +9972,6 @@ func (cx *Transform3D) LookingAt(target Vector3, up Vector3, use_model_front boo
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14152,14 +9979,10 @@ func (cx *Transform3D) LookingAt(target Vector3, up Vector3, use_model_front boo
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&target)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&up)
 
-	pnr.Pin(args[1])
 	args[2] = BoolEncoder.EncodeTypePtr(use_model_front)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[Transform3D](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14175,7 +9998,6 @@ func (cx *Transform3D) InterpolateWith(xform Transform3D, weight float32) Transf
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14183,11 +10005,8 @@ func (cx *Transform3D) InterpolateWith(xform Transform3D, weight float32) Transf
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&xform)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(weight)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Transform3D](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14203,7 +10022,6 @@ func (cx *Transform3D) IsEqualApprox(xform Transform3D) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14211,8 +10029,6 @@ func (cx *Transform3D) IsEqualApprox(xform Transform3D) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&xform)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14228,7 +10044,6 @@ func (cx *Transform3D) IsFinite() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14377,7 +10192,23 @@ var _ GDBuiltInClass = (*Projection)(nil)
  * isKeyed: false
  * hasDestructor: false
  */
-type Projection [64]uint8
+type Projection struct {
+	X Vector4
+	Y Vector4
+	Z Vector4
+	W Vector4
+}
+
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Projection{})-ProjectionSize]
+	_ = x[unsafe.Offsetof(Projection{}.X)-0]
+	_ = x[unsafe.Offsetof(Projection{}.Y)-16]
+	_ = x[unsafe.Offsetof(Projection{}.Z)-32]
+	_ = x[unsafe.Offsetof(Projection{}.W)-48]
+}
 
 func (cx *Projection) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
@@ -14392,7 +10223,6 @@ func (cx *Projection) NativePtr() GDExtensionTypePtr {
 func NewProjection() Projection {
 	cx := Projection{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalProjectionMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalProjectionMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -14402,13 +10232,11 @@ func NewProjection() Projection {
 func NewProjectionWithProjection(from Projection) Projection {
 	cx := Projection{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Projection
 	// ProjectionEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalProjectionMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalProjectionMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -14418,13 +10246,11 @@ func NewProjectionWithProjection(from Projection) Projection {
 func NewProjectionWithTransform3D(from Transform3D) Projection {
 	cx := Projection{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Transform3D
 	// Transform3DEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalProjectionMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalProjectionMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -14434,28 +10260,23 @@ func NewProjectionWithTransform3D(from Transform3D) Projection {
 func NewProjectionWithVector4Vector4Vector4Vector4(x_axis Vector4, y_axis Vector4, z_axis Vector4, w_axis Vector4) Projection {
 	cx := Projection{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [4]GDExtensionConstTypePtr
 	// Vector4
 	// Vector4Encoder
 	args[0] = x_axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("x_axis", x_axis))
-	pnr.Pin(args[0])
 	// Vector4
 	// Vector4Encoder
 	args[1] = y_axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("y_axis", y_axis))
-	pnr.Pin(args[1])
 	// Vector4
 	// Vector4Encoder
 	args[2] = z_axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[2]", uintptr(args[2])), zap.Any("z_axis", z_axis))
-	pnr.Pin(args[2])
 	// Vector4
 	// Vector4Encoder
 	args[3] = w_axis.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[3]", uintptr(args[3])), zap.Any("w_axis", w_axis))
-	pnr.Pin(args[3])
 	CallBuiltinConstructor(globalProjectionMethodBindings.constructor_3, ptr, args[0], args[1], args[2], args[3])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalProjectionMethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
@@ -14473,7 +10294,6 @@ func (cx *Projection) CreateDepthCorrection(flip_y bool) Projection {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14481,8 +10301,6 @@ func (cx *Projection) CreateDepthCorrection(flip_y bool) Projection {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = BoolEncoder.EncodeTypePtr(flip_y)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14498,7 +10316,6 @@ func (cx *Projection) CreateLightAtlasRect(rect Rect2) Projection {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14506,8 +10323,6 @@ func (cx *Projection) CreateLightAtlasRect(rect Rect2) Projection {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Rect2Encoder.EncodeTypePtr(rect)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14523,7 +10338,6 @@ func (cx *Projection) CreatePerspective(fovy float32, aspect float32, z_near flo
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14531,20 +10345,14 @@ func (cx *Projection) CreatePerspective(fovy float32, aspect float32, z_near flo
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(fovy)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(aspect)
 
-	pnr.Pin(args[1])
 	args[2] = Float32Encoder.EncodeTypePtr(z_near)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(z_far)
 
-	pnr.Pin(args[3])
 	args[4] = BoolEncoder.EncodeTypePtr(flip_fov)
 
-	pnr.Pin(args[4])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14560,7 +10368,6 @@ func (cx *Projection) CreatePerspectiveHmd(fovy float32, aspect float32, z_near
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14568,29 +10375,20 @@ func (cx *Projection) CreatePerspectiveHmd(fovy float32, aspect float32, z_near
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(fovy)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(aspect)
 
-	pnr.Pin(args[1])
 	args[2] = Float32Encoder.EncodeTypePtr(z_near)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(z_far)
 
-	pnr.Pin(args[3])
 	args[4] = BoolEncoder.EncodeTypePtr(flip_fov)
 
-	pnr.Pin(args[4])
 	args[5] = Int64Encoder.EncodeTypePtr(eye)
 
-	pnr.Pin(args[5])
 	args[6] = Float32Encoder.EncodeTypePtr(intraocular_dist)
 
-	pnr.Pin(args[6])
 	args[7] = Float32Encoder.EncodeTypePtr(convergence_dist)
 
-	pnr.Pin(args[7])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14606,7 +10404,6 @@ func (cx *Projection) CreateForHmd(eye int64, aspect float32, intraocular_dist f
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14614,29 +10411,20 @@ func (cx *Projection) CreateForHmd(eye int64, aspect float32, intraocular_dist f
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(eye)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(aspect)
 
-	pnr.Pin(args[1])
 	args[2] = Float32Encoder.EncodeTypePtr(intraocular_dist)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(display_width)
 
-	pnr.Pin(args[3])
 	args[4] = Float32Encoder.EncodeTypePtr(display_to_lens)
 
-	pnr.Pin(args[4])
 	args[5] = Float32Encoder.EncodeTypePtr(oversample)
 
-	pnr.Pin(args[5])
 	args[6] = Float32Encoder.EncodeTypePtr(z_near)
 
-	pnr.Pin(args[6])
 	args[7] = Float32Encoder.EncodeTypePtr(z_far)
 
-	pnr.Pin(args[7])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14652,7 +10440,6 @@ func (cx *Projection) CreateOrthogonal(left float32, right float32, bottom float
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14660,23 +10447,16 @@ func (cx *Projection) CreateOrthogonal(left float32, right float32, bottom float
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(left)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(right)
 
-	pnr.Pin(args[1])
 	args[2] = Float32Encoder.EncodeTypePtr(bottom)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(top)
 
-	pnr.Pin(args[3])
 	args[4] = Float32Encoder.EncodeTypePtr(z_near)
 
-	pnr.Pin(args[4])
 	args[5] = Float32Encoder.EncodeTypePtr(z_far)
 
-	pnr.Pin(args[5])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14692,7 +10472,6 @@ func (cx *Projection) CreateOrthogonalAspect(size float32, aspect float32, z_nea
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14700,20 +10479,14 @@ func (cx *Projection) CreateOrthogonalAspect(size float32, aspect float32, z_nea
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(size)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(aspect)
 
-	pnr.Pin(args[1])
 	args[2] = Float32Encoder.EncodeTypePtr(z_near)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(z_far)
 
-	pnr.Pin(args[3])
 	args[4] = BoolEncoder.EncodeTypePtr(flip_fov)
 
-	pnr.Pin(args[4])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14729,7 +10502,6 @@ func (cx *Projection) CreateFrustum(left float32, right float32, bottom float32,
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14737,23 +10509,16 @@ func (cx *Projection) CreateFrustum(left float32, right float32, bottom float32,
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(left)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(right)
 
-	pnr.Pin(args[1])
 	args[2] = Float32Encoder.EncodeTypePtr(bottom)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(top)
 
-	pnr.Pin(args[3])
 	args[4] = Float32Encoder.EncodeTypePtr(z_near)
 
-	pnr.Pin(args[4])
 	args[5] = Float32Encoder.EncodeTypePtr(z_far)
 
-	pnr.Pin(args[5])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14769,7 +10534,6 @@ func (cx *Projection) CreateFrustumAspect(size float32, aspect float32, offset V
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14777,23 +10541,16 @@ func (cx *Projection) CreateFrustumAspect(size float32, aspect float32, offset V
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(size)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(aspect)
 
-	pnr.Pin(args[1])
 	args[2] = (GDExtensionTypePtr)(&offset)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(z_near)
 
-	pnr.Pin(args[3])
 	args[4] = Float32Encoder.EncodeTypePtr(z_far)
 
-	pnr.Pin(args[4])
 	args[5] = BoolEncoder.EncodeTypePtr(flip_fov)
 
-	pnr.Pin(args[5])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14809,7 +10566,6 @@ func (cx *Projection) CreateFitAabb(aabb AABB) Projection {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14817,8 +10573,6 @@ func (cx *Projection) CreateFitAabb(aabb AABB) Projection {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&aabb)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14834,7 +10588,6 @@ func (cx *Projection) Determinant() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14853,7 +10606,6 @@ func (cx *Projection) PerspectiveZnearAdjusted(new_znear float32) Projection {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14861,8 +10613,6 @@ func (cx *Projection) PerspectiveZnearAdjusted(new_znear float32) Projection {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(new_znear)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14878,7 +10628,6 @@ func (cx *Projection) GetProjectionPlane(plane int64) Plane {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14886,8 +10635,6 @@ func (cx *Projection) GetProjectionPlane(plane int64) Plane {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(plane)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Plane](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14903,7 +10650,6 @@ func (cx *Projection) FlippedY() Projection {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14922,7 +10668,6 @@ func (cx *Projection) JitterOffseted(offset Vector2) Projection {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14930,8 +10675,6 @@ func (cx *Projection) JitterOffseted(offset Vector2) Projection {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Projection](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14947,7 +10690,6 @@ func (cx *Projection) GetFovy(fovx float32, aspect float32) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14955,11 +10697,8 @@ func (cx *Projection) GetFovy(fovx float32, aspect float32) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(fovx)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(aspect)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -14975,7 +10714,6 @@ func (cx *Projection) GetZFar() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -14994,7 +10732,6 @@ func (cx *Projection) GetZNear() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15013,7 +10750,6 @@ func (cx *Projection) GetAspect() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15032,7 +10768,6 @@ func (cx *Projection) GetFov() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15051,7 +10786,6 @@ func (cx *Projection) IsOrthogonal() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15070,7 +10804,6 @@ func (cx *Projection) GetViewportHalfExtents() Vector2 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15089,7 +10822,6 @@ func (cx *Projection) GetFarPlaneHalfExtents() Vector2 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15108,7 +10840,6 @@ func (cx *Projection) Inverse() Projection {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15127,7 +10858,6 @@ func (cx *Projection) GetPixelsPerMeter(for_pixel_width int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15135,8 +10865,6 @@ func (cx *Projection) GetPixelsPerMeter(for_pixel_width int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(for_pixel_width)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -15152,7 +10880,6 @@ func (cx *Projection) GetLodMultiplier() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15282,7 +11009,23 @@ var _ GDBuiltInClass = (*Color)(nil)
  * isKeyed: false
  * hasDestructor: false
  */
-type Color [16]uint8
+type Color struct {
+	R float32
+	G float32
+	B float32
+	A float32
+}
+
+// layout check against builtin_class_sizes and builtin_class_member_offsets
+// (float_64); any mismatch is an out of range index.
+func _() {
+	var x [1]struct{}
+	_ = x[unsafe.Sizeof(Color{})-ColorSize]
+	_ = x[unsafe.Offsetof(Color{}.R)-0]
+	_ = x[unsafe.Offsetof(Color{}.G)-4]
+	_ = x[unsafe.Offsetof(Color{}.B)-8]
+	_ = x[unsafe.Offsetof(Color{}.A)-12]
+}
 
 func (cx *Color) NativeConstPtr() GDExtensionConstTypePtr {
 	return (GDExtensionConstTypePtr)(cx)
@@ -15297,7 +11040,6 @@ func (cx *Color) NativePtr() GDExtensionTypePtr {
 func NewColor() Color {
 	cx := Color{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalColorMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalColorMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -15307,13 +11049,11 @@ func NewColor() Color {
 func NewColorWithColor(from Color) Color {
 	cx := Color{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Color
 	// ColorEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalColorMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalColorMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -15323,16 +11063,13 @@ func NewColorWithColor(from Color) Color {
 func NewColorWithColorFloat32(from Color, alpha float32) Color {
 	cx := Color{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// Color
 	// ColorEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	// float
 	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(alpha))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalColorMethodBindings.constructor_2, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalColorMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -15342,17 +11079,13 @@ func NewColorWithColorFloat32(from Color, alpha float32) Color {
 func NewColorWithFloat32Float32Float32(r float32, g float32, b float32) Color {
 	cx := Color{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [3]GDExtensionConstTypePtr
 	// float
 	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(r))
-	pnr.Pin(args[0])
 	// float
 	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(g))
-	pnr.Pin(args[1])
 	// float
 	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(b))
-	pnr.Pin(args[2])
 	CallBuiltinConstructor(globalColorMethodBindings.constructor_3, ptr, args[0], args[1], args[2])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalColorMethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
@@ -15362,20 +11095,15 @@ func NewColorWithFloat32Float32Float32(r float32, g float32, b float32) Color {
 func NewColorWithFloat32Float32Float32Float32(r float32, g float32, b float32, a float32) Color {
 	cx := Color{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [4]GDExtensionConstTypePtr
 	// float
 	args[0] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(r))
-	pnr.Pin(args[0])
 	// float
 	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(g))
-	pnr.Pin(args[1])
 	// float
 	args[2] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(b))
-	pnr.Pin(args[2])
 	// float
 	args[3] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(a))
-	pnr.Pin(args[3])
 	CallBuiltinConstructor(globalColorMethodBindings.constructor_4, ptr, args[0], args[1], args[2], args[3])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalColorMethodBindings.constructor_4"), zap.Any("cx", cx))
 	return cx
@@ -15385,13 +11113,11 @@ func NewColorWithFloat32Float32Float32Float32(r float32, g float32, b float32, a
 func NewColorWithString(code String) Color {
 	cx := Color{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// String
 	// StringEncoder
 	args[0] = code.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("code", code))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalColorMethodBindings.constructor_5, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalColorMethodBindings.constructor_5"), zap.Any("cx", cx))
 	return cx
@@ -15401,129 +11127,30 @@ func NewColorWithString(code String) Color {
 func NewColorWithStringFloat32(code String, alpha float32) Color {
 	cx := Color{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// String
 	// StringEncoder
 	args[0] = code.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("code", code))
-	pnr.Pin(args[0])
-	// float
-	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(alpha))
-	pnr.Pin(args[1])
-	CallBuiltinConstructor(globalColorMethodBindings.constructor_6, ptr, args[0], args[1])
-	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalColorMethodBindings.constructor_6"), zap.Any("cx", cx))
-	return cx
-}
-
-// methods
-
-/* ToArgb32 : to_argb32
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Color) ToArgb32() int64 {
-	mb := globalColorMethodBindings.method_to_argb32
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* ToAbgr32 : to_abgr32
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Color) ToAbgr32() int64 {
-	mb := globalColorMethodBindings.method_to_abgr32
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* ToRgba32 : to_rgba32
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Color) ToRgba32() int64 {
-	mb := globalColorMethodBindings.method_to_rgba32
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* ToArgb64 : to_argb64
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Color) ToArgb64() int64 {
-	mb := globalColorMethodBindings.method_to_argb64
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
-}
-
-/* ToAbgr64 : to_abgr64
- * is_vararg = false, is_static = false
- * goReturnType(int) -> int64
- */
-func (cx *Color) ToAbgr64() int64 {
-	mb := globalColorMethodBindings.method_to_abgr64
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
-	return ret
+	// float
+	args[1] = (GDExtensionConstTypePtr)(Float32Encoder.EncodeTypePtr(alpha))
+	CallBuiltinConstructor(globalColorMethodBindings.constructor_6, ptr, args[0], args[1])
+	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalColorMethodBindings.constructor_6"), zap.Any("cx", cx))
+	return cx
 }
 
-/* ToRgba64 : to_rgba64
+// methods
+
+/* ToArgb32 : to_argb32
  * is_vararg = false, is_static = false
  * goReturnType(int) -> int64
  */
-func (cx *Color) ToRgba64() int64 {
-	mb := globalColorMethodBindings.method_to_rgba64
+func (cx *Color) ToArgb32() int64 {
+	mb := globalColorMethodBindings.method_to_argb32
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15532,200 +11159,118 @@ func (cx *Color) ToRgba64() int64 {
 	return ret
 }
 
-/* ToHtml : to_html
- * is_vararg = false, is_static = false
- * goReturnType(String) -> String
- */
-func (cx *Color) ToHtml(with_alpha bool) String {
-	mb := globalColorMethodBindings.method_to_html
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = BoolEncoder.EncodeTypePtr(with_alpha)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
-/* Clamp : clamp
+/* ToAbgr32 : to_abgr32
  * is_vararg = false, is_static = false
- * goReturnType(Color) -> Color
+ * goReturnType(int) -> int64
  */
-func (cx *Color) Clamp(min Color, max Color) Color {
-	mb := globalColorMethodBindings.method_clamp
+func (cx *Color) ToAbgr32() int64 {
+	mb := globalColorMethodBindings.method_to_abgr32
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&min)
-
-	pnr.Pin(args[0])
-	args[1] = (GDExtensionTypePtr)(&max)
-
-	pnr.Pin(args[1])
 
-	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* Inverted : inverted
+/* ToRgba32 : to_rgba32
  * is_vararg = false, is_static = false
- * goReturnType(Color) -> Color
+ * goReturnType(int) -> int64
  */
-func (cx *Color) Inverted() Color {
-	mb := globalColorMethodBindings.method_inverted
+func (cx *Color) ToRgba32() int64 {
+	mb := globalColorMethodBindings.method_to_rgba32
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 
-	ret := CallBuiltinMethodPtrRet[Color](mb, bx, nil)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* Lerp : lerp
+/* ToArgb64 : to_argb64
  * is_vararg = false, is_static = false
- * goReturnType(Color) -> Color
+ * goReturnType(int) -> int64
  */
-func (cx *Color) Lerp(to Color, weight float32) Color {
-	mb := globalColorMethodBindings.method_lerp
+func (cx *Color) ToArgb64() int64 {
+	mb := globalColorMethodBindings.method_to_argb64
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 2
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-	args[1] = Float32Encoder.EncodeTypePtr(weight)
 
-	pnr.Pin(args[1])
-
-	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* Lightened : lightened
+/* ToAbgr64 : to_abgr64
  * is_vararg = false, is_static = false
- * goReturnType(Color) -> Color
+ * goReturnType(int) -> int64
  */
-func (cx *Color) Lightened(amount float32) Color {
-	mb := globalColorMethodBindings.method_lightened
+func (cx *Color) ToAbgr64() int64 {
+	mb := globalColorMethodBindings.method_to_abgr64
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(amount)
-
-	pnr.Pin(args[0])
 
-	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* Darkened : darkened
+/* ToRgba64 : to_rgba64
  * is_vararg = false, is_static = false
- * goReturnType(Color) -> Color
+ * goReturnType(int) -> int64
  */
-func (cx *Color) Darkened(amount float32) Color {
-	mb := globalColorMethodBindings.method_darkened
+func (cx *Color) ToRgba64() int64 {
+	mb := globalColorMethodBindings.method_to_rgba64
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = Float32Encoder.EncodeTypePtr(amount)
 
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
-	runtime.KeepAlive(args)
+	ret := CallBuiltinMethodPtrRet[int64](mb, bx, nil)
 	return ret
 }
 
-/* Blend : blend
+/* ToHtml : to_html
  * is_vararg = false, is_static = false
- * goReturnType(Color) -> Color
+ * goReturnType(String) -> String
  */
-func (cx *Color) Blend(over Color) Color {
-	mb := globalColorMethodBindings.method_blend
+func (cx *Color) ToHtml(with_alpha bool) String {
+	mb := globalColorMethodBindings.method_to_html
 	if mb == nil {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
 	sz := 1
 	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&over)
-
-	pnr.Pin(args[0])
+	args[0] = BoolEncoder.EncodeTypePtr(with_alpha)
 
-	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
+	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
 }
 
-/* GetLuminance : get_luminance
- * is_vararg = false, is_static = false
- * goReturnType(float) -> float32
- */
-func (cx *Color) GetLuminance() float32 {
-	mb := globalColorMethodBindings.method_get_luminance
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-
-	ret := CallBuiltinMethodPtrRet[float32](mb, bx, nil)
-	return ret
-}
-
 /* SrgbToLinear : srgb_to_linear
  * is_vararg = false, is_static = false
  * goReturnType(Color) -> Color
@@ -15736,7 +11281,6 @@ func (cx *Color) SrgbToLinear() Color {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15755,7 +11299,6 @@ func (cx *Color) LinearToSrgb() Color {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15764,31 +11307,6 @@ func (cx *Color) LinearToSrgb() Color {
 	return ret
 }
 
-/* IsEqualApprox : is_equal_approx
- * is_vararg = false, is_static = false
- * goReturnType(bool) -> bool
- */
-func (cx *Color) IsEqualApprox(to Color) bool {
-	mb := globalColorMethodBindings.method_is_equal_approx
-	if mb == nil {
-		log.Panic("method bind cannot be nil")
-	}
-	bx := cx.NativePtr()
-	pnr.Pin(bx)
-	if bx == nil {
-		log.Panic("object cannot be nil")
-	}
-	sz := 1
-	args := make([]GDExtensionTypePtr, sz, sz)
-	args[0] = (GDExtensionTypePtr)(&to)
-
-	pnr.Pin(args[0])
-
-	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
-	runtime.KeepAlive(args)
-	return ret
-}
-
 /* Hex : hex
  * is_vararg = false, is_static = true
  * goReturnType(Color) -> Color
@@ -15799,7 +11317,6 @@ func (cx *Color) Hex(hex int64) Color {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15807,8 +11324,6 @@ func (cx *Color) Hex(hex int64) Color {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(hex)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -15824,7 +11339,6 @@ func (cx *Color) Hex64(hex int64) Color {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15832,8 +11346,6 @@ func (cx *Color) Hex64(hex int64) Color {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(hex)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -15849,7 +11361,6 @@ func (cx *Color) Html(rgba String) Color {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15857,8 +11368,6 @@ func (cx *Color) Html(rgba String) Color {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&rgba)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -15874,7 +11383,6 @@ func (cx *Color) HtmlIsValid(color String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15882,8 +11390,6 @@ func (cx *Color) HtmlIsValid(color String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&color)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -15899,7 +11405,6 @@ func (cx *Color) FromString(str String, defaultName Color) Color {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15907,11 +11412,8 @@ func (cx *Color) FromString(str String, defaultName Color) Color {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&str)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&defaultName)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -15927,7 +11429,6 @@ func (cx *Color) FromHsv(h float32, s float32, v float32, alpha float32) Color {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15935,17 +11436,12 @@ func (cx *Color) FromHsv(h float32, s float32, v float32, alpha float32) Color {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(h)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(s)
 
-	pnr.Pin(args[1])
 	args[2] = Float32Encoder.EncodeTypePtr(v)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(alpha)
 
-	pnr.Pin(args[3])
-
 	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -15961,7 +11457,6 @@ func (cx *Color) FromOkHsl(h float32, s float32, l float32, alpha float32) Color
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -15969,17 +11464,12 @@ func (cx *Color) FromOkHsl(h float32, s float32, l float32, alpha float32) Color
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(h)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(s)
 
-	pnr.Pin(args[1])
 	args[2] = Float32Encoder.EncodeTypePtr(l)
 
-	pnr.Pin(args[2])
 	args[3] = Float32Encoder.EncodeTypePtr(alpha)
 
-	pnr.Pin(args[3])
-
 	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -15995,7 +11485,6 @@ func (cx *Color) FromRgbe9995(rgbe int64) Color {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16003,8 +11492,6 @@ func (cx *Color) FromRgbe9995(rgbe int64) Color {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(rgbe)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16020,7 +11507,6 @@ func (cx *Color) FromRgba8(r8 int64, g8 int64, b8 int64, a8 int64) Color {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16028,17 +11514,12 @@ func (cx *Color) FromRgba8(r8 int64, g8 int64, b8 int64, a8 int64) Color {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(r8)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(g8)
 
-	pnr.Pin(args[1])
 	args[2] = Int64Encoder.EncodeTypePtr(b8)
 
-	pnr.Pin(args[2])
 	args[3] = Int64Encoder.EncodeTypePtr(a8)
 
-	pnr.Pin(args[3])
-
 	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16178,20 +11659,6 @@ func (cx *Color) Not_equal_Variant(right Variant) bool {
 	return CallBuiltinOperatorPtr[bool](globalColorMethodBindings.operator_not_equal_Variant, lt, rt)
 }
 
-// Negate operator
-func (cx *Color) Negate() Color {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_negate, lt, rt)
-}
-
-// Positive operator
-func (cx *Color) Positive() Color {
-	lt := cx.NativeConstPtr()
-	rt := (GDExtensionConstTypePtr)(nullptr)
-	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_positive, lt, rt)
-}
-
 // Not operator
 func (cx *Color) Not() bool {
 	lt := cx.NativeConstPtr()
@@ -16199,80 +11666,6 @@ func (cx *Color) Not() bool {
 	return CallBuiltinOperatorPtr[bool](globalColorMethodBindings.operator_not, lt, rt)
 }
 
-// Multiply_int operator
-func (cx *Color) Multiply_int(right int64) Color {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_multiply_int, lt, rt)
-}
-
-// Divide_int operator
-func (cx *Color) Divide_int(right int64) Color {
-	lt := cx.NativeConstPtr()
-	eRight := Int64Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_divide_int, lt, rt)
-}
-
-// Multiply_float operator
-func (cx *Color) Multiply_float(right float32) Color {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_multiply_float, lt, rt)
-}
-
-// Divide_float operator
-func (cx *Color) Divide_float(right float32) Color {
-	lt := cx.NativeConstPtr()
-	eRight := Float32Encoder.EncodeTypePtr(right)
-	rt := (GDExtensionConstTypePtr)(eRight)
-	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_divide_float, lt, rt)
-}
-
-// Equal_Color operator
-func (cx *Color) Equal_Color(right Color) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalColorMethodBindings.operator_equal_Color, lt, rt)
-}
-
-// Not_equal_Color operator
-func (cx *Color) Not_equal_Color(right Color) bool {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[bool](globalColorMethodBindings.operator_not_equal_Color, lt, rt)
-}
-
-// Add_Color operator
-func (cx *Color) Add_Color(right Color) Color {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_add_Color, lt, rt)
-}
-
-// Subtract_Color operator
-func (cx *Color) Subtract_Color(right Color) Color {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_subtract_Color, lt, rt)
-}
-
-// Multiply_Color operator
-func (cx *Color) Multiply_Color(right Color) Color {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_multiply_Color, lt, rt)
-}
-
-// Divide_Color operator
-func (cx *Color) Divide_Color(right Color) Color {
-	lt := cx.NativeConstPtr()
-	rt := right.NativeConstPtr()
-	return CallBuiltinOperatorPtr[Color](globalColorMethodBindings.operator_divide_Color, lt, rt)
-}
-
 // In_Dictionary operator
 func (cx *Color) In_Dictionary(right Dictionary) bool {
 	lt := cx.NativeConstPtr()
@@ -16317,7 +11710,6 @@ func (cx *StringName) NativePtr() GDExtensionTypePtr {
 func NewStringName() StringName {
 	cx := StringName{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalStringNameMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalStringNameMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -16327,13 +11719,11 @@ func NewStringName() StringName {
 func NewStringNameWithStringName(from StringName) StringName {
 	cx := StringName{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// StringName
 	// StringNameEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalStringNameMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalStringNameMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -16343,13 +11733,11 @@ func NewStringNameWithStringName(from StringName) StringName {
 func NewStringNameWithString(from String) StringName {
 	cx := StringName{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// String
 	// StringEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalStringNameMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalStringNameMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -16358,7 +11746,6 @@ func NewStringNameWithString(from String) StringName {
 func (cx *StringName) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalStringNameMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -16374,7 +11761,6 @@ func (cx *StringName) CasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16382,8 +11768,6 @@ func (cx *StringName) CasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16399,7 +11783,6 @@ func (cx *StringName) NocasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16407,8 +11790,6 @@ func (cx *StringName) NocasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16424,7 +11805,6 @@ func (cx *StringName) NaturalcasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16432,8 +11812,6 @@ func (cx *StringName) NaturalcasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16449,7 +11827,6 @@ func (cx *StringName) NaturalnocasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16457,8 +11834,6 @@ func (cx *StringName) NaturalnocasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16474,7 +11849,6 @@ func (cx *StringName) FilecasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16482,8 +11856,6 @@ func (cx *StringName) FilecasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16499,7 +11871,6 @@ func (cx *StringName) FilenocasecmpTo(to String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16507,8 +11878,6 @@ func (cx *StringName) FilenocasecmpTo(to String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&to)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16524,7 +11893,6 @@ func (cx *StringName) Length() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16543,7 +11911,6 @@ func (cx *StringName) Substr(from int64, len int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16551,11 +11918,8 @@ func (cx *StringName) Substr(from int64, len int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(len)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16571,7 +11935,6 @@ func (cx *StringName) GetSlice(delimiter String, slice int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16579,11 +11942,8 @@ func (cx *StringName) GetSlice(delimiter String, slice int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&delimiter)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(slice)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16599,7 +11959,6 @@ func (cx *StringName) GetSlicec(delimiter int64, slice int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16607,11 +11966,8 @@ func (cx *StringName) GetSlicec(delimiter int64, slice int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(delimiter)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(slice)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16627,7 +11983,6 @@ func (cx *StringName) GetSliceCount(delimiter String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16635,8 +11990,6 @@ func (cx *StringName) GetSliceCount(delimiter String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&delimiter)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16652,7 +12005,6 @@ func (cx *StringName) Find(what String, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16660,11 +12012,8 @@ func (cx *StringName) Find(what String, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16680,7 +12029,6 @@ func (cx *StringName) Findn(what String, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16688,11 +12036,8 @@ func (cx *StringName) Findn(what String, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16708,7 +12053,6 @@ func (cx *StringName) Count(what String, from int64, to int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16716,14 +12060,10 @@ func (cx *StringName) Count(what String, from int64, to int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
 	args[2] = Int64Encoder.EncodeTypePtr(to)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16739,7 +12079,6 @@ func (cx *StringName) Countn(what String, from int64, to int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16747,14 +12086,10 @@ func (cx *StringName) Countn(what String, from int64, to int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
 	args[2] = Int64Encoder.EncodeTypePtr(to)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16770,7 +12105,6 @@ func (cx *StringName) Rfind(what String, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16778,11 +12112,8 @@ func (cx *StringName) Rfind(what String, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16798,7 +12129,6 @@ func (cx *StringName) Rfindn(what String, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16806,11 +12136,8 @@ func (cx *StringName) Rfindn(what String, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16826,7 +12153,6 @@ func (cx *StringName) Match(expr String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16834,8 +12160,6 @@ func (cx *StringName) Match(expr String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&expr)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16851,7 +12175,6 @@ func (cx *StringName) Matchn(expr String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16859,8 +12182,6 @@ func (cx *StringName) Matchn(expr String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&expr)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16876,7 +12197,6 @@ func (cx *StringName) BeginsWith(text String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16884,8 +12204,6 @@ func (cx *StringName) BeginsWith(text String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&text)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16901,7 +12219,6 @@ func (cx *StringName) EndsWith(text String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16909,8 +12226,6 @@ func (cx *StringName) EndsWith(text String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&text)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16926,7 +12241,6 @@ func (cx *StringName) IsSubsequenceOf(text String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16934,8 +12248,6 @@ func (cx *StringName) IsSubsequenceOf(text String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&text)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16951,7 +12263,6 @@ func (cx *StringName) IsSubsequenceOfn(text String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16959,8 +12270,6 @@ func (cx *StringName) IsSubsequenceOfn(text String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&text)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -16976,7 +12285,6 @@ func (cx *StringName) Bigrams() PackedStringArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -16995,7 +12303,6 @@ func (cx *StringName) Similarity(text String) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17003,8 +12310,6 @@ func (cx *StringName) Similarity(text String) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&text)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17020,7 +12325,6 @@ func (cx *StringName) Format(values Variant, placeholder String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17028,11 +12332,8 @@ func (cx *StringName) Format(values Variant, placeholder String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&values)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&placeholder)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17048,7 +12349,6 @@ func (cx *StringName) Replace(what String, forwhat String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17056,11 +12356,8 @@ func (cx *StringName) Replace(what String, forwhat String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&forwhat)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17076,7 +12373,6 @@ func (cx *StringName) Replacen(what String, forwhat String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17084,11 +12380,8 @@ func (cx *StringName) Replacen(what String, forwhat String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&forwhat)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17104,7 +12397,6 @@ func (cx *StringName) ReplaceChar(key int64, with int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17112,11 +12404,8 @@ func (cx *StringName) ReplaceChar(key int64, with int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(key)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(with)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17132,7 +12421,6 @@ func (cx *StringName) ReplaceChars(keys String, with int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17140,11 +12428,8 @@ func (cx *StringName) ReplaceChars(keys String, with int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&keys)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(with)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17160,7 +12445,6 @@ func (cx *StringName) RemoveChar(what int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17168,8 +12452,6 @@ func (cx *StringName) RemoveChar(what int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(what)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17185,7 +12467,6 @@ func (cx *StringName) RemoveChars(chars String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17193,8 +12474,6 @@ func (cx *StringName) RemoveChars(chars String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&chars)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17210,7 +12489,6 @@ func (cx *StringName) Repeat(count int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17218,8 +12496,6 @@ func (cx *StringName) Repeat(count int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(count)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17235,7 +12511,6 @@ func (cx *StringName) Reverse() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17254,7 +12529,6 @@ func (cx *StringName) Insert(position int64, what String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17262,11 +12536,8 @@ func (cx *StringName) Insert(position int64, what String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(position)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17282,7 +12553,6 @@ func (cx *StringName) Erase(position int64, chars int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17290,11 +12560,8 @@ func (cx *StringName) Erase(position int64, chars int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(position)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(chars)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17310,7 +12577,6 @@ func (cx *StringName) Capitalize() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17329,7 +12595,6 @@ func (cx *StringName) ToCamelCase() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17348,7 +12613,6 @@ func (cx *StringName) ToPascalCase() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17367,7 +12631,6 @@ func (cx *StringName) ToSnakeCase() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17386,7 +12649,6 @@ func (cx *StringName) ToKebabCase() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17405,7 +12667,6 @@ func (cx *StringName) Split(delimiter String, allow_empty bool, maxsplit int64)
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17413,14 +12674,10 @@ func (cx *StringName) Split(delimiter String, allow_empty bool, maxsplit int64)
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&delimiter)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(allow_empty)
 
-	pnr.Pin(args[1])
 	args[2] = Int64Encoder.EncodeTypePtr(maxsplit)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[PackedStringArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17436,7 +12693,6 @@ func (cx *StringName) Rsplit(delimiter String, allow_empty bool, maxsplit int64)
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17444,14 +12700,10 @@ func (cx *StringName) Rsplit(delimiter String, allow_empty bool, maxsplit int64)
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&delimiter)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(allow_empty)
 
-	pnr.Pin(args[1])
 	args[2] = Int64Encoder.EncodeTypePtr(maxsplit)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[PackedStringArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17467,7 +12719,6 @@ func (cx *StringName) SplitFloats(delimiter String, allow_empty bool) PackedFloa
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17475,11 +12726,8 @@ func (cx *StringName) SplitFloats(delimiter String, allow_empty bool) PackedFloa
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&delimiter)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(allow_empty)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedFloat64Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17495,7 +12743,6 @@ func (cx *StringName) Join(parts PackedStringArray) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17503,8 +12750,6 @@ func (cx *StringName) Join(parts PackedStringArray) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&parts)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17520,7 +12765,6 @@ func (cx *StringName) ToUpper() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17539,7 +12783,6 @@ func (cx *StringName) ToLower() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17558,7 +12801,6 @@ func (cx *StringName) Left(length int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17566,8 +12808,6 @@ func (cx *StringName) Left(length int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(length)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17583,7 +12823,6 @@ func (cx *StringName) Right(length int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17591,8 +12830,6 @@ func (cx *StringName) Right(length int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(length)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17608,7 +12845,6 @@ func (cx *StringName) StripEdges(left bool, right bool) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17616,11 +12852,8 @@ func (cx *StringName) StripEdges(left bool, right bool) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = BoolEncoder.EncodeTypePtr(left)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(right)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17636,7 +12869,6 @@ func (cx *StringName) StripEscapes() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17655,7 +12887,6 @@ func (cx *StringName) Lstrip(chars String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17663,8 +12894,6 @@ func (cx *StringName) Lstrip(chars String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&chars)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17680,7 +12909,6 @@ func (cx *StringName) Rstrip(chars String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17688,8 +12916,6 @@ func (cx *StringName) Rstrip(chars String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&chars)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17705,7 +12931,6 @@ func (cx *StringName) GetExtension() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17724,7 +12949,6 @@ func (cx *StringName) GetBasename() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17743,7 +12967,6 @@ func (cx *StringName) PathJoin(path String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17751,8 +12974,6 @@ func (cx *StringName) PathJoin(path String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&path)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17768,7 +12989,6 @@ func (cx *StringName) UnicodeAt(at int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17776,8 +12996,6 @@ func (cx *StringName) UnicodeAt(at int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17793,7 +13011,6 @@ func (cx *StringName) Indent(prefix String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17801,8 +13018,6 @@ func (cx *StringName) Indent(prefix String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&prefix)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17818,7 +13033,6 @@ func (cx *StringName) Dedent() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17837,7 +13051,6 @@ func (cx *StringName) Md5Text() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17856,7 +13069,6 @@ func (cx *StringName) Sha1Text() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17875,7 +13087,6 @@ func (cx *StringName) Sha256Text() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17894,7 +13105,6 @@ func (cx *StringName) Md5Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17913,7 +13123,6 @@ func (cx *StringName) Sha1Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17932,7 +13141,6 @@ func (cx *StringName) Sha256Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17951,7 +13159,6 @@ func (cx *StringName) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17970,7 +13177,6 @@ func (cx *StringName) Contains(what String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -17978,8 +13184,6 @@ func (cx *StringName) Contains(what String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -17995,7 +13199,6 @@ func (cx *StringName) Containsn(what String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18003,8 +13206,6 @@ func (cx *StringName) Containsn(what String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -18020,7 +13221,6 @@ func (cx *StringName) IsAbsolutePath() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18039,7 +13239,6 @@ func (cx *StringName) IsRelativePath() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18058,7 +13257,6 @@ func (cx *StringName) SimplifyPath() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18077,7 +13275,6 @@ func (cx *StringName) GetBaseDir() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18096,7 +13293,6 @@ func (cx *StringName) GetFile() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18115,7 +13311,6 @@ func (cx *StringName) XmlEscape(escape_quotes bool) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18123,8 +13318,6 @@ func (cx *StringName) XmlEscape(escape_quotes bool) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = BoolEncoder.EncodeTypePtr(escape_quotes)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -18140,7 +13333,6 @@ func (cx *StringName) XmlUnescape() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18159,7 +13351,6 @@ func (cx *StringName) UriEncode() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18178,7 +13369,6 @@ func (cx *StringName) UriDecode() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18197,7 +13387,6 @@ func (cx *StringName) UriFileDecode() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18216,7 +13405,6 @@ func (cx *StringName) CEscape() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18235,7 +13423,6 @@ func (cx *StringName) CUnescape() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18254,7 +13441,6 @@ func (cx *StringName) JsonEscape() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18273,7 +13459,6 @@ func (cx *StringName) ValidateNodeName() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18292,7 +13477,6 @@ func (cx *StringName) ValidateFilename() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18311,7 +13495,6 @@ func (cx *StringName) IsValidAsciiIdentifier() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18330,7 +13513,6 @@ func (cx *StringName) IsValidUnicodeIdentifier() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18349,7 +13531,6 @@ func (cx *StringName) IsValidIdentifier() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18368,7 +13549,6 @@ func (cx *StringName) IsValidInt() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18387,7 +13567,6 @@ func (cx *StringName) IsValidFloat() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18406,7 +13585,6 @@ func (cx *StringName) IsValidHexNumber(with_prefix bool) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18414,8 +13592,6 @@ func (cx *StringName) IsValidHexNumber(with_prefix bool) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = BoolEncoder.EncodeTypePtr(with_prefix)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -18431,7 +13607,6 @@ func (cx *StringName) IsValidHtmlColor() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18450,7 +13625,6 @@ func (cx *StringName) IsValidIpAddress() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18469,7 +13643,6 @@ func (cx *StringName) IsValidFilename() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18488,7 +13661,6 @@ func (cx *StringName) ToInt() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18507,7 +13679,6 @@ func (cx *StringName) ToFloat() float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18526,7 +13697,6 @@ func (cx *StringName) HexToInt() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18545,7 +13715,6 @@ func (cx *StringName) BinToInt() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18564,7 +13733,6 @@ func (cx *StringName) Lpad(min_length int64, character String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18572,11 +13740,8 @@ func (cx *StringName) Lpad(min_length int64, character String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(min_length)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&character)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -18592,7 +13757,6 @@ func (cx *StringName) Rpad(min_length int64, character String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18600,11 +13764,8 @@ func (cx *StringName) Rpad(min_length int64, character String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(min_length)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&character)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -18620,7 +13781,6 @@ func (cx *StringName) PadDecimals(digits int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18628,8 +13788,6 @@ func (cx *StringName) PadDecimals(digits int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(digits)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -18645,7 +13803,6 @@ func (cx *StringName) PadZeros(digits int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18653,8 +13810,6 @@ func (cx *StringName) PadZeros(digits int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(digits)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -18670,7 +13825,6 @@ func (cx *StringName) TrimPrefix(prefix String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18678,8 +13832,6 @@ func (cx *StringName) TrimPrefix(prefix String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&prefix)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -18695,7 +13847,6 @@ func (cx *StringName) TrimSuffix(suffix String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18703,8 +13854,6 @@ func (cx *StringName) TrimSuffix(suffix String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&suffix)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -18720,7 +13869,6 @@ func (cx *StringName) ToAsciiBuffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18739,7 +13887,6 @@ func (cx *StringName) ToUtf8Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18758,7 +13905,6 @@ func (cx *StringName) ToUtf16Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18777,7 +13923,6 @@ func (cx *StringName) ToUtf32Buffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18796,7 +13941,6 @@ func (cx *StringName) ToWcharBuffer() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18815,7 +13959,6 @@ func (cx *StringName) ToMultibyteCharBuffer(encoding String) PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18823,8 +13966,6 @@ func (cx *StringName) ToMultibyteCharBuffer(encoding String) PackedByteArray {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&encoding)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[PackedByteArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -18840,7 +13981,6 @@ func (cx *StringName) HexDecode() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -18859,7 +13999,6 @@ func (cx *StringName) Hash() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19306,7 +14445,6 @@ func (cx *NodePath) NativePtr() GDExtensionTypePtr {
 func NewNodePath() NodePath {
 	cx := NodePath{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalNodePathMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalNodePathMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -19316,13 +14454,11 @@ func NewNodePath() NodePath {
 func NewNodePathWithNodePath(from NodePath) NodePath {
 	cx := NodePath{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// NodePath
 	// NodePathEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalNodePathMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalNodePathMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -19332,13 +14468,11 @@ func NewNodePathWithNodePath(from NodePath) NodePath {
 func NewNodePathWithString(from String) NodePath {
 	cx := NodePath{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// String
 	// StringEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalNodePathMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalNodePathMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -19347,7 +14481,6 @@ func NewNodePathWithString(from String) NodePath {
 func (cx *NodePath) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalNodePathMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -19363,7 +14496,6 @@ func (cx *NodePath) IsAbsolute() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19382,7 +14514,6 @@ func (cx *NodePath) GetNameCount() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19401,7 +14532,6 @@ func (cx *NodePath) GetName(idx int64) StringName {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19409,8 +14539,6 @@ func (cx *NodePath) GetName(idx int64) StringName {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(idx)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[StringName](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -19426,7 +14554,6 @@ func (cx *NodePath) GetSubnameCount() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19445,7 +14572,6 @@ func (cx *NodePath) Hash() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19464,7 +14590,6 @@ func (cx *NodePath) GetSubname(idx int64) StringName {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19472,8 +14597,6 @@ func (cx *NodePath) GetSubname(idx int64) StringName {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(idx)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[StringName](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -19489,7 +14612,6 @@ func (cx *NodePath) GetConcatenatedNames() StringName {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19508,7 +14630,6 @@ func (cx *NodePath) GetConcatenatedSubnames() StringName {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19527,7 +14648,6 @@ func (cx *NodePath) Slice(begin int64, end int64) NodePath {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19535,11 +14655,8 @@ func (cx *NodePath) Slice(begin int64, end int64) NodePath {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[NodePath](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -19555,7 +14672,6 @@ func (cx *NodePath) GetAsPropertyPath() NodePath {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19574,7 +14690,6 @@ func (cx *NodePath) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19656,7 +14771,6 @@ func (cx *RID) NativePtr() GDExtensionTypePtr {
 func NewRID() RID {
 	cx := RID{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalRIDMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRIDMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -19666,13 +14780,11 @@ func NewRID() RID {
 func NewRIDWithRID(from RID) RID {
 	cx := RID{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// RID
 	// RIDEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalRIDMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalRIDMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -19690,7 +14802,6 @@ func (cx *RID) IsValid() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19709,7 +14820,6 @@ func (cx *RID) GetId() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19819,7 +14929,6 @@ func (cx *Callable) NativePtr() GDExtensionTypePtr {
 func NewCallable() Callable {
 	cx := Callable{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalCallableMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalCallableMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -19829,13 +14938,11 @@ func NewCallable() Callable {
 func NewCallableWithCallable(from Callable) Callable {
 	cx := Callable{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Callable
 	// CallableEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalCallableMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalCallableMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -19845,17 +14952,14 @@ func NewCallableWithCallable(from Callable) Callable {
 func NewCallableWithObjectStringName(object Object, method StringName) Callable {
 	cx := Callable{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// Object
 	arg0 := object.GetGodotObjectOwner()
 	args[0] = (GDExtensionConstTypePtr)(&arg0)
-	pnr.Pin(args[0])
 	// StringName
 	// StringNameEncoder
 	args[1] = method.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("method", method))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalCallableMethodBindings.constructor_2, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalCallableMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -19864,7 +14968,6 @@ func NewCallableWithObjectStringName(object Object, method StringName) Callable
 func (cx *Callable) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalCallableMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -19880,7 +14983,6 @@ func (cx *Callable) Create(variant Variant, method StringName) Callable {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19888,11 +14990,8 @@ func (cx *Callable) Create(variant Variant, method StringName) Callable {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&variant)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&method)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Callable](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -19908,7 +15007,6 @@ func (cx *Callable) Callv(arguments Array) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19916,8 +15014,6 @@ func (cx *Callable) Callv(arguments Array) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&arguments)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -19933,7 +15029,6 @@ func (cx *Callable) IsNull() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19952,7 +15047,6 @@ func (cx *Callable) IsCustom() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19971,7 +15065,6 @@ func (cx *Callable) IsStandard() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -19990,7 +15083,6 @@ func (cx *Callable) IsValid() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20009,7 +15101,6 @@ func (cx *Callable) GetObject() Object {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20028,7 +15119,6 @@ func (cx *Callable) GetObjectId() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20047,7 +15137,6 @@ func (cx *Callable) GetMethod() StringName {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20066,7 +15155,6 @@ func (cx *Callable) GetArgumentCount() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20085,7 +15173,6 @@ func (cx *Callable) GetBoundArgumentsCount() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20104,7 +15191,6 @@ func (cx *Callable) GetBoundArguments() Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20123,7 +15209,6 @@ func (cx *Callable) GetUnboundArgumentsCount() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20142,7 +15227,6 @@ func (cx *Callable) Hash() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20161,7 +15245,6 @@ func (cx *Callable) Bindv(arguments Array) Callable {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20169,8 +15252,6 @@ func (cx *Callable) Bindv(arguments Array) Callable {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&arguments)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Callable](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -20186,7 +15267,6 @@ func (cx *Callable) Unbind(argcount int64) Callable {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20194,8 +15274,6 @@ func (cx *Callable) Unbind(argcount int64) Callable {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(argcount)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Callable](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -20274,7 +15352,6 @@ func (cx *Signal) NativePtr() GDExtensionTypePtr {
 func NewSignal() Signal {
 	cx := Signal{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalSignalMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalSignalMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -20284,13 +15361,11 @@ func NewSignal() Signal {
 func NewSignalWithSignal(from Signal) Signal {
 	cx := Signal{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Signal
 	// SignalEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalSignalMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalSignalMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -20300,17 +15375,14 @@ func NewSignalWithSignal(from Signal) Signal {
 func NewSignalWithObjectStringName(object Object, signal StringName) Signal {
 	cx := Signal{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [2]GDExtensionConstTypePtr
 	// Object
 	arg0 := object.GetGodotObjectOwner()
 	args[0] = (GDExtensionConstTypePtr)(&arg0)
-	pnr.Pin(args[0])
 	// StringName
 	// StringNameEncoder
 	args[1] = signal.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[1]", uintptr(args[1])), zap.Any("signal", signal))
-	pnr.Pin(args[1])
 	CallBuiltinConstructor(globalSignalMethodBindings.constructor_2, ptr, args[0], args[1])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalSignalMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -20319,7 +15391,6 @@ func NewSignalWithObjectStringName(object Object, signal StringName) Signal {
 func (cx *Signal) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalSignalMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -20335,7 +15406,6 @@ func (cx *Signal) IsNull() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20354,7 +15424,6 @@ func (cx *Signal) GetObject() Object {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20373,7 +15442,6 @@ func (cx *Signal) GetObjectId() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20392,7 +15460,6 @@ func (cx *Signal) GetName() StringName {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20411,7 +15478,6 @@ func (cx *Signal) Connect(callable Callable, flags int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20419,11 +15485,8 @@ func (cx *Signal) Connect(callable Callable, flags int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&callable)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(flags)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -20439,7 +15502,6 @@ func (cx *Signal) Disconnect(callable Callable) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20447,8 +15509,6 @@ func (cx *Signal) Disconnect(callable Callable) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&callable)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -20464,7 +15524,6 @@ func (cx *Signal) IsConnected(callable Callable) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20472,8 +15531,6 @@ func (cx *Signal) IsConnected(callable Callable) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&callable)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -20489,7 +15546,6 @@ func (cx *Signal) GetConnections() Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20508,7 +15564,6 @@ func (cx *Signal) HasConnections() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20590,7 +15645,6 @@ func (cx *Dictionary) NativePtr() GDExtensionTypePtr {
 func NewDictionary() Dictionary {
 	cx := Dictionary{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalDictionaryMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalDictionaryMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -20600,13 +15654,11 @@ func NewDictionary() Dictionary {
 func NewDictionaryWithDictionary(from Dictionary) Dictionary {
 	cx := Dictionary{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Dictionary
 	// DictionaryEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalDictionaryMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalDictionaryMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -20616,35 +15668,27 @@ func NewDictionaryWithDictionary(from Dictionary) Dictionary {
 func NewDictionaryWithDictionaryInt64StringNameVariantInt64StringNameVariant(base Dictionary, key_type int64, key_class_name StringName, key_script Variant, value_type int64, value_class_name StringName, value_script Variant) Dictionary {
 	cx := Dictionary{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [7]GDExtensionConstTypePtr
 	// Dictionary
 	// DictionaryEncoder
 	args[0] = base.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("base", base))
-	pnr.Pin(args[0])
 	// int
 	args[1] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(key_type))
-	pnr.Pin(args[1])
 	// StringName
 	// StringNameEncoder
 	args[2] = key_class_name.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[2]", uintptr(args[2])), zap.Any("key_class_name", key_class_name))
-	pnr.Pin(args[2])
 	// Variant
 	args[3] = (GDExtensionConstTypePtr)(&key_script)
-	pnr.Pin(args[3])
 	// int
 	args[4] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(value_type))
-	pnr.Pin(args[4])
 	// StringName
 	// StringNameEncoder
 	args[5] = value_class_name.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[5]", uintptr(args[5])), zap.Any("value_class_name", value_class_name))
-	pnr.Pin(args[5])
 	// Variant
 	args[6] = (GDExtensionConstTypePtr)(&value_script)
-	pnr.Pin(args[6])
 	CallBuiltinConstructor(globalDictionaryMethodBindings.constructor_2, ptr, args[0], args[1], args[2], args[3], args[4], args[5], args[6])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalDictionaryMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -20653,7 +15697,6 @@ func NewDictionaryWithDictionaryInt64StringNameVariantInt64StringNameVariant(bas
 func (cx *Dictionary) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalDictionaryMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -20669,7 +15712,6 @@ func (cx *Dictionary) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20688,7 +15730,6 @@ func (cx *Dictionary) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20707,7 +15748,6 @@ func (cx *Dictionary) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20726,7 +15766,6 @@ func (cx *Dictionary) Assign(dictionary Dictionary) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20734,8 +15773,6 @@ func (cx *Dictionary) Assign(dictionary Dictionary) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&dictionary)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -20751,7 +15788,6 @@ func (cx *Dictionary) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20770,7 +15806,6 @@ func (cx *Dictionary) Merge(dictionary Dictionary, overwrite bool) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20778,11 +15813,8 @@ func (cx *Dictionary) Merge(dictionary Dictionary, overwrite bool) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&dictionary)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(overwrite)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -20798,7 +15830,6 @@ func (cx *Dictionary) Merged(dictionary Dictionary, overwrite bool) Dictionary {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20806,11 +15837,8 @@ func (cx *Dictionary) Merged(dictionary Dictionary, overwrite bool) Dictionary {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&dictionary)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(overwrite)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Dictionary](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -20826,7 +15854,6 @@ func (cx *Dictionary) Has(key Variant) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20834,8 +15861,6 @@ func (cx *Dictionary) Has(key Variant) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&key)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -20851,7 +15876,6 @@ func (cx *Dictionary) HasAll(keys Array) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20859,8 +15883,6 @@ func (cx *Dictionary) HasAll(keys Array) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&keys)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -20876,7 +15898,6 @@ func (cx *Dictionary) FindKey(value Variant) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20884,8 +15905,6 @@ func (cx *Dictionary) FindKey(value Variant) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -20901,7 +15920,6 @@ func (cx *Dictionary) Erase(key Variant) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20909,8 +15927,6 @@ func (cx *Dictionary) Erase(key Variant) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&key)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -20926,7 +15942,6 @@ func (cx *Dictionary) Hash() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20945,7 +15960,6 @@ func (cx *Dictionary) Keys() Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20964,7 +15978,6 @@ func (cx *Dictionary) Values() Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20983,7 +15996,6 @@ func (cx *Dictionary) Duplicate(deep bool) Dictionary {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -20991,8 +16003,6 @@ func (cx *Dictionary) Duplicate(deep bool) Dictionary {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = BoolEncoder.EncodeTypePtr(deep)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Dictionary](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -21008,7 +16018,6 @@ func (cx *Dictionary) DuplicateDeep(deep_subresources_mode int64) Dictionary {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21016,8 +16025,6 @@ func (cx *Dictionary) DuplicateDeep(deep_subresources_mode int64) Dictionary {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(deep_subresources_mode)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Dictionary](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -21033,7 +16040,6 @@ func (cx *Dictionary) Get(key Variant, defaultName Variant) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21041,11 +16047,8 @@ func (cx *Dictionary) Get(key Variant, defaultName Variant) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&key)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&defaultName)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -21061,7 +16064,6 @@ func (cx *Dictionary) GetOrAdd(key Variant, defaultName Variant) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21069,11 +16071,8 @@ func (cx *Dictionary) GetOrAdd(key Variant, defaultName Variant) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&key)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&defaultName)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -21089,7 +16088,6 @@ func (cx *Dictionary) Set(key Variant, value Variant) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21097,11 +16095,8 @@ func (cx *Dictionary) Set(key Variant, value Variant) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&key)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -21117,7 +16112,6 @@ func (cx *Dictionary) IsTyped() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21136,7 +16130,6 @@ func (cx *Dictionary) IsTypedKey() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21155,7 +16148,6 @@ func (cx *Dictionary) IsTypedValue() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21174,7 +16166,6 @@ func (cx *Dictionary) IsSameTyped(dictionary Dictionary) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21182,8 +16173,6 @@ func (cx *Dictionary) IsSameTyped(dictionary Dictionary) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&dictionary)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -21199,7 +16188,6 @@ func (cx *Dictionary) IsSameTypedKey(dictionary Dictionary) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21207,8 +16195,6 @@ func (cx *Dictionary) IsSameTypedKey(dictionary Dictionary) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&dictionary)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -21224,7 +16210,6 @@ func (cx *Dictionary) IsSameTypedValue(dictionary Dictionary) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21232,8 +16217,6 @@ func (cx *Dictionary) IsSameTypedValue(dictionary Dictionary) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&dictionary)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -21249,7 +16232,6 @@ func (cx *Dictionary) GetTypedKeyBuiltin() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21268,7 +16250,6 @@ func (cx *Dictionary) GetTypedValueBuiltin() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21287,7 +16268,6 @@ func (cx *Dictionary) GetTypedKeyClassName() StringName {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21306,7 +16286,6 @@ func (cx *Dictionary) GetTypedValueClassName() StringName {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21325,7 +16304,6 @@ func (cx *Dictionary) GetTypedKeyScript() Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21344,7 +16322,6 @@ func (cx *Dictionary) GetTypedValueScript() Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21363,7 +16340,6 @@ func (cx *Dictionary) MakeReadOnly() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21382,7 +16358,6 @@ func (cx *Dictionary) IsReadOnly() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21401,7 +16376,6 @@ func (cx *Dictionary) RecursiveEqual(dictionary Dictionary, recursion_count int6
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21409,11 +16383,8 @@ func (cx *Dictionary) RecursiveEqual(dictionary Dictionary, recursion_count int6
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&dictionary)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(recursion_count)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -21539,7 +16510,6 @@ func (cx *Array) NativePtr() GDExtensionTypePtr {
 func NewArray() Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -21549,13 +16519,11 @@ func NewArray() Array {
 func NewArrayWithArray(from Array) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -21565,24 +16533,19 @@ func NewArrayWithArray(from Array) Array {
 func NewArrayWithArrayInt64StringNameVariant(base Array, typeName int64, class_name StringName, script Variant) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [4]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = base.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("base", base))
-	pnr.Pin(args[0])
 	// int
 	args[1] = (GDExtensionConstTypePtr)(Int64Encoder.EncodeTypePtr(typeName))
-	pnr.Pin(args[1])
 	// StringName
 	// StringNameEncoder
 	args[2] = class_name.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[2]", uintptr(args[2])), zap.Any("class_name", class_name))
-	pnr.Pin(args[2])
 	// Variant
 	args[3] = (GDExtensionConstTypePtr)(&script)
-	pnr.Pin(args[3])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_2, ptr, args[0], args[1], args[2], args[3])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -21592,13 +16555,11 @@ func NewArrayWithArrayInt64StringNameVariant(base Array, typeName int64, class_n
 func NewArrayWithPackedByteArray(from PackedByteArray) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedByteArray
 	// PackedByteArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_3, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_3"), zap.Any("cx", cx))
 	return cx
@@ -21608,13 +16569,11 @@ func NewArrayWithPackedByteArray(from PackedByteArray) Array {
 func NewArrayWithPackedInt32Array(from PackedInt32Array) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedInt32Array
 	// PackedInt32ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_4, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_4"), zap.Any("cx", cx))
 	return cx
@@ -21624,13 +16583,11 @@ func NewArrayWithPackedInt32Array(from PackedInt32Array) Array {
 func NewArrayWithPackedInt64Array(from PackedInt64Array) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedInt64Array
 	// PackedInt64ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_5, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_5"), zap.Any("cx", cx))
 	return cx
@@ -21640,13 +16597,11 @@ func NewArrayWithPackedInt64Array(from PackedInt64Array) Array {
 func NewArrayWithPackedFloat32Array(from PackedFloat32Array) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedFloat32Array
 	// PackedFloat32ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_6, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_6"), zap.Any("cx", cx))
 	return cx
@@ -21656,13 +16611,11 @@ func NewArrayWithPackedFloat32Array(from PackedFloat32Array) Array {
 func NewArrayWithPackedFloat64Array(from PackedFloat64Array) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedFloat64Array
 	// PackedFloat64ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_7, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_7"), zap.Any("cx", cx))
 	return cx
@@ -21672,13 +16625,11 @@ func NewArrayWithPackedFloat64Array(from PackedFloat64Array) Array {
 func NewArrayWithPackedStringArray(from PackedStringArray) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedStringArray
 	// PackedStringArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_8, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_8"), zap.Any("cx", cx))
 	return cx
@@ -21688,13 +16639,11 @@ func NewArrayWithPackedStringArray(from PackedStringArray) Array {
 func NewArrayWithPackedVector2Array(from PackedVector2Array) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedVector2Array
 	// PackedVector2ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_9, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_9"), zap.Any("cx", cx))
 	return cx
@@ -21704,13 +16653,11 @@ func NewArrayWithPackedVector2Array(from PackedVector2Array) Array {
 func NewArrayWithPackedVector3Array(from PackedVector3Array) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedVector3Array
 	// PackedVector3ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_10, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_10"), zap.Any("cx", cx))
 	return cx
@@ -21720,13 +16667,11 @@ func NewArrayWithPackedVector3Array(from PackedVector3Array) Array {
 func NewArrayWithPackedColorArray(from PackedColorArray) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedColorArray
 	// PackedColorArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_11, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_11"), zap.Any("cx", cx))
 	return cx
@@ -21736,13 +16681,11 @@ func NewArrayWithPackedColorArray(from PackedColorArray) Array {
 func NewArrayWithPackedVector4Array(from PackedVector4Array) Array {
 	cx := Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedVector4Array
 	// PackedVector4ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalArrayMethodBindings.constructor_12, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalArrayMethodBindings.constructor_12"), zap.Any("cx", cx))
 	return cx
@@ -21751,7 +16694,6 @@ func NewArrayWithPackedVector4Array(from PackedVector4Array) Array {
 func (cx *Array) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -21767,7 +16709,6 @@ func (cx *Array) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21786,7 +16727,6 @@ func (cx *Array) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21805,7 +16745,6 @@ func (cx *Array) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21824,7 +16763,6 @@ func (cx *Array) Hash() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21843,7 +16781,6 @@ func (cx *Array) Assign(array Array) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21851,8 +16788,6 @@ func (cx *Array) Assign(array Array) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -21868,7 +16803,6 @@ func (cx *Array) Get(index int64) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21876,8 +16810,6 @@ func (cx *Array) Get(index int64) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -21893,7 +16825,6 @@ func (cx *Array) Set(index int64, value Variant) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21901,11 +16832,8 @@ func (cx *Array) Set(index int64, value Variant) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -21921,7 +16849,6 @@ func (cx *Array) PushBack(value Variant) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21929,8 +16856,6 @@ func (cx *Array) PushBack(value Variant) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -21946,7 +16871,6 @@ func (cx *Array) PushFront(value Variant) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21954,8 +16878,6 @@ func (cx *Array) PushFront(value Variant) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -21971,7 +16893,6 @@ func (cx *Array) Append(value Variant) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -21979,8 +16900,6 @@ func (cx *Array) Append(value Variant) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -21996,7 +16915,6 @@ func (cx *Array) AppendArray(array Array) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22004,8 +16922,6 @@ func (cx *Array) AppendArray(array Array) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -22021,7 +16937,6 @@ func (cx *Array) Resize(size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22029,8 +16944,6 @@ func (cx *Array) Resize(size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22046,7 +16959,6 @@ func (cx *Array) Insert(position int64, value Variant) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22054,11 +16966,8 @@ func (cx *Array) Insert(position int64, value Variant) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(position)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22074,7 +16983,6 @@ func (cx *Array) RemoveAt(position int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22082,8 +16990,6 @@ func (cx *Array) RemoveAt(position int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(position)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -22099,7 +17005,6 @@ func (cx *Array) Fill(value Variant) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22107,8 +17012,6 @@ func (cx *Array) Fill(value Variant) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -22124,7 +17027,6 @@ func (cx *Array) Erase(value Variant) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22132,8 +17034,6 @@ func (cx *Array) Erase(value Variant) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -22149,7 +17049,6 @@ func (cx *Array) Front() Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22168,7 +17067,6 @@ func (cx *Array) Back() Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22187,7 +17085,6 @@ func (cx *Array) PickRandom() Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22206,7 +17103,6 @@ func (cx *Array) Find(what Variant, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22214,11 +17110,8 @@ func (cx *Array) Find(what Variant, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22234,7 +17127,6 @@ func (cx *Array) FindCustom(method Callable, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22242,11 +17134,8 @@ func (cx *Array) FindCustom(method Callable, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&method)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22262,7 +17151,6 @@ func (cx *Array) Rfind(what Variant, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22270,11 +17158,8 @@ func (cx *Array) Rfind(what Variant, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&what)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22290,7 +17175,6 @@ func (cx *Array) RfindCustom(method Callable, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22298,11 +17182,8 @@ func (cx *Array) RfindCustom(method Callable, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&method)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22318,7 +17199,6 @@ func (cx *Array) Count(value Variant) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22326,8 +17206,6 @@ func (cx *Array) Count(value Variant) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22343,7 +17221,6 @@ func (cx *Array) Has(value Variant) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22351,8 +17228,6 @@ func (cx *Array) Has(value Variant) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22368,7 +17243,6 @@ func (cx *Array) PopBack() Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22387,7 +17261,6 @@ func (cx *Array) PopFront() Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22406,7 +17279,6 @@ func (cx *Array) PopAt(position int64) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22414,8 +17286,6 @@ func (cx *Array) PopAt(position int64) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(position)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22431,7 +17301,6 @@ func (cx *Array) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22450,7 +17319,6 @@ func (cx *Array) SortCustom(callbackFunc Callable) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22458,8 +17326,6 @@ func (cx *Array) SortCustom(callbackFunc Callable) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&callbackFunc)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -22475,7 +17341,6 @@ func (cx *Array) Shuffle() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22494,7 +17359,6 @@ func (cx *Array) Bsearch(value Variant, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22502,11 +17366,8 @@ func (cx *Array) Bsearch(value Variant, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22522,7 +17383,6 @@ func (cx *Array) BsearchCustom(value Variant, callbackFunc Callable, before bool
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22530,14 +17390,10 @@ func (cx *Array) BsearchCustom(value Variant, callbackFunc Callable, before bool
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&callbackFunc)
 
-	pnr.Pin(args[1])
 	args[2] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22553,7 +17409,6 @@ func (cx *Array) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22572,7 +17427,6 @@ func (cx *Array) Duplicate(deep bool) Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22580,8 +17434,6 @@ func (cx *Array) Duplicate(deep bool) Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = BoolEncoder.EncodeTypePtr(deep)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22597,7 +17449,6 @@ func (cx *Array) DuplicateDeep(deep_subresources_mode int64) Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22605,8 +17456,6 @@ func (cx *Array) DuplicateDeep(deep_subresources_mode int64) Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(deep_subresources_mode)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22622,7 +17471,6 @@ func (cx *Array) Slice(begin int64, end int64, step int64, deep bool) Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22630,17 +17478,12 @@ func (cx *Array) Slice(begin int64, end int64, step int64, deep bool) Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
 	args[2] = Int64Encoder.EncodeTypePtr(step)
 
-	pnr.Pin(args[2])
 	args[3] = BoolEncoder.EncodeTypePtr(deep)
 
-	pnr.Pin(args[3])
-
 	ret := CallBuiltinMethodPtrRet[Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22656,7 +17499,6 @@ func (cx *Array) Filter(method Callable) Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22664,8 +17506,6 @@ func (cx *Array) Filter(method Callable) Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&method)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22681,7 +17521,6 @@ func (cx *Array) Map(method Callable) Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22689,8 +17528,6 @@ func (cx *Array) Map(method Callable) Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&method)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22706,7 +17543,6 @@ func (cx *Array) Reduce(method Callable, accum Variant) Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22714,11 +17550,8 @@ func (cx *Array) Reduce(method Callable, accum Variant) Variant {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&method)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&accum)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22734,7 +17567,6 @@ func (cx *Array) Any(method Callable) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22742,8 +17574,6 @@ func (cx *Array) Any(method Callable) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&method)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22759,7 +17589,6 @@ func (cx *Array) All(method Callable) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22767,8 +17596,6 @@ func (cx *Array) All(method Callable) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&method)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22784,7 +17611,6 @@ func (cx *Array) Max() Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22803,7 +17629,6 @@ func (cx *Array) Min() Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22822,7 +17647,6 @@ func (cx *Array) IsTyped() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22841,7 +17665,6 @@ func (cx *Array) IsSameTyped(array Array) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22849,8 +17672,6 @@ func (cx *Array) IsSameTyped(array Array) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -22866,7 +17687,6 @@ func (cx *Array) GetTypedBuiltin() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22885,7 +17705,6 @@ func (cx *Array) GetTypedClassName() StringName {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22904,7 +17723,6 @@ func (cx *Array) GetTypedScript() Variant {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22923,7 +17741,6 @@ func (cx *Array) MakeReadOnly() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -22942,7 +17759,6 @@ func (cx *Array) IsReadOnly() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23079,7 +17895,6 @@ func (cx *PackedByteArray) NativePtr() GDExtensionTypePtr {
 func NewPackedByteArray() PackedByteArray {
 	cx := PackedByteArray{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPackedByteArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedByteArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -23089,13 +17904,11 @@ func NewPackedByteArray() PackedByteArray {
 func NewPackedByteArrayWithPackedByteArray(from PackedByteArray) PackedByteArray {
 	cx := PackedByteArray{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedByteArray
 	// PackedByteArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedByteArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedByteArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -23105,13 +17918,11 @@ func NewPackedByteArrayWithPackedByteArray(from PackedByteArray) PackedByteArray
 func NewPackedByteArrayWithArray(from Array) PackedByteArray {
 	cx := PackedByteArray{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedByteArrayMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedByteArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -23120,7 +17931,6 @@ func NewPackedByteArrayWithArray(from Array) PackedByteArray {
 func (cx *PackedByteArray) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalPackedByteArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -23136,7 +17946,6 @@ func (cx *PackedByteArray) Get(index int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23144,8 +17953,6 @@ func (cx *PackedByteArray) Get(index int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23161,7 +17968,6 @@ func (cx *PackedByteArray) Set(index int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23169,11 +17975,8 @@ func (cx *PackedByteArray) Set(index int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -23189,7 +17992,6 @@ func (cx *PackedByteArray) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23208,7 +18010,6 @@ func (cx *PackedByteArray) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23227,7 +18028,6 @@ func (cx *PackedByteArray) PushBack(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23235,8 +18035,6 @@ func (cx *PackedByteArray) PushBack(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23252,7 +18050,6 @@ func (cx *PackedByteArray) Append(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23260,8 +18057,6 @@ func (cx *PackedByteArray) Append(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23277,7 +18072,6 @@ func (cx *PackedByteArray) AppendArray(array PackedByteArray) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23285,8 +18079,6 @@ func (cx *PackedByteArray) AppendArray(array PackedByteArray) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -23302,7 +18094,6 @@ func (cx *PackedByteArray) RemoveAt(index int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23310,8 +18101,6 @@ func (cx *PackedByteArray) RemoveAt(index int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -23327,7 +18116,6 @@ func (cx *PackedByteArray) Insert(at_index int64, value int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23335,11 +18123,8 @@ func (cx *PackedByteArray) Insert(at_index int64, value int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at_index)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23355,7 +18140,6 @@ func (cx *PackedByteArray) Fill(value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23363,8 +18147,6 @@ func (cx *PackedByteArray) Fill(value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -23380,7 +18162,6 @@ func (cx *PackedByteArray) Resize(new_size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23388,8 +18169,6 @@ func (cx *PackedByteArray) Resize(new_size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(new_size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23405,7 +18184,6 @@ func (cx *PackedByteArray) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23424,7 +18202,6 @@ func (cx *PackedByteArray) Has(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23432,8 +18209,6 @@ func (cx *PackedByteArray) Has(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23449,7 +18224,6 @@ func (cx *PackedByteArray) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23468,7 +18242,6 @@ func (cx *PackedByteArray) Slice(begin int64, end int64) PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23476,11 +18249,8 @@ func (cx *PackedByteArray) Slice(begin int64, end int64) PackedByteArray {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedByteArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23496,7 +18266,6 @@ func (cx *PackedByteArray) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23515,7 +18284,6 @@ func (cx *PackedByteArray) Bsearch(value int64, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23523,11 +18291,8 @@ func (cx *PackedByteArray) Bsearch(value int64, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23543,7 +18308,6 @@ func (cx *PackedByteArray) Duplicate() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23562,7 +18326,6 @@ func (cx *PackedByteArray) Find(value int64, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23570,11 +18333,8 @@ func (cx *PackedByteArray) Find(value int64, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23590,7 +18350,6 @@ func (cx *PackedByteArray) Rfind(value int64, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23598,11 +18357,8 @@ func (cx *PackedByteArray) Rfind(value int64, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23618,7 +18374,6 @@ func (cx *PackedByteArray) Count(value int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23626,8 +18381,6 @@ func (cx *PackedByteArray) Count(value int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23643,7 +18396,6 @@ func (cx *PackedByteArray) Erase(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23651,8 +18403,6 @@ func (cx *PackedByteArray) Erase(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23668,7 +18418,6 @@ func (cx *PackedByteArray) GetStringFromAscii() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23687,7 +18436,6 @@ func (cx *PackedByteArray) GetStringFromUtf8() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23706,7 +18454,6 @@ func (cx *PackedByteArray) GetStringFromUtf16() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23725,7 +18472,6 @@ func (cx *PackedByteArray) GetStringFromUtf32() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23744,7 +18490,6 @@ func (cx *PackedByteArray) GetStringFromWchar() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23763,7 +18508,6 @@ func (cx *PackedByteArray) GetStringFromMultibyteChar(encoding String) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23771,8 +18515,6 @@ func (cx *PackedByteArray) GetStringFromMultibyteChar(encoding String) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&encoding)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23788,7 +18530,6 @@ func (cx *PackedByteArray) HexEncode() String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23807,7 +18548,6 @@ func (cx *PackedByteArray) Compress(compression_mode int64) PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23815,8 +18555,6 @@ func (cx *PackedByteArray) Compress(compression_mode int64) PackedByteArray {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(compression_mode)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[PackedByteArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23832,7 +18570,6 @@ func (cx *PackedByteArray) Decompress(buffer_size int64, compression_mode int64)
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23840,11 +18577,8 @@ func (cx *PackedByteArray) Decompress(buffer_size int64, compression_mode int64)
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(buffer_size)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(compression_mode)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedByteArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23860,7 +18594,6 @@ func (cx *PackedByteArray) DecompressDynamic(max_output_size int64, compression_
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23868,11 +18601,8 @@ func (cx *PackedByteArray) DecompressDynamic(max_output_size int64, compression_
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(max_output_size)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(compression_mode)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedByteArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23888,7 +18618,6 @@ func (cx *PackedByteArray) DecodeU8(byte_offset int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23896,8 +18625,6 @@ func (cx *PackedByteArray) DecodeU8(byte_offset int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23913,7 +18640,6 @@ func (cx *PackedByteArray) DecodeS8(byte_offset int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23921,8 +18647,6 @@ func (cx *PackedByteArray) DecodeS8(byte_offset int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23938,7 +18662,6 @@ func (cx *PackedByteArray) DecodeU16(byte_offset int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23946,8 +18669,6 @@ func (cx *PackedByteArray) DecodeU16(byte_offset int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23963,7 +18684,6 @@ func (cx *PackedByteArray) DecodeS16(byte_offset int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23971,8 +18691,6 @@ func (cx *PackedByteArray) DecodeS16(byte_offset int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -23988,7 +18706,6 @@ func (cx *PackedByteArray) DecodeU32(byte_offset int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -23996,8 +18713,6 @@ func (cx *PackedByteArray) DecodeU32(byte_offset int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24013,7 +18728,6 @@ func (cx *PackedByteArray) DecodeS32(byte_offset int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24021,8 +18735,6 @@ func (cx *PackedByteArray) DecodeS32(byte_offset int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24038,7 +18750,6 @@ func (cx *PackedByteArray) DecodeU64(byte_offset int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24046,8 +18757,6 @@ func (cx *PackedByteArray) DecodeU64(byte_offset int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24063,7 +18772,6 @@ func (cx *PackedByteArray) DecodeS64(byte_offset int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24071,8 +18779,6 @@ func (cx *PackedByteArray) DecodeS64(byte_offset int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24088,7 +18794,6 @@ func (cx *PackedByteArray) DecodeHalf(byte_offset int64) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24096,8 +18801,6 @@ func (cx *PackedByteArray) DecodeHalf(byte_offset int64) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24113,7 +18816,6 @@ func (cx *PackedByteArray) DecodeFloat(byte_offset int64) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24121,8 +18823,6 @@ func (cx *PackedByteArray) DecodeFloat(byte_offset int64) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24138,7 +18838,6 @@ func (cx *PackedByteArray) DecodeDouble(byte_offset int64) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24146,8 +18845,6 @@ func (cx *PackedByteArray) DecodeDouble(byte_offset int64) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24163,7 +18860,6 @@ func (cx *PackedByteArray) HasEncodedVar(byte_offset int64, allow_objects bool)
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24171,11 +18867,8 @@ func (cx *PackedByteArray) HasEncodedVar(byte_offset int64, allow_objects bool)
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(allow_objects)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24191,7 +18884,6 @@ func (cx *PackedByteArray) DecodeVar(byte_offset int64, allow_objects bool) Vari
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24199,11 +18891,8 @@ func (cx *PackedByteArray) DecodeVar(byte_offset int64, allow_objects bool) Vari
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(allow_objects)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[Variant](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24219,7 +18908,6 @@ func (cx *PackedByteArray) DecodeVarSize(byte_offset int64, allow_objects bool)
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24227,11 +18915,8 @@ func (cx *PackedByteArray) DecodeVarSize(byte_offset int64, allow_objects bool)
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(allow_objects)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24247,7 +18932,6 @@ func (cx *PackedByteArray) ToInt32Array() PackedInt32Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24266,7 +18950,6 @@ func (cx *PackedByteArray) ToInt64Array() PackedInt64Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24285,7 +18968,6 @@ func (cx *PackedByteArray) ToFloat32Array() PackedFloat32Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24304,7 +18986,6 @@ func (cx *PackedByteArray) ToFloat64Array() PackedFloat64Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24323,7 +19004,6 @@ func (cx *PackedByteArray) ToVector2Array() PackedVector2Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24342,7 +19022,6 @@ func (cx *PackedByteArray) ToVector3Array() PackedVector3Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24361,7 +19040,6 @@ func (cx *PackedByteArray) ToVector4Array() PackedVector4Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24380,7 +19058,6 @@ func (cx *PackedByteArray) ToColorArray() PackedColorArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24399,7 +19076,6 @@ func (cx *PackedByteArray) Bswap16(offset int64, count int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24407,11 +19083,8 @@ func (cx *PackedByteArray) Bswap16(offset int64, count int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(count)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24427,7 +19100,6 @@ func (cx *PackedByteArray) Bswap32(offset int64, count int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24435,11 +19107,8 @@ func (cx *PackedByteArray) Bswap32(offset int64, count int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(count)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24455,7 +19124,6 @@ func (cx *PackedByteArray) Bswap64(offset int64, count int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24463,11 +19131,8 @@ func (cx *PackedByteArray) Bswap64(offset int64, count int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(count)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24483,7 +19148,6 @@ func (cx *PackedByteArray) EncodeU8(byte_offset int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24491,11 +19155,8 @@ func (cx *PackedByteArray) EncodeU8(byte_offset int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24511,7 +19172,6 @@ func (cx *PackedByteArray) EncodeS8(byte_offset int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24519,11 +19179,8 @@ func (cx *PackedByteArray) EncodeS8(byte_offset int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24539,7 +19196,6 @@ func (cx *PackedByteArray) EncodeU16(byte_offset int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24547,11 +19203,8 @@ func (cx *PackedByteArray) EncodeU16(byte_offset int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24567,7 +19220,6 @@ func (cx *PackedByteArray) EncodeS16(byte_offset int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24575,11 +19227,8 @@ func (cx *PackedByteArray) EncodeS16(byte_offset int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24595,7 +19244,6 @@ func (cx *PackedByteArray) EncodeU32(byte_offset int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24603,11 +19251,8 @@ func (cx *PackedByteArray) EncodeU32(byte_offset int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24623,7 +19268,6 @@ func (cx *PackedByteArray) EncodeS32(byte_offset int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24631,11 +19275,8 @@ func (cx *PackedByteArray) EncodeS32(byte_offset int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24651,7 +19292,6 @@ func (cx *PackedByteArray) EncodeU64(byte_offset int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24659,11 +19299,8 @@ func (cx *PackedByteArray) EncodeU64(byte_offset int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24679,7 +19316,6 @@ func (cx *PackedByteArray) EncodeS64(byte_offset int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24687,11 +19323,8 @@ func (cx *PackedByteArray) EncodeS64(byte_offset int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24707,7 +19340,6 @@ func (cx *PackedByteArray) EncodeHalf(byte_offset int64, value float32) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24715,11 +19347,8 @@ func (cx *PackedByteArray) EncodeHalf(byte_offset int64, value float32) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24735,7 +19364,6 @@ func (cx *PackedByteArray) EncodeFloat(byte_offset int64, value float32) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24743,11 +19371,8 @@ func (cx *PackedByteArray) EncodeFloat(byte_offset int64, value float32) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24763,7 +19388,6 @@ func (cx *PackedByteArray) EncodeDouble(byte_offset int64, value float32) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24771,11 +19395,8 @@ func (cx *PackedByteArray) EncodeDouble(byte_offset int64, value float32) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -24791,7 +19412,6 @@ func (cx *PackedByteArray) EncodeVar(byte_offset int64, value Variant, allow_obj
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24799,14 +19419,10 @@ func (cx *PackedByteArray) EncodeVar(byte_offset int64, value Variant, allow_obj
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(byte_offset)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
 	args[2] = BoolEncoder.EncodeTypePtr(allow_objects)
 
-	pnr.Pin(args[2])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24912,7 +19528,6 @@ func (cx *PackedInt32Array) NativePtr() GDExtensionTypePtr {
 func NewPackedInt32Array() PackedInt32Array {
 	cx := PackedInt32Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPackedInt32ArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedInt32ArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -24922,13 +19537,11 @@ func NewPackedInt32Array() PackedInt32Array {
 func NewPackedInt32ArrayWithPackedInt32Array(from PackedInt32Array) PackedInt32Array {
 	cx := PackedInt32Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedInt32Array
 	// PackedInt32ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedInt32ArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedInt32ArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -24938,13 +19551,11 @@ func NewPackedInt32ArrayWithPackedInt32Array(from PackedInt32Array) PackedInt32A
 func NewPackedInt32ArrayWithArray(from Array) PackedInt32Array {
 	cx := PackedInt32Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedInt32ArrayMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedInt32ArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -24953,7 +19564,6 @@ func NewPackedInt32ArrayWithArray(from Array) PackedInt32Array {
 func (cx *PackedInt32Array) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalPackedInt32ArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -24969,7 +19579,6 @@ func (cx *PackedInt32Array) Get(index int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -24977,8 +19586,6 @@ func (cx *PackedInt32Array) Get(index int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -24994,7 +19601,6 @@ func (cx *PackedInt32Array) Set(index int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25002,11 +19608,8 @@ func (cx *PackedInt32Array) Set(index int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -25022,7 +19625,6 @@ func (cx *PackedInt32Array) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25041,7 +19643,6 @@ func (cx *PackedInt32Array) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25060,7 +19661,6 @@ func (cx *PackedInt32Array) PushBack(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25068,8 +19668,6 @@ func (cx *PackedInt32Array) PushBack(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25085,7 +19683,6 @@ func (cx *PackedInt32Array) Append(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25093,8 +19690,6 @@ func (cx *PackedInt32Array) Append(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25110,7 +19705,6 @@ func (cx *PackedInt32Array) AppendArray(array PackedInt32Array) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25118,8 +19712,6 @@ func (cx *PackedInt32Array) AppendArray(array PackedInt32Array) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -25135,7 +19727,6 @@ func (cx *PackedInt32Array) RemoveAt(index int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25143,8 +19734,6 @@ func (cx *PackedInt32Array) RemoveAt(index int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -25160,7 +19749,6 @@ func (cx *PackedInt32Array) Insert(at_index int64, value int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25168,11 +19756,8 @@ func (cx *PackedInt32Array) Insert(at_index int64, value int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at_index)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25188,7 +19773,6 @@ func (cx *PackedInt32Array) Fill(value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25196,8 +19780,6 @@ func (cx *PackedInt32Array) Fill(value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -25213,7 +19795,6 @@ func (cx *PackedInt32Array) Resize(new_size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25221,8 +19802,6 @@ func (cx *PackedInt32Array) Resize(new_size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(new_size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25238,7 +19817,6 @@ func (cx *PackedInt32Array) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25257,7 +19835,6 @@ func (cx *PackedInt32Array) Has(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25265,8 +19842,6 @@ func (cx *PackedInt32Array) Has(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25282,7 +19857,6 @@ func (cx *PackedInt32Array) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25301,7 +19875,6 @@ func (cx *PackedInt32Array) Slice(begin int64, end int64) PackedInt32Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25309,11 +19882,8 @@ func (cx *PackedInt32Array) Slice(begin int64, end int64) PackedInt32Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedInt32Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25329,7 +19899,6 @@ func (cx *PackedInt32Array) ToByteArray() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25348,7 +19917,6 @@ func (cx *PackedInt32Array) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25367,7 +19935,6 @@ func (cx *PackedInt32Array) Bsearch(value int64, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25375,11 +19942,8 @@ func (cx *PackedInt32Array) Bsearch(value int64, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25395,7 +19959,6 @@ func (cx *PackedInt32Array) Duplicate() PackedInt32Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25414,7 +19977,6 @@ func (cx *PackedInt32Array) Find(value int64, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25422,11 +19984,8 @@ func (cx *PackedInt32Array) Find(value int64, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25442,7 +20001,6 @@ func (cx *PackedInt32Array) Rfind(value int64, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25450,11 +20008,8 @@ func (cx *PackedInt32Array) Rfind(value int64, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25470,7 +20025,6 @@ func (cx *PackedInt32Array) Count(value int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25478,8 +20032,6 @@ func (cx *PackedInt32Array) Count(value int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25495,7 +20047,6 @@ func (cx *PackedInt32Array) Erase(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25503,8 +20054,6 @@ func (cx *PackedInt32Array) Erase(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25610,7 +20159,6 @@ func (cx *PackedInt64Array) NativePtr() GDExtensionTypePtr {
 func NewPackedInt64Array() PackedInt64Array {
 	cx := PackedInt64Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPackedInt64ArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedInt64ArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -25620,13 +20168,11 @@ func NewPackedInt64Array() PackedInt64Array {
 func NewPackedInt64ArrayWithPackedInt64Array(from PackedInt64Array) PackedInt64Array {
 	cx := PackedInt64Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedInt64Array
 	// PackedInt64ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedInt64ArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedInt64ArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -25636,13 +20182,11 @@ func NewPackedInt64ArrayWithPackedInt64Array(from PackedInt64Array) PackedInt64A
 func NewPackedInt64ArrayWithArray(from Array) PackedInt64Array {
 	cx := PackedInt64Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedInt64ArrayMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedInt64ArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -25651,7 +20195,6 @@ func NewPackedInt64ArrayWithArray(from Array) PackedInt64Array {
 func (cx *PackedInt64Array) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalPackedInt64ArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -25667,7 +20210,6 @@ func (cx *PackedInt64Array) Get(index int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25675,8 +20217,6 @@ func (cx *PackedInt64Array) Get(index int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25692,7 +20232,6 @@ func (cx *PackedInt64Array) Set(index int64, value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25700,11 +20239,8 @@ func (cx *PackedInt64Array) Set(index int64, value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -25720,7 +20256,6 @@ func (cx *PackedInt64Array) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25739,7 +20274,6 @@ func (cx *PackedInt64Array) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25758,7 +20292,6 @@ func (cx *PackedInt64Array) PushBack(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25766,8 +20299,6 @@ func (cx *PackedInt64Array) PushBack(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25783,7 +20314,6 @@ func (cx *PackedInt64Array) Append(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25791,8 +20321,6 @@ func (cx *PackedInt64Array) Append(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25808,7 +20336,6 @@ func (cx *PackedInt64Array) AppendArray(array PackedInt64Array) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25816,8 +20343,6 @@ func (cx *PackedInt64Array) AppendArray(array PackedInt64Array) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -25833,7 +20358,6 @@ func (cx *PackedInt64Array) RemoveAt(index int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25841,8 +20365,6 @@ func (cx *PackedInt64Array) RemoveAt(index int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -25858,7 +20380,6 @@ func (cx *PackedInt64Array) Insert(at_index int64, value int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25866,11 +20387,8 @@ func (cx *PackedInt64Array) Insert(at_index int64, value int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at_index)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25886,7 +20404,6 @@ func (cx *PackedInt64Array) Fill(value int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25894,8 +20411,6 @@ func (cx *PackedInt64Array) Fill(value int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -25911,7 +20426,6 @@ func (cx *PackedInt64Array) Resize(new_size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25919,8 +20433,6 @@ func (cx *PackedInt64Array) Resize(new_size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(new_size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25936,7 +20448,6 @@ func (cx *PackedInt64Array) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25955,7 +20466,6 @@ func (cx *PackedInt64Array) Has(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25963,8 +20473,6 @@ func (cx *PackedInt64Array) Has(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -25980,7 +20488,6 @@ func (cx *PackedInt64Array) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -25999,7 +20506,6 @@ func (cx *PackedInt64Array) Slice(begin int64, end int64) PackedInt64Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26007,11 +20513,8 @@ func (cx *PackedInt64Array) Slice(begin int64, end int64) PackedInt64Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedInt64Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26027,7 +20530,6 @@ func (cx *PackedInt64Array) ToByteArray() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26046,7 +20548,6 @@ func (cx *PackedInt64Array) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26065,7 +20566,6 @@ func (cx *PackedInt64Array) Bsearch(value int64, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26073,11 +20573,8 @@ func (cx *PackedInt64Array) Bsearch(value int64, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26093,7 +20590,6 @@ func (cx *PackedInt64Array) Duplicate() PackedInt64Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26112,7 +20608,6 @@ func (cx *PackedInt64Array) Find(value int64, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26120,11 +20615,8 @@ func (cx *PackedInt64Array) Find(value int64, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26140,7 +20632,6 @@ func (cx *PackedInt64Array) Rfind(value int64, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26148,11 +20639,8 @@ func (cx *PackedInt64Array) Rfind(value int64, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26168,7 +20656,6 @@ func (cx *PackedInt64Array) Count(value int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26176,8 +20663,6 @@ func (cx *PackedInt64Array) Count(value int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26193,7 +20678,6 @@ func (cx *PackedInt64Array) Erase(value int64) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26201,8 +20685,6 @@ func (cx *PackedInt64Array) Erase(value int64) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26308,7 +20790,6 @@ func (cx *PackedFloat32Array) NativePtr() GDExtensionTypePtr {
 func NewPackedFloat32Array() PackedFloat32Array {
 	cx := PackedFloat32Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPackedFloat32ArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedFloat32ArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -26318,13 +20799,11 @@ func NewPackedFloat32Array() PackedFloat32Array {
 func NewPackedFloat32ArrayWithPackedFloat32Array(from PackedFloat32Array) PackedFloat32Array {
 	cx := PackedFloat32Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedFloat32Array
 	// PackedFloat32ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedFloat32ArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedFloat32ArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -26334,13 +20813,11 @@ func NewPackedFloat32ArrayWithPackedFloat32Array(from PackedFloat32Array) Packed
 func NewPackedFloat32ArrayWithArray(from Array) PackedFloat32Array {
 	cx := PackedFloat32Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedFloat32ArrayMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedFloat32ArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -26349,7 +20826,6 @@ func NewPackedFloat32ArrayWithArray(from Array) PackedFloat32Array {
 func (cx *PackedFloat32Array) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalPackedFloat32ArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -26365,7 +20841,6 @@ func (cx *PackedFloat32Array) Get(index int64) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26373,8 +20848,6 @@ func (cx *PackedFloat32Array) Get(index int64) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26390,7 +20863,6 @@ func (cx *PackedFloat32Array) Set(index int64, value float32) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26398,11 +20870,8 @@ func (cx *PackedFloat32Array) Set(index int64, value float32) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -26418,7 +20887,6 @@ func (cx *PackedFloat32Array) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26437,7 +20905,6 @@ func (cx *PackedFloat32Array) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26456,7 +20923,6 @@ func (cx *PackedFloat32Array) PushBack(value float32) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26464,8 +20930,6 @@ func (cx *PackedFloat32Array) PushBack(value float32) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26481,7 +20945,6 @@ func (cx *PackedFloat32Array) Append(value float32) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26489,8 +20952,6 @@ func (cx *PackedFloat32Array) Append(value float32) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26506,7 +20967,6 @@ func (cx *PackedFloat32Array) AppendArray(array PackedFloat32Array) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26514,8 +20974,6 @@ func (cx *PackedFloat32Array) AppendArray(array PackedFloat32Array) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -26531,7 +20989,6 @@ func (cx *PackedFloat32Array) RemoveAt(index int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26539,8 +20996,6 @@ func (cx *PackedFloat32Array) RemoveAt(index int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -26556,7 +21011,6 @@ func (cx *PackedFloat32Array) Insert(at_index int64, value float32) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26564,11 +21018,8 @@ func (cx *PackedFloat32Array) Insert(at_index int64, value float32) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at_index)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26584,7 +21035,6 @@ func (cx *PackedFloat32Array) Fill(value float32) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26592,8 +21042,6 @@ func (cx *PackedFloat32Array) Fill(value float32) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -26609,7 +21057,6 @@ func (cx *PackedFloat32Array) Resize(new_size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26617,8 +21064,6 @@ func (cx *PackedFloat32Array) Resize(new_size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(new_size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26634,7 +21079,6 @@ func (cx *PackedFloat32Array) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26653,7 +21097,6 @@ func (cx *PackedFloat32Array) Has(value float32) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26661,8 +21104,6 @@ func (cx *PackedFloat32Array) Has(value float32) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26678,7 +21119,6 @@ func (cx *PackedFloat32Array) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26697,7 +21137,6 @@ func (cx *PackedFloat32Array) Slice(begin int64, end int64) PackedFloat32Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26705,11 +21144,8 @@ func (cx *PackedFloat32Array) Slice(begin int64, end int64) PackedFloat32Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedFloat32Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26725,7 +21161,6 @@ func (cx *PackedFloat32Array) ToByteArray() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26744,7 +21179,6 @@ func (cx *PackedFloat32Array) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26763,7 +21197,6 @@ func (cx *PackedFloat32Array) Bsearch(value float32, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26771,11 +21204,8 @@ func (cx *PackedFloat32Array) Bsearch(value float32, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26791,7 +21221,6 @@ func (cx *PackedFloat32Array) Duplicate() PackedFloat32Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26810,7 +21239,6 @@ func (cx *PackedFloat32Array) Find(value float32, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26818,11 +21246,8 @@ func (cx *PackedFloat32Array) Find(value float32, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26838,7 +21263,6 @@ func (cx *PackedFloat32Array) Rfind(value float32, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26846,11 +21270,8 @@ func (cx *PackedFloat32Array) Rfind(value float32, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26866,7 +21287,6 @@ func (cx *PackedFloat32Array) Count(value float32) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26874,8 +21294,6 @@ func (cx *PackedFloat32Array) Count(value float32) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -26891,7 +21309,6 @@ func (cx *PackedFloat32Array) Erase(value float32) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -26899,8 +21316,6 @@ func (cx *PackedFloat32Array) Erase(value float32) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27006,7 +21421,6 @@ func (cx *PackedFloat64Array) NativePtr() GDExtensionTypePtr {
 func NewPackedFloat64Array() PackedFloat64Array {
 	cx := PackedFloat64Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPackedFloat64ArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedFloat64ArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -27016,13 +21430,11 @@ func NewPackedFloat64Array() PackedFloat64Array {
 func NewPackedFloat64ArrayWithPackedFloat64Array(from PackedFloat64Array) PackedFloat64Array {
 	cx := PackedFloat64Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedFloat64Array
 	// PackedFloat64ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedFloat64ArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedFloat64ArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -27032,13 +21444,11 @@ func NewPackedFloat64ArrayWithPackedFloat64Array(from PackedFloat64Array) Packed
 func NewPackedFloat64ArrayWithArray(from Array) PackedFloat64Array {
 	cx := PackedFloat64Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedFloat64ArrayMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedFloat64ArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -27047,7 +21457,6 @@ func NewPackedFloat64ArrayWithArray(from Array) PackedFloat64Array {
 func (cx *PackedFloat64Array) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalPackedFloat64ArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -27063,7 +21472,6 @@ func (cx *PackedFloat64Array) Get(index int64) float32 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27071,8 +21479,6 @@ func (cx *PackedFloat64Array) Get(index int64) float32 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[float32](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27088,7 +21494,6 @@ func (cx *PackedFloat64Array) Set(index int64, value float32) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27096,11 +21501,8 @@ func (cx *PackedFloat64Array) Set(index int64, value float32) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -27116,7 +21518,6 @@ func (cx *PackedFloat64Array) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27135,7 +21536,6 @@ func (cx *PackedFloat64Array) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27154,7 +21554,6 @@ func (cx *PackedFloat64Array) PushBack(value float32) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27162,8 +21561,6 @@ func (cx *PackedFloat64Array) PushBack(value float32) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27179,7 +21576,6 @@ func (cx *PackedFloat64Array) Append(value float32) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27187,8 +21583,6 @@ func (cx *PackedFloat64Array) Append(value float32) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27204,7 +21598,6 @@ func (cx *PackedFloat64Array) AppendArray(array PackedFloat64Array) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27212,8 +21605,6 @@ func (cx *PackedFloat64Array) AppendArray(array PackedFloat64Array) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -27229,7 +21620,6 @@ func (cx *PackedFloat64Array) RemoveAt(index int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27237,8 +21627,6 @@ func (cx *PackedFloat64Array) RemoveAt(index int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -27254,7 +21642,6 @@ func (cx *PackedFloat64Array) Insert(at_index int64, value float32) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27262,11 +21649,8 @@ func (cx *PackedFloat64Array) Insert(at_index int64, value float32) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at_index)
 
-	pnr.Pin(args[0])
 	args[1] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27282,7 +21666,6 @@ func (cx *PackedFloat64Array) Fill(value float32) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27290,8 +21673,6 @@ func (cx *PackedFloat64Array) Fill(value float32) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -27307,7 +21688,6 @@ func (cx *PackedFloat64Array) Resize(new_size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27315,8 +21695,6 @@ func (cx *PackedFloat64Array) Resize(new_size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(new_size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27332,7 +21710,6 @@ func (cx *PackedFloat64Array) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27351,7 +21728,6 @@ func (cx *PackedFloat64Array) Has(value float32) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27359,8 +21735,6 @@ func (cx *PackedFloat64Array) Has(value float32) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27376,7 +21750,6 @@ func (cx *PackedFloat64Array) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27395,7 +21768,6 @@ func (cx *PackedFloat64Array) Slice(begin int64, end int64) PackedFloat64Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27403,11 +21775,8 @@ func (cx *PackedFloat64Array) Slice(begin int64, end int64) PackedFloat64Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedFloat64Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27423,7 +21792,6 @@ func (cx *PackedFloat64Array) ToByteArray() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27442,7 +21810,6 @@ func (cx *PackedFloat64Array) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27461,7 +21828,6 @@ func (cx *PackedFloat64Array) Bsearch(value float32, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27469,11 +21835,8 @@ func (cx *PackedFloat64Array) Bsearch(value float32, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27489,7 +21852,6 @@ func (cx *PackedFloat64Array) Duplicate() PackedFloat64Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27508,7 +21870,6 @@ func (cx *PackedFloat64Array) Find(value float32, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27516,11 +21877,8 @@ func (cx *PackedFloat64Array) Find(value float32, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27536,7 +21894,6 @@ func (cx *PackedFloat64Array) Rfind(value float32, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27544,11 +21901,8 @@ func (cx *PackedFloat64Array) Rfind(value float32, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27564,7 +21918,6 @@ func (cx *PackedFloat64Array) Count(value float32) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27572,8 +21925,6 @@ func (cx *PackedFloat64Array) Count(value float32) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27589,7 +21940,6 @@ func (cx *PackedFloat64Array) Erase(value float32) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27597,8 +21947,6 @@ func (cx *PackedFloat64Array) Erase(value float32) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Float32Encoder.EncodeTypePtr(value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27704,7 +22052,6 @@ func (cx *PackedStringArray) NativePtr() GDExtensionTypePtr {
 func NewPackedStringArray() PackedStringArray {
 	cx := PackedStringArray{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPackedStringArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedStringArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -27714,13 +22061,11 @@ func NewPackedStringArray() PackedStringArray {
 func NewPackedStringArrayWithPackedStringArray(from PackedStringArray) PackedStringArray {
 	cx := PackedStringArray{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedStringArray
 	// PackedStringArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedStringArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedStringArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -27730,13 +22075,11 @@ func NewPackedStringArrayWithPackedStringArray(from PackedStringArray) PackedStr
 func NewPackedStringArrayWithArray(from Array) PackedStringArray {
 	cx := PackedStringArray{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedStringArrayMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedStringArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -27745,7 +22088,6 @@ func NewPackedStringArrayWithArray(from Array) PackedStringArray {
 func (cx *PackedStringArray) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalPackedStringArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -27761,7 +22103,6 @@ func (cx *PackedStringArray) Get(index int64) String {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27769,8 +22110,6 @@ func (cx *PackedStringArray) Get(index int64) String {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[String](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27786,7 +22125,6 @@ func (cx *PackedStringArray) Set(index int64, value String) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27794,11 +22132,8 @@ func (cx *PackedStringArray) Set(index int64, value String) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -27814,7 +22149,6 @@ func (cx *PackedStringArray) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27833,7 +22167,6 @@ func (cx *PackedStringArray) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27852,7 +22185,6 @@ func (cx *PackedStringArray) PushBack(value String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27860,8 +22192,6 @@ func (cx *PackedStringArray) PushBack(value String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27877,7 +22207,6 @@ func (cx *PackedStringArray) Append(value String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27885,8 +22214,6 @@ func (cx *PackedStringArray) Append(value String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27902,7 +22229,6 @@ func (cx *PackedStringArray) AppendArray(array PackedStringArray) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27910,8 +22236,6 @@ func (cx *PackedStringArray) AppendArray(array PackedStringArray) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -27927,7 +22251,6 @@ func (cx *PackedStringArray) RemoveAt(index int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27935,8 +22258,6 @@ func (cx *PackedStringArray) RemoveAt(index int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -27952,7 +22273,6 @@ func (cx *PackedStringArray) Insert(at_index int64, value String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27960,11 +22280,8 @@ func (cx *PackedStringArray) Insert(at_index int64, value String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at_index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -27980,7 +22297,6 @@ func (cx *PackedStringArray) Fill(value String) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -27988,8 +22304,6 @@ func (cx *PackedStringArray) Fill(value String) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -28005,7 +22319,6 @@ func (cx *PackedStringArray) Resize(new_size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28013,8 +22326,6 @@ func (cx *PackedStringArray) Resize(new_size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(new_size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28030,7 +22341,6 @@ func (cx *PackedStringArray) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28049,7 +22359,6 @@ func (cx *PackedStringArray) Has(value String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28057,8 +22366,6 @@ func (cx *PackedStringArray) Has(value String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28074,7 +22381,6 @@ func (cx *PackedStringArray) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28093,7 +22399,6 @@ func (cx *PackedStringArray) Slice(begin int64, end int64) PackedStringArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28101,11 +22406,8 @@ func (cx *PackedStringArray) Slice(begin int64, end int64) PackedStringArray {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedStringArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28121,7 +22423,6 @@ func (cx *PackedStringArray) ToByteArray() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28140,7 +22441,6 @@ func (cx *PackedStringArray) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28159,7 +22459,6 @@ func (cx *PackedStringArray) Bsearch(value String, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28167,11 +22466,8 @@ func (cx *PackedStringArray) Bsearch(value String, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28187,7 +22483,6 @@ func (cx *PackedStringArray) Duplicate() PackedStringArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28206,7 +22501,6 @@ func (cx *PackedStringArray) Find(value String, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28214,11 +22508,8 @@ func (cx *PackedStringArray) Find(value String, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28234,7 +22525,6 @@ func (cx *PackedStringArray) Rfind(value String, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28242,11 +22532,8 @@ func (cx *PackedStringArray) Rfind(value String, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28262,7 +22549,6 @@ func (cx *PackedStringArray) Count(value String) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28270,8 +22556,6 @@ func (cx *PackedStringArray) Count(value String) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28287,7 +22571,6 @@ func (cx *PackedStringArray) Erase(value String) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28295,8 +22578,6 @@ func (cx *PackedStringArray) Erase(value String) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28402,7 +22683,6 @@ func (cx *PackedVector2Array) NativePtr() GDExtensionTypePtr {
 func NewPackedVector2Array() PackedVector2Array {
 	cx := PackedVector2Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPackedVector2ArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedVector2ArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -28412,13 +22692,11 @@ func NewPackedVector2Array() PackedVector2Array {
 func NewPackedVector2ArrayWithPackedVector2Array(from PackedVector2Array) PackedVector2Array {
 	cx := PackedVector2Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedVector2Array
 	// PackedVector2ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedVector2ArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedVector2ArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -28428,13 +22706,11 @@ func NewPackedVector2ArrayWithPackedVector2Array(from PackedVector2Array) Packed
 func NewPackedVector2ArrayWithArray(from Array) PackedVector2Array {
 	cx := PackedVector2Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedVector2ArrayMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedVector2ArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -28443,7 +22719,6 @@ func NewPackedVector2ArrayWithArray(from Array) PackedVector2Array {
 func (cx *PackedVector2Array) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalPackedVector2ArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -28459,7 +22734,6 @@ func (cx *PackedVector2Array) Get(index int64) Vector2 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28467,8 +22741,6 @@ func (cx *PackedVector2Array) Get(index int64) Vector2 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector2](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28484,7 +22756,6 @@ func (cx *PackedVector2Array) Set(index int64, value Vector2) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28492,11 +22763,8 @@ func (cx *PackedVector2Array) Set(index int64, value Vector2) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -28512,7 +22780,6 @@ func (cx *PackedVector2Array) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28531,7 +22798,6 @@ func (cx *PackedVector2Array) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28550,7 +22816,6 @@ func (cx *PackedVector2Array) PushBack(value Vector2) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28558,8 +22823,6 @@ func (cx *PackedVector2Array) PushBack(value Vector2) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28575,7 +22838,6 @@ func (cx *PackedVector2Array) Append(value Vector2) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28583,8 +22845,6 @@ func (cx *PackedVector2Array) Append(value Vector2) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28600,7 +22860,6 @@ func (cx *PackedVector2Array) AppendArray(array PackedVector2Array) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28608,8 +22867,6 @@ func (cx *PackedVector2Array) AppendArray(array PackedVector2Array) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -28625,7 +22882,6 @@ func (cx *PackedVector2Array) RemoveAt(index int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28633,8 +22889,6 @@ func (cx *PackedVector2Array) RemoveAt(index int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -28650,7 +22904,6 @@ func (cx *PackedVector2Array) Insert(at_index int64, value Vector2) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28658,11 +22911,8 @@ func (cx *PackedVector2Array) Insert(at_index int64, value Vector2) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at_index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28678,7 +22928,6 @@ func (cx *PackedVector2Array) Fill(value Vector2) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28686,8 +22935,6 @@ func (cx *PackedVector2Array) Fill(value Vector2) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -28703,7 +22950,6 @@ func (cx *PackedVector2Array) Resize(new_size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28711,8 +22957,6 @@ func (cx *PackedVector2Array) Resize(new_size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(new_size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28728,7 +22972,6 @@ func (cx *PackedVector2Array) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28747,7 +22990,6 @@ func (cx *PackedVector2Array) Has(value Vector2) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28755,8 +22997,6 @@ func (cx *PackedVector2Array) Has(value Vector2) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28772,7 +23012,6 @@ func (cx *PackedVector2Array) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28791,7 +23030,6 @@ func (cx *PackedVector2Array) Slice(begin int64, end int64) PackedVector2Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28799,11 +23037,8 @@ func (cx *PackedVector2Array) Slice(begin int64, end int64) PackedVector2Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedVector2Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28819,7 +23054,6 @@ func (cx *PackedVector2Array) ToByteArray() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28838,7 +23072,6 @@ func (cx *PackedVector2Array) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28857,7 +23090,6 @@ func (cx *PackedVector2Array) Bsearch(value Vector2, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28865,11 +23097,8 @@ func (cx *PackedVector2Array) Bsearch(value Vector2, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28885,7 +23114,6 @@ func (cx *PackedVector2Array) Duplicate() PackedVector2Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28904,7 +23132,6 @@ func (cx *PackedVector2Array) Find(value Vector2, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28912,11 +23139,8 @@ func (cx *PackedVector2Array) Find(value Vector2, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28932,7 +23156,6 @@ func (cx *PackedVector2Array) Rfind(value Vector2, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28940,11 +23163,8 @@ func (cx *PackedVector2Array) Rfind(value Vector2, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28960,7 +23180,6 @@ func (cx *PackedVector2Array) Count(value Vector2) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28968,8 +23187,6 @@ func (cx *PackedVector2Array) Count(value Vector2) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -28985,7 +23202,6 @@ func (cx *PackedVector2Array) Erase(value Vector2) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -28993,8 +23209,6 @@ func (cx *PackedVector2Array) Erase(value Vector2) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29107,7 +23321,6 @@ func (cx *PackedVector3Array) NativePtr() GDExtensionTypePtr {
 func NewPackedVector3Array() PackedVector3Array {
 	cx := PackedVector3Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPackedVector3ArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedVector3ArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -29117,13 +23330,11 @@ func NewPackedVector3Array() PackedVector3Array {
 func NewPackedVector3ArrayWithPackedVector3Array(from PackedVector3Array) PackedVector3Array {
 	cx := PackedVector3Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedVector3Array
 	// PackedVector3ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedVector3ArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedVector3ArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -29133,13 +23344,11 @@ func NewPackedVector3ArrayWithPackedVector3Array(from PackedVector3Array) Packed
 func NewPackedVector3ArrayWithArray(from Array) PackedVector3Array {
 	cx := PackedVector3Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedVector3ArrayMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedVector3ArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -29148,7 +23357,6 @@ func NewPackedVector3ArrayWithArray(from Array) PackedVector3Array {
 func (cx *PackedVector3Array) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalPackedVector3ArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -29164,7 +23372,6 @@ func (cx *PackedVector3Array) Get(index int64) Vector3 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29172,8 +23379,6 @@ func (cx *PackedVector3Array) Get(index int64) Vector3 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector3](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29189,7 +23394,6 @@ func (cx *PackedVector3Array) Set(index int64, value Vector3) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29197,11 +23401,8 @@ func (cx *PackedVector3Array) Set(index int64, value Vector3) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -29217,7 +23418,6 @@ func (cx *PackedVector3Array) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29236,7 +23436,6 @@ func (cx *PackedVector3Array) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29255,7 +23454,6 @@ func (cx *PackedVector3Array) PushBack(value Vector3) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29263,8 +23461,6 @@ func (cx *PackedVector3Array) PushBack(value Vector3) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29280,7 +23476,6 @@ func (cx *PackedVector3Array) Append(value Vector3) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29288,8 +23483,6 @@ func (cx *PackedVector3Array) Append(value Vector3) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29305,7 +23498,6 @@ func (cx *PackedVector3Array) AppendArray(array PackedVector3Array) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29313,8 +23505,6 @@ func (cx *PackedVector3Array) AppendArray(array PackedVector3Array) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -29330,7 +23520,6 @@ func (cx *PackedVector3Array) RemoveAt(index int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29338,8 +23527,6 @@ func (cx *PackedVector3Array) RemoveAt(index int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -29355,7 +23542,6 @@ func (cx *PackedVector3Array) Insert(at_index int64, value Vector3) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29363,11 +23549,8 @@ func (cx *PackedVector3Array) Insert(at_index int64, value Vector3) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at_index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29383,7 +23566,6 @@ func (cx *PackedVector3Array) Fill(value Vector3) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29391,8 +23573,6 @@ func (cx *PackedVector3Array) Fill(value Vector3) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -29408,7 +23588,6 @@ func (cx *PackedVector3Array) Resize(new_size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29416,8 +23595,6 @@ func (cx *PackedVector3Array) Resize(new_size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(new_size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29433,7 +23610,6 @@ func (cx *PackedVector3Array) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29452,7 +23628,6 @@ func (cx *PackedVector3Array) Has(value Vector3) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29460,8 +23635,6 @@ func (cx *PackedVector3Array) Has(value Vector3) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29477,7 +23650,6 @@ func (cx *PackedVector3Array) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29496,7 +23668,6 @@ func (cx *PackedVector3Array) Slice(begin int64, end int64) PackedVector3Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29504,11 +23675,8 @@ func (cx *PackedVector3Array) Slice(begin int64, end int64) PackedVector3Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedVector3Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29524,7 +23692,6 @@ func (cx *PackedVector3Array) ToByteArray() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29543,7 +23710,6 @@ func (cx *PackedVector3Array) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29562,7 +23728,6 @@ func (cx *PackedVector3Array) Bsearch(value Vector3, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29570,11 +23735,8 @@ func (cx *PackedVector3Array) Bsearch(value Vector3, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29590,7 +23752,6 @@ func (cx *PackedVector3Array) Duplicate() PackedVector3Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29609,7 +23770,6 @@ func (cx *PackedVector3Array) Find(value Vector3, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29617,11 +23777,8 @@ func (cx *PackedVector3Array) Find(value Vector3, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29637,7 +23794,6 @@ func (cx *PackedVector3Array) Rfind(value Vector3, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29645,11 +23801,8 @@ func (cx *PackedVector3Array) Rfind(value Vector3, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29665,7 +23818,6 @@ func (cx *PackedVector3Array) Count(value Vector3) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29673,8 +23825,6 @@ func (cx *PackedVector3Array) Count(value Vector3) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29690,7 +23840,6 @@ func (cx *PackedVector3Array) Erase(value Vector3) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29698,8 +23847,6 @@ func (cx *PackedVector3Array) Erase(value Vector3) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29812,7 +23959,6 @@ func (cx *PackedColorArray) NativePtr() GDExtensionTypePtr {
 func NewPackedColorArray() PackedColorArray {
 	cx := PackedColorArray{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPackedColorArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedColorArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -29822,13 +23968,11 @@ func NewPackedColorArray() PackedColorArray {
 func NewPackedColorArrayWithPackedColorArray(from PackedColorArray) PackedColorArray {
 	cx := PackedColorArray{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedColorArray
 	// PackedColorArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedColorArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedColorArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -29838,13 +23982,11 @@ func NewPackedColorArrayWithPackedColorArray(from PackedColorArray) PackedColorA
 func NewPackedColorArrayWithArray(from Array) PackedColorArray {
 	cx := PackedColorArray{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedColorArrayMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedColorArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -29853,7 +23995,6 @@ func NewPackedColorArrayWithArray(from Array) PackedColorArray {
 func (cx *PackedColorArray) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalPackedColorArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -29869,7 +24010,6 @@ func (cx *PackedColorArray) Get(index int64) Color {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29877,8 +24017,6 @@ func (cx *PackedColorArray) Get(index int64) Color {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Color](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29894,7 +24032,6 @@ func (cx *PackedColorArray) Set(index int64, value Color) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29902,11 +24039,8 @@ func (cx *PackedColorArray) Set(index int64, value Color) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -29922,7 +24056,6 @@ func (cx *PackedColorArray) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29941,7 +24074,6 @@ func (cx *PackedColorArray) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29960,7 +24092,6 @@ func (cx *PackedColorArray) PushBack(value Color) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29968,8 +24099,6 @@ func (cx *PackedColorArray) PushBack(value Color) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -29985,7 +24114,6 @@ func (cx *PackedColorArray) Append(value Color) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -29993,8 +24121,6 @@ func (cx *PackedColorArray) Append(value Color) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30010,7 +24136,6 @@ func (cx *PackedColorArray) AppendArray(array PackedColorArray) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30018,8 +24143,6 @@ func (cx *PackedColorArray) AppendArray(array PackedColorArray) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -30035,7 +24158,6 @@ func (cx *PackedColorArray) RemoveAt(index int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30043,8 +24165,6 @@ func (cx *PackedColorArray) RemoveAt(index int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -30060,7 +24180,6 @@ func (cx *PackedColorArray) Insert(at_index int64, value Color) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30068,11 +24187,8 @@ func (cx *PackedColorArray) Insert(at_index int64, value Color) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at_index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30088,7 +24204,6 @@ func (cx *PackedColorArray) Fill(value Color) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30096,8 +24211,6 @@ func (cx *PackedColorArray) Fill(value Color) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -30113,7 +24226,6 @@ func (cx *PackedColorArray) Resize(new_size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30121,8 +24233,6 @@ func (cx *PackedColorArray) Resize(new_size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(new_size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30138,7 +24248,6 @@ func (cx *PackedColorArray) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30157,7 +24266,6 @@ func (cx *PackedColorArray) Has(value Color) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30165,8 +24273,6 @@ func (cx *PackedColorArray) Has(value Color) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30182,7 +24288,6 @@ func (cx *PackedColorArray) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30201,7 +24306,6 @@ func (cx *PackedColorArray) Slice(begin int64, end int64) PackedColorArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30209,11 +24313,8 @@ func (cx *PackedColorArray) Slice(begin int64, end int64) PackedColorArray {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedColorArray](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30229,7 +24330,6 @@ func (cx *PackedColorArray) ToByteArray() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30248,7 +24348,6 @@ func (cx *PackedColorArray) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30267,7 +24366,6 @@ func (cx *PackedColorArray) Bsearch(value Color, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30275,11 +24373,8 @@ func (cx *PackedColorArray) Bsearch(value Color, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30295,7 +24390,6 @@ func (cx *PackedColorArray) Duplicate() PackedColorArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30314,7 +24408,6 @@ func (cx *PackedColorArray) Find(value Color, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30322,11 +24415,8 @@ func (cx *PackedColorArray) Find(value Color, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30342,7 +24432,6 @@ func (cx *PackedColorArray) Rfind(value Color, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30350,11 +24439,8 @@ func (cx *PackedColorArray) Rfind(value Color, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30370,7 +24456,6 @@ func (cx *PackedColorArray) Count(value Color) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30378,8 +24463,6 @@ func (cx *PackedColorArray) Count(value Color) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30395,7 +24478,6 @@ func (cx *PackedColorArray) Erase(value Color) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30403,8 +24485,6 @@ func (cx *PackedColorArray) Erase(value Color) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30510,7 +24590,6 @@ func (cx *PackedVector4Array) NativePtr() GDExtensionTypePtr {
 func NewPackedVector4Array() PackedVector4Array {
 	cx := PackedVector4Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallBuiltinConstructor(globalPackedVector4ArrayMethodBindings.constructor_0, ptr)
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedVector4ArrayMethodBindings.constructor_0"), zap.Any("cx", cx))
 	return cx
@@ -30520,13 +24599,11 @@ func NewPackedVector4Array() PackedVector4Array {
 func NewPackedVector4ArrayWithPackedVector4Array(from PackedVector4Array) PackedVector4Array {
 	cx := PackedVector4Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// PackedVector4Array
 	// PackedVector4ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedVector4ArrayMethodBindings.constructor_1, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedVector4ArrayMethodBindings.constructor_1"), zap.Any("cx", cx))
 	return cx
@@ -30536,13 +24613,11 @@ func NewPackedVector4ArrayWithPackedVector4Array(from PackedVector4Array) Packed
 func NewPackedVector4ArrayWithArray(from Array) PackedVector4Array {
 	cx := PackedVector4Array{}
 	ptr := (GDExtensionUninitializedTypePtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	var args [1]GDExtensionConstTypePtr
 	// Array
 	// ArrayEncoder
 	args[0] = from.NativeConstPtr()
 	log.Debug("CallBuiltinConstructor before", zap.Uintptr("args[0]", uintptr(args[0])), zap.Any("from", from))
-	pnr.Pin(args[0])
 	CallBuiltinConstructor(globalPackedVector4ArrayMethodBindings.constructor_2, ptr, args[0])
 	log.Debug("CallBuiltinConstructor after", zap.String("name", "globalPackedVector4ArrayMethodBindings.constructor_2"), zap.Any("cx", cx))
 	return cx
@@ -30551,7 +24626,6 @@ func NewPackedVector4ArrayWithArray(from Array) PackedVector4Array {
 func (cx *PackedVector4Array) Destroy() {
 	md := (GDExtensionPtrDestructor)(globalPackedVector4ArrayMethodBindings.destructor)
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	CallFunc_GDExtensionPtrDestructor(md, bx)
 }
 
@@ -30567,7 +24641,6 @@ func (cx *PackedVector4Array) Get(index int64) Vector4 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30575,8 +24648,6 @@ func (cx *PackedVector4Array) Get(index int64) Vector4 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[Vector4](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30592,7 +24663,6 @@ func (cx *PackedVector4Array) Set(index int64, value Vector4) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30600,11 +24670,8 @@ func (cx *PackedVector4Array) Set(index int64, value Vector4) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -30620,7 +24687,6 @@ func (cx *PackedVector4Array) Size() int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30639,7 +24705,6 @@ func (cx *PackedVector4Array) IsEmpty() bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30658,7 +24723,6 @@ func (cx *PackedVector4Array) PushBack(value Vector4) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30666,8 +24730,6 @@ func (cx *PackedVector4Array) PushBack(value Vector4) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30683,7 +24745,6 @@ func (cx *PackedVector4Array) Append(value Vector4) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30691,8 +24752,6 @@ func (cx *PackedVector4Array) Append(value Vector4) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30708,7 +24767,6 @@ func (cx *PackedVector4Array) AppendArray(array PackedVector4Array) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30716,8 +24774,6 @@ func (cx *PackedVector4Array) AppendArray(array PackedVector4Array) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = PackedVector4ArrayEncoder.EncodeTypePtr(array)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -30733,7 +24789,6 @@ func (cx *PackedVector4Array) RemoveAt(index int64) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30741,8 +24796,6 @@ func (cx *PackedVector4Array) RemoveAt(index int64) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(index)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -30758,7 +24811,6 @@ func (cx *PackedVector4Array) Insert(at_index int64, value Vector4) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30766,11 +24818,8 @@ func (cx *PackedVector4Array) Insert(at_index int64, value Vector4) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(at_index)
 
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30786,7 +24835,6 @@ func (cx *PackedVector4Array) Fill(value Vector4) {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30794,8 +24842,6 @@ func (cx *PackedVector4Array) Fill(value Vector4) {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	CallBuiltinMethodPtrNoRet(mb, bx, args...)
 	runtime.KeepAlive(args)
 
@@ -30811,7 +24857,6 @@ func (cx *PackedVector4Array) Resize(new_size int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30819,8 +24864,6 @@ func (cx *PackedVector4Array) Resize(new_size int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(new_size)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30836,7 +24879,6 @@ func (cx *PackedVector4Array) Clear() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30855,7 +24897,6 @@ func (cx *PackedVector4Array) Has(value Vector4) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30863,8 +24904,6 @@ func (cx *PackedVector4Array) Has(value Vector4) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30880,7 +24919,6 @@ func (cx *PackedVector4Array) Reverse() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30899,7 +24937,6 @@ func (cx *PackedVector4Array) Slice(begin int64, end int64) PackedVector4Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30907,11 +24944,8 @@ func (cx *PackedVector4Array) Slice(begin int64, end int64) PackedVector4Array {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = Int64Encoder.EncodeTypePtr(begin)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(end)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[PackedVector4Array](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30927,7 +24961,6 @@ func (cx *PackedVector4Array) ToByteArray() PackedByteArray {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30946,7 +24979,6 @@ func (cx *PackedVector4Array) Sort() {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30965,7 +24997,6 @@ func (cx *PackedVector4Array) Bsearch(value Vector4, before bool) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -30973,11 +25004,8 @@ func (cx *PackedVector4Array) Bsearch(value Vector4, before bool) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = BoolEncoder.EncodeTypePtr(before)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -30993,7 +25021,6 @@ func (cx *PackedVector4Array) Duplicate() PackedVector4Array {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -31012,7 +25039,6 @@ func (cx *PackedVector4Array) Find(value Vector4, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -31020,11 +25046,8 @@ func (cx *PackedVector4Array) Find(value Vector4, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -31040,7 +25063,6 @@ func (cx *PackedVector4Array) Rfind(value Vector4, from int64) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -31048,11 +25070,8 @@ func (cx *PackedVector4Array) Rfind(value Vector4, from int64) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
 	args[1] = Int64Encoder.EncodeTypePtr(from)
 
-	pnr.Pin(args[1])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -31068,7 +25087,6 @@ func (cx *PackedVector4Array) Count(value Vector4) int64 {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -31076,8 +25094,6 @@ func (cx *PackedVector4Array) Count(value Vector4) int64 {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[int64](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
@@ -31093,7 +25109,6 @@ func (cx *PackedVector4Array) Erase(value Vector4) bool {
 		log.Panic("method bind cannot be nil")
 	}
 	bx := cx.NativePtr()
-	pnr.Pin(bx)
 	if bx == nil {
 		log.Panic("object cannot be nil")
 	}
@@ -31101,8 +25116,6 @@ func (cx *PackedVector4Array) Erase(value Vector4) bool {
 	args := make([]GDExtensionTypePtr, sz, sz)
 	args[0] = (GDExtensionTypePtr)(&value)
 
-	pnr.Pin(args[0])
-
 	ret := CallBuiltinMethodPtrRet[bool](mb, bx, args...)
 	runtime.KeepAlive(args)
 	return ret
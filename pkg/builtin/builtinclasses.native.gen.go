@@ -0,0 +1,2228 @@
+//go:build !cgomath
+
+package builtin
+
+/*------------------------------------------------------------------------------
+//   This code was generated by template builtinclasses.native.go.tmpl.
+//
+//   Changes to this file may cause incorrect behavior and will be lost if
+//   the code is regenerated. Any updates should be done in
+//   "builtinclasses.native.go.tmpl" so they can be included in the generated
+//   code.
+//----------------------------------------------------------------------------*/
+
+//revive:disable
+
+// Pure Go implementations of the math heavy built-in class methods and
+// operators. The bodies follow core/math in the engine operation for
+// operation. They match an x86-64 single precision engine build bit for bit;
+// builds that contract a*b+c into FMA (clang on arm64) can differ in the last
+// bit. Methods that call sinf, cosf, atan2f or acosf are left to the engine,
+// since Go has no single precision libm to match them with, and so are methods
+// whose engine implementation bails out early under MATH_CHECKS, since debug
+// and release builds of Godot disagree on their results. Build with the
+// cgomath tag to route every method in this file through the engine instead.
+
+/*
+ * Vector2
+ */
+
+// methods
+/* DirectionTo : direction_to
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) DirectionTo(to Vector2) Vector2 {
+	ret := Vector2{to.X - cx.X, to.Y - cx.Y}
+	return ret.Normalized()
+}
+
+/* DistanceTo : distance_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) DistanceTo(to Vector2) float32 {
+	return mathSqrt(cx.DistanceSquaredTo(to))
+}
+
+/* DistanceSquaredTo : distance_squared_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) DistanceSquaredTo(to Vector2) float32 {
+	dx, dy := cx.X-to.X, cx.Y-to.Y
+	return float32(dx*dx) + float32(dy*dy)
+}
+
+/* Length : length
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) Length() float32 {
+	return mathSqrt(cx.LengthSquared())
+}
+
+/* LengthSquared : length_squared
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) LengthSquared() float32 {
+	x, y := cx.X, cx.Y
+	return float32(x*x) + float32(y*y)
+}
+
+/* LimitLength : limit_length
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) LimitLength(length float32) Vector2 {
+	l := cx.Length()
+	v := *cx
+	if l > 0 && length < l {
+		v = v.Divide_float(l)
+		v = v.Multiply_float(length)
+	}
+	return v
+}
+
+/* Normalized : normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Normalized() Vector2 {
+	x, y := cx.X, cx.Y
+	l := float32(x*x) + float32(y*y)
+	if l != 0 {
+		l = mathSqrt(l)
+		x /= l
+		y /= l
+	}
+	return Vector2{x, y}
+}
+
+/* IsNormalized : is_normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector2) IsNormalized() bool {
+	return mathIsEqualApproxTolerance(cx.LengthSquared(), 1, unitEpsilon)
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector2) IsEqualApprox(to Vector2) bool {
+	return mathIsEqualApprox(cx.X, to.X) && mathIsEqualApprox(cx.Y, to.Y)
+}
+
+/* IsZeroApprox : is_zero_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector2) IsZeroApprox() bool {
+	return mathIsZeroApprox(cx.X) && mathIsZeroApprox(cx.Y)
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector2) IsFinite() bool {
+	return mathIsFinite(cx.X) && mathIsFinite(cx.Y)
+}
+
+/* Project : project
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Project(b Vector2) Vector2 {
+	return b.Multiply_float(cx.Dot(b) / b.LengthSquared())
+}
+
+/* Lerp : lerp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Lerp(to Vector2, weight float32) Vector2 {
+	return Vector2{
+		mathLerp(cx.X, to.X, weight),
+		mathLerp(cx.Y, to.Y, weight),
+	}
+}
+
+/* MoveToward : move_toward
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) MoveToward(to Vector2, delta float32) Vector2 {
+	vd := to.Subtract_Vector2(*cx)
+	l := vd.Length()
+	if l <= delta || l < cmpEpsilon {
+		return to
+	}
+	step := vd.Divide_float(l)
+	step = step.Multiply_float(delta)
+	return cx.Add_Vector2(step)
+}
+
+/* Orthogonal : orthogonal
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Orthogonal() Vector2 {
+	return Vector2{cx.Y, -cx.X}
+}
+
+/* Floor : floor
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Floor() Vector2 {
+	return Vector2{mathFloor(cx.X), mathFloor(cx.Y)}
+}
+
+/* Ceil : ceil
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Ceil() Vector2 {
+	return Vector2{mathCeil(cx.X), mathCeil(cx.Y)}
+}
+
+/* Round : round
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Round() Vector2 {
+	return Vector2{mathRound(cx.X), mathRound(cx.Y)}
+}
+
+/* Aspect : aspect
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) Aspect() float32 {
+	return cx.X / cx.Y
+}
+
+/* Dot : dot
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) Dot(with Vector2) float32 {
+	return float32(cx.X*with.X) + float32(cx.Y*with.Y)
+}
+
+/* Cross : cross
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector2) Cross(with Vector2) float32 {
+	return float32(cx.X*with.Y) - float32(cx.Y*with.X)
+}
+
+/* Abs : abs
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Abs() Vector2 {
+	return Vector2{mathAbs(cx.X), mathAbs(cx.Y)}
+}
+
+/* Sign : sign
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Sign() Vector2 {
+	return Vector2{mathSign(cx.X), mathSign(cx.Y)}
+}
+
+/* Clamp : clamp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Clamp(min Vector2, max Vector2) Vector2 {
+	return Vector2{
+		mathClamp(cx.X, min.X, max.X),
+		mathClamp(cx.Y, min.Y, max.Y),
+	}
+}
+
+/* Clampf : clampf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Clampf(min float32, max float32) Vector2 {
+	return Vector2{mathClamp(cx.X, min, max), mathClamp(cx.Y, min, max)}
+}
+
+/* Min : min
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Min(with Vector2) Vector2 {
+	return Vector2{mathMin(cx.X, with.X), mathMin(cx.Y, with.Y)}
+}
+
+/* Minf : minf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Minf(with float32) Vector2 {
+	return Vector2{mathMin(cx.X, with), mathMin(cx.Y, with)}
+}
+
+/* Max : max
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Max(with Vector2) Vector2 {
+	return Vector2{mathMax(cx.X, with.X), mathMax(cx.Y, with.Y)}
+}
+
+/* Maxf : maxf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Vector2) Maxf(with float32) Vector2 {
+	return Vector2{mathMax(cx.X, with), mathMax(cx.Y, with)}
+}
+
+// operators
+// Negate operator
+func (cx *Vector2) Negate() Vector2 {
+	return Vector2{-cx.X, -cx.Y}
+}
+
+// Positive operator
+func (cx *Vector2) Positive() Vector2 {
+	return *cx
+}
+
+// Multiply_int operator
+func (cx *Vector2) Multiply_int(right int64) Vector2 {
+	return cx.Multiply_float(float32(right))
+}
+
+// Divide_int operator
+func (cx *Vector2) Divide_int(right int64) Vector2 {
+	return cx.Divide_float(float32(right))
+}
+
+// Multiply_float operator
+func (cx *Vector2) Multiply_float(right float32) Vector2 {
+	return Vector2{cx.X * right, cx.Y * right}
+}
+
+// Divide_float operator
+func (cx *Vector2) Divide_float(right float32) Vector2 {
+	return Vector2{cx.X / right, cx.Y / right}
+}
+
+// Equal_Vector2 operator
+func (cx *Vector2) Equal_Vector2(right Vector2) bool {
+	return cx.X == right.X && cx.Y == right.Y
+}
+
+// Not_equal_Vector2 operator
+func (cx *Vector2) Not_equal_Vector2(right Vector2) bool {
+	return cx.X != right.X || cx.Y != right.Y
+}
+
+// Less_Vector2 operator
+func (cx *Vector2) Less_Vector2(right Vector2) bool {
+	if cx.X == right.X {
+		return cx.Y < right.Y
+	}
+	return cx.X < right.X
+}
+
+// Less_equal_Vector2 operator
+func (cx *Vector2) Less_equal_Vector2(right Vector2) bool {
+	if cx.X == right.X {
+		return cx.Y <= right.Y
+	}
+	return cx.X < right.X
+}
+
+// Greater_Vector2 operator
+func (cx *Vector2) Greater_Vector2(right Vector2) bool {
+	if cx.X == right.X {
+		return cx.Y > right.Y
+	}
+	return cx.X > right.X
+}
+
+// Greater_equal_Vector2 operator
+func (cx *Vector2) Greater_equal_Vector2(right Vector2) bool {
+	if cx.X == right.X {
+		return cx.Y >= right.Y
+	}
+	return cx.X > right.X
+}
+
+// Add_Vector2 operator
+func (cx *Vector2) Add_Vector2(right Vector2) Vector2 {
+	return Vector2{cx.X + right.X, cx.Y + right.Y}
+}
+
+// Subtract_Vector2 operator
+func (cx *Vector2) Subtract_Vector2(right Vector2) Vector2 {
+	return Vector2{cx.X - right.X, cx.Y - right.Y}
+}
+
+// Multiply_Vector2 operator
+func (cx *Vector2) Multiply_Vector2(right Vector2) Vector2 {
+	return Vector2{cx.X * right.X, cx.Y * right.Y}
+}
+
+// Divide_Vector2 operator
+func (cx *Vector2) Divide_Vector2(right Vector2) Vector2 {
+	return Vector2{cx.X / right.X, cx.Y / right.Y}
+}
+
+// Multiply_Transform2D operator
+func (cx *Vector2) Multiply_Transform2D(right Transform2D) Vector2 {
+	// Transform2D::xform_inv
+	c0, c1, origin := right.X, right.Y, right.Origin
+	v := cx.Subtract_Vector2(origin)
+	return Vector2{c0.Dot(v), c1.Dot(v)}
+}
+
+/*
+ * Rect2
+ */
+
+func (cx *Rect2) nativeBounds() (px, py, sx, sy float32) {
+	p, s := cx.Position, cx.Size
+	return p.X, p.Y, s.X, s.Y
+}
+
+func makeRect2Bounds(px, py, sx, sy float32) Rect2 {
+	return Rect2{Vector2{px, py}, Vector2{sx, sy}}
+}
+
+// methods
+/* GetCenter : get_center
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Rect2) GetCenter() Vector2 {
+	p, s := cx.Position, cx.Size
+	return p.Add_Vector2(s.Multiply_float(0.5))
+}
+
+/* GetArea : get_area
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Rect2) GetArea() float32 {
+	_, _, sx, sy := cx.nativeBounds()
+	return sx * sy
+}
+
+/* HasArea : has_area
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) HasArea() bool {
+	_, _, sx, sy := cx.nativeBounds()
+	return sx > 0 && sy > 0
+}
+
+/* HasPoint : has_point
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) HasPoint(point Vector2) bool {
+	px, py, sx, sy := cx.nativeBounds()
+	x, y := point.X, point.Y
+	if x < px || y < py {
+		return false
+	}
+	if x >= px+sx || y >= py+sy {
+		return false
+	}
+	return true
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) IsEqualApprox(rect Rect2) bool {
+	p, s := cx.Position, cx.Size
+	return p.IsEqualApprox(rect.Position) && s.IsEqualApprox(rect.Size)
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) IsFinite() bool {
+	p, s := cx.Position, cx.Size
+	return p.IsFinite() && s.IsFinite()
+}
+
+/* Intersects : intersects
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) Intersects(b Rect2, include_borders bool) bool {
+	px, py, sx, sy := cx.nativeBounds()
+	bpx, bpy, bsx, bsy := b.nativeBounds()
+	if include_borders {
+		if px > bpx+bsx || px+sx < bpx || py > bpy+bsy || py+sy < bpy {
+			return false
+		}
+	} else {
+		if px >= bpx+bsx || px+sx <= bpx || py >= bpy+bsy || py+sy <= bpy {
+			return false
+		}
+	}
+	return true
+}
+
+/* Encloses : encloses
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Rect2) Encloses(b Rect2) bool {
+	px, py, sx, sy := cx.nativeBounds()
+	bpx, bpy, bsx, bsy := b.nativeBounds()
+	return bpx >= px && bpy >= py && bpx+bsx <= px+sx && bpy+bsy <= py+sy
+}
+
+/* Intersection : intersection
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) Intersection(b Rect2) Rect2 {
+	if !cx.Intersects(b, false) {
+		return Rect2{}
+	}
+	px, py, sx, sy := cx.nativeBounds()
+	bpx, bpy, bsx, bsy := b.nativeBounds()
+	nx, ny := mathMax(bpx, px), mathMax(bpy, py)
+	return makeRect2Bounds(
+		nx, ny,
+		mathMin(bpx+bsx, px+sx)-nx,
+		mathMin(bpy+bsy, py+sy)-ny,
+	)
+}
+
+/* Merge : merge
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) Merge(b Rect2) Rect2 {
+	px, py, sx, sy := cx.nativeBounds()
+	bpx, bpy, bsx, bsy := b.nativeBounds()
+	nx, ny := mathMin(bpx, px), mathMin(bpy, py)
+	return makeRect2Bounds(
+		nx, ny,
+		mathMax(bpx+bsx, px+sx)-nx,
+		mathMax(bpy+bsy, py+sy)-ny,
+	)
+}
+
+/* Expand : expand
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) Expand(to Vector2) Rect2 {
+	px, py, sx, sy := cx.nativeBounds()
+	bx, by := px, py
+	ex, ey := px+sx, py+sy
+	x, y := to.X, to.Y
+	if x < bx {
+		bx = x
+	}
+	if y < by {
+		by = y
+	}
+	if x > ex {
+		ex = x
+	}
+	if y > ey {
+		ey = y
+	}
+	return makeRect2Bounds(bx, by, ex-bx, ey-by)
+}
+
+/* Grow : grow
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) Grow(amount float32) Rect2 {
+	px, py, sx, sy := cx.nativeBounds()
+	return makeRect2Bounds(px-amount, py-amount, sx+float32(amount*2), sy+float32(amount*2))
+}
+
+/* GrowIndividual : grow_individual
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) GrowIndividual(left float32, top float32, right float32, bottom float32) Rect2 {
+	px, py, sx, sy := cx.nativeBounds()
+	return makeRect2Bounds(px-left, py-top, sx+(left+right), sy+(top+bottom))
+}
+
+/* Abs : abs
+ * is_vararg = false, is_static = false
+ * goReturnType(Rect2) -> Rect2
+ */
+func (cx *Rect2) Abs() Rect2 {
+	px, py, sx, sy := cx.nativeBounds()
+	return makeRect2Bounds(px+mathMin(sx, 0), py+mathMin(sy, 0), mathAbs(sx), mathAbs(sy))
+}
+
+// operators
+// Equal_Rect2 operator
+func (cx *Rect2) Equal_Rect2(right Rect2) bool {
+	px, py, sx, sy := cx.nativeBounds()
+	bpx, bpy, bsx, bsy := right.nativeBounds()
+	return px == bpx && py == bpy && sx == bsx && sy == bsy
+}
+
+// Not_equal_Rect2 operator
+func (cx *Rect2) Not_equal_Rect2(right Rect2) bool {
+	px, py, sx, sy := cx.nativeBounds()
+	bpx, bpy, bsx, bsy := right.nativeBounds()
+	return px != bpx || py != bpy || sx != bsx || sy != bsy
+}
+
+/*
+ * Vector3
+ */
+
+// methods
+/* DirectionTo : direction_to
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) DirectionTo(to Vector3) Vector3 {
+	ret := Vector3{to.X - cx.X, to.Y - cx.Y, to.Z - cx.Z}
+	return ret.Normalized()
+}
+
+/* DistanceTo : distance_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector3) DistanceTo(to Vector3) float32 {
+	d := to.Subtract_Vector3(*cx)
+	return d.Length()
+}
+
+/* DistanceSquaredTo : distance_squared_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector3) DistanceSquaredTo(to Vector3) float32 {
+	d := to.Subtract_Vector3(*cx)
+	return d.LengthSquared()
+}
+
+/* Length : length
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector3) Length() float32 {
+	return mathSqrt(cx.LengthSquared())
+}
+
+/* LengthSquared : length_squared
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector3) LengthSquared() float32 {
+	x, y, z := cx.X, cx.Y, cx.Z
+	return float32(x*x) + float32(y*y) + float32(z*z)
+}
+
+/* LimitLength : limit_length
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) LimitLength(length float32) Vector3 {
+	l := cx.Length()
+	v := *cx
+	if l > 0 && length < l {
+		v = v.Divide_float(l)
+		v = v.Multiply_float(length)
+	}
+	return v
+}
+
+/* Normalized : normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Normalized() Vector3 {
+	l := cx.LengthSquared()
+	if l == 0 {
+		return Vector3{}
+	}
+	l = mathSqrt(l)
+	return Vector3{cx.X / l, cx.Y / l, cx.Z / l}
+}
+
+/* IsNormalized : is_normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector3) IsNormalized() bool {
+	return mathIsEqualApproxTolerance(cx.LengthSquared(), 1, unitEpsilon)
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector3) IsEqualApprox(to Vector3) bool {
+	return mathIsEqualApprox(cx.X, to.X) &&
+		mathIsEqualApprox(cx.Y, to.Y) &&
+		mathIsEqualApprox(cx.Z, to.Z)
+}
+
+/* IsZeroApprox : is_zero_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector3) IsZeroApprox() bool {
+	return mathIsZeroApprox(cx.X) && mathIsZeroApprox(cx.Y) && mathIsZeroApprox(cx.Z)
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector3) IsFinite() bool {
+	return mathIsFinite(cx.X) && mathIsFinite(cx.Y) && mathIsFinite(cx.Z)
+}
+
+/* Inverse : inverse
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Inverse() Vector3 {
+	return Vector3{1 / cx.X, 1 / cx.Y, 1 / cx.Z}
+}
+
+/* Clamp : clamp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Clamp(min Vector3, max Vector3) Vector3 {
+	return Vector3{
+		mathClamp(cx.X, min.X, max.X),
+		mathClamp(cx.Y, min.Y, max.Y),
+		mathClamp(cx.Z, min.Z, max.Z),
+	}
+}
+
+/* Clampf : clampf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Clampf(min float32, max float32) Vector3 {
+	return Vector3{
+		mathClamp(cx.X, min, max),
+		mathClamp(cx.Y, min, max),
+		mathClamp(cx.Z, min, max),
+	}
+}
+
+/* Lerp : lerp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Lerp(to Vector3, weight float32) Vector3 {
+	return Vector3{
+		mathLerp(cx.X, to.X, weight),
+		mathLerp(cx.Y, to.Y, weight),
+		mathLerp(cx.Z, to.Z, weight),
+	}
+}
+
+/* MoveToward : move_toward
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) MoveToward(to Vector3, delta float32) Vector3 {
+	vd := to.Subtract_Vector3(*cx)
+	l := vd.Length()
+	if l <= delta || l < cmpEpsilon {
+		return to
+	}
+	step := vd.Divide_float(l)
+	step = step.Multiply_float(delta)
+	return cx.Add_Vector3(step)
+}
+
+/* Dot : dot
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector3) Dot(with Vector3) float32 {
+	return float32(cx.X*with.X) +
+		float32(cx.Y*with.Y) +
+		float32(cx.Z*with.Z)
+}
+
+/* Cross : cross
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Cross(with Vector3) Vector3 {
+	x, y, z := cx.X, cx.Y, cx.Z
+	wx, wy, wz := with.X, with.Y, with.Z
+	return Vector3{
+		float32(y*wz) - float32(z*wy),
+		float32(z*wx) - float32(x*wz),
+		float32(x*wy) - float32(y*wx),
+	}
+}
+
+/* Outer : outer
+ * is_vararg = false, is_static = false
+ * goReturnType(Basis) -> Basis
+ */
+func (cx *Vector3) Outer(with Vector3) Basis {
+	x, y, z := cx.X, cx.Y, cx.Z
+	wx, wy, wz := with.X, with.Y, with.Z
+	return makeBasisRows([3][3]float32{
+		{x * wx, x * wy, x * wz},
+		{y * wx, y * wy, y * wz},
+		{z * wx, z * wy, z * wz},
+	})
+}
+
+/* Abs : abs
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Abs() Vector3 {
+	return Vector3{mathAbs(cx.X), mathAbs(cx.Y), mathAbs(cx.Z)}
+}
+
+/* Floor : floor
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Floor() Vector3 {
+	return Vector3{mathFloor(cx.X), mathFloor(cx.Y), mathFloor(cx.Z)}
+}
+
+/* Ceil : ceil
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Ceil() Vector3 {
+	return Vector3{mathCeil(cx.X), mathCeil(cx.Y), mathCeil(cx.Z)}
+}
+
+/* Round : round
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Round() Vector3 {
+	return Vector3{mathRound(cx.X), mathRound(cx.Y), mathRound(cx.Z)}
+}
+
+/* Project : project
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Project(b Vector3) Vector3 {
+	return b.Multiply_float(cx.Dot(b) / b.LengthSquared())
+}
+
+/* Sign : sign
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Sign() Vector3 {
+	return Vector3{mathSign(cx.X), mathSign(cx.Y), mathSign(cx.Z)}
+}
+
+/* Min : min
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Min(with Vector3) Vector3 {
+	return Vector3{
+		mathMin(cx.X, with.X),
+		mathMin(cx.Y, with.Y),
+		mathMin(cx.Z, with.Z),
+	}
+}
+
+/* Minf : minf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Minf(with float32) Vector3 {
+	return Vector3{mathMin(cx.X, with), mathMin(cx.Y, with), mathMin(cx.Z, with)}
+}
+
+/* Max : max
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Max(with Vector3) Vector3 {
+	return Vector3{
+		mathMax(cx.X, with.X),
+		mathMax(cx.Y, with.Y),
+		mathMax(cx.Z, with.Z),
+	}
+}
+
+/* Maxf : maxf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Vector3) Maxf(with float32) Vector3 {
+	return Vector3{mathMax(cx.X, with), mathMax(cx.Y, with), mathMax(cx.Z, with)}
+}
+
+// operators
+// Negate operator
+func (cx *Vector3) Negate() Vector3 {
+	return Vector3{-cx.X, -cx.Y, -cx.Z}
+}
+
+// Positive operator
+func (cx *Vector3) Positive() Vector3 {
+	return *cx
+}
+
+// Multiply_int operator
+func (cx *Vector3) Multiply_int(right int64) Vector3 {
+	return cx.Multiply_float(float32(right))
+}
+
+// Divide_int operator
+func (cx *Vector3) Divide_int(right int64) Vector3 {
+	return cx.Divide_float(float32(right))
+}
+
+// Multiply_float operator
+func (cx *Vector3) Multiply_float(right float32) Vector3 {
+	return Vector3{cx.X * right, cx.Y * right, cx.Z * right}
+}
+
+// Divide_float operator
+func (cx *Vector3) Divide_float(right float32) Vector3 {
+	return Vector3{cx.X / right, cx.Y / right, cx.Z / right}
+}
+
+// Equal_Vector3 operator
+func (cx *Vector3) Equal_Vector3(right Vector3) bool {
+	return cx.X == right.X && cx.Y == right.Y && cx.Z == right.Z
+}
+
+// Not_equal_Vector3 operator
+func (cx *Vector3) Not_equal_Vector3(right Vector3) bool {
+	return cx.X != right.X || cx.Y != right.Y || cx.Z != right.Z
+}
+
+// Less_Vector3 operator
+func (cx *Vector3) Less_Vector3(right Vector3) bool {
+	if cx.X == right.X {
+		if cx.Y == right.Y {
+			return cx.Z < right.Z
+		}
+		return cx.Y < right.Y
+	}
+	return cx.X < right.X
+}
+
+// Less_equal_Vector3 operator
+func (cx *Vector3) Less_equal_Vector3(right Vector3) bool {
+	if cx.X == right.X {
+		if cx.Y == right.Y {
+			return cx.Z <= right.Z
+		}
+		return cx.Y < right.Y
+	}
+	return cx.X < right.X
+}
+
+// Greater_Vector3 operator
+func (cx *Vector3) Greater_Vector3(right Vector3) bool {
+	if cx.X == right.X {
+		if cx.Y == right.Y {
+			return cx.Z > right.Z
+		}
+		return cx.Y > right.Y
+	}
+	return cx.X > right.X
+}
+
+// Greater_equal_Vector3 operator
+func (cx *Vector3) Greater_equal_Vector3(right Vector3) bool {
+	if cx.X == right.X {
+		if cx.Y == right.Y {
+			return cx.Z >= right.Z
+		}
+		return cx.Y > right.Y
+	}
+	return cx.X > right.X
+}
+
+// Add_Vector3 operator
+func (cx *Vector3) Add_Vector3(right Vector3) Vector3 {
+	return Vector3{cx.X + right.X, cx.Y + right.Y, cx.Z + right.Z}
+}
+
+// Subtract_Vector3 operator
+func (cx *Vector3) Subtract_Vector3(right Vector3) Vector3 {
+	return Vector3{cx.X - right.X, cx.Y - right.Y, cx.Z - right.Z}
+}
+
+// Multiply_Vector3 operator
+func (cx *Vector3) Multiply_Vector3(right Vector3) Vector3 {
+	return Vector3{cx.X * right.X, cx.Y * right.Y, cx.Z * right.Z}
+}
+
+// Divide_Vector3 operator
+func (cx *Vector3) Divide_Vector3(right Vector3) Vector3 {
+	return Vector3{cx.X / right.X, cx.Y / right.Y, cx.Z / right.Z}
+}
+
+// Multiply_Basis operator
+func (cx *Vector3) Multiply_Basis(right Basis) Vector3 {
+	// Basis::xform_inv
+	m := right.nativeRows()
+	x, y, z := cx.X, cx.Y, cx.Z
+	return Vector3{
+		float32(m[0][0]*x) + float32(m[1][0]*y) + float32(m[2][0]*z),
+		float32(m[0][1]*x) + float32(m[1][1]*y) + float32(m[2][1]*z),
+		float32(m[0][2]*x) + float32(m[1][2]*y) + float32(m[2][2]*z),
+	}
+}
+
+/*
+ * Transform2D
+ */
+
+// nativeColumns unpacks columns[3] of the engine's Transform2D.
+func (cx *Transform2D) nativeColumns() (m [3][2]float32) {
+	for i, c := range [3]Vector2{cx.X, cx.Y, cx.Origin} {
+		m[i] = [2]float32{c.X, c.Y}
+	}
+	return
+}
+
+func makeTransform2DColumns(m [3][2]float32) Transform2D {
+	return Transform2D{
+		Vector2{m[0][0], m[0][1]},
+		Vector2{m[1][0], m[1][1]},
+		Vector2{m[2][0], m[2][1]},
+	}
+}
+
+// transform2DBasisXform is Transform2D::basis_xform, spelled out as tdotx and
+// tdoty.
+func transform2DBasisXform(m *[3][2]float32, x, y float32) (float32, float32) {
+	return float32(m[0][0]*x) + float32(m[1][0]*y), float32(m[0][1]*x) + float32(m[1][1]*y)
+}
+
+// methods
+/* Inverse : inverse
+ * is_vararg = false, is_static = false
+ * goReturnType(Transform2D) -> Transform2D
+ */
+func (cx *Transform2D) Inverse() Transform2D {
+	// Transform2D::invert assumes an orthonormal basis
+	m := cx.nativeColumns()
+	m[0][1], m[1][0] = m[1][0], m[0][1]
+	m[2][0], m[2][1] = transform2DBasisXform(&m, -m[2][0], -m[2][1])
+	return makeTransform2DColumns(m)
+}
+
+/* GetOrigin : get_origin
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Transform2D) GetOrigin() Vector2 {
+	return cx.Origin
+}
+
+/* GetScale : get_scale
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Transform2D) GetScale() Vector2 {
+	detSign := mathSign(cx.Determinant())
+	c0, c1 := cx.X, cx.Y
+	return Vector2{c0.Length(), detSign * c1.Length()}
+}
+
+/* Scaled : scaled
+ * is_vararg = false, is_static = false
+ * goReturnType(Transform2D) -> Transform2D
+ */
+func (cx *Transform2D) Scaled(scale Vector2) Transform2D {
+	m := cx.nativeColumns()
+	sx, sy := scale.X, scale.Y
+	for i := range m {
+		m[i][0] *= sx
+		m[i][1] *= sy
+	}
+	return makeTransform2DColumns(m)
+}
+
+/* ScaledLocal : scaled_local
+ * is_vararg = false, is_static = false
+ * goReturnType(Transform2D) -> Transform2D
+ */
+func (cx *Transform2D) ScaledLocal(scale Vector2) Transform2D {
+	m := cx.nativeColumns()
+	sx, sy := scale.X, scale.Y
+	m[0][0] *= sx
+	m[0][1] *= sx
+	m[1][0] *= sy
+	m[1][1] *= sy
+	return makeTransform2DColumns(m)
+}
+
+/* Translated : translated
+ * is_vararg = false, is_static = false
+ * goReturnType(Transform2D) -> Transform2D
+ */
+func (cx *Transform2D) Translated(offset Vector2) Transform2D {
+	m := cx.nativeColumns()
+	m[2][0] += offset.X
+	m[2][1] += offset.Y
+	return makeTransform2DColumns(m)
+}
+
+/* TranslatedLocal : translated_local
+ * is_vararg = false, is_static = false
+ * goReturnType(Transform2D) -> Transform2D
+ */
+func (cx *Transform2D) TranslatedLocal(offset Vector2) Transform2D {
+	m := cx.nativeColumns()
+	ox, oy := transform2DBasisXform(&m, offset.X, offset.Y)
+	m[2][0] += ox
+	m[2][1] += oy
+	return makeTransform2DColumns(m)
+}
+
+/* Determinant : determinant
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Transform2D) Determinant() float32 {
+	m := cx.nativeColumns()
+	return float32(m[0][0]*m[1][1]) - float32(m[0][1]*m[1][0])
+}
+
+/* BasisXform : basis_xform
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Transform2D) BasisXform(v Vector2) Vector2 {
+	m := cx.nativeColumns()
+	x, y := transform2DBasisXform(&m, v.X, v.Y)
+	return Vector2{x, y}
+}
+
+/* BasisXformInv : basis_xform_inv
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector2) -> Vector2
+ */
+func (cx *Transform2D) BasisXformInv(v Vector2) Vector2 {
+	c0, c1 := cx.X, cx.Y
+	return Vector2{c0.Dot(v), c1.Dot(v)}
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Transform2D) IsEqualApprox(xform Transform2D) bool {
+	a, b := cx.nativeColumns(), xform.nativeColumns()
+	for i := range a {
+		if !mathIsEqualApprox(a[i][0], b[i][0]) || !mathIsEqualApprox(a[i][1], b[i][1]) {
+			return false
+		}
+	}
+	return true
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Transform2D) IsFinite() bool {
+	m := cx.nativeColumns()
+	for i := range m {
+		if !mathIsFinite(m[i][0]) || !mathIsFinite(m[i][1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// operators
+// Multiply_int operator
+func (cx *Transform2D) Multiply_int(right int64) Transform2D {
+	return cx.Multiply_float(float32(right))
+}
+
+// Divide_int operator
+func (cx *Transform2D) Divide_int(right int64) Transform2D {
+	return cx.Divide_float(float32(right))
+}
+
+// Multiply_float operator
+func (cx *Transform2D) Multiply_float(right float32) Transform2D {
+	m := cx.nativeColumns()
+	for i := range m {
+		m[i][0] *= right
+		m[i][1] *= right
+	}
+	return makeTransform2DColumns(m)
+}
+
+// Divide_float operator
+func (cx *Transform2D) Divide_float(right float32) Transform2D {
+	m := cx.nativeColumns()
+	for i := range m {
+		m[i][0] /= right
+		m[i][1] /= right
+	}
+	return makeTransform2DColumns(m)
+}
+
+// Multiply_Vector2 operator
+func (cx *Transform2D) Multiply_Vector2(right Vector2) Vector2 {
+	// Transform2D::xform
+	m := cx.nativeColumns()
+	x, y := transform2DBasisXform(&m, right.X, right.Y)
+	return Vector2{x + m[2][0], y + m[2][1]}
+}
+
+// Equal_Transform2D operator
+func (cx *Transform2D) Equal_Transform2D(right Transform2D) bool {
+	return cx.nativeColumns() == right.nativeColumns()
+}
+
+// Not_equal_Transform2D operator
+func (cx *Transform2D) Not_equal_Transform2D(right Transform2D) bool {
+	return cx.nativeColumns() != right.nativeColumns()
+}
+
+// Multiply_Transform2D operator
+func (cx *Transform2D) Multiply_Transform2D(right Transform2D) Transform2D {
+	a, b := cx.nativeColumns(), right.nativeColumns()
+	var m [3][2]float32
+	m[2][0], m[2][1] = transform2DBasisXform(&a, b[2][0], b[2][1])
+	m[2][0] += a[2][0]
+	m[2][1] += a[2][1]
+	m[0][0], m[0][1] = transform2DBasisXform(&a, b[0][0], b[0][1])
+	m[1][0], m[1][1] = transform2DBasisXform(&a, b[1][0], b[1][1])
+	return makeTransform2DColumns(m)
+}
+
+/*
+ * Vector4
+ */
+
+func (cx *Vector4) nativeComponents() [4]float32 {
+	return [4]float32{cx.X, cx.Y, cx.Z, cx.W}
+}
+
+func makeVector4Components(v [4]float32) Vector4 {
+	return Vector4{v[0], v[1], v[2], v[3]}
+}
+
+// methods
+/* Length : length
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector4) Length() float32 {
+	return mathSqrt(cx.LengthSquared())
+}
+
+/* LengthSquared : length_squared
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector4) LengthSquared() float32 {
+	return cx.Dot(*cx)
+}
+
+/* Abs : abs
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Abs() Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = mathAbs(v[i])
+	}
+	return makeVector4Components(v)
+}
+
+/* Sign : sign
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Sign() Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = mathSign(v[i])
+	}
+	return makeVector4Components(v)
+}
+
+/* Floor : floor
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Floor() Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = mathFloor(v[i])
+	}
+	return makeVector4Components(v)
+}
+
+/* Ceil : ceil
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Ceil() Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = mathCeil(v[i])
+	}
+	return makeVector4Components(v)
+}
+
+/* Round : round
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Round() Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = mathRound(v[i])
+	}
+	return makeVector4Components(v)
+}
+
+/* Lerp : lerp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Lerp(to Vector4, weight float32) Vector4 {
+	v, t := cx.nativeComponents(), to.nativeComponents()
+	for i := range v {
+		v[i] = mathLerp(v[i], t[i], weight)
+	}
+	return makeVector4Components(v)
+}
+
+/* Clamp : clamp
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Clamp(min Vector4, max Vector4) Vector4 {
+	v, lo, hi := cx.nativeComponents(), min.nativeComponents(), max.nativeComponents()
+	for i := range v {
+		v[i] = mathClamp(v[i], lo[i], hi[i])
+	}
+	return makeVector4Components(v)
+}
+
+/* Clampf : clampf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Clampf(min float32, max float32) Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = mathClamp(v[i], min, max)
+	}
+	return makeVector4Components(v)
+}
+
+/* Normalized : normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Normalized() Vector4 {
+	l := cx.LengthSquared()
+	if l == 0 {
+		return Vector4{}
+	}
+	l = mathSqrt(l)
+	return cx.Divide_float(l)
+}
+
+/* IsNormalized : is_normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector4) IsNormalized() bool {
+	return mathIsEqualApproxTolerance(cx.LengthSquared(), 1, unitEpsilon)
+}
+
+/* DirectionTo : direction_to
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) DirectionTo(to Vector4) Vector4 {
+	ret := to.Subtract_Vector4(*cx)
+	return ret.Normalized()
+}
+
+/* DistanceTo : distance_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector4) DistanceTo(to Vector4) float32 {
+	d := to.Subtract_Vector4(*cx)
+	return d.Length()
+}
+
+/* DistanceSquaredTo : distance_squared_to
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector4) DistanceSquaredTo(to Vector4) float32 {
+	d := to.Subtract_Vector4(*cx)
+	return d.LengthSquared()
+}
+
+/* Dot : dot
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Vector4) Dot(with Vector4) float32 {
+	v, w := cx.nativeComponents(), with.nativeComponents()
+	return float32(v[0]*w[0]) + float32(v[1]*w[1]) + float32(v[2]*w[2]) + float32(v[3]*w[3])
+}
+
+/* Inverse : inverse
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Inverse() Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = 1 / v[i]
+	}
+	return makeVector4Components(v)
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector4) IsEqualApprox(to Vector4) bool {
+	v, t := cx.nativeComponents(), to.nativeComponents()
+	for i := range v {
+		if !mathIsEqualApprox(v[i], t[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+/* IsZeroApprox : is_zero_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector4) IsZeroApprox() bool {
+	v := cx.nativeComponents()
+	for i := range v {
+		if !mathIsZeroApprox(v[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Vector4) IsFinite() bool {
+	v := cx.nativeComponents()
+	for i := range v {
+		if !mathIsFinite(v[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+/* Min : min
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Min(with Vector4) Vector4 {
+	v, w := cx.nativeComponents(), with.nativeComponents()
+	for i := range v {
+		v[i] = mathMin(v[i], w[i])
+	}
+	return makeVector4Components(v)
+}
+
+/* Minf : minf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Minf(with float32) Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = mathMin(v[i], with)
+	}
+	return makeVector4Components(v)
+}
+
+/* Max : max
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Max(with Vector4) Vector4 {
+	v, w := cx.nativeComponents(), with.nativeComponents()
+	for i := range v {
+		v[i] = mathMax(v[i], w[i])
+	}
+	return makeVector4Components(v)
+}
+
+/* Maxf : maxf
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector4) -> Vector4
+ */
+func (cx *Vector4) Maxf(with float32) Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = mathMax(v[i], with)
+	}
+	return makeVector4Components(v)
+}
+
+// operators
+// Negate operator
+func (cx *Vector4) Negate() Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = -v[i]
+	}
+	return makeVector4Components(v)
+}
+
+// Positive operator
+func (cx *Vector4) Positive() Vector4 {
+	return *cx
+}
+
+// Multiply_int operator
+func (cx *Vector4) Multiply_int(right int64) Vector4 {
+	return cx.Multiply_float(float32(right))
+}
+
+// Divide_int operator
+func (cx *Vector4) Divide_int(right int64) Vector4 {
+	return cx.Divide_float(float32(right))
+}
+
+// Multiply_float operator
+func (cx *Vector4) Multiply_float(right float32) Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] *= right
+	}
+	return makeVector4Components(v)
+}
+
+// Divide_float operator
+func (cx *Vector4) Divide_float(right float32) Vector4 {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] /= right
+	}
+	return makeVector4Components(v)
+}
+
+// Equal_Vector4 operator
+func (cx *Vector4) Equal_Vector4(right Vector4) bool {
+	return cx.nativeComponents() == right.nativeComponents()
+}
+
+// Not_equal_Vector4 operator
+func (cx *Vector4) Not_equal_Vector4(right Vector4) bool {
+	return cx.nativeComponents() != right.nativeComponents()
+}
+
+// Less_Vector4 operator
+func (cx *Vector4) Less_Vector4(right Vector4) bool {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := 0; i < 3; i++ {
+		if v[i] != r[i] {
+			return v[i] < r[i]
+		}
+	}
+	return v[3] < r[3]
+}
+
+// Less_equal_Vector4 operator
+func (cx *Vector4) Less_equal_Vector4(right Vector4) bool {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := 0; i < 3; i++ {
+		if v[i] != r[i] {
+			return v[i] < r[i]
+		}
+	}
+	return v[3] <= r[3]
+}
+
+// Greater_Vector4 operator
+func (cx *Vector4) Greater_Vector4(right Vector4) bool {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := 0; i < 3; i++ {
+		if v[i] != r[i] {
+			return v[i] > r[i]
+		}
+	}
+	return v[3] > r[3]
+}
+
+// Greater_equal_Vector4 operator
+func (cx *Vector4) Greater_equal_Vector4(right Vector4) bool {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := 0; i < 3; i++ {
+		if v[i] != r[i] {
+			return v[i] > r[i]
+		}
+	}
+	return v[3] >= r[3]
+}
+
+// Add_Vector4 operator
+func (cx *Vector4) Add_Vector4(right Vector4) Vector4 {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := range v {
+		v[i] += r[i]
+	}
+	return makeVector4Components(v)
+}
+
+// Subtract_Vector4 operator
+func (cx *Vector4) Subtract_Vector4(right Vector4) Vector4 {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := range v {
+		v[i] -= r[i]
+	}
+	return makeVector4Components(v)
+}
+
+// Multiply_Vector4 operator
+func (cx *Vector4) Multiply_Vector4(right Vector4) Vector4 {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := range v {
+		v[i] *= r[i]
+	}
+	return makeVector4Components(v)
+}
+
+// Divide_Vector4 operator
+func (cx *Vector4) Divide_Vector4(right Vector4) Vector4 {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := range v {
+		v[i] /= r[i]
+	}
+	return makeVector4Components(v)
+}
+
+/*
+ * Quaternion
+ */
+
+func (cx *Quaternion) nativeComponents() [4]float32 {
+	return [4]float32{cx.X, cx.Y, cx.Z, cx.W}
+}
+
+func makeQuaternionComponents(v [4]float32) Quaternion {
+	return Quaternion{v[0], v[1], v[2], v[3]}
+}
+
+// methods
+/* Length : length
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Quaternion) Length() float32 {
+	return mathSqrt(cx.LengthSquared())
+}
+
+/* LengthSquared : length_squared
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Quaternion) LengthSquared() float32 {
+	return cx.Dot(*cx)
+}
+
+/* Normalized : normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(Quaternion) -> Quaternion
+ */
+func (cx *Quaternion) Normalized() Quaternion {
+	// Quaternion::operator/ multiplies by the reciprocal
+	return cx.Divide_float(cx.Length())
+}
+
+/* IsNormalized : is_normalized
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Quaternion) IsNormalized() bool {
+	return mathIsEqualApproxTolerance(cx.LengthSquared(), 1, unitEpsilon)
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Quaternion) IsEqualApprox(to Quaternion) bool {
+	v, t := cx.nativeComponents(), to.nativeComponents()
+	for i := range v {
+		if !mathIsEqualApprox(v[i], t[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Quaternion) IsFinite() bool {
+	v := cx.nativeComponents()
+	for i := range v {
+		if !mathIsFinite(v[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+/* Dot : dot
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Quaternion) Dot(with Quaternion) float32 {
+	v, w := cx.nativeComponents(), with.nativeComponents()
+	return float32(v[0]*w[0]) + float32(v[1]*w[1]) + float32(v[2]*w[2]) + float32(v[3]*w[3])
+}
+
+/* GetAxis : get_axis
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Quaternion) GetAxis() Vector3 {
+	x, y, z, w := cx.X, cx.Y, cx.Z, cx.W
+	// the engine compares against a double precision epsilon
+	if float64(mathAbs(w)) > 1-cmpEpsilon {
+		return Vector3{x, y, z}
+	}
+	r := 1 / mathSqrt(1-float32(w*w))
+	return Vector3{x * r, y * r, z * r}
+}
+
+// operators
+// Negate operator
+func (cx *Quaternion) Negate() Quaternion {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = -v[i]
+	}
+	return makeQuaternionComponents(v)
+}
+
+// Positive operator
+func (cx *Quaternion) Positive() Quaternion {
+	return *cx
+}
+
+// Multiply_int operator
+func (cx *Quaternion) Multiply_int(right int64) Quaternion {
+	return cx.Multiply_float(float32(right))
+}
+
+// Divide_int operator
+func (cx *Quaternion) Divide_int(right int64) Quaternion {
+	return cx.Divide_float(float32(right))
+}
+
+// Multiply_float operator
+func (cx *Quaternion) Multiply_float(right float32) Quaternion {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] *= right
+	}
+	return makeQuaternionComponents(v)
+}
+
+// Divide_float operator
+func (cx *Quaternion) Divide_float(right float32) Quaternion {
+	return cx.Multiply_float(1 / right)
+}
+
+// Equal_Quaternion operator
+func (cx *Quaternion) Equal_Quaternion(right Quaternion) bool {
+	return cx.nativeComponents() == right.nativeComponents()
+}
+
+// Not_equal_Quaternion operator
+func (cx *Quaternion) Not_equal_Quaternion(right Quaternion) bool {
+	return cx.nativeComponents() != right.nativeComponents()
+}
+
+// Add_Quaternion operator
+func (cx *Quaternion) Add_Quaternion(right Quaternion) Quaternion {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := range v {
+		v[i] += r[i]
+	}
+	return makeQuaternionComponents(v)
+}
+
+// Subtract_Quaternion operator
+func (cx *Quaternion) Subtract_Quaternion(right Quaternion) Quaternion {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := range v {
+		v[i] -= r[i]
+	}
+	return makeQuaternionComponents(v)
+}
+
+// Multiply_Quaternion operator
+func (cx *Quaternion) Multiply_Quaternion(right Quaternion) Quaternion {
+	x, y, z, w := cx.X, cx.Y, cx.Z, cx.W
+	qx, qy, qz, qw := right.X, right.Y, right.Z, right.W
+	return Quaternion{
+		float32(w*qx) + float32(x*qw) + float32(y*qz) - float32(z*qy),
+		float32(w*qy) + float32(y*qw) + float32(z*qx) - float32(x*qz),
+		float32(w*qz) + float32(z*qw) + float32(x*qy) - float32(y*qx),
+		float32(w*qw) - float32(x*qx) - float32(y*qy) - float32(z*qz),
+	}
+}
+
+/*
+ * Basis
+ */
+
+// nativeRows unpacks rows[3] of the engine's Basis.
+func (cx *Basis) nativeRows() (m [3][3]float32) {
+	for i, r := range cx.Rows {
+		m[i] = [3]float32{r.X, r.Y, r.Z}
+	}
+	return
+}
+
+func makeBasisRows(m [3][3]float32) Basis {
+	return Basis{Rows: [3]Vector3{
+		{m[0][0], m[0][1], m[0][2]},
+		{m[1][0], m[1][1], m[1][2]},
+		{m[2][0], m[2][1], m[2][2]},
+	}}
+}
+
+// basisTdot is Basis::tdotx, tdoty and tdotz for column j.
+func basisTdot(m *[3][3]float32, j int, v [3]float32) float32 {
+	return float32(m[0][j]*v[0]) + float32(m[1][j]*v[1]) + float32(m[2][j]*v[2])
+}
+
+// methods
+/* Transposed : transposed
+ * is_vararg = false, is_static = false
+ * goReturnType(Basis) -> Basis
+ */
+func (cx *Basis) Transposed() Basis {
+	m := cx.nativeRows()
+	m[0][1], m[1][0] = m[1][0], m[0][1]
+	m[0][2], m[2][0] = m[2][0], m[0][2]
+	m[1][2], m[2][1] = m[2][1], m[1][2]
+	return makeBasisRows(m)
+}
+
+/* Determinant : determinant
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Basis) Determinant() float32 {
+	m := cx.nativeRows()
+	return float32(m[0][0]*(float32(m[1][1]*m[2][2])-float32(m[2][1]*m[1][2]))) -
+		float32(m[1][0]*(float32(m[0][1]*m[2][2])-float32(m[2][1]*m[0][2]))) +
+		float32(m[2][0]*(float32(m[0][1]*m[1][2])-float32(m[1][1]*m[0][2])))
+}
+
+/* Scaled : scaled
+ * is_vararg = false, is_static = false
+ * goReturnType(Basis) -> Basis
+ */
+func (cx *Basis) Scaled(scale Vector3) Basis {
+	m := cx.nativeRows()
+	s := [3]float32{scale.X, scale.Y, scale.Z}
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] *= s[i]
+		}
+	}
+	return makeBasisRows(m)
+}
+
+/* GetScale : get_scale
+ * is_vararg = false, is_static = false
+ * goReturnType(Vector3) -> Vector3
+ */
+func (cx *Basis) GetScale() Vector3 {
+	detSign := mathSign(cx.Determinant())
+	m := cx.nativeRows()
+	var s [3]float32
+	for j := range s {
+		col := Vector3{m[0][j], m[1][j], m[2][j]}
+		s[j] = detSign * col.Length()
+	}
+	return Vector3{s[0], s[1], s[2]}
+}
+
+/* Tdotx : tdotx
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Basis) Tdotx(with Vector3) float32 {
+	m := cx.nativeRows()
+	return basisTdot(&m, 0, [3]float32{with.X, with.Y, with.Z})
+}
+
+/* Tdoty : tdoty
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Basis) Tdoty(with Vector3) float32 {
+	m := cx.nativeRows()
+	return basisTdot(&m, 1, [3]float32{with.X, with.Y, with.Z})
+}
+
+/* Tdotz : tdotz
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Basis) Tdotz(with Vector3) float32 {
+	m := cx.nativeRows()
+	return basisTdot(&m, 2, [3]float32{with.X, with.Y, with.Z})
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Basis) IsEqualApprox(b Basis) bool {
+	a, c := cx.nativeRows(), b.nativeRows()
+	for i := range a {
+		for j := range a[i] {
+			if !mathIsEqualApprox(a[i][j], c[i][j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+/* IsFinite : is_finite
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Basis) IsFinite() bool {
+	m := cx.nativeRows()
+	for i := range m {
+		for j := range m[i] {
+			if !mathIsFinite(m[i][j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// operators
+// Multiply_int operator
+func (cx *Basis) Multiply_int(right int64) Basis {
+	return cx.Multiply_float(float32(right))
+}
+
+// Divide_int operator
+func (cx *Basis) Divide_int(right int64) Basis {
+	return cx.Divide_float(float32(right))
+}
+
+// Multiply_float operator
+func (cx *Basis) Multiply_float(right float32) Basis {
+	m := cx.nativeRows()
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] *= right
+		}
+	}
+	return makeBasisRows(m)
+}
+
+// Divide_float operator
+func (cx *Basis) Divide_float(right float32) Basis {
+	m := cx.nativeRows()
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] /= right
+		}
+	}
+	return makeBasisRows(m)
+}
+
+// Multiply_Vector3 operator
+func (cx *Basis) Multiply_Vector3(right Vector3) Vector3 {
+	// Basis::xform
+	m := cx.nativeRows()
+	x, y, z := right.X, right.Y, right.Z
+	return Vector3{
+		float32(m[0][0]*x) + float32(m[0][1]*y) + float32(m[0][2]*z),
+		float32(m[1][0]*x) + float32(m[1][1]*y) + float32(m[1][2]*z),
+		float32(m[2][0]*x) + float32(m[2][1]*y) + float32(m[2][2]*z),
+	}
+}
+
+// Equal_Basis operator
+func (cx *Basis) Equal_Basis(right Basis) bool {
+	return cx.nativeRows() == right.nativeRows()
+}
+
+// Not_equal_Basis operator
+func (cx *Basis) Not_equal_Basis(right Basis) bool {
+	return cx.nativeRows() != right.nativeRows()
+}
+
+// Multiply_Basis operator
+func (cx *Basis) Multiply_Basis(right Basis) Basis {
+	a, b := cx.nativeRows(), right.nativeRows()
+	var m [3][3]float32
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] = basisTdot(&b, j, a[i])
+		}
+	}
+	return makeBasisRows(m)
+}
+
+/*
+ * Color
+ */
+
+func (cx *Color) nativeComponents() [4]float32 {
+	return [4]float32{cx.R, cx.G, cx.B, cx.A}
+}
+
+func makeColorComponents(v [4]float32) Color {
+	return Color{v[0], v[1], v[2], v[3]}
+}
+
+// methods
+/* Clamp : clamp
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Clamp(min Color, max Color) Color {
+	v, lo, hi := cx.nativeComponents(), min.nativeComponents(), max.nativeComponents()
+	for i := range v {
+		v[i] = mathClamp(v[i], lo[i], hi[i])
+	}
+	return makeColorComponents(v)
+}
+
+/* Inverted : inverted
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Inverted() Color {
+	return Color{1 - cx.R, 1 - cx.G, 1 - cx.B, cx.A}
+}
+
+/* Lerp : lerp
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Lerp(to Color, weight float32) Color {
+	v, t := cx.nativeComponents(), to.nativeComponents()
+	for i := range v {
+		v[i] = mathLerp(v[i], t[i], weight)
+	}
+	return makeColorComponents(v)
+}
+
+/* Lightened : lightened
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Lightened(amount float32) Color {
+	v := cx.nativeComponents()
+	for i := 0; i < 3; i++ {
+		v[i] = v[i] + float32((1-v[i])*amount)
+	}
+	return makeColorComponents(v)
+}
+
+/* Darkened : darkened
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Darkened(amount float32) Color {
+	v := cx.nativeComponents()
+	for i := 0; i < 3; i++ {
+		v[i] = v[i] * (1 - amount)
+	}
+	return makeColorComponents(v)
+}
+
+/* Blend : blend
+ * is_vararg = false, is_static = false
+ * goReturnType(Color) -> Color
+ */
+func (cx *Color) Blend(over Color) Color {
+	v, o := cx.nativeComponents(), over.nativeComponents()
+	sa := 1 - o[3]
+	a := float32(v[3]*sa) + o[3]
+	if a == 0 {
+		return Color{}
+	}
+	var res [4]float32
+	for i := 0; i < 3; i++ {
+		res[i] = (float32(float32(v[i]*v[3])*sa) + float32(o[i]*o[3])) / a
+	}
+	res[3] = a
+	return makeColorComponents(res)
+}
+
+/* GetLuminance : get_luminance
+ * is_vararg = false, is_static = false
+ * goReturnType(float) -> float32
+ */
+func (cx *Color) GetLuminance() float32 {
+	return float32(0.2126*cx.R) + float32(0.7152*cx.G) + float32(0.0722*cx.B)
+}
+
+/* IsEqualApprox : is_equal_approx
+ * is_vararg = false, is_static = false
+ * goReturnType(bool) -> bool
+ */
+func (cx *Color) IsEqualApprox(to Color) bool {
+	v, t := cx.nativeComponents(), to.nativeComponents()
+	for i := range v {
+		if !mathIsEqualApprox(v[i], t[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// operators
+// Negate operator
+func (cx *Color) Negate() Color {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] = 1 - v[i]
+	}
+	return makeColorComponents(v)
+}
+
+// Positive operator
+func (cx *Color) Positive() Color {
+	return *cx
+}
+
+// Multiply_int operator
+func (cx *Color) Multiply_int(right int64) Color {
+	return cx.Multiply_float(float32(right))
+}
+
+// Divide_int operator
+func (cx *Color) Divide_int(right int64) Color {
+	return cx.Divide_float(float32(right))
+}
+
+// Multiply_float operator
+func (cx *Color) Multiply_float(right float32) Color {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] *= right
+	}
+	return makeColorComponents(v)
+}
+
+// Divide_float operator
+func (cx *Color) Divide_float(right float32) Color {
+	v := cx.nativeComponents()
+	for i := range v {
+		v[i] /= right
+	}
+	return makeColorComponents(v)
+}
+
+// Equal_Color operator
+func (cx *Color) Equal_Color(right Color) bool {
+	return cx.nativeComponents() == right.nativeComponents()
+}
+
+// Not_equal_Color operator
+func (cx *Color) Not_equal_Color(right Color) bool {
+	return cx.nativeComponents() != right.nativeComponents()
+}
+
+// Add_Color operator
+func (cx *Color) Add_Color(right Color) Color {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := range v {
+		v[i] += r[i]
+	}
+	return makeColorComponents(v)
+}
+
+// Subtract_Color operator
+func (cx *Color) Subtract_Color(right Color) Color {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := range v {
+		v[i] -= r[i]
+	}
+	return makeColorComponents(v)
+}
+
+// Multiply_Color operator
+func (cx *Color) Multiply_Color(right Color) Color {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := range v {
+		v[i] *= r[i]
+	}
+	return makeColorComponents(v)
+}
+
+// Divide_Color operator
+func (cx *Color) Divide_Color(right Color) Color {
+	v, r := cx.nativeComponents(), right.nativeComponents()
+	for i := range v {
+		v[i] /= r[i]
+	}
+	return makeColorComponents(v)
+}
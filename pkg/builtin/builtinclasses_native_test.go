@@ -0,0 +1,157 @@
+//go:build !cgomath
+
+package builtin
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The expected bit patterns were produced by evaluating the engine's
+// core/math formulas in single precision C, compiled with -ffp-contract=off.
+func TestNativeMathBitCompatible(t *testing.T) {
+	v2 := Vector2{1.3, -2.7}
+	v2to := Vector2{4.25, 0.5}
+	a3 := Vector3{0.25, -1.5, 3.125}
+	b3 := Vector3{2.0 / 3.0, 0.1, -0.9}
+	q1 := Quaternion{0.1, 0.2, 0.3, 0.9}
+	q2 := Quaternion{-0.4, 0.5, 0.6, 0.2}
+	basis := makeBasisRows([3][3]float32{
+		{1.5, 0.25, -0.75},
+		{0.1, 2.0, 0.3},
+		{-0.2, 0.4, 0.9},
+	})
+	bv := Vector3{0.3, -1.1, 2.2}
+	xf := makeTransform2DColumns([3][2]float32{{0.8, 0.6}, {-1.2, 1.6}, {5.5, -3.25}})
+	c := Color{0.2, 0.4, 0.6, 0.5}
+	over := Color{0.9, 0.1, 0.3, 0.25}
+
+	tests := []struct {
+		name string
+		got  func() float32
+		want uint32
+	}{
+		{"Vector2.Length", func() float32 { return v2.Length() }, 0x403fc95c},
+		{"Vector2.Normalized.x", func() float32 { n := v2.Normalized(); return n.X }, 0x3ede1d13},
+		{"Vector2.Normalized.y", func() float32 { n := v2.Normalized(); return n.Y }, 0xbf66a80b},
+		{"Vector2.DistanceTo", func() float32 { return v2.DistanceTo(v2to) }, 0x408b4607},
+		{"Vector2.Lerp.x", func() float32 { r := v2.Lerp(v2to, 0.3); return r.X }, 0x400bd70a},
+		{"Vector2.Lerp.y", func() float32 { r := v2.Lerp(v2to, 0.3); return r.Y }, 0xbfdeb852},
+		{"Vector2.MoveToward.x", func() float32 { r := v2.MoveToward(v2to, 1.5); return r.X }, 0x401444e2},
+		{"Vector2.MoveToward.y", func() float32 { r := v2.MoveToward(v2to, 1.5); return r.Y }, 0xbfcc6ee7},
+		{"Vector3.Cross.x", func() float32 { r := a3.Cross(b3); return r.X }, 0x3f84cccc},
+		{"Vector3.Cross.y", func() float32 { r := a3.Cross(b3); return r.Y }, 0x4013bbbc},
+		{"Vector3.Cross.z", func() float32 { r := a3.Cross(b3); return r.Z }, 0x3f833333},
+		{"Vector3.Normalized.z", func() float32 { r := a3.Normalized(); return r.Z }, 0x3f663127},
+		{"Quaternion.Multiply.x", func() float32 { r := q1.Multiply_Quaternion(q2); return r.X }, 0xbebd70a3},
+		{"Quaternion.Multiply.y", func() float32 { r := q1.Multiply_Quaternion(q2); return r.Y }, 0x3e9eb851},
+		{"Quaternion.Multiply.z", func() float32 { r := q1.Multiply_Quaternion(q2); return r.Z }, 0x3f3ae148},
+		{"Quaternion.Multiply.w", func() float32 { r := q1.Multiply_Quaternion(q2); return r.W }, 0xbd75c292},
+		{"Quaternion.Normalized.x", func() float32 { r := q1.Normalized(); return r.X }, 0x3dd21ed0},
+		{"Quaternion.Normalized.w", func() float32 { r := q1.Normalized(); return r.W }, 0x3f6c62aa},
+		{"Basis.Determinant", func() float32 { return basis.Determinant() }, 0x4009c28f},
+		{"Basis.Multiply_Vector3.y", func() float32 { r := basis.Multiply_Vector3(bv); return r.Y }, 0xbfc147ae},
+		{"Vector3.Multiply_Basis.z", func() float32 { r := bv.Multiply_Basis(basis); return r.Z }, 0x3fb66666},
+		{"Basis.Multiply_Basis[2][1]", func() float32 { r := basis.Multiply_Basis(basis); return r.nativeRows()[2][1] }, 0x3f8e147b},
+		{"Transform2D.Multiply_Vector2.x", func() float32 { r := xf.Multiply_Vector2(Vector2{2.5, -0.5}); return r.X }, 0x4101999a},
+		{"Transform2D.Multiply_Vector2.y", func() float32 { r := xf.Multiply_Vector2(Vector2{2.5, -0.5}); return r.Y }, 0xc0233333},
+		{"Transform2D.Determinant", func() float32 { return xf.Determinant() }, 0x40000000},
+		{"Color.Blend.r", func() float32 { r := c.Blend(over); return r.R }, 0x3ef5c290},
+		{"Color.Blend.a", func() float32 { r := c.Blend(over); return r.A }, 0x3f200000},
+		{"Color.GetLuminance", func() float32 { return c.GetLuminance() }, 0x3ebe6c4c},
+		{"Color.Lightened.g", func() float32 { r := c.Lightened(0.35); return r.G }, 0x3f1c28f6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.got()
+			assert.Equalf(t, tt.want, math.Float32bits(got), "got %v", got)
+		})
+	}
+}
+
+func TestNativeMathComparisons(t *testing.T) {
+	nan := float32(math.NaN())
+
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"Vector2 equal", ptr(Vector2{1, 2}).Equal_Vector2(Vector2{1, 2}), true},
+		{"Vector2 NaN equal", ptr(Vector2{nan, 2}).Equal_Vector2(Vector2{nan, 2}), false},
+		{"Vector2 NaN not equal", ptr(Vector2{nan, 2}).Not_equal_Vector2(Vector2{nan, 2}), true},
+		{"Vector2 less on y", ptr(Vector2{1, 2}).Less_Vector2(Vector2{1, 3}), true},
+		{"Vector2 less on x", ptr(Vector2{2, 0}).Less_Vector2(Vector2{1, 3}), false},
+		{"Vector3 less equal on z", ptr(Vector3{1, 2, 3}).Less_equal_Vector3(Vector3{1, 2, 3}), true},
+		{"Vector4 greater on w", ptr(Vector4{1, 2, 3, 5}).Greater_Vector4(Vector4{1, 2, 3, 4}), true},
+		{"Vector2 is normalized", ptr(Vector2{0.6, 0.8}).IsNormalized(), true},
+		{"Vector2 is equal approx", ptr(Vector2{1, 1}).IsEqualApprox(Vector2{1.000001, 1}), true},
+		{"Vector3 is zero approx", ptr(Vector3{0, 0.000001, 0}).IsZeroApprox(), true},
+		{"Vector4 is finite", ptr(Vector4{0, float32(math.Inf(1)), 0, 0}).IsFinite(), false},
+		{"Rect2 has point on begin", ptr(makeRect2Bounds(0, 0, 2, 2)).HasPoint(Vector2{0, 0}), true},
+		{"Rect2 has point on end", ptr(makeRect2Bounds(0, 0, 2, 2)).HasPoint(Vector2{2, 1}), false},
+		{"Rect2 touching without borders", ptr(makeRect2Bounds(0, 0, 2, 2)).Intersects(makeRect2Bounds(2, 0, 1, 1), false), false},
+		{"Rect2 touching with borders", ptr(makeRect2Bounds(0, 0, 2, 2)).Intersects(makeRect2Bounds(2, 0, 1, 1), true), true},
+		{"Rect2 encloses", ptr(makeRect2Bounds(0, 0, 4, 4)).Encloses(makeRect2Bounds(1, 1, 2, 3)), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestNativeMathRect2(t *testing.T) {
+	r := makeRect2Bounds(0, 0, 4, 2)
+
+	tests := []struct {
+		name string
+		got  Rect2
+		want Rect2
+	}{
+		{"intersection", r.Intersection(makeRect2Bounds(3, 1, 4, 4)), makeRect2Bounds(3, 1, 1, 1)},
+		{"intersection empty", r.Intersection(makeRect2Bounds(5, 5, 1, 1)), Rect2{}},
+		{"merge", r.Merge(makeRect2Bounds(-1, 1, 2, 4)), makeRect2Bounds(-1, 0, 5, 5)},
+		{"expand", r.Expand(Vector2{-2, 3}), makeRect2Bounds(-2, 0, 6, 3)},
+		{"grow", r.Grow(1), makeRect2Bounds(-1, -1, 6, 4)},
+		{"grow individual", r.GrowIndividual(1, 2, 3, 4), makeRect2Bounds(-1, -2, 8, 8)},
+		{"abs", ptr(makeRect2Bounds(2, 2, -3, -1)).Abs(), makeRect2Bounds(-1, 1, 3, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestNativeMathTransform2D(t *testing.T) {
+	// a quarter turn
+	xf := makeTransform2DColumns([3][2]float32{{0, 1}, {-1, 0}, {0, 0}})
+	xf = xf.Translated(Vector2{3, 4})
+
+	inv := xf.Inverse()
+	identity := xf.Multiply_Transform2D(inv)
+	assert.True(t, identity.IsEqualApprox(makeTransform2DColumns([3][2]float32{{1, 0}, {0, 1}, {0, 0}})))
+
+	p := Vector2{1, 2}
+	q := xf.Multiply_Vector2(p)
+	back := q.Multiply_Transform2D(xf)
+	assert.True(t, back.IsEqualApprox(p))
+
+	// local scaling applies to the basis columns, global scaling to the rows
+	scaled := xf.ScaledLocal(Vector2{2, -3})
+	scale := scaled.GetScale()
+	assert.True(t, scale.IsEqualApprox(Vector2{2, -3}))
+	scaled = xf.Scaled(Vector2{2, -3})
+	scale = scaled.GetScale()
+	assert.True(t, scale.IsEqualApprox(Vector2{3, -2}))
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,46 @@
+package builtin
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltinStructLiterals(t *testing.T) {
+	xf := Transform3D{
+		Basis:  Basis{Rows: [3]Vector3{{X: 1}, {Y: 1}, {Z: 1}}},
+		Origin: Vector3{1, 2, 3},
+	}
+	assert.Equal(t, float32(2), xf.Origin.Y)
+	assert.True(t, xf == Transform3D{Basis: xf.Basis, Origin: Vector3{1, 2, 3}})
+	assert.False(t, Rect2{Size: Vector2{1, 1}} == Rect2{})
+	assert.Equal(t, Vector2i{3, 4}, Rect2i{Position: Vector2i{3, 4}}.Position)
+}
+
+func TestBasisColumns(t *testing.T) {
+	b := Basis{Rows: [3]Vector3{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}}
+	assert.Equal(t, Vector3{1, 4, 7}, b.X())
+	assert.Equal(t, Vector3{2, 5, 8}, b.Y())
+	assert.Equal(t, Vector3{3, 6, 9}, b.Z())
+
+	b.SetY(Vector3{-1, -2, -3})
+	assert.Equal(t, Vector3{-1, -2, -3}, b.Y())
+	assert.Equal(t, Vector3{4, -2, 6}, b.Rows[1])
+}
+
+func TestBuiltinStructMatchesEngineMemory(t *testing.T) {
+	// the engine reads and writes these through NativePtr, so the fields have
+	// to sit exactly where builtin_class_member_offsets says they are
+	c := Color{R: 0.25, G: 0.5, B: 0.75, A: 1}
+	raw := (*[ColorSize / 4]float32)(unsafe.Pointer(c.NativePtr()))
+	assert.Equal(t, [4]float32{0.25, 0.5, 0.75, 1}, *raw)
+
+	p := Plane{Normal: Vector3{0, 1, 0}, D: 5}
+	rawPlane := (*[PlaneSize / 4]float32)(unsafe.Pointer(p.NativePtr()))
+	assert.Equal(t, [4]float32{0, 1, 0, 5}, *rawPlane)
+}
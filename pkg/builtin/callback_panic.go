@@ -0,0 +1,27 @@
+package builtin
+
+import (
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// CallbackPanicHandler is called with the value recovered from a panic of an
+// engine callback of this package or of pkg/gdclassinit. pkg/core sets it to
+// report the panic and apply its PanicPolicy.
+var CallbackPanicHandler func(r any, callback string)
+
+// RecoverCallback recovers a panic of a callback called by the engine, which
+// would otherwise unwind into the engine, and passes it to
+// CallbackPanicHandler. It must be deferred directly by the callback.
+func RecoverCallback(callback string) {
+	if r := recover(); r != nil {
+		if CallbackPanicHandler == nil {
+			log.Error("panic in Go callback",
+				zap.String("callback", callback),
+				zap.Any("panic", r),
+			)
+			return
+		}
+		CallbackPanicHandler(r, callback)
+	}
+}
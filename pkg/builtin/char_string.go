@@ -17,7 +17,6 @@ import (
 
 func NewStringNameWithGDExtensionConstStringNamePtr(ptr GDExtensionConstStringNamePtr) StringName {
 	cx := StringName{}
-	pnr.Pin(&cx)
 	typedSrc := (*[StringNameSize]uint8)(ptr)
 	for i := 0; i < StringNameSize; i++ {
 		cx[i] = typedSrc[i]
@@ -29,7 +28,6 @@ func NewStringNameWithLatin1Chars(content string) StringName {
 	cx := String{}
 	defer cx.Destroy()
 	ptr := (GDExtensionUninitializedStringPtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	// log.Debug("create string name",
 	// 	zap.String("ptr", fmt.Sprintf("%p", ptr)),
 	// 	zap.Uintptr("ptr_int", uintptr(ptr)),
@@ -50,7 +48,6 @@ func NewStringNameWithUtf8Chars(content string) StringName {
 	cx := String{}
 	defer cx.Destroy()
 	ptr := (GDExtensionUninitializedStringPtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	log.Debug("create string name",
 		zap.String("ptr", fmt.Sprintf("%p", ptr)),
 		zap.Uintptr("ptr_int", uintptr(unsafe.Pointer(ptr))),
@@ -73,9 +70,7 @@ func (cx StringName) ToUtf8() string {
 }
 
 func (cx *StringName) AsGDExtensionConstStringNamePtr() GDExtensionConstStringNamePtr {
-	ptr := (GDExtensionConstStringNamePtr)(cx)
-	pnr.Pin(ptr)
-	return ptr
+	return (GDExtensionConstStringNamePtr)(cx)
 }
 
 func GDExtensionStringPtrWithUtf8Chars(ptr GDExtensionStringPtr, content string) {
@@ -89,7 +84,6 @@ func GDExtensionStringPtrWithLatin1Chars(ptr GDExtensionStringPtr, content strin
 func NewStringWithLatin1Chars(content string) String {
 	cx := String{}
 	ptr := (GDExtensionUninitializedStringPtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionInterfaceStringNewWithLatin1Chars(ptr, content)
 	return cx
 }
@@ -97,7 +91,6 @@ func NewStringWithLatin1Chars(content string) String {
 func NewStringWithUtf8Chars(content string) String {
 	cx := String{}
 	ptr := (GDExtensionUninitializedStringPtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionInterfaceStringNewWithUtf8Chars(ptr, content)
 	return cx
 }
@@ -105,24 +98,19 @@ func NewStringWithUtf8Chars(content string) String {
 func NewStringWithUtf32Char(content Char32T) String {
 	cx := String{}
 	ptr := (GDExtensionUninitializedStringPtr)(cx.NativePtr())
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionInterfaceStringNewWithUtf32Chars(ptr, &content)
 	return cx
 }
 
 func (cx *String) AsGDExtensionConstStringPtr() GDExtensionConstStringPtr {
-	ptr := (GDExtensionConstStringPtr)(cx)
-	pnr.Pin(ptr)
-	return ptr
+	return (GDExtensionConstStringPtr)(cx)
 }
 
 func (cx String) ToAscii() string {
 	ptr := (GDExtensionConstStringPtr)(cx.NativeConstPtr())
-	pnr.Pin(ptr)
 	size := CallFunc_GDExtensionInterfaceStringToLatin1Chars(ptr, (*Char)(nullptr), (GDExtensionInt)(0))
 	cstrSlice := make([]C.char, int(size)+1)
 	cstr := unsafe.SliceData(cstrSlice)
-	pnr.Pin(cstr)
 	CallFunc_GDExtensionInterfaceStringToLatin1Chars((GDExtensionConstStringPtr)(ptr), (*Char)(cstr), (GDExtensionInt)(size+1))
 	ret := C.GoString(cstr)[:]
 	return ret
@@ -130,7 +118,6 @@ func (cx String) ToAscii() string {
 
 func (cx String) ToUtf8() string {
 	ptr := (GDExtensionConstStringPtr)(cx.NativeConstPtr())
-	pnr.Pin(ptr)
 	size := CallFunc_GDExtensionInterfaceStringToUtf8Chars(ptr, (*Char)(nullptr), (GDExtensionInt)(0))
 	cstrSlice := make([]C.char, int(size)+1)
 	cstr := unsafe.SliceData(cstrSlice)
@@ -141,7 +128,6 @@ func (cx String) ToUtf8() string {
 	// 	}
 	// 	CallFunc_GDExtensionPtrDestructor(stringDestructor, (GDExtensionTypePtr)(cstr))
 	// }()
-	pnr.Pin(cstr)
 	CallFunc_GDExtensionInterfaceStringToUtf8Chars(ptr, (*Char)(cstr), (GDExtensionInt)(size+1))
 	ret := C.GoString(cstr)[:]
 	return ret
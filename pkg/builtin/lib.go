@@ -8,7 +8,6 @@ package builtin
 import "C"
 
 import (
-	"runtime"
 	"unsafe"
 
 	. "github.com/godot-go/godot-go/pkg/ffi"
@@ -31,15 +30,9 @@ var (
 	variantFromTypeConstructor                            [GDEXTENSION_VARIANT_TYPE_VARIANT_MAX]GDExtensionVariantFromTypeConstructorFunc
 	typeFromVariantConstructor                            [GDEXTENSION_VARIANT_TYPE_VARIANT_MAX]GDExtensionTypeFromVariantConstructorFunc
 	nullptr                                               = unsafe.Pointer(nil)
-	GDExtensionBindingGDExtensionInstanceBindingCallbacks = NewSyncMap[string, GDExtensionInstanceBindingCallbacks]()
-	pnr                                                   = runtime.Pinner{}
+	GDExtensionBindingGDExtensionInstanceBindingCallbacks = NewSyncMap[string, *GDExtensionInstanceBindingCallbacks]()
 )
 
-// UnpinBuiltinPins releases pins held for the extension lifetime during shutdown.
-func UnpinBuiltinPins() {
-	pnr.Unpin()
-}
-
 func GDClassRegisterInstanceBindingCallbacks(tn string) {
 	// substitute for:
 	// static constexpr GDExtensionInstanceBindingCallbacks ___binding_callbacks = {
@@ -50,9 +43,6 @@ func GDClassRegisterInstanceBindingCallbacks(tn string) {
 	createPtr := (*[0]byte)(C.cgo_gdclass_binding_create_callback)
 	freePtr := (*[0]byte)(C.cgo_gdclass_binding_free_callback)
 	referencePtr := (*[0]byte)(C.cgo_gdclass_binding_reference_callback)
-	pnr.Pin(createPtr)
-	pnr.Pin(freePtr)
-	pnr.Pin(referencePtr)
 	cbs := NewGDExtensionInstanceBindingCallbacks(
 		createPtr,
 		freePtr,
@@ -62,5 +52,5 @@ func GDClassRegisterInstanceBindingCallbacks(tn string) {
 	if ok {
 		log.Panic("Class with the same name already initialized", zap.String("class", tn))
 	}
-	GDExtensionBindingGDExtensionInstanceBindingCallbacks.Set(tn, (GDExtensionInstanceBindingCallbacks)(cbs))
+	GDExtensionBindingGDExtensionInstanceBindingCallbacks.Set(tn, cbs)
 }
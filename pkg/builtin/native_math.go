@@ -0,0 +1,109 @@
+//go:build !cgomath
+
+package builtin
+
+import (
+	"math"
+)
+
+// Single precision helpers mirroring core/math/math_funcs.h. They back the
+// pure Go implementations in builtinclasses.native.gen.go, which are only
+// compiled when the cgomath build tag is absent.
+//
+// Products that feed into a sum are wrapped in an explicit float32
+// conversion throughout: the Go spec allows x*y+z to be fused into a single
+// FMA instruction on some architectures, which would round differently from
+// the engine.
+
+const (
+	cmpEpsilon  = 0.00001
+	unitEpsilon = 0.001
+)
+
+func mathSqrt(x float32) float32 {
+	return float32(math.Sqrt(float64(x)))
+}
+
+func mathAbs(x float32) float32 {
+	return float32(math.Abs(float64(x)))
+}
+
+func mathFloor(x float32) float32 {
+	return float32(math.Floor(float64(x)))
+}
+
+func mathCeil(x float32) float32 {
+	return float32(math.Ceil(float64(x)))
+}
+
+// mathRound rounds half away from zero, like roundf.
+func mathRound(x float32) float32 {
+	return float32(math.Round(float64(x)))
+}
+
+// mathMin, mathMax, mathClamp and mathSign follow the MIN, MAX, CLAMP and
+// SIGN macros exactly, including their behavior for NaN and signed zeros.
+func mathMin(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func mathMax(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func mathClamp(a, lo, hi float32) float32 {
+	if a < lo {
+		return lo
+	}
+	if a > hi {
+		return hi
+	}
+	return a
+}
+
+func mathSign(x float32) float32 {
+	if x == 0 {
+		return 0
+	}
+	if x < 0 {
+		return -1
+	}
+	return 1
+}
+
+func mathLerp(from, to, weight float32) float32 {
+	return from + float32((to-from)*weight)
+}
+
+func mathIsEqualApprox(a, b float32) bool {
+	// exact equality is required to handle infinities
+	if a == b {
+		return true
+	}
+	tolerance := float32(cmpEpsilon) * mathAbs(a)
+	if tolerance < float32(cmpEpsilon) {
+		tolerance = float32(cmpEpsilon)
+	}
+	return mathAbs(a-b) < tolerance
+}
+
+func mathIsEqualApproxTolerance(a, b, tolerance float32) bool {
+	if a == b {
+		return true
+	}
+	return mathAbs(a-b) < tolerance
+}
+
+func mathIsZeroApprox(x float32) bool {
+	return mathAbs(x) < float32(cmpEpsilon)
+}
+
+func mathIsFinite(x float32) bool {
+	return !math.IsInf(float64(x), 0) && !math.IsNaN(float64(x))
+}
@@ -71,15 +71,11 @@ func (cx *TypedRef[T]) IsValid() bool {
 func NewTypedRef[T TypedRefT](reference T) *TypedRef[T] {
 	ref := TypedRef[T]{}
 	ref.RefPointer(reference)
-	ptr := &ref
-	pnr.Pin(ptr)
-	return ptr
+	return &ref
 }
 
 func NewTypedRefGDExtensionIternalConstructor[T TypedRefT](reference T) *TypedRef[T] {
 	ref := TypedRef[T]{}
 	ref.Reference = reference
-	ptr := &ref
-	pnr.Pin(ptr)
-	return ptr
+	return &ref
 }
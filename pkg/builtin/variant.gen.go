@@ -9,7 +9,6 @@ import (
 func NewVariantBool(v bool) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromBool(v, ptr)
 	return ret
 }
@@ -30,7 +29,6 @@ func (c Variant) ToBool() bool {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_BOOL]
 	var v uint8
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -44,7 +42,6 @@ func (c Variant) ToBool() bool {
 func NewVariantUint(v uint) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromUint(v, ptr)
 	return ret
 }
@@ -65,7 +62,6 @@ func (c Variant) ToUint() uint {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_INT]
 	var v int64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -79,7 +75,6 @@ func (c Variant) ToUint() uint {
 func NewVariantInt(v int) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromInt(v, ptr)
 	return ret
 }
@@ -100,7 +95,6 @@ func (c Variant) ToInt() int {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_INT]
 	var v int64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -114,7 +108,6 @@ func (c Variant) ToInt() int {
 func NewVariantUint8(v uint8) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromUint8(v, ptr)
 	return ret
 }
@@ -135,7 +128,6 @@ func (c Variant) ToUint8() uint8 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_INT]
 	var v int64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -149,7 +141,6 @@ func (c Variant) ToUint8() uint8 {
 func NewVariantInt8(v int8) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromInt8(v, ptr)
 	return ret
 }
@@ -170,7 +161,6 @@ func (c Variant) ToInt8() int8 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_INT]
 	var v int64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -184,7 +174,6 @@ func (c Variant) ToInt8() int8 {
 func NewVariantUint16(v uint16) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromUint16(v, ptr)
 	return ret
 }
@@ -205,7 +194,6 @@ func (c Variant) ToUint16() uint16 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_INT]
 	var v int64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -219,7 +207,6 @@ func (c Variant) ToUint16() uint16 {
 func NewVariantInt16(v int16) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromInt16(v, ptr)
 	return ret
 }
@@ -240,7 +227,6 @@ func (c Variant) ToInt16() int16 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_INT]
 	var v int64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -254,7 +240,6 @@ func (c Variant) ToInt16() int16 {
 func NewVariantUint32(v uint32) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromUint32(v, ptr)
 	return ret
 }
@@ -275,7 +260,6 @@ func (c Variant) ToUint32() uint32 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_INT]
 	var v int64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -289,7 +273,6 @@ func (c Variant) ToUint32() uint32 {
 func NewVariantInt32(v int32) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromInt32(v, ptr)
 	return ret
 }
@@ -310,7 +293,6 @@ func (c Variant) ToInt32() int32 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_INT]
 	var v int64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -324,7 +306,6 @@ func (c Variant) ToInt32() int32 {
 func NewVariantUint64(v uint64) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromUint64(v, ptr)
 	return ret
 }
@@ -345,7 +326,6 @@ func (c Variant) ToUint64() uint64 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_INT]
 	var v int64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -359,7 +339,6 @@ func (c Variant) ToUint64() uint64 {
 func NewVariantInt64(v int64) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromInt64(v, ptr)
 	return ret
 }
@@ -380,7 +359,6 @@ func (c Variant) ToInt64() int64 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_INT]
 	var v int64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -394,7 +372,6 @@ func (c Variant) ToInt64() int64 {
 func NewVariantFloat32(v float32) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromFloat32(v, ptr)
 	return ret
 }
@@ -415,7 +392,6 @@ func (c Variant) ToFloat32() float32 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_FLOAT]
 	var v float64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -429,7 +405,6 @@ func (c Variant) ToFloat32() float32 {
 func NewVariantFloat64(v float64) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromFloat64(v, ptr)
 	return ret
 }
@@ -450,7 +425,6 @@ func (c Variant) ToFloat64() float64 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_FLOAT]
 	var v float64
 	ptr := (GDExtensionTypePtr)(&v)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -464,7 +438,6 @@ func (c Variant) ToFloat64() float64 {
 func NewVariantString(v String) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromString(v, ptr)
 	return ret
 }
@@ -472,7 +445,6 @@ func NewVariantString(v String) Variant {
 func GDExtensionVariantPtrFromString(v String, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded String
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	StringEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_STRING]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -486,7 +458,6 @@ func (c Variant) ToString() String {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_STRING]
 	var v String
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -498,7 +469,6 @@ func (c Variant) ToString() String {
 func NewVariantVector2(v Vector2) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromVector2(v, ptr)
 	return ret
 }
@@ -506,7 +476,6 @@ func NewVariantVector2(v Vector2) Variant {
 func GDExtensionVariantPtrFromVector2(v Vector2, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Vector2
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	Vector2Encoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR2]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -520,7 +489,6 @@ func (c Variant) ToVector2() Vector2 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR2]
 	var v Vector2
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -532,7 +500,6 @@ func (c Variant) ToVector2() Vector2 {
 func NewVariantVector2i(v Vector2i) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromVector2i(v, ptr)
 	return ret
 }
@@ -540,7 +507,6 @@ func NewVariantVector2i(v Vector2i) Variant {
 func GDExtensionVariantPtrFromVector2i(v Vector2i, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Vector2i
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	Vector2iEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR2I]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -554,7 +520,6 @@ func (c Variant) ToVector2i() Vector2i {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR2I]
 	var v Vector2i
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -566,7 +531,6 @@ func (c Variant) ToVector2i() Vector2i {
 func NewVariantVector3(v Vector3) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromVector3(v, ptr)
 	return ret
 }
@@ -574,7 +538,6 @@ func NewVariantVector3(v Vector3) Variant {
 func GDExtensionVariantPtrFromVector3(v Vector3, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Vector3
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	Vector3Encoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR3]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -588,7 +551,6 @@ func (c Variant) ToVector3() Vector3 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR3]
 	var v Vector3
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -600,7 +562,6 @@ func (c Variant) ToVector3() Vector3 {
 func NewVariantVector3i(v Vector3i) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromVector3i(v, ptr)
 	return ret
 }
@@ -608,7 +569,6 @@ func NewVariantVector3i(v Vector3i) Variant {
 func GDExtensionVariantPtrFromVector3i(v Vector3i, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Vector3i
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	Vector3iEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR3I]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -622,7 +582,6 @@ func (c Variant) ToVector3i() Vector3i {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR3I]
 	var v Vector3i
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -634,7 +593,6 @@ func (c Variant) ToVector3i() Vector3i {
 func NewVariantTransform2D(v Transform2D) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromTransform2D(v, ptr)
 	return ret
 }
@@ -642,7 +600,6 @@ func NewVariantTransform2D(v Transform2D) Variant {
 func GDExtensionVariantPtrFromTransform2D(v Transform2D, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Transform2D
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	Transform2DEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_TRANSFORM2D]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -656,7 +613,6 @@ func (c Variant) ToTransform2D() Transform2D {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_TRANSFORM2D]
 	var v Transform2D
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -668,7 +624,6 @@ func (c Variant) ToTransform2D() Transform2D {
 func NewVariantVector4(v Vector4) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromVector4(v, ptr)
 	return ret
 }
@@ -676,7 +631,6 @@ func NewVariantVector4(v Vector4) Variant {
 func GDExtensionVariantPtrFromVector4(v Vector4, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Vector4
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	Vector4Encoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR4]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -690,7 +644,6 @@ func (c Variant) ToVector4() Vector4 {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR4]
 	var v Vector4
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -702,7 +655,6 @@ func (c Variant) ToVector4() Vector4 {
 func NewVariantVector4i(v Vector4i) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromVector4i(v, ptr)
 	return ret
 }
@@ -710,7 +662,6 @@ func NewVariantVector4i(v Vector4i) Variant {
 func GDExtensionVariantPtrFromVector4i(v Vector4i, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Vector4i
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	Vector4iEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR4I]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -724,7 +675,6 @@ func (c Variant) ToVector4i() Vector4i {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_VECTOR4I]
 	var v Vector4i
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -736,7 +686,6 @@ func (c Variant) ToVector4i() Vector4i {
 func NewVariantPlane(v Plane) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromPlane(v, ptr)
 	return ret
 }
@@ -744,7 +693,6 @@ func NewVariantPlane(v Plane) Variant {
 func GDExtensionVariantPtrFromPlane(v Plane, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Plane
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	PlaneEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PLANE]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -758,7 +706,6 @@ func (c Variant) ToPlane() Plane {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PLANE]
 	var v Plane
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -770,7 +717,6 @@ func (c Variant) ToPlane() Plane {
 func NewVariantQuaternion(v Quaternion) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromQuaternion(v, ptr)
 	return ret
 }
@@ -778,7 +724,6 @@ func NewVariantQuaternion(v Quaternion) Variant {
 func GDExtensionVariantPtrFromQuaternion(v Quaternion, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Quaternion
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	QuaternionEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_QUATERNION]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -792,7 +737,6 @@ func (c Variant) ToQuaternion() Quaternion {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_QUATERNION]
 	var v Quaternion
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -804,7 +748,6 @@ func (c Variant) ToQuaternion() Quaternion {
 func NewVariantAABB(v AABB) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromAABB(v, ptr)
 	return ret
 }
@@ -812,7 +755,6 @@ func NewVariantAABB(v AABB) Variant {
 func GDExtensionVariantPtrFromAABB(v AABB, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded AABB
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	AABBEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_AABB]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -826,7 +768,6 @@ func (c Variant) ToAABB() AABB {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_AABB]
 	var v AABB
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -838,7 +779,6 @@ func (c Variant) ToAABB() AABB {
 func NewVariantBasis(v Basis) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromBasis(v, ptr)
 	return ret
 }
@@ -846,7 +786,6 @@ func NewVariantBasis(v Basis) Variant {
 func GDExtensionVariantPtrFromBasis(v Basis, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Basis
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	BasisEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_BASIS]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -860,7 +799,6 @@ func (c Variant) ToBasis() Basis {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_BASIS]
 	var v Basis
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -872,7 +810,6 @@ func (c Variant) ToBasis() Basis {
 func NewVariantTransform3D(v Transform3D) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromTransform3D(v, ptr)
 	return ret
 }
@@ -880,7 +817,6 @@ func NewVariantTransform3D(v Transform3D) Variant {
 func GDExtensionVariantPtrFromTransform3D(v Transform3D, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Transform3D
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	Transform3DEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_TRANSFORM3D]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -894,7 +830,6 @@ func (c Variant) ToTransform3D() Transform3D {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_TRANSFORM3D]
 	var v Transform3D
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -906,7 +841,6 @@ func (c Variant) ToTransform3D() Transform3D {
 func NewVariantProjection(v Projection) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromProjection(v, ptr)
 	return ret
 }
@@ -914,7 +848,6 @@ func NewVariantProjection(v Projection) Variant {
 func GDExtensionVariantPtrFromProjection(v Projection, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Projection
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	ProjectionEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PROJECTION]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -928,7 +861,6 @@ func (c Variant) ToProjection() Projection {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PROJECTION]
 	var v Projection
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -940,7 +872,6 @@ func (c Variant) ToProjection() Projection {
 func NewVariantColor(v Color) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromColor(v, ptr)
 	return ret
 }
@@ -948,7 +879,6 @@ func NewVariantColor(v Color) Variant {
 func GDExtensionVariantPtrFromColor(v Color, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Color
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	ColorEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_COLOR]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -962,7 +892,6 @@ func (c Variant) ToColor() Color {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_COLOR]
 	var v Color
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -974,7 +903,6 @@ func (c Variant) ToColor() Color {
 func NewVariantStringName(v StringName) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromStringName(v, ptr)
 	return ret
 }
@@ -982,7 +910,6 @@ func NewVariantStringName(v StringName) Variant {
 func GDExtensionVariantPtrFromStringName(v StringName, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded StringName
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	StringNameEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_STRING_NAME]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -996,7 +923,6 @@ func (c Variant) ToStringName() StringName {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_STRING_NAME]
 	var v StringName
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1008,7 +934,6 @@ func (c Variant) ToStringName() StringName {
 func NewVariantNodePath(v NodePath) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromNodePath(v, ptr)
 	return ret
 }
@@ -1016,7 +941,6 @@ func NewVariantNodePath(v NodePath) Variant {
 func GDExtensionVariantPtrFromNodePath(v NodePath, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded NodePath
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	NodePathEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_NODE_PATH]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1030,7 +954,6 @@ func (c Variant) ToNodePath() NodePath {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_NODE_PATH]
 	var v NodePath
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1042,7 +965,6 @@ func (c Variant) ToNodePath() NodePath {
 func NewVariantRID(v RID) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromRID(v, ptr)
 	return ret
 }
@@ -1050,7 +972,6 @@ func NewVariantRID(v RID) Variant {
 func GDExtensionVariantPtrFromRID(v RID, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded RID
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	RIDEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_RID]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1064,7 +985,6 @@ func (c Variant) ToRID() RID {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_RID]
 	var v RID
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1076,7 +996,6 @@ func (c Variant) ToRID() RID {
 func NewVariantCallable(v Callable) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromCallable(v, ptr)
 	return ret
 }
@@ -1084,7 +1003,6 @@ func NewVariantCallable(v Callable) Variant {
 func GDExtensionVariantPtrFromCallable(v Callable, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Callable
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	CallableEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_CALLABLE]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1098,7 +1016,6 @@ func (c Variant) ToCallable() Callable {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_CALLABLE]
 	var v Callable
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1110,7 +1027,6 @@ func (c Variant) ToCallable() Callable {
 func NewVariantSignal(v Signal) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromSignal(v, ptr)
 	return ret
 }
@@ -1118,7 +1034,6 @@ func NewVariantSignal(v Signal) Variant {
 func GDExtensionVariantPtrFromSignal(v Signal, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Signal
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	SignalEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_SIGNAL]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1132,7 +1047,6 @@ func (c Variant) ToSignal() Signal {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_SIGNAL]
 	var v Signal
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1144,7 +1058,6 @@ func (c Variant) ToSignal() Signal {
 func NewVariantDictionary(v Dictionary) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromDictionary(v, ptr)
 	return ret
 }
@@ -1152,7 +1065,6 @@ func NewVariantDictionary(v Dictionary) Variant {
 func GDExtensionVariantPtrFromDictionary(v Dictionary, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Dictionary
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	DictionaryEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_DICTIONARY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1166,7 +1078,6 @@ func (c Variant) ToDictionary() Dictionary {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_DICTIONARY]
 	var v Dictionary
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1178,7 +1089,6 @@ func (c Variant) ToDictionary() Dictionary {
 func NewVariantArray(v Array) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromArray(v, ptr)
 	return ret
 }
@@ -1186,7 +1096,6 @@ func NewVariantArray(v Array) Variant {
 func GDExtensionVariantPtrFromArray(v Array, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded Array
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	ArrayEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_ARRAY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1200,7 +1109,6 @@ func (c Variant) ToArray() Array {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_ARRAY]
 	var v Array
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1212,7 +1120,6 @@ func (c Variant) ToArray() Array {
 func NewVariantPackedByteArray(v PackedByteArray) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromPackedByteArray(v, ptr)
 	return ret
 }
@@ -1220,7 +1127,6 @@ func NewVariantPackedByteArray(v PackedByteArray) Variant {
 func GDExtensionVariantPtrFromPackedByteArray(v PackedByteArray, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded PackedByteArray
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	PackedByteArrayEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_BYTE_ARRAY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1234,7 +1140,6 @@ func (c Variant) ToPackedByteArray() PackedByteArray {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_BYTE_ARRAY]
 	var v PackedByteArray
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1246,7 +1151,6 @@ func (c Variant) ToPackedByteArray() PackedByteArray {
 func NewVariantPackedInt32Array(v PackedInt32Array) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromPackedInt32Array(v, ptr)
 	return ret
 }
@@ -1254,7 +1158,6 @@ func NewVariantPackedInt32Array(v PackedInt32Array) Variant {
 func GDExtensionVariantPtrFromPackedInt32Array(v PackedInt32Array, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded PackedInt32Array
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	PackedInt32ArrayEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_INT32_ARRAY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1268,7 +1171,6 @@ func (c Variant) ToPackedInt32Array() PackedInt32Array {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_INT32_ARRAY]
 	var v PackedInt32Array
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1280,7 +1182,6 @@ func (c Variant) ToPackedInt32Array() PackedInt32Array {
 func NewVariantPackedInt64Array(v PackedInt64Array) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromPackedInt64Array(v, ptr)
 	return ret
 }
@@ -1288,7 +1189,6 @@ func NewVariantPackedInt64Array(v PackedInt64Array) Variant {
 func GDExtensionVariantPtrFromPackedInt64Array(v PackedInt64Array, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded PackedInt64Array
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	PackedInt64ArrayEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_INT64_ARRAY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1302,7 +1202,6 @@ func (c Variant) ToPackedInt64Array() PackedInt64Array {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_INT64_ARRAY]
 	var v PackedInt64Array
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1314,7 +1213,6 @@ func (c Variant) ToPackedInt64Array() PackedInt64Array {
 func NewVariantPackedFloat32Array(v PackedFloat32Array) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromPackedFloat32Array(v, ptr)
 	return ret
 }
@@ -1322,7 +1220,6 @@ func NewVariantPackedFloat32Array(v PackedFloat32Array) Variant {
 func GDExtensionVariantPtrFromPackedFloat32Array(v PackedFloat32Array, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded PackedFloat32Array
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	PackedFloat32ArrayEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT32_ARRAY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1336,7 +1233,6 @@ func (c Variant) ToPackedFloat32Array() PackedFloat32Array {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT32_ARRAY]
 	var v PackedFloat32Array
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1348,7 +1244,6 @@ func (c Variant) ToPackedFloat32Array() PackedFloat32Array {
 func NewVariantPackedFloat64Array(v PackedFloat64Array) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromPackedFloat64Array(v, ptr)
 	return ret
 }
@@ -1356,7 +1251,6 @@ func NewVariantPackedFloat64Array(v PackedFloat64Array) Variant {
 func GDExtensionVariantPtrFromPackedFloat64Array(v PackedFloat64Array, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded PackedFloat64Array
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	PackedFloat64ArrayEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT64_ARRAY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1370,7 +1264,6 @@ func (c Variant) ToPackedFloat64Array() PackedFloat64Array {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_FLOAT64_ARRAY]
 	var v PackedFloat64Array
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1382,7 +1275,6 @@ func (c Variant) ToPackedFloat64Array() PackedFloat64Array {
 func NewVariantPackedStringArray(v PackedStringArray) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromPackedStringArray(v, ptr)
 	return ret
 }
@@ -1390,7 +1282,6 @@ func NewVariantPackedStringArray(v PackedStringArray) Variant {
 func GDExtensionVariantPtrFromPackedStringArray(v PackedStringArray, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded PackedStringArray
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	PackedStringArrayEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_STRING_ARRAY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1404,7 +1295,6 @@ func (c Variant) ToPackedStringArray() PackedStringArray {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_STRING_ARRAY]
 	var v PackedStringArray
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1416,7 +1306,6 @@ func (c Variant) ToPackedStringArray() PackedStringArray {
 func NewVariantPackedVector2Array(v PackedVector2Array) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromPackedVector2Array(v, ptr)
 	return ret
 }
@@ -1424,7 +1313,6 @@ func NewVariantPackedVector2Array(v PackedVector2Array) Variant {
 func GDExtensionVariantPtrFromPackedVector2Array(v PackedVector2Array, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded PackedVector2Array
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	PackedVector2ArrayEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR2_ARRAY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1438,7 +1326,6 @@ func (c Variant) ToPackedVector2Array() PackedVector2Array {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR2_ARRAY]
 	var v PackedVector2Array
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1450,7 +1337,6 @@ func (c Variant) ToPackedVector2Array() PackedVector2Array {
 func NewVariantPackedVector3Array(v PackedVector3Array) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromPackedVector3Array(v, ptr)
 	return ret
 }
@@ -1458,7 +1344,6 @@ func NewVariantPackedVector3Array(v PackedVector3Array) Variant {
 func GDExtensionVariantPtrFromPackedVector3Array(v PackedVector3Array, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded PackedVector3Array
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	PackedVector3ArrayEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR3_ARRAY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1472,7 +1357,6 @@ func (c Variant) ToPackedVector3Array() PackedVector3Array {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_VECTOR3_ARRAY]
 	var v PackedVector3Array
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
@@ -1484,7 +1368,6 @@ func (c Variant) ToPackedVector3Array() PackedVector3Array {
 func NewVariantPackedColorArray(v PackedColorArray) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromPackedColorArray(v, ptr)
 	return ret
 }
@@ -1492,7 +1375,6 @@ func NewVariantPackedColorArray(v PackedColorArray) Variant {
 func GDExtensionVariantPtrFromPackedColorArray(v PackedColorArray, rOut GDExtensionUninitializedVariantPtr) {
 	var encoded PackedColorArray
 	encodedPtr := (GDExtensionTypePtr)(&encoded)
-	pnr.Pin(encodedPtr)
 	PackedColorArrayEncoder.EncodeTypePtrArg(v, (GDExtensionUninitializedTypePtr)(encodedPtr))
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_COLOR_ARRAY]
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
@@ -1506,7 +1388,6 @@ func (c Variant) ToPackedColorArray() PackedColorArray {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_PACKED_COLOR_ARRAY]
 	var v PackedColorArray
 	ptr := v.NativePtr()
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(ptr),
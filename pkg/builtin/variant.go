@@ -35,7 +35,6 @@ func VariantInitBindings() {
 func NewVariantNativeCopy(NativeConstPtr GDExtensionConstVariantPtr) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionInterfaceVariantNewCopy(ptr, NativeConstPtr)
 	return ret
 }
@@ -47,20 +46,17 @@ func NewVariantCopy(dst, src Variant) {
 func NewVariantNil() Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrWithNil(ptr)
 	return ret
 }
 
 func GDExtensionVariantPtrWithNil(rOut GDExtensionUninitializedVariantPtr) {
-	pnr.Pin(rOut)
 	CallFunc_GDExtensionInterfaceVariantNewNil(rOut)
 }
 
 func NewVariantCopyWithGDExtensionConstVariantPtr(ptr GDExtensionConstVariantPtr) Variant {
 	ret := Variant{}
 	typedSrc := (*[VariantSize]uint8)(ptr)
-	pnr.Pin(ptr)
 	for i := range VariantSize {
 		ret[i] = typedSrc[i]
 	}
@@ -70,17 +66,13 @@ func NewVariantCopyWithGDExtensionConstVariantPtr(ptr GDExtensionConstVariantPtr
 func NewVariantGodotObject(owner *GodotObject) Variant {
 	ret := Variant{}
 	ptr := (GDExtensionUninitializedVariantPtr)(ret.NativePtr())
-	pnr.Pin(ptr)
-	pnr.Pin(owner)
 	GDExtensionVariantPtrFromGodotObjectPtr(owner, ptr)
 	return ret
 }
 
 func GDExtensionVariantPtrFromGodotObjectPtr(owner *GodotObject, rOut GDExtensionUninitializedVariantPtr) {
 	fn := variantFromTypeConstructor[GDEXTENSION_VARIANT_TYPE_OBJECT]
-	pnr.Pin(rOut)
 	ownerPtr := unsafe.Pointer(&owner)
-	pnr.Pin(ownerPtr)
 	CallFunc_GDExtensionVariantFromTypeConstructorFunc(
 		(GDExtensionVariantFromTypeConstructorFunc)(fn),
 		rOut,
@@ -95,7 +87,6 @@ func (c *Variant) ToObject() Object {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_OBJECT]
 	var engineObject *GodotObject
 	engineObjectPtr := &engineObject
-	pnr.Pin(engineObjectPtr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(engineObjectPtr),
@@ -112,7 +103,6 @@ func (c *Variant) ToObjectPtr() *GodotObject {
 	fn := typeFromVariantConstructor[GDEXTENSION_VARIANT_TYPE_OBJECT]
 	var engineObject *GodotObject
 	engineObjectPtr := &engineObject
-	pnr.Pin(engineObjectPtr)
 	CallFunc_GDExtensionTypeFromVariantConstructorFunc(
 		(GDExtensionTypeFromVariantConstructorFunc)(fn),
 		(GDExtensionUninitializedTypePtr)(engineObjectPtr),
@@ -135,7 +125,6 @@ func getObjectInstanceBinding(engineObject *GodotObject) Object {
 	}
 	snClassName := StringName{}
 	snClassNamePtr := snClassName.NativePtr()
-	pnr.Pin(snClassNamePtr)
 	cok := CallFunc_GDExtensionInterfaceObjectGetClassName(
 		(GDExtensionConstObjectPtr)(engineObject),
 		FFI.Library,
@@ -146,7 +135,6 @@ func getObjectInstanceBinding(engineObject *GodotObject) Object {
 			zap.Any("owner", engineObject),
 		)
 	}
-	pnr.Pin(snClassNamePtr)
 	className := snClassName.ToUtf8()
 	snClassName.Destroy()
 	// const GDExtensionInstanceBindingCallbacks *binding_callbacks = nullptr;
@@ -156,26 +144,21 @@ func getObjectInstanceBinding(engineObject *GodotObject) Object {
 		log.Warn("unable to find callbacks for Object")
 		return nil
 	}
-	cbsPtr := &cbs
-	pnr.Pin(cbsPtr)
-	pnr.Pin(engineObject)
-	pnr.Pin(FFI.Token)
 
-	util.CgoTestCall(unsafe.Pointer(cbsPtr))
+	util.CgoTestCall(unsafe.Pointer(cbs))
 	util.CgoTestCall(unsafe.Pointer(engineObject))
 	util.CgoTestCall(FFI.Token)
 	instPtr = (*Object)(CallFunc_GDExtensionInterfaceObjectGetInstanceBinding(
 		(GDExtensionObjectPtr)(engineObject),
 		FFI.Token,
-		cbsPtr))
+		cbs))
 	runtime.KeepAlive(engineObject)
 	runtime.KeepAlive(FFI.Token)
-	runtime.KeepAlive(cbsPtr)
+	runtime.KeepAlive(cbs)
 	if instPtr == nil || *instPtr == nil {
 		log.Panic("unable to get instance")
 		return nil
 	}
-	pnr.Pin(instPtr)
 	wrapperClassName := (*instPtr).GetClassName()
 	gdStrClassName := (*instPtr).GetClass()
 	defer gdStrClassName.Destroy()
@@ -191,7 +174,6 @@ func NewVariantGoString(v string) Variant {
 	defer gdStr.Destroy()
 	ret := Variant{}
 	ptr := ret.NativePtr()
-	pnr.Pin(ptr)
 	GDExtensionVariantPtrFromString(gdStr, (GDExtensionUninitializedVariantPtr)(ptr))
 	return ret
 }
@@ -270,6 +252,9 @@ func (c *Variant) Call(
 	)
 	sn := NewStringNameWithLatin1Chars(method)
 	defer sn.Destroy()
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	PinArgs(&pinner, args)
 	callArgs = (*GDExtensionConstVariantPtr)(unsafe.Pointer(unsafe.SliceData(args)))
 
 	callArgCount := len(args)
@@ -297,6 +282,9 @@ func (c *Variant) CallStatic(
 	var callArgs *GDExtensionConstVariantPtr
 	sn := NewStringNameWithLatin1Chars(method)
 	defer sn.Destroy()
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	PinArgs(&pinner, args)
 	callArgs = (*GDExtensionConstVariantPtr)(unsafe.Pointer(unsafe.SliceData(args)))
 	callArgCount := len(args)
 	var err GDExtensionCallError
@@ -78,14 +78,11 @@ func ObjectCastTo(obj Object, className string) Object {
 		)
 		return nil
 	}
-	cbsPtr := &cbs
-	pnr.Pin(casted)
-	pnr.Pin(cbsPtr)
 	// TODO: validate this is working as expected
 	inst := CallFunc_GDExtensionInterfaceObjectGetInstanceBinding(
 		casted,
 		FFI.Token,
-		cbsPtr)
+		cbs)
 	wci := (*WrappedClassInstance)(inst)
 	wrapperClassName := wci.Instance.GetClassName()
 	gdStrClassName := wci.Instance.GetClass()
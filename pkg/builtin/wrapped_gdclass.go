@@ -23,6 +23,16 @@ type GDClass interface {
 	Wrapped
 }
 
+// GDClassReloadState is implemented by a GDClass that keeps state outside of
+// its properties across a hot reload of the extension. SaveReloadState is
+// called on the old instance before the extension is unloaded, and
+// RestoreReloadState on the instance recreated for the same object once the
+// extension is loaded again and its properties are restored.
+type GDClassReloadState interface {
+	SaveReloadState() Dictionary
+	RestoreReloadState(state Dictionary)
+}
+
 func ObjectClassFromGDExtensionClassInstancePtr(p_instance GDExtensionClassInstancePtr) Object {
 	if p_instance == nil {
 		return nil
@@ -35,9 +45,11 @@ func ObjectClassFromGDExtensionClassInstancePtr(p_instance GDExtensionClassInsta
 	return wci.Instance
 }
 
-// WrappedPostInitialize is equivalent to Wrapped::_postinitialize in godot-cpp
-// this should only be called for GDClasses and not GDExtensionClasses
-func WrappedPostInitialize(extensionClassName string, w Wrapped) {
+// WrappedPostInitialize is equivalent to Wrapped::_postinitialize in godot-cpp:
+// it binds w as the extension instance of its owner and returns the instance
+// pointer the engine passes back to class callbacks. It should only be called
+// for GDClasses and not GDExtensionClasses.
+func WrappedPostInitialize(extensionClassName string, w Wrapped) GDExtensionClassInstancePtr {
 	owner := w.GetGodotObjectOwner()
 	if len(extensionClassName) == 0 {
 		log.Panic("extension class name cannot be empty",
@@ -59,10 +71,6 @@ func WrappedPostInitialize(extensionClassName string, w Wrapped) {
 	inst := &WrappedClassInstance{
 		Instance: obj,
 	}
-	pnr.Pin(obj)
-	pnr.Pin(owner)
-	pnr.Pin(inst)
-	pnr.Pin(cnPtr)
 	instHandle := cgo.NewHandle(inst)
 	if cnPtr != nil {
 		CallFunc_GDExtensionInterfaceObjectSetInstance(
@@ -75,20 +83,24 @@ func WrappedPostInitialize(extensionClassName string, w Wrapped) {
 		(GDExtensionObjectPtr)(owner),
 		unsafe.Pointer(FFI.Token),
 		instHandle,
-		&callbacks,
+		callbacks,
 	)
+	return (GDExtensionClassInstancePtr)(instHandle)
 }
 
 //export GoCallback_GDClassBindingCreate
 func GoCallback_GDClassBindingCreate(p_token unsafe.Pointer, p_instance unsafe.Pointer) unsafe.Pointer {
+	defer RecoverCallback("GoCallback_GDClassBindingCreate")
 	return nullptr
 }
 
 //export GoCallback_GDClassBindingFree
 func GoCallback_GDClassBindingFree(p_token unsafe.Pointer, p_instance unsafe.Pointer, p_binding unsafe.Pointer) {
+	defer RecoverCallback("GoCallback_GDClassBindingFree")
 }
 
 //export GoCallback_GDClassBindingReference
 func GoCallback_GDClassBindingReference(p_token unsafe.Pointer, p_instance unsafe.Pointer, p_reference C.GDExtensionBool) C.GDExtensionBool {
+	defer RecoverCallback("GoCallback_GDClassBindingReference")
 	return 1
 }
@@ -41,32 +41,32 @@ const (
 )
 
 const (
-	CANVAS_ITEM_NOTIFICATION_TRANSFORM_CHANGED       int32 = 2000
-	CANVAS_ITEM_NOTIFICATION_LOCAL_TRANSFORM_CHANGED int32 = 35
-	CANVAS_ITEM_NOTIFICATION_DRAW                    int32 = 30
-	CANVAS_ITEM_NOTIFICATION_VISIBILITY_CHANGED      int32 = 31
-	CANVAS_ITEM_NOTIFICATION_ENTER_CANVAS            int32 = 32
-	CANVAS_ITEM_NOTIFICATION_EXIT_CANVAS             int32 = 33
-	CANVAS_ITEM_NOTIFICATION_WORLD_2_D_CHANGED       int32 = 36
+	CANVAS_ITEM_NOTIFICATION_TRANSFORM_CHANGED       Notification = 2000
+	CANVAS_ITEM_NOTIFICATION_LOCAL_TRANSFORM_CHANGED Notification = 35
+	CANVAS_ITEM_NOTIFICATION_DRAW                    Notification = 30
+	CANVAS_ITEM_NOTIFICATION_VISIBILITY_CHANGED      Notification = 31
+	CANVAS_ITEM_NOTIFICATION_ENTER_CANVAS            Notification = 32
+	CANVAS_ITEM_NOTIFICATION_EXIT_CANVAS             Notification = 33
+	CANVAS_ITEM_NOTIFICATION_WORLD_2_D_CHANGED       Notification = 36
 )
 
 const (
-	CONTAINER_NOTIFICATION_PRE_SORT_CHILDREN int32 = 50
-	CONTAINER_NOTIFICATION_SORT_CHILDREN     int32 = 51
+	CONTAINER_NOTIFICATION_PRE_SORT_CHILDREN Notification = 50
+	CONTAINER_NOTIFICATION_SORT_CHILDREN     Notification = 51
 )
 
 const (
-	CONTROL_NOTIFICATION_RESIZED                  int32 = 40
-	CONTROL_NOTIFICATION_MOUSE_ENTER              int32 = 41
-	CONTROL_NOTIFICATION_MOUSE_EXIT               int32 = 42
-	CONTROL_NOTIFICATION_MOUSE_ENTER_SELF         int32 = 60
-	CONTROL_NOTIFICATION_MOUSE_EXIT_SELF          int32 = 61
-	CONTROL_NOTIFICATION_FOCUS_ENTER              int32 = 43
-	CONTROL_NOTIFICATION_FOCUS_EXIT               int32 = 44
-	CONTROL_NOTIFICATION_THEME_CHANGED            int32 = 45
-	CONTROL_NOTIFICATION_SCROLL_BEGIN             int32 = 47
-	CONTROL_NOTIFICATION_SCROLL_END               int32 = 48
-	CONTROL_NOTIFICATION_LAYOUT_DIRECTION_CHANGED int32 = 49
+	CONTROL_NOTIFICATION_RESIZED                  Notification = 40
+	CONTROL_NOTIFICATION_MOUSE_ENTER              Notification = 41
+	CONTROL_NOTIFICATION_MOUSE_EXIT               Notification = 42
+	CONTROL_NOTIFICATION_MOUSE_ENTER_SELF         Notification = 60
+	CONTROL_NOTIFICATION_MOUSE_EXIT_SELF          Notification = 61
+	CONTROL_NOTIFICATION_FOCUS_ENTER              Notification = 43
+	CONTROL_NOTIFICATION_FOCUS_EXIT               Notification = 44
+	CONTROL_NOTIFICATION_THEME_CHANGED            Notification = 45
+	CONTROL_NOTIFICATION_SCROLL_BEGIN             Notification = 47
+	CONTROL_NOTIFICATION_SCROLL_END               Notification = 48
+	CONTROL_NOTIFICATION_LAYOUT_DIRECTION_CHANGED Notification = 49
 )
 
 const (
@@ -99,7 +99,7 @@ const (
 )
 
 const (
-	EDITOR_SETTINGS_NOTIFICATION_EDITOR_SETTINGS_CHANGED int32 = 10000
+	EDITOR_SETTINGS_NOTIFICATION_EDITOR_SETTINGS_CHANGED Notification = 10000
 )
 
 const (
@@ -132,16 +132,16 @@ const (
 )
 
 const (
-	MAIN_LOOP_NOTIFICATION_OS_MEMORY_WARNING     int32 = 2009
-	MAIN_LOOP_NOTIFICATION_TRANSLATION_CHANGED   int32 = 2010
-	MAIN_LOOP_NOTIFICATION_WM_ABOUT              int32 = 2011
-	MAIN_LOOP_NOTIFICATION_CRASH                 int32 = 2012
-	MAIN_LOOP_NOTIFICATION_OS_IME_UPDATE         int32 = 2013
-	MAIN_LOOP_NOTIFICATION_APPLICATION_RESUMED   int32 = 2014
-	MAIN_LOOP_NOTIFICATION_APPLICATION_PAUSED    int32 = 2015
-	MAIN_LOOP_NOTIFICATION_APPLICATION_FOCUS_IN  int32 = 2016
-	MAIN_LOOP_NOTIFICATION_APPLICATION_FOCUS_OUT int32 = 2017
-	MAIN_LOOP_NOTIFICATION_TEXT_SERVER_CHANGED   int32 = 2018
+	MAIN_LOOP_NOTIFICATION_OS_MEMORY_WARNING     Notification = 2009
+	MAIN_LOOP_NOTIFICATION_TRANSLATION_CHANGED   Notification = 2010
+	MAIN_LOOP_NOTIFICATION_WM_ABOUT              Notification = 2011
+	MAIN_LOOP_NOTIFICATION_CRASH                 Notification = 2012
+	MAIN_LOOP_NOTIFICATION_OS_IME_UPDATE         Notification = 2013
+	MAIN_LOOP_NOTIFICATION_APPLICATION_RESUMED   Notification = 2014
+	MAIN_LOOP_NOTIFICATION_APPLICATION_PAUSED    Notification = 2015
+	MAIN_LOOP_NOTIFICATION_APPLICATION_FOCUS_IN  Notification = 2016
+	MAIN_LOOP_NOTIFICATION_APPLICATION_FOCUS_OUT Notification = 2017
+	MAIN_LOOP_NOTIFICATION_TEXT_SERVER_CHANGED   Notification = 2018
 )
 
 const (
@@ -155,66 +155,66 @@ const (
 )
 
 const (
-	NODE_NOTIFICATION_ENTER_TREE                  int32 = 10
-	NODE_NOTIFICATION_EXIT_TREE                   int32 = 11
-	NODE_NOTIFICATION_MOVED_IN_PARENT             int32 = 12
-	NODE_NOTIFICATION_READY                       int32 = 13
-	NODE_NOTIFICATION_PAUSED                      int32 = 14
-	NODE_NOTIFICATION_UNPAUSED                    int32 = 15
-	NODE_NOTIFICATION_PHYSICS_PROCESS             int32 = 16
-	NODE_NOTIFICATION_PROCESS                     int32 = 17
-	NODE_NOTIFICATION_PARENTED                    int32 = 18
-	NODE_NOTIFICATION_UNPARENTED                  int32 = 19
-	NODE_NOTIFICATION_SCENE_INSTANTIATED          int32 = 20
-	NODE_NOTIFICATION_DRAG_BEGIN                  int32 = 21
-	NODE_NOTIFICATION_DRAG_END                    int32 = 22
-	NODE_NOTIFICATION_PATH_RENAMED                int32 = 23
-	NODE_NOTIFICATION_CHILD_ORDER_CHANGED         int32 = 24
-	NODE_NOTIFICATION_INTERNAL_PROCESS            int32 = 25
-	NODE_NOTIFICATION_INTERNAL_PHYSICS_PROCESS    int32 = 26
-	NODE_NOTIFICATION_POST_ENTER_TREE             int32 = 27
-	NODE_NOTIFICATION_DISABLED                    int32 = 28
-	NODE_NOTIFICATION_ENABLED                     int32 = 29
-	NODE_NOTIFICATION_RESET_PHYSICS_INTERPOLATION int32 = 2001
-	NODE_NOTIFICATION_EDITOR_PRE_SAVE             int32 = 9001
-	NODE_NOTIFICATION_EDITOR_POST_SAVE            int32 = 9002
-	NODE_NOTIFICATION_WM_MOUSE_ENTER              int32 = 1002
-	NODE_NOTIFICATION_WM_MOUSE_EXIT               int32 = 1003
-	NODE_NOTIFICATION_WM_WINDOW_FOCUS_IN          int32 = 1004
-	NODE_NOTIFICATION_WM_WINDOW_FOCUS_OUT         int32 = 1005
-	NODE_NOTIFICATION_WM_CLOSE_REQUEST            int32 = 1006
-	NODE_NOTIFICATION_WM_GO_BACK_REQUEST          int32 = 1007
-	NODE_NOTIFICATION_WM_SIZE_CHANGED             int32 = 1008
-	NODE_NOTIFICATION_WM_DPI_CHANGE               int32 = 1009
-	NODE_NOTIFICATION_VP_MOUSE_ENTER              int32 = 1010
-	NODE_NOTIFICATION_VP_MOUSE_EXIT               int32 = 1011
-	NODE_NOTIFICATION_WM_POSITION_CHANGED         int32 = 1012
-	NODE_NOTIFICATION_OS_MEMORY_WARNING           int32 = 2009
-	NODE_NOTIFICATION_TRANSLATION_CHANGED         int32 = 2010
-	NODE_NOTIFICATION_WM_ABOUT                    int32 = 2011
-	NODE_NOTIFICATION_CRASH                       int32 = 2012
-	NODE_NOTIFICATION_OS_IME_UPDATE               int32 = 2013
-	NODE_NOTIFICATION_APPLICATION_RESUMED         int32 = 2014
-	NODE_NOTIFICATION_APPLICATION_PAUSED          int32 = 2015
-	NODE_NOTIFICATION_APPLICATION_FOCUS_IN        int32 = 2016
-	NODE_NOTIFICATION_APPLICATION_FOCUS_OUT       int32 = 2017
-	NODE_NOTIFICATION_TEXT_SERVER_CHANGED         int32 = 2018
-	NODE_NOTIFICATION_ACCESSIBILITY_UPDATE        int32 = 3000
-	NODE_NOTIFICATION_ACCESSIBILITY_INVALIDATE    int32 = 3001
-)
-
-const (
-	NODE_3_D_NOTIFICATION_TRANSFORM_CHANGED       int32 = 2000
-	NODE_3_D_NOTIFICATION_ENTER_WORLD             int32 = 41
-	NODE_3_D_NOTIFICATION_EXIT_WORLD              int32 = 42
-	NODE_3_D_NOTIFICATION_VISIBILITY_CHANGED      int32 = 43
-	NODE_3_D_NOTIFICATION_LOCAL_TRANSFORM_CHANGED int32 = 44
-)
-
-const (
-	OBJECT_NOTIFICATION_POSTINITIALIZE     int32 = 0
-	OBJECT_NOTIFICATION_PREDELETE          int32 = 1
-	OBJECT_NOTIFICATION_EXTENSION_RELOADED int32 = 2
+	NODE_NOTIFICATION_ENTER_TREE                  Notification = 10
+	NODE_NOTIFICATION_EXIT_TREE                   Notification = 11
+	NODE_NOTIFICATION_MOVED_IN_PARENT             Notification = 12
+	NODE_NOTIFICATION_READY                       Notification = 13
+	NODE_NOTIFICATION_PAUSED                      Notification = 14
+	NODE_NOTIFICATION_UNPAUSED                    Notification = 15
+	NODE_NOTIFICATION_PHYSICS_PROCESS             Notification = 16
+	NODE_NOTIFICATION_PROCESS                     Notification = 17
+	NODE_NOTIFICATION_PARENTED                    Notification = 18
+	NODE_NOTIFICATION_UNPARENTED                  Notification = 19
+	NODE_NOTIFICATION_SCENE_INSTANTIATED          Notification = 20
+	NODE_NOTIFICATION_DRAG_BEGIN                  Notification = 21
+	NODE_NOTIFICATION_DRAG_END                    Notification = 22
+	NODE_NOTIFICATION_PATH_RENAMED                Notification = 23
+	NODE_NOTIFICATION_CHILD_ORDER_CHANGED         Notification = 24
+	NODE_NOTIFICATION_INTERNAL_PROCESS            Notification = 25
+	NODE_NOTIFICATION_INTERNAL_PHYSICS_PROCESS    Notification = 26
+	NODE_NOTIFICATION_POST_ENTER_TREE             Notification = 27
+	NODE_NOTIFICATION_DISABLED                    Notification = 28
+	NODE_NOTIFICATION_ENABLED                     Notification = 29
+	NODE_NOTIFICATION_RESET_PHYSICS_INTERPOLATION Notification = 2001
+	NODE_NOTIFICATION_EDITOR_PRE_SAVE             Notification = 9001
+	NODE_NOTIFICATION_EDITOR_POST_SAVE            Notification = 9002
+	NODE_NOTIFICATION_WM_MOUSE_ENTER              Notification = 1002
+	NODE_NOTIFICATION_WM_MOUSE_EXIT               Notification = 1003
+	NODE_NOTIFICATION_WM_WINDOW_FOCUS_IN          Notification = 1004
+	NODE_NOTIFICATION_WM_WINDOW_FOCUS_OUT         Notification = 1005
+	NODE_NOTIFICATION_WM_CLOSE_REQUEST            Notification = 1006
+	NODE_NOTIFICATION_WM_GO_BACK_REQUEST          Notification = 1007
+	NODE_NOTIFICATION_WM_SIZE_CHANGED             Notification = 1008
+	NODE_NOTIFICATION_WM_DPI_CHANGE               Notification = 1009
+	NODE_NOTIFICATION_VP_MOUSE_ENTER              Notification = 1010
+	NODE_NOTIFICATION_VP_MOUSE_EXIT               Notification = 1011
+	NODE_NOTIFICATION_WM_POSITION_CHANGED         Notification = 1012
+	NODE_NOTIFICATION_OS_MEMORY_WARNING           Notification = 2009
+	NODE_NOTIFICATION_TRANSLATION_CHANGED         Notification = 2010
+	NODE_NOTIFICATION_WM_ABOUT                    Notification = 2011
+	NODE_NOTIFICATION_CRASH                       Notification = 2012
+	NODE_NOTIFICATION_OS_IME_UPDATE               Notification = 2013
+	NODE_NOTIFICATION_APPLICATION_RESUMED         Notification = 2014
+	NODE_NOTIFICATION_APPLICATION_PAUSED          Notification = 2015
+	NODE_NOTIFICATION_APPLICATION_FOCUS_IN        Notification = 2016
+	NODE_NOTIFICATION_APPLICATION_FOCUS_OUT       Notification = 2017
+	NODE_NOTIFICATION_TEXT_SERVER_CHANGED         Notification = 2018
+	NODE_NOTIFICATION_ACCESSIBILITY_UPDATE        Notification = 3000
+	NODE_NOTIFICATION_ACCESSIBILITY_INVALIDATE    Notification = 3001
+)
+
+const (
+	NODE_3_D_NOTIFICATION_TRANSFORM_CHANGED       Notification = 2000
+	NODE_3_D_NOTIFICATION_ENTER_WORLD             Notification = 41
+	NODE_3_D_NOTIFICATION_EXIT_WORLD              Notification = 42
+	NODE_3_D_NOTIFICATION_VISIBILITY_CHANGED      Notification = 43
+	NODE_3_D_NOTIFICATION_LOCAL_TRANSFORM_CHANGED Notification = 44
+)
+
+const (
+	OBJECT_NOTIFICATION_POSTINITIALIZE     Notification = 0
+	OBJECT_NOTIFICATION_PREDELETE          Notification = 1
+	OBJECT_NOTIFICATION_EXTENSION_RELOADED Notification = 2
 )
 
 const (
@@ -252,7 +252,7 @@ const (
 )
 
 const (
-	SKELETON_3_D_NOTIFICATION_UPDATE_SKELETON int32 = 50
+	SKELETON_3_D_NOTIFICATION_UPDATE_SKELETON Notification = 50
 )
 
 const (
@@ -267,6 +267,6 @@ const (
 )
 
 const (
-	WINDOW_NOTIFICATION_VISIBILITY_CHANGED int32 = 30
-	WINDOW_NOTIFICATION_THEME_CHANGED      int32 = 32
+	WINDOW_NOTIFICATION_VISIBILITY_CHANGED Notification = 30
+	WINDOW_NOTIFICATION_THEME_CHANGED      Notification = 32
 )
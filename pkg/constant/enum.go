@@ -0,0 +1,119 @@
+package constant
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// enumName pairs the Godot name of an enum value with the value. The tables
+// generated for each enum list every name, so values with several names
+// format as the first one.
+type enumName struct {
+	name  string
+	value int64
+}
+
+// formatEnum returns the name of v, or typeName(v) for a value without one.
+func formatEnum(typeName string, names []enumName, v int64) string {
+	for _, n := range names {
+		if n.value == v {
+			return n.name
+		}
+	}
+	return fmt.Sprintf("%s(%d)", typeName, v)
+}
+
+// formatBitfield returns the names of the flags set in v joined by "|", e.g.
+// KEY_MASK_SHIFT|KEY_MASK_CTRL. Bits without a name are appended as
+// typeName(bits).
+func formatBitfield(typeName string, names []enumName, v int64) string {
+	for _, n := range names {
+		if n.value == v {
+			return n.name
+		}
+	}
+	var (
+		parts []string
+		rest  = v
+	)
+	for _, n := range names {
+		if n.value == 0 || n.value&(n.value-1) != 0 || rest&n.value == 0 {
+			continue
+		}
+		parts = append(parts, n.name)
+		rest &^= n.value
+	}
+	if rest != 0 || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%s(%d)", typeName, rest))
+	}
+	return strings.Join(parts, "|")
+}
+
+// marshalEnum returns the name of v, or v in decimal for a value without one,
+// so that parseEnum reads it back.
+func marshalEnum(names []enumName, v int64) []byte {
+	for _, n := range names {
+		if n.value == v {
+			return []byte(n.name)
+		}
+	}
+	return strconv.AppendInt(nil, v, 10)
+}
+
+// marshalBitfield is marshalEnum for bitfields; see formatBitfield.
+func marshalBitfield(names []enumName, v int64) []byte {
+	for _, n := range names {
+		if n.value == v {
+			return []byte(n.name)
+		}
+	}
+	var (
+		b    []byte
+		rest = v
+	)
+	for _, n := range names {
+		if n.value == 0 || n.value&(n.value-1) != 0 || rest&n.value == 0 {
+			continue
+		}
+		if len(b) > 0 {
+			b = append(b, '|')
+		}
+		b = append(b, n.name...)
+		rest &^= n.value
+	}
+	if rest != 0 || len(b) == 0 {
+		if len(b) > 0 {
+			b = append(b, '|')
+		}
+		b = strconv.AppendInt(b, rest, 10)
+	}
+	return b
+}
+
+// parseEnum returns the value named text, which may also be an integer.
+func parseEnum(typeName string, names []enumName, text string) (int64, error) {
+	text = strings.TrimSpace(text)
+	for _, n := range names {
+		if n.name == text {
+			return n.value, nil
+		}
+	}
+	if v, err := strconv.ParseInt(text, 0, 64); err == nil {
+		return v, nil
+	}
+	return 0, fmt.Errorf("invalid %s: %q", typeName, text)
+}
+
+// parseBitfield returns the flags named in text, separated by "|".
+func parseBitfield(typeName string, names []enumName, text string) (int64, error) {
+	var v int64
+	for _, part := range strings.Split(text, "|") {
+		flag, err := parseEnum(typeName, names, part)
+		if err != nil {
+			return 0, err
+		}
+		v |= flag
+	}
+	return v, nil
+}
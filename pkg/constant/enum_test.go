@@ -0,0 +1,95 @@
+package constant
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumString(t *testing.T) {
+	tests := []struct {
+		side     Side
+		expected string
+	}{
+		{SIDE_TOP, "SIDE_TOP"},
+		{SideTop, "SIDE_TOP"},
+		{Side(42), "Side(42)"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, tt.side.String())
+	}
+}
+
+func TestParseEnum(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected Side
+		err      bool
+	}{
+		{"SIDE_BOTTOM", SIDE_BOTTOM, false},
+		{" SIDE_LEFT ", SIDE_LEFT, false},
+		{"2", SIDE_RIGHT, false},
+		{"SIDE_MIDDLE", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSide(tt.text)
+		if tt.err {
+			require.Error(t, err, "%q", tt.text)
+			continue
+		}
+		require.NoError(t, err, "%q", tt.text)
+		assert.Equal(t, tt.expected, got, "%q", tt.text)
+	}
+}
+
+func TestBitfield(t *testing.T) {
+	mask := KEY_MASK_SHIFT.Set(KEY_MASK_CTRL)
+
+	tests := []struct {
+		flag     KeyModifierMask
+		expected bool
+	}{
+		{KEY_MASK_SHIFT, true},
+		{KEY_MASK_CTRL, true},
+		{KEY_MASK_ALT, false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, mask.Has(tt.flag), "%v", tt.flag)
+	}
+	assert.Equal(t, "KEY_MASK_SHIFT|KEY_MASK_CTRL", mask.String())
+	assert.Equal(t, KEY_MASK_CTRL, mask.Clear(KEY_MASK_SHIFT))
+
+	parsed, err := ParseKeyModifierMask("KEY_MASK_SHIFT|KEY_MASK_CTRL")
+	require.NoError(t, err)
+	assert.Equal(t, mask, parsed)
+}
+
+func TestEnumTextMarshaling(t *testing.T) {
+	type settings struct {
+		Side Side
+		Mask KeyModifierMask
+	}
+
+	tests := []struct {
+		in       settings
+		expected string
+	}{
+		{settings{Side: SIDE_BOTTOM, Mask: KEY_MASK_ALT | KEY_MASK_META | 1}, `{"Side":"SIDE_BOTTOM","Mask":"KEY_MASK_ALT|KEY_MASK_META|1"}`},
+		{settings{Side: Side(42)}, `{"Side":"42","Mask":"0"}`},
+	}
+
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.in)
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, string(data))
+
+		var out settings
+		require.NoError(t, json.Unmarshal(data, &out))
+		assert.Equal(t, tt.in, out)
+	}
+}
@@ -20,6 +20,43 @@ const (
 	SIDE_BOTTOM Side = 3
 )
 
+const (
+	SideLeft   = SIDE_LEFT
+	SideTop    = SIDE_TOP
+	SideRight  = SIDE_RIGHT
+	SideBottom = SIDE_BOTTOM
+)
+
+var sideNames = []enumName{
+	{"SIDE_LEFT", 0},
+	{"SIDE_TOP", 1},
+	{"SIDE_RIGHT", 2},
+	{"SIDE_BOTTOM", 3},
+}
+
+func (e Side) String() string {
+	return formatEnum("Side", sideNames, int64(e))
+}
+
+// ParseSide returns the Side named text, which may also be an integer.
+func ParseSide(text string) (Side, error) {
+	v, err := parseEnum("Side", sideNames, text)
+	return Side(v), err
+}
+
+func (e Side) MarshalText() ([]byte, error) {
+	return marshalEnum(sideNames, int64(e)), nil
+}
+
+func (e *Side) UnmarshalText(text []byte) error {
+	v, err := ParseSide(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type Corner int
 
 const (
@@ -29,6 +66,43 @@ const (
 	CORNER_BOTTOM_LEFT  Corner = 3
 )
 
+const (
+	CornerTopLeft     = CORNER_TOP_LEFT
+	CornerTopRight    = CORNER_TOP_RIGHT
+	CornerBottomRight = CORNER_BOTTOM_RIGHT
+	CornerBottomLeft  = CORNER_BOTTOM_LEFT
+)
+
+var cornerNames = []enumName{
+	{"CORNER_TOP_LEFT", 0},
+	{"CORNER_TOP_RIGHT", 1},
+	{"CORNER_BOTTOM_RIGHT", 2},
+	{"CORNER_BOTTOM_LEFT", 3},
+}
+
+func (e Corner) String() string {
+	return formatEnum("Corner", cornerNames, int64(e))
+}
+
+// ParseCorner returns the Corner named text, which may also be an integer.
+func ParseCorner(text string) (Corner, error) {
+	v, err := parseEnum("Corner", cornerNames, text)
+	return Corner(v), err
+}
+
+func (e Corner) MarshalText() ([]byte, error) {
+	return marshalEnum(cornerNames, int64(e)), nil
+}
+
+func (e *Corner) UnmarshalText(text []byte) error {
+	v, err := ParseCorner(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type Orientation int
 
 const (
@@ -36,6 +110,39 @@ const (
 	HORIZONTAL Orientation = 0
 )
 
+const (
+	OrientationVertical   = VERTICAL
+	OrientationHorizontal = HORIZONTAL
+)
+
+var orientationNames = []enumName{
+	{"VERTICAL", 1},
+	{"HORIZONTAL", 0},
+}
+
+func (e Orientation) String() string {
+	return formatEnum("Orientation", orientationNames, int64(e))
+}
+
+// ParseOrientation returns the Orientation named text, which may also be an integer.
+func ParseOrientation(text string) (Orientation, error) {
+	v, err := parseEnum("Orientation", orientationNames, text)
+	return Orientation(v), err
+}
+
+func (e Orientation) MarshalText() ([]byte, error) {
+	return marshalEnum(orientationNames, int64(e)), nil
+}
+
+func (e *Orientation) UnmarshalText(text []byte) error {
+	v, err := ParseOrientation(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type ClockDirection int
 
 const (
@@ -43,6 +150,39 @@ const (
 	COUNTERCLOCKWISE ClockDirection = 1
 )
 
+const (
+	ClockDirectionClockwise        = CLOCKWISE
+	ClockDirectionCounterclockwise = COUNTERCLOCKWISE
+)
+
+var clockDirectionNames = []enumName{
+	{"CLOCKWISE", 0},
+	{"COUNTERCLOCKWISE", 1},
+}
+
+func (e ClockDirection) String() string {
+	return formatEnum("ClockDirection", clockDirectionNames, int64(e))
+}
+
+// ParseClockDirection returns the ClockDirection named text, which may also be an integer.
+func ParseClockDirection(text string) (ClockDirection, error) {
+	v, err := parseEnum("ClockDirection", clockDirectionNames, text)
+	return ClockDirection(v), err
+}
+
+func (e ClockDirection) MarshalText() ([]byte, error) {
+	return marshalEnum(clockDirectionNames, int64(e)), nil
+}
+
+func (e *ClockDirection) UnmarshalText(text []byte) error {
+	v, err := ParseClockDirection(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type HorizontalAlignment int
 
 const (
@@ -52,6 +192,43 @@ const (
 	HORIZONTAL_ALIGNMENT_FILL   HorizontalAlignment = 3
 )
 
+const (
+	HorizontalAlignmentLeft   = HORIZONTAL_ALIGNMENT_LEFT
+	HorizontalAlignmentCenter = HORIZONTAL_ALIGNMENT_CENTER
+	HorizontalAlignmentRight  = HORIZONTAL_ALIGNMENT_RIGHT
+	HorizontalAlignmentFill   = HORIZONTAL_ALIGNMENT_FILL
+)
+
+var horizontalAlignmentNames = []enumName{
+	{"HORIZONTAL_ALIGNMENT_LEFT", 0},
+	{"HORIZONTAL_ALIGNMENT_CENTER", 1},
+	{"HORIZONTAL_ALIGNMENT_RIGHT", 2},
+	{"HORIZONTAL_ALIGNMENT_FILL", 3},
+}
+
+func (e HorizontalAlignment) String() string {
+	return formatEnum("HorizontalAlignment", horizontalAlignmentNames, int64(e))
+}
+
+// ParseHorizontalAlignment returns the HorizontalAlignment named text, which may also be an integer.
+func ParseHorizontalAlignment(text string) (HorizontalAlignment, error) {
+	v, err := parseEnum("HorizontalAlignment", horizontalAlignmentNames, text)
+	return HorizontalAlignment(v), err
+}
+
+func (e HorizontalAlignment) MarshalText() ([]byte, error) {
+	return marshalEnum(horizontalAlignmentNames, int64(e)), nil
+}
+
+func (e *HorizontalAlignment) UnmarshalText(text []byte) error {
+	v, err := ParseHorizontalAlignment(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type VerticalAlignment int
 
 const (
@@ -61,6 +238,43 @@ const (
 	VERTICAL_ALIGNMENT_FILL   VerticalAlignment = 3
 )
 
+const (
+	VerticalAlignmentTop    = VERTICAL_ALIGNMENT_TOP
+	VerticalAlignmentCenter = VERTICAL_ALIGNMENT_CENTER
+	VerticalAlignmentBottom = VERTICAL_ALIGNMENT_BOTTOM
+	VerticalAlignmentFill   = VERTICAL_ALIGNMENT_FILL
+)
+
+var verticalAlignmentNames = []enumName{
+	{"VERTICAL_ALIGNMENT_TOP", 0},
+	{"VERTICAL_ALIGNMENT_CENTER", 1},
+	{"VERTICAL_ALIGNMENT_BOTTOM", 2},
+	{"VERTICAL_ALIGNMENT_FILL", 3},
+}
+
+func (e VerticalAlignment) String() string {
+	return formatEnum("VerticalAlignment", verticalAlignmentNames, int64(e))
+}
+
+// ParseVerticalAlignment returns the VerticalAlignment named text, which may also be an integer.
+func ParseVerticalAlignment(text string) (VerticalAlignment, error) {
+	v, err := parseEnum("VerticalAlignment", verticalAlignmentNames, text)
+	return VerticalAlignment(v), err
+}
+
+func (e VerticalAlignment) MarshalText() ([]byte, error) {
+	return marshalEnum(verticalAlignmentNames, int64(e)), nil
+}
+
+func (e *VerticalAlignment) UnmarshalText(text []byte) error {
+	v, err := ParseVerticalAlignment(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type InlineAlignment int
 
 const (
@@ -79,6 +293,61 @@ const (
 	INLINE_ALIGNMENT_TEXT_MASK   InlineAlignment = 12
 )
 
+const (
+	InlineAlignmentTopTo      = INLINE_ALIGNMENT_TOP_TO
+	InlineAlignmentCenterTo   = INLINE_ALIGNMENT_CENTER_TO
+	InlineAlignmentBaselineTo = INLINE_ALIGNMENT_BASELINE_TO
+	InlineAlignmentBottomTo   = INLINE_ALIGNMENT_BOTTOM_TO
+	InlineAlignmentToTop      = INLINE_ALIGNMENT_TO_TOP
+	InlineAlignmentToCenter   = INLINE_ALIGNMENT_TO_CENTER
+	InlineAlignmentToBaseline = INLINE_ALIGNMENT_TO_BASELINE
+	InlineAlignmentToBottom   = INLINE_ALIGNMENT_TO_BOTTOM
+	InlineAlignmentTop        = INLINE_ALIGNMENT_TOP
+	InlineAlignmentCenter     = INLINE_ALIGNMENT_CENTER
+	InlineAlignmentBottom     = INLINE_ALIGNMENT_BOTTOM
+	InlineAlignmentImageMask  = INLINE_ALIGNMENT_IMAGE_MASK
+	InlineAlignmentTextMask   = INLINE_ALIGNMENT_TEXT_MASK
+)
+
+var inlineAlignmentNames = []enumName{
+	{"INLINE_ALIGNMENT_TOP_TO", 0},
+	{"INLINE_ALIGNMENT_CENTER_TO", 1},
+	{"INLINE_ALIGNMENT_BASELINE_TO", 3},
+	{"INLINE_ALIGNMENT_BOTTOM_TO", 2},
+	{"INLINE_ALIGNMENT_TO_TOP", 0},
+	{"INLINE_ALIGNMENT_TO_CENTER", 4},
+	{"INLINE_ALIGNMENT_TO_BASELINE", 8},
+	{"INLINE_ALIGNMENT_TO_BOTTOM", 12},
+	{"INLINE_ALIGNMENT_TOP", 0},
+	{"INLINE_ALIGNMENT_CENTER", 5},
+	{"INLINE_ALIGNMENT_BOTTOM", 14},
+	{"INLINE_ALIGNMENT_IMAGE_MASK", 3},
+	{"INLINE_ALIGNMENT_TEXT_MASK", 12},
+}
+
+func (e InlineAlignment) String() string {
+	return formatEnum("InlineAlignment", inlineAlignmentNames, int64(e))
+}
+
+// ParseInlineAlignment returns the InlineAlignment named text, which may also be an integer.
+func ParseInlineAlignment(text string) (InlineAlignment, error) {
+	v, err := parseEnum("InlineAlignment", inlineAlignmentNames, text)
+	return InlineAlignment(v), err
+}
+
+func (e InlineAlignment) MarshalText() ([]byte, error) {
+	return marshalEnum(inlineAlignmentNames, int64(e)), nil
+}
+
+func (e *InlineAlignment) UnmarshalText(text []byte) error {
+	v, err := ParseInlineAlignment(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type EulerOrder int
 
 const (
@@ -90,6 +359,47 @@ const (
 	EULER_ORDER_ZYX EulerOrder = 5
 )
 
+const (
+	EulerOrderXyz = EULER_ORDER_XYZ
+	EulerOrderXzy = EULER_ORDER_XZY
+	EulerOrderYxz = EULER_ORDER_YXZ
+	EulerOrderYzx = EULER_ORDER_YZX
+	EulerOrderZxy = EULER_ORDER_ZXY
+	EulerOrderZyx = EULER_ORDER_ZYX
+)
+
+var eulerOrderNames = []enumName{
+	{"EULER_ORDER_XYZ", 0},
+	{"EULER_ORDER_XZY", 1},
+	{"EULER_ORDER_YXZ", 2},
+	{"EULER_ORDER_YZX", 3},
+	{"EULER_ORDER_ZXY", 4},
+	{"EULER_ORDER_ZYX", 5},
+}
+
+func (e EulerOrder) String() string {
+	return formatEnum("EulerOrder", eulerOrderNames, int64(e))
+}
+
+// ParseEulerOrder returns the EulerOrder named text, which may also be an integer.
+func ParseEulerOrder(text string) (EulerOrder, error) {
+	v, err := parseEnum("EulerOrder", eulerOrderNames, text)
+	return EulerOrder(v), err
+}
+
+func (e EulerOrder) MarshalText() ([]byte, error) {
+	return marshalEnum(eulerOrderNames, int64(e)), nil
+}
+
+func (e *EulerOrder) UnmarshalText(text []byte) error {
+	v, err := ParseEulerOrder(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type Key int
 
 const (
@@ -288,6 +598,421 @@ const (
 	KEY_SECTION       Key = 167
 )
 
+const (
+	KeyNone          = KEY_NONE
+	KeySpecial       = KEY_SPECIAL
+	KeyEscape        = KEY_ESCAPE
+	KeyTab           = KEY_TAB
+	KeyBacktab       = KEY_BACKTAB
+	KeyBackspace     = KEY_BACKSPACE
+	KeyEnter         = KEY_ENTER
+	KeyKpEnter       = KEY_KP_ENTER
+	KeyInsert        = KEY_INSERT
+	KeyDelete        = KEY_DELETE
+	KeyPause         = KEY_PAUSE
+	KeyPrint         = KEY_PRINT
+	KeySysreq        = KEY_SYSREQ
+	KeyClear         = KEY_CLEAR
+	KeyHome          = KEY_HOME
+	KeyEnd           = KEY_END
+	KeyLeft          = KEY_LEFT
+	KeyUp            = KEY_UP
+	KeyRight         = KEY_RIGHT
+	KeyDown          = KEY_DOWN
+	KeyPageup        = KEY_PAGEUP
+	KeyPagedown      = KEY_PAGEDOWN
+	KeyShift         = KEY_SHIFT
+	KeyCtrl          = KEY_CTRL
+	KeyMeta          = KEY_META
+	KeyAlt           = KEY_ALT
+	KeyCapslock      = KEY_CAPSLOCK
+	KeyNumlock       = KEY_NUMLOCK
+	KeyScrolllock    = KEY_SCROLLLOCK
+	KeyF1            = KEY_F1
+	KeyF2            = KEY_F2
+	KeyF3            = KEY_F3
+	KeyF4            = KEY_F4
+	KeyF5            = KEY_F5
+	KeyF6            = KEY_F6
+	KeyF7            = KEY_F7
+	KeyF8            = KEY_F8
+	KeyF9            = KEY_F9
+	KeyF10           = KEY_F10
+	KeyF11           = KEY_F11
+	KeyF12           = KEY_F12
+	KeyF13           = KEY_F13
+	KeyF14           = KEY_F14
+	KeyF15           = KEY_F15
+	KeyF16           = KEY_F16
+	KeyF17           = KEY_F17
+	KeyF18           = KEY_F18
+	KeyF19           = KEY_F19
+	KeyF20           = KEY_F20
+	KeyF21           = KEY_F21
+	KeyF22           = KEY_F22
+	KeyF23           = KEY_F23
+	KeyF24           = KEY_F24
+	KeyF25           = KEY_F25
+	KeyF26           = KEY_F26
+	KeyF27           = KEY_F27
+	KeyF28           = KEY_F28
+	KeyF29           = KEY_F29
+	KeyF30           = KEY_F30
+	KeyF31           = KEY_F31
+	KeyF32           = KEY_F32
+	KeyF33           = KEY_F33
+	KeyF34           = KEY_F34
+	KeyF35           = KEY_F35
+	KeyKpMultiply    = KEY_KP_MULTIPLY
+	KeyKpDivide      = KEY_KP_DIVIDE
+	KeyKpSubtract    = KEY_KP_SUBTRACT
+	KeyKpPeriod      = KEY_KP_PERIOD
+	KeyKpAdd         = KEY_KP_ADD
+	KeyKp0           = KEY_KP_0
+	KeyKp1           = KEY_KP_1
+	KeyKp2           = KEY_KP_2
+	KeyKp3           = KEY_KP_3
+	KeyKp4           = KEY_KP_4
+	KeyKp5           = KEY_KP_5
+	KeyKp6           = KEY_KP_6
+	KeyKp7           = KEY_KP_7
+	KeyKp8           = KEY_KP_8
+	KeyKp9           = KEY_KP_9
+	KeyMenu          = KEY_MENU
+	KeyHyper         = KEY_HYPER
+	KeyHelp          = KEY_HELP
+	KeyBack          = KEY_BACK
+	KeyForward       = KEY_FORWARD
+	KeyStop          = KEY_STOP
+	KeyRefresh       = KEY_REFRESH
+	KeyVolumedown    = KEY_VOLUMEDOWN
+	KeyVolumemute    = KEY_VOLUMEMUTE
+	KeyVolumeup      = KEY_VOLUMEUP
+	KeyMediaplay     = KEY_MEDIAPLAY
+	KeyMediastop     = KEY_MEDIASTOP
+	KeyMediaprevious = KEY_MEDIAPREVIOUS
+	KeyMedianext     = KEY_MEDIANEXT
+	KeyMediarecord   = KEY_MEDIARECORD
+	KeyHomepage      = KEY_HOMEPAGE
+	KeyFavorites     = KEY_FAVORITES
+	KeySearch        = KEY_SEARCH
+	KeyStandby       = KEY_STANDBY
+	KeyOpenurl       = KEY_OPENURL
+	KeyLaunchmail    = KEY_LAUNCHMAIL
+	KeyLaunchmedia   = KEY_LAUNCHMEDIA
+	KeyLaunch0       = KEY_LAUNCH0
+	KeyLaunch1       = KEY_LAUNCH1
+	KeyLaunch2       = KEY_LAUNCH2
+	KeyLaunch3       = KEY_LAUNCH3
+	KeyLaunch4       = KEY_LAUNCH4
+	KeyLaunch5       = KEY_LAUNCH5
+	KeyLaunch6       = KEY_LAUNCH6
+	KeyLaunch7       = KEY_LAUNCH7
+	KeyLaunch8       = KEY_LAUNCH8
+	KeyLaunch9       = KEY_LAUNCH9
+	KeyLauncha       = KEY_LAUNCHA
+	KeyLaunchb       = KEY_LAUNCHB
+	KeyLaunchc       = KEY_LAUNCHC
+	KeyLaunchd       = KEY_LAUNCHD
+	KeyLaunche       = KEY_LAUNCHE
+	KeyLaunchf       = KEY_LAUNCHF
+	KeyGlobe         = KEY_GLOBE
+	KeyKeyboard      = KEY_KEYBOARD
+	KeyJisEisu       = KEY_JIS_EISU
+	KeyJisKana       = KEY_JIS_KANA
+	KeyUnknown       = KEY_UNKNOWN
+	KeySpace         = KEY_SPACE
+	KeyExclam        = KEY_EXCLAM
+	KeyQuotedbl      = KEY_QUOTEDBL
+	KeyNumbersign    = KEY_NUMBERSIGN
+	KeyDollar        = KEY_DOLLAR
+	KeyPercent       = KEY_PERCENT
+	KeyAmpersand     = KEY_AMPERSAND
+	KeyApostrophe    = KEY_APOSTROPHE
+	KeyParenleft     = KEY_PARENLEFT
+	KeyParenright    = KEY_PARENRIGHT
+	KeyAsterisk      = KEY_ASTERISK
+	KeyPlus          = KEY_PLUS
+	KeyComma         = KEY_COMMA
+	KeyMinus         = KEY_MINUS
+	KeyPeriod        = KEY_PERIOD
+	KeySlash         = KEY_SLASH
+	Key0             = KEY_0
+	Key1             = KEY_1
+	Key2             = KEY_2
+	Key3             = KEY_3
+	Key4             = KEY_4
+	Key5             = KEY_5
+	Key6             = KEY_6
+	Key7             = KEY_7
+	Key8             = KEY_8
+	Key9             = KEY_9
+	KeyColon         = KEY_COLON
+	KeySemicolon     = KEY_SEMICOLON
+	KeyLess          = KEY_LESS
+	KeyEqual         = KEY_EQUAL
+	KeyGreater       = KEY_GREATER
+	KeyQuestion      = KEY_QUESTION
+	KeyAt            = KEY_AT
+	KeyA             = KEY_A
+	KeyB             = KEY_B
+	KeyC             = KEY_C
+	KeyD             = KEY_D
+	KeyE             = KEY_E
+	KeyF             = KEY_F
+	KeyG             = KEY_G
+	KeyH             = KEY_H
+	KeyI             = KEY_I
+	KeyJ             = KEY_J
+	KeyK             = KEY_K
+	KeyL             = KEY_L
+	KeyM             = KEY_M
+	KeyN             = KEY_N
+	KeyO             = KEY_O
+	KeyP             = KEY_P
+	KeyQ             = KEY_Q
+	KeyR             = KEY_R
+	KeyS             = KEY_S
+	KeyT             = KEY_T
+	KeyU             = KEY_U
+	KeyV             = KEY_V
+	KeyW             = KEY_W
+	KeyX             = KEY_X
+	KeyY             = KEY_Y
+	KeyZ             = KEY_Z
+	KeyBracketleft   = KEY_BRACKETLEFT
+	KeyBackslash     = KEY_BACKSLASH
+	KeyBracketright  = KEY_BRACKETRIGHT
+	KeyAsciicircum   = KEY_ASCIICIRCUM
+	KeyUnderscore    = KEY_UNDERSCORE
+	KeyQuoteleft     = KEY_QUOTELEFT
+	KeyBraceleft     = KEY_BRACELEFT
+	KeyBar           = KEY_BAR
+	KeyBraceright    = KEY_BRACERIGHT
+	KeyAsciitilde    = KEY_ASCIITILDE
+	KeyYen           = KEY_YEN
+	KeySection       = KEY_SECTION
+)
+
+var keyNames = []enumName{
+	{"KEY_NONE", 0},
+	{"KEY_SPECIAL", 4194304},
+	{"KEY_ESCAPE", 4194305},
+	{"KEY_TAB", 4194306},
+	{"KEY_BACKTAB", 4194307},
+	{"KEY_BACKSPACE", 4194308},
+	{"KEY_ENTER", 4194309},
+	{"KEY_KP_ENTER", 4194310},
+	{"KEY_INSERT", 4194311},
+	{"KEY_DELETE", 4194312},
+	{"KEY_PAUSE", 4194313},
+	{"KEY_PRINT", 4194314},
+	{"KEY_SYSREQ", 4194315},
+	{"KEY_CLEAR", 4194316},
+	{"KEY_HOME", 4194317},
+	{"KEY_END", 4194318},
+	{"KEY_LEFT", 4194319},
+	{"KEY_UP", 4194320},
+	{"KEY_RIGHT", 4194321},
+	{"KEY_DOWN", 4194322},
+	{"KEY_PAGEUP", 4194323},
+	{"KEY_PAGEDOWN", 4194324},
+	{"KEY_SHIFT", 4194325},
+	{"KEY_CTRL", 4194326},
+	{"KEY_META", 4194327},
+	{"KEY_ALT", 4194328},
+	{"KEY_CAPSLOCK", 4194329},
+	{"KEY_NUMLOCK", 4194330},
+	{"KEY_SCROLLLOCK", 4194331},
+	{"KEY_F1", 4194332},
+	{"KEY_F2", 4194333},
+	{"KEY_F3", 4194334},
+	{"KEY_F4", 4194335},
+	{"KEY_F5", 4194336},
+	{"KEY_F6", 4194337},
+	{"KEY_F7", 4194338},
+	{"KEY_F8", 4194339},
+	{"KEY_F9", 4194340},
+	{"KEY_F10", 4194341},
+	{"KEY_F11", 4194342},
+	{"KEY_F12", 4194343},
+	{"KEY_F13", 4194344},
+	{"KEY_F14", 4194345},
+	{"KEY_F15", 4194346},
+	{"KEY_F16", 4194347},
+	{"KEY_F17", 4194348},
+	{"KEY_F18", 4194349},
+	{"KEY_F19", 4194350},
+	{"KEY_F20", 4194351},
+	{"KEY_F21", 4194352},
+	{"KEY_F22", 4194353},
+	{"KEY_F23", 4194354},
+	{"KEY_F24", 4194355},
+	{"KEY_F25", 4194356},
+	{"KEY_F26", 4194357},
+	{"KEY_F27", 4194358},
+	{"KEY_F28", 4194359},
+	{"KEY_F29", 4194360},
+	{"KEY_F30", 4194361},
+	{"KEY_F31", 4194362},
+	{"KEY_F32", 4194363},
+	{"KEY_F33", 4194364},
+	{"KEY_F34", 4194365},
+	{"KEY_F35", 4194366},
+	{"KEY_KP_MULTIPLY", 4194433},
+	{"KEY_KP_DIVIDE", 4194434},
+	{"KEY_KP_SUBTRACT", 4194435},
+	{"KEY_KP_PERIOD", 4194436},
+	{"KEY_KP_ADD", 4194437},
+	{"KEY_KP_0", 4194438},
+	{"KEY_KP_1", 4194439},
+	{"KEY_KP_2", 4194440},
+	{"KEY_KP_3", 4194441},
+	{"KEY_KP_4", 4194442},
+	{"KEY_KP_5", 4194443},
+	{"KEY_KP_6", 4194444},
+	{"KEY_KP_7", 4194445},
+	{"KEY_KP_8", 4194446},
+	{"KEY_KP_9", 4194447},
+	{"KEY_MENU", 4194370},
+	{"KEY_HYPER", 4194371},
+	{"KEY_HELP", 4194373},
+	{"KEY_BACK", 4194376},
+	{"KEY_FORWARD", 4194377},
+	{"KEY_STOP", 4194378},
+	{"KEY_REFRESH", 4194379},
+	{"KEY_VOLUMEDOWN", 4194380},
+	{"KEY_VOLUMEMUTE", 4194381},
+	{"KEY_VOLUMEUP", 4194382},
+	{"KEY_MEDIAPLAY", 4194388},
+	{"KEY_MEDIASTOP", 4194389},
+	{"KEY_MEDIAPREVIOUS", 4194390},
+	{"KEY_MEDIANEXT", 4194391},
+	{"KEY_MEDIARECORD", 4194392},
+	{"KEY_HOMEPAGE", 4194393},
+	{"KEY_FAVORITES", 4194394},
+	{"KEY_SEARCH", 4194395},
+	{"KEY_STANDBY", 4194396},
+	{"KEY_OPENURL", 4194397},
+	{"KEY_LAUNCHMAIL", 4194398},
+	{"KEY_LAUNCHMEDIA", 4194399},
+	{"KEY_LAUNCH0", 4194400},
+	{"KEY_LAUNCH1", 4194401},
+	{"KEY_LAUNCH2", 4194402},
+	{"KEY_LAUNCH3", 4194403},
+	{"KEY_LAUNCH4", 4194404},
+	{"KEY_LAUNCH5", 4194405},
+	{"KEY_LAUNCH6", 4194406},
+	{"KEY_LAUNCH7", 4194407},
+	{"KEY_LAUNCH8", 4194408},
+	{"KEY_LAUNCH9", 4194409},
+	{"KEY_LAUNCHA", 4194410},
+	{"KEY_LAUNCHB", 4194411},
+	{"KEY_LAUNCHC", 4194412},
+	{"KEY_LAUNCHD", 4194413},
+	{"KEY_LAUNCHE", 4194414},
+	{"KEY_LAUNCHF", 4194415},
+	{"KEY_GLOBE", 4194416},
+	{"KEY_KEYBOARD", 4194417},
+	{"KEY_JIS_EISU", 4194418},
+	{"KEY_JIS_KANA", 4194419},
+	{"KEY_UNKNOWN", 8388607},
+	{"KEY_SPACE", 32},
+	{"KEY_EXCLAM", 33},
+	{"KEY_QUOTEDBL", 34},
+	{"KEY_NUMBERSIGN", 35},
+	{"KEY_DOLLAR", 36},
+	{"KEY_PERCENT", 37},
+	{"KEY_AMPERSAND", 38},
+	{"KEY_APOSTROPHE", 39},
+	{"KEY_PARENLEFT", 40},
+	{"KEY_PARENRIGHT", 41},
+	{"KEY_ASTERISK", 42},
+	{"KEY_PLUS", 43},
+	{"KEY_COMMA", 44},
+	{"KEY_MINUS", 45},
+	{"KEY_PERIOD", 46},
+	{"KEY_SLASH", 47},
+	{"KEY_0", 48},
+	{"KEY_1", 49},
+	{"KEY_2", 50},
+	{"KEY_3", 51},
+	{"KEY_4", 52},
+	{"KEY_5", 53},
+	{"KEY_6", 54},
+	{"KEY_7", 55},
+	{"KEY_8", 56},
+	{"KEY_9", 57},
+	{"KEY_COLON", 58},
+	{"KEY_SEMICOLON", 59},
+	{"KEY_LESS", 60},
+	{"KEY_EQUAL", 61},
+	{"KEY_GREATER", 62},
+	{"KEY_QUESTION", 63},
+	{"KEY_AT", 64},
+	{"KEY_A", 65},
+	{"KEY_B", 66},
+	{"KEY_C", 67},
+	{"KEY_D", 68},
+	{"KEY_E", 69},
+	{"KEY_F", 70},
+	{"KEY_G", 71},
+	{"KEY_H", 72},
+	{"KEY_I", 73},
+	{"KEY_J", 74},
+	{"KEY_K", 75},
+	{"KEY_L", 76},
+	{"KEY_M", 77},
+	{"KEY_N", 78},
+	{"KEY_O", 79},
+	{"KEY_P", 80},
+	{"KEY_Q", 81},
+	{"KEY_R", 82},
+	{"KEY_S", 83},
+	{"KEY_T", 84},
+	{"KEY_U", 85},
+	{"KEY_V", 86},
+	{"KEY_W", 87},
+	{"KEY_X", 88},
+	{"KEY_Y", 89},
+	{"KEY_Z", 90},
+	{"KEY_BRACKETLEFT", 91},
+	{"KEY_BACKSLASH", 92},
+	{"KEY_BRACKETRIGHT", 93},
+	{"KEY_ASCIICIRCUM", 94},
+	{"KEY_UNDERSCORE", 95},
+	{"KEY_QUOTELEFT", 96},
+	{"KEY_BRACELEFT", 123},
+	{"KEY_BAR", 124},
+	{"KEY_BRACERIGHT", 125},
+	{"KEY_ASCIITILDE", 126},
+	{"KEY_YEN", 165},
+	{"KEY_SECTION", 167},
+}
+
+func (e Key) String() string {
+	return formatEnum("Key", keyNames, int64(e))
+}
+
+// ParseKey returns the Key named text, which may also be an integer.
+func ParseKey(text string) (Key, error) {
+	v, err := parseEnum("Key", keyNames, text)
+	return Key(v), err
+}
+
+func (e Key) MarshalText() ([]byte, error) {
+	return marshalEnum(keyNames, int64(e)), nil
+}
+
+func (e *Key) UnmarshalText(text []byte) error {
+	v, err := ParseKey(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type KeyModifierMask int
 
 const (
@@ -302,6 +1027,68 @@ const (
 	KEY_MASK_GROUP_SWITCH KeyModifierMask = 1073741824
 )
 
+const (
+	KeyModifierMaskCodeMask        = KEY_CODE_MASK
+	KeyModifierMaskModifierMask    = KEY_MODIFIER_MASK
+	KeyModifierMaskMaskCmdOrCtrl   = KEY_MASK_CMD_OR_CTRL
+	KeyModifierMaskMaskShift       = KEY_MASK_SHIFT
+	KeyModifierMaskMaskAlt         = KEY_MASK_ALT
+	KeyModifierMaskMaskMeta        = KEY_MASK_META
+	KeyModifierMaskMaskCtrl        = KEY_MASK_CTRL
+	KeyModifierMaskMaskKpad        = KEY_MASK_KPAD
+	KeyModifierMaskMaskGroupSwitch = KEY_MASK_GROUP_SWITCH
+)
+
+var keyModifierMaskNames = []enumName{
+	{"KEY_CODE_MASK", 8388607},
+	{"KEY_MODIFIER_MASK", 2130706432},
+	{"KEY_MASK_CMD_OR_CTRL", 16777216},
+	{"KEY_MASK_SHIFT", 33554432},
+	{"KEY_MASK_ALT", 67108864},
+	{"KEY_MASK_META", 134217728},
+	{"KEY_MASK_CTRL", 268435456},
+	{"KEY_MASK_KPAD", 536870912},
+	{"KEY_MASK_GROUP_SWITCH", 1073741824},
+}
+
+func (e KeyModifierMask) String() string {
+	return formatBitfield("KeyModifierMask", keyModifierMaskNames, int64(e))
+}
+
+// ParseKeyModifierMask returns the KeyModifierMask named text, which may also be an integer or several flags separated by "|".
+func ParseKeyModifierMask(text string) (KeyModifierMask, error) {
+	v, err := parseBitfield("KeyModifierMask", keyModifierMaskNames, text)
+	return KeyModifierMask(v), err
+}
+
+func (e KeyModifierMask) MarshalText() ([]byte, error) {
+	return marshalBitfield(keyModifierMaskNames, int64(e)), nil
+}
+
+func (e *KeyModifierMask) UnmarshalText(text []byte) error {
+	v, err := ParseKeyModifierMask(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// Has reports whether all of flags are set in e.
+func (e KeyModifierMask) Has(flags KeyModifierMask) bool {
+	return e&flags == flags
+}
+
+// Set returns e with flags set.
+func (e KeyModifierMask) Set(flags KeyModifierMask) KeyModifierMask {
+	return e | flags
+}
+
+// Clear returns e with flags cleared.
+func (e KeyModifierMask) Clear(flags KeyModifierMask) KeyModifierMask {
+	return e &^ flags
+}
+
 type KeyLocation int
 
 const (
@@ -310,6 +1097,41 @@ const (
 	KEY_LOCATION_RIGHT       KeyLocation = 2
 )
 
+const (
+	KeyLocationUnspecified = KEY_LOCATION_UNSPECIFIED
+	KeyLocationLeft        = KEY_LOCATION_LEFT
+	KeyLocationRight       = KEY_LOCATION_RIGHT
+)
+
+var keyLocationNames = []enumName{
+	{"KEY_LOCATION_UNSPECIFIED", 0},
+	{"KEY_LOCATION_LEFT", 1},
+	{"KEY_LOCATION_RIGHT", 2},
+}
+
+func (e KeyLocation) String() string {
+	return formatEnum("KeyLocation", keyLocationNames, int64(e))
+}
+
+// ParseKeyLocation returns the KeyLocation named text, which may also be an integer.
+func ParseKeyLocation(text string) (KeyLocation, error) {
+	v, err := parseEnum("KeyLocation", keyLocationNames, text)
+	return KeyLocation(v), err
+}
+
+func (e KeyLocation) MarshalText() ([]byte, error) {
+	return marshalEnum(keyLocationNames, int64(e)), nil
+}
+
+func (e *KeyLocation) UnmarshalText(text []byte) error {
+	v, err := ParseKeyLocation(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type MouseButton int
 
 const (
@@ -325,6 +1147,55 @@ const (
 	MOUSE_BUTTON_XBUTTON2    MouseButton = 9
 )
 
+const (
+	MouseButtonNone       = MOUSE_BUTTON_NONE
+	MouseButtonLeft       = MOUSE_BUTTON_LEFT
+	MouseButtonRight      = MOUSE_BUTTON_RIGHT
+	MouseButtonMiddle     = MOUSE_BUTTON_MIDDLE
+	MouseButtonWheelUp    = MOUSE_BUTTON_WHEEL_UP
+	MouseButtonWheelDown  = MOUSE_BUTTON_WHEEL_DOWN
+	MouseButtonWheelLeft  = MOUSE_BUTTON_WHEEL_LEFT
+	MouseButtonWheelRight = MOUSE_BUTTON_WHEEL_RIGHT
+	MouseButtonXbutton1   = MOUSE_BUTTON_XBUTTON1
+	MouseButtonXbutton2   = MOUSE_BUTTON_XBUTTON2
+)
+
+var mouseButtonNames = []enumName{
+	{"MOUSE_BUTTON_NONE", 0},
+	{"MOUSE_BUTTON_LEFT", 1},
+	{"MOUSE_BUTTON_RIGHT", 2},
+	{"MOUSE_BUTTON_MIDDLE", 3},
+	{"MOUSE_BUTTON_WHEEL_UP", 4},
+	{"MOUSE_BUTTON_WHEEL_DOWN", 5},
+	{"MOUSE_BUTTON_WHEEL_LEFT", 6},
+	{"MOUSE_BUTTON_WHEEL_RIGHT", 7},
+	{"MOUSE_BUTTON_XBUTTON1", 8},
+	{"MOUSE_BUTTON_XBUTTON2", 9},
+}
+
+func (e MouseButton) String() string {
+	return formatEnum("MouseButton", mouseButtonNames, int64(e))
+}
+
+// ParseMouseButton returns the MouseButton named text, which may also be an integer.
+func ParseMouseButton(text string) (MouseButton, error) {
+	v, err := parseEnum("MouseButton", mouseButtonNames, text)
+	return MouseButton(v), err
+}
+
+func (e MouseButton) MarshalText() ([]byte, error) {
+	return marshalEnum(mouseButtonNames, int64(e)), nil
+}
+
+func (e *MouseButton) UnmarshalText(text []byte) error {
+	v, err := ParseMouseButton(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type MouseButtonMask int
 
 const (
@@ -335,6 +1206,60 @@ const (
 	MOUSE_BUTTON_MASK_MB_XBUTTON2 MouseButtonMask = 256
 )
 
+const (
+	MouseButtonMaskLeft       = MOUSE_BUTTON_MASK_LEFT
+	MouseButtonMaskRight      = MOUSE_BUTTON_MASK_RIGHT
+	MouseButtonMaskMiddle     = MOUSE_BUTTON_MASK_MIDDLE
+	MouseButtonMaskMbXbutton1 = MOUSE_BUTTON_MASK_MB_XBUTTON1
+	MouseButtonMaskMbXbutton2 = MOUSE_BUTTON_MASK_MB_XBUTTON2
+)
+
+var mouseButtonMaskNames = []enumName{
+	{"MOUSE_BUTTON_MASK_LEFT", 1},
+	{"MOUSE_BUTTON_MASK_RIGHT", 2},
+	{"MOUSE_BUTTON_MASK_MIDDLE", 4},
+	{"MOUSE_BUTTON_MASK_MB_XBUTTON1", 128},
+	{"MOUSE_BUTTON_MASK_MB_XBUTTON2", 256},
+}
+
+func (e MouseButtonMask) String() string {
+	return formatBitfield("MouseButtonMask", mouseButtonMaskNames, int64(e))
+}
+
+// ParseMouseButtonMask returns the MouseButtonMask named text, which may also be an integer or several flags separated by "|".
+func ParseMouseButtonMask(text string) (MouseButtonMask, error) {
+	v, err := parseBitfield("MouseButtonMask", mouseButtonMaskNames, text)
+	return MouseButtonMask(v), err
+}
+
+func (e MouseButtonMask) MarshalText() ([]byte, error) {
+	return marshalBitfield(mouseButtonMaskNames, int64(e)), nil
+}
+
+func (e *MouseButtonMask) UnmarshalText(text []byte) error {
+	v, err := ParseMouseButtonMask(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// Has reports whether all of flags are set in e.
+func (e MouseButtonMask) Has(flags MouseButtonMask) bool {
+	return e&flags == flags
+}
+
+// Set returns e with flags set.
+func (e MouseButtonMask) Set(flags MouseButtonMask) MouseButtonMask {
+	return e | flags
+}
+
+// Clear returns e with flags cleared.
+func (e MouseButtonMask) Clear(flags MouseButtonMask) MouseButtonMask {
+	return e &^ flags
+}
+
 type JoyButton int
 
 const (
@@ -364,6 +1289,83 @@ const (
 	JOY_BUTTON_MAX            JoyButton = 128
 )
 
+const (
+	JoyButtonInvalid       = JOY_BUTTON_INVALID
+	JoyButtonA             = JOY_BUTTON_A
+	JoyButtonB             = JOY_BUTTON_B
+	JoyButtonX             = JOY_BUTTON_X
+	JoyButtonY             = JOY_BUTTON_Y
+	JoyButtonBack          = JOY_BUTTON_BACK
+	JoyButtonGuide         = JOY_BUTTON_GUIDE
+	JoyButtonStart         = JOY_BUTTON_START
+	JoyButtonLeftStick     = JOY_BUTTON_LEFT_STICK
+	JoyButtonRightStick    = JOY_BUTTON_RIGHT_STICK
+	JoyButtonLeftShoulder  = JOY_BUTTON_LEFT_SHOULDER
+	JoyButtonRightShoulder = JOY_BUTTON_RIGHT_SHOULDER
+	JoyButtonDpadUp        = JOY_BUTTON_DPAD_UP
+	JoyButtonDpadDown      = JOY_BUTTON_DPAD_DOWN
+	JoyButtonDpadLeft      = JOY_BUTTON_DPAD_LEFT
+	JoyButtonDpadRight     = JOY_BUTTON_DPAD_RIGHT
+	JoyButtonMisc1         = JOY_BUTTON_MISC1
+	JoyButtonPaddle1       = JOY_BUTTON_PADDLE1
+	JoyButtonPaddle2       = JOY_BUTTON_PADDLE2
+	JoyButtonPaddle3       = JOY_BUTTON_PADDLE3
+	JoyButtonPaddle4       = JOY_BUTTON_PADDLE4
+	JoyButtonTouchpad      = JOY_BUTTON_TOUCHPAD
+	JoyButtonSdlMax        = JOY_BUTTON_SDL_MAX
+	JoyButtonMax           = JOY_BUTTON_MAX
+)
+
+var joyButtonNames = []enumName{
+	{"JOY_BUTTON_INVALID", -1},
+	{"JOY_BUTTON_A", 0},
+	{"JOY_BUTTON_B", 1},
+	{"JOY_BUTTON_X", 2},
+	{"JOY_BUTTON_Y", 3},
+	{"JOY_BUTTON_BACK", 4},
+	{"JOY_BUTTON_GUIDE", 5},
+	{"JOY_BUTTON_START", 6},
+	{"JOY_BUTTON_LEFT_STICK", 7},
+	{"JOY_BUTTON_RIGHT_STICK", 8},
+	{"JOY_BUTTON_LEFT_SHOULDER", 9},
+	{"JOY_BUTTON_RIGHT_SHOULDER", 10},
+	{"JOY_BUTTON_DPAD_UP", 11},
+	{"JOY_BUTTON_DPAD_DOWN", 12},
+	{"JOY_BUTTON_DPAD_LEFT", 13},
+	{"JOY_BUTTON_DPAD_RIGHT", 14},
+	{"JOY_BUTTON_MISC1", 15},
+	{"JOY_BUTTON_PADDLE1", 16},
+	{"JOY_BUTTON_PADDLE2", 17},
+	{"JOY_BUTTON_PADDLE3", 18},
+	{"JOY_BUTTON_PADDLE4", 19},
+	{"JOY_BUTTON_TOUCHPAD", 20},
+	{"JOY_BUTTON_SDL_MAX", 21},
+	{"JOY_BUTTON_MAX", 128},
+}
+
+func (e JoyButton) String() string {
+	return formatEnum("JoyButton", joyButtonNames, int64(e))
+}
+
+// ParseJoyButton returns the JoyButton named text, which may also be an integer.
+func ParseJoyButton(text string) (JoyButton, error) {
+	v, err := parseEnum("JoyButton", joyButtonNames, text)
+	return JoyButton(v), err
+}
+
+func (e JoyButton) MarshalText() ([]byte, error) {
+	return marshalEnum(joyButtonNames, int64(e)), nil
+}
+
+func (e *JoyButton) UnmarshalText(text []byte) error {
+	v, err := ParseJoyButton(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type JoyAxis int
 
 const (
@@ -378,6 +1380,53 @@ const (
 	JOY_AXIS_MAX           JoyAxis = 10
 )
 
+const (
+	JoyAxisInvalid      = JOY_AXIS_INVALID
+	JoyAxisLeftX        = JOY_AXIS_LEFT_X
+	JoyAxisLeftY        = JOY_AXIS_LEFT_Y
+	JoyAxisRightX       = JOY_AXIS_RIGHT_X
+	JoyAxisRightY       = JOY_AXIS_RIGHT_Y
+	JoyAxisTriggerLeft  = JOY_AXIS_TRIGGER_LEFT
+	JoyAxisTriggerRight = JOY_AXIS_TRIGGER_RIGHT
+	JoyAxisSdlMax       = JOY_AXIS_SDL_MAX
+	JoyAxisMax          = JOY_AXIS_MAX
+)
+
+var joyAxisNames = []enumName{
+	{"JOY_AXIS_INVALID", -1},
+	{"JOY_AXIS_LEFT_X", 0},
+	{"JOY_AXIS_LEFT_Y", 1},
+	{"JOY_AXIS_RIGHT_X", 2},
+	{"JOY_AXIS_RIGHT_Y", 3},
+	{"JOY_AXIS_TRIGGER_LEFT", 4},
+	{"JOY_AXIS_TRIGGER_RIGHT", 5},
+	{"JOY_AXIS_SDL_MAX", 6},
+	{"JOY_AXIS_MAX", 10},
+}
+
+func (e JoyAxis) String() string {
+	return formatEnum("JoyAxis", joyAxisNames, int64(e))
+}
+
+// ParseJoyAxis returns the JoyAxis named text, which may also be an integer.
+func ParseJoyAxis(text string) (JoyAxis, error) {
+	v, err := parseEnum("JoyAxis", joyAxisNames, text)
+	return JoyAxis(v), err
+}
+
+func (e JoyAxis) MarshalText() ([]byte, error) {
+	return marshalEnum(joyAxisNames, int64(e)), nil
+}
+
+func (e *JoyAxis) UnmarshalText(text []byte) error {
+	v, err := ParseJoyAxis(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type MIDIMessage int
 
 const (
@@ -402,6 +1451,73 @@ const (
 	MIDI_MESSAGE_SYSTEM_RESET          MIDIMessage = 255
 )
 
+const (
+	MIDIMessageNone                = MIDI_MESSAGE_NONE
+	MIDIMessageNoteOff             = MIDI_MESSAGE_NOTE_OFF
+	MIDIMessageNoteOn              = MIDI_MESSAGE_NOTE_ON
+	MIDIMessageAftertouch          = MIDI_MESSAGE_AFTERTOUCH
+	MIDIMessageControlChange       = MIDI_MESSAGE_CONTROL_CHANGE
+	MIDIMessageProgramChange       = MIDI_MESSAGE_PROGRAM_CHANGE
+	MIDIMessageChannelPressure     = MIDI_MESSAGE_CHANNEL_PRESSURE
+	MIDIMessagePitchBend           = MIDI_MESSAGE_PITCH_BEND
+	MIDIMessageSystemExclusive     = MIDI_MESSAGE_SYSTEM_EXCLUSIVE
+	MIDIMessageQuarterFrame        = MIDI_MESSAGE_QUARTER_FRAME
+	MIDIMessageSongPositionPointer = MIDI_MESSAGE_SONG_POSITION_POINTER
+	MIDIMessageSongSelect          = MIDI_MESSAGE_SONG_SELECT
+	MIDIMessageTuneRequest         = MIDI_MESSAGE_TUNE_REQUEST
+	MIDIMessageTimingClock         = MIDI_MESSAGE_TIMING_CLOCK
+	MIDIMessageStart               = MIDI_MESSAGE_START
+	MIDIMessageContinue            = MIDI_MESSAGE_CONTINUE
+	MIDIMessageStop                = MIDI_MESSAGE_STOP
+	MIDIMessageActiveSensing       = MIDI_MESSAGE_ACTIVE_SENSING
+	MIDIMessageSystemReset         = MIDI_MESSAGE_SYSTEM_RESET
+)
+
+var mIDIMessageNames = []enumName{
+	{"MIDI_MESSAGE_NONE", 0},
+	{"MIDI_MESSAGE_NOTE_OFF", 8},
+	{"MIDI_MESSAGE_NOTE_ON", 9},
+	{"MIDI_MESSAGE_AFTERTOUCH", 10},
+	{"MIDI_MESSAGE_CONTROL_CHANGE", 11},
+	{"MIDI_MESSAGE_PROGRAM_CHANGE", 12},
+	{"MIDI_MESSAGE_CHANNEL_PRESSURE", 13},
+	{"MIDI_MESSAGE_PITCH_BEND", 14},
+	{"MIDI_MESSAGE_SYSTEM_EXCLUSIVE", 240},
+	{"MIDI_MESSAGE_QUARTER_FRAME", 241},
+	{"MIDI_MESSAGE_SONG_POSITION_POINTER", 242},
+	{"MIDI_MESSAGE_SONG_SELECT", 243},
+	{"MIDI_MESSAGE_TUNE_REQUEST", 246},
+	{"MIDI_MESSAGE_TIMING_CLOCK", 248},
+	{"MIDI_MESSAGE_START", 250},
+	{"MIDI_MESSAGE_CONTINUE", 251},
+	{"MIDI_MESSAGE_STOP", 252},
+	{"MIDI_MESSAGE_ACTIVE_SENSING", 254},
+	{"MIDI_MESSAGE_SYSTEM_RESET", 255},
+}
+
+func (e MIDIMessage) String() string {
+	return formatEnum("MIDIMessage", mIDIMessageNames, int64(e))
+}
+
+// ParseMIDIMessage returns the MIDIMessage named text, which may also be an integer.
+func ParseMIDIMessage(text string) (MIDIMessage, error) {
+	v, err := parseEnum("MIDIMessage", mIDIMessageNames, text)
+	return MIDIMessage(v), err
+}
+
+func (e MIDIMessage) MarshalText() ([]byte, error) {
+	return marshalEnum(mIDIMessageNames, int64(e)), nil
+}
+
+func (e *MIDIMessage) UnmarshalText(text []byte) error {
+	v, err := ParseMIDIMessage(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type Error int
 
 const (
@@ -456,6 +1572,133 @@ const (
 	ERR_PRINTER_ON_FIRE           Error = 48
 )
 
+const (
+	ErrorOk                         = OK
+	ErrorFailed                     = FAILED
+	ErrorErrUnavailable             = ERR_UNAVAILABLE
+	ErrorErrUnconfigured            = ERR_UNCONFIGURED
+	ErrorErrUnauthorized            = ERR_UNAUTHORIZED
+	ErrorErrParameterRangeError     = ERR_PARAMETER_RANGE_ERROR
+	ErrorErrOutOfMemory             = ERR_OUT_OF_MEMORY
+	ErrorErrFileNotFound            = ERR_FILE_NOT_FOUND
+	ErrorErrFileBadDrive            = ERR_FILE_BAD_DRIVE
+	ErrorErrFileBadPath             = ERR_FILE_BAD_PATH
+	ErrorErrFileNoPermission        = ERR_FILE_NO_PERMISSION
+	ErrorErrFileAlreadyInUse        = ERR_FILE_ALREADY_IN_USE
+	ErrorErrFileCantOpen            = ERR_FILE_CANT_OPEN
+	ErrorErrFileCantWrite           = ERR_FILE_CANT_WRITE
+	ErrorErrFileCantRead            = ERR_FILE_CANT_READ
+	ErrorErrFileUnrecognized        = ERR_FILE_UNRECOGNIZED
+	ErrorErrFileCorrupt             = ERR_FILE_CORRUPT
+	ErrorErrFileMissingDependencies = ERR_FILE_MISSING_DEPENDENCIES
+	ErrorErrFileEof                 = ERR_FILE_EOF
+	ErrorErrCantOpen                = ERR_CANT_OPEN
+	ErrorErrCantCreate              = ERR_CANT_CREATE
+	ErrorErrQueryFailed             = ERR_QUERY_FAILED
+	ErrorErrAlreadyInUse            = ERR_ALREADY_IN_USE
+	ErrorErrLocked                  = ERR_LOCKED
+	ErrorErrTimeout                 = ERR_TIMEOUT
+	ErrorErrCantConnect             = ERR_CANT_CONNECT
+	ErrorErrCantResolve             = ERR_CANT_RESOLVE
+	ErrorErrConnectionError         = ERR_CONNECTION_ERROR
+	ErrorErrCantAcquireResource     = ERR_CANT_ACQUIRE_RESOURCE
+	ErrorErrCantFork                = ERR_CANT_FORK
+	ErrorErrInvalidData             = ERR_INVALID_DATA
+	ErrorErrInvalidParameter        = ERR_INVALID_PARAMETER
+	ErrorErrAlreadyExists           = ERR_ALREADY_EXISTS
+	ErrorErrDoesNotExist            = ERR_DOES_NOT_EXIST
+	ErrorErrDatabaseCantRead        = ERR_DATABASE_CANT_READ
+	ErrorErrDatabaseCantWrite       = ERR_DATABASE_CANT_WRITE
+	ErrorErrCompilationFailed       = ERR_COMPILATION_FAILED
+	ErrorErrMethodNotFound          = ERR_METHOD_NOT_FOUND
+	ErrorErrLinkFailed              = ERR_LINK_FAILED
+	ErrorErrScriptFailed            = ERR_SCRIPT_FAILED
+	ErrorErrCyclicLink              = ERR_CYCLIC_LINK
+	ErrorErrInvalidDeclaration      = ERR_INVALID_DECLARATION
+	ErrorErrDuplicateSymbol         = ERR_DUPLICATE_SYMBOL
+	ErrorErrParseError              = ERR_PARSE_ERROR
+	ErrorErrBusy                    = ERR_BUSY
+	ErrorErrSkip                    = ERR_SKIP
+	ErrorErrHelp                    = ERR_HELP
+	ErrorErrBug                     = ERR_BUG
+	ErrorErrPrinterOnFire           = ERR_PRINTER_ON_FIRE
+)
+
+var errorNames = []enumName{
+	{"OK", 0},
+	{"FAILED", 1},
+	{"ERR_UNAVAILABLE", 2},
+	{"ERR_UNCONFIGURED", 3},
+	{"ERR_UNAUTHORIZED", 4},
+	{"ERR_PARAMETER_RANGE_ERROR", 5},
+	{"ERR_OUT_OF_MEMORY", 6},
+	{"ERR_FILE_NOT_FOUND", 7},
+	{"ERR_FILE_BAD_DRIVE", 8},
+	{"ERR_FILE_BAD_PATH", 9},
+	{"ERR_FILE_NO_PERMISSION", 10},
+	{"ERR_FILE_ALREADY_IN_USE", 11},
+	{"ERR_FILE_CANT_OPEN", 12},
+	{"ERR_FILE_CANT_WRITE", 13},
+	{"ERR_FILE_CANT_READ", 14},
+	{"ERR_FILE_UNRECOGNIZED", 15},
+	{"ERR_FILE_CORRUPT", 16},
+	{"ERR_FILE_MISSING_DEPENDENCIES", 17},
+	{"ERR_FILE_EOF", 18},
+	{"ERR_CANT_OPEN", 19},
+	{"ERR_CANT_CREATE", 20},
+	{"ERR_QUERY_FAILED", 21},
+	{"ERR_ALREADY_IN_USE", 22},
+	{"ERR_LOCKED", 23},
+	{"ERR_TIMEOUT", 24},
+	{"ERR_CANT_CONNECT", 25},
+	{"ERR_CANT_RESOLVE", 26},
+	{"ERR_CONNECTION_ERROR", 27},
+	{"ERR_CANT_ACQUIRE_RESOURCE", 28},
+	{"ERR_CANT_FORK", 29},
+	{"ERR_INVALID_DATA", 30},
+	{"ERR_INVALID_PARAMETER", 31},
+	{"ERR_ALREADY_EXISTS", 32},
+	{"ERR_DOES_NOT_EXIST", 33},
+	{"ERR_DATABASE_CANT_READ", 34},
+	{"ERR_DATABASE_CANT_WRITE", 35},
+	{"ERR_COMPILATION_FAILED", 36},
+	{"ERR_METHOD_NOT_FOUND", 37},
+	{"ERR_LINK_FAILED", 38},
+	{"ERR_SCRIPT_FAILED", 39},
+	{"ERR_CYCLIC_LINK", 40},
+	{"ERR_INVALID_DECLARATION", 41},
+	{"ERR_DUPLICATE_SYMBOL", 42},
+	{"ERR_PARSE_ERROR", 43},
+	{"ERR_BUSY", 44},
+	{"ERR_SKIP", 45},
+	{"ERR_HELP", 46},
+	{"ERR_BUG", 47},
+	{"ERR_PRINTER_ON_FIRE", 48},
+}
+
+func (e Error) String() string {
+	return formatEnum("Error", errorNames, int64(e))
+}
+
+// ParseError returns the Error named text, which may also be an integer.
+func ParseError(text string) (Error, error) {
+	v, err := parseEnum("Error", errorNames, text)
+	return Error(v), err
+}
+
+func (e Error) MarshalText() ([]byte, error) {
+	return marshalEnum(errorNames, int64(e)), nil
+}
+
+func (e *Error) UnmarshalText(text []byte) error {
+	v, err := ParseError(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type PropertyHint int
 
 const (
@@ -506,6 +1749,125 @@ const (
 	PROPERTY_HINT_MAX                      PropertyHint = 45
 )
 
+const (
+	PropertyHintNone                 = PROPERTY_HINT_NONE
+	PropertyHintRange                = PROPERTY_HINT_RANGE
+	PropertyHintEnum                 = PROPERTY_HINT_ENUM
+	PropertyHintEnumSuggestion       = PROPERTY_HINT_ENUM_SUGGESTION
+	PropertyHintExpEasing            = PROPERTY_HINT_EXP_EASING
+	PropertyHintLink                 = PROPERTY_HINT_LINK
+	PropertyHintFlags                = PROPERTY_HINT_FLAGS
+	PropertyHintLayers2DRender       = PROPERTY_HINT_LAYERS_2D_RENDER
+	PropertyHintLayers2DPhysics      = PROPERTY_HINT_LAYERS_2D_PHYSICS
+	PropertyHintLayers2DNavigation   = PROPERTY_HINT_LAYERS_2D_NAVIGATION
+	PropertyHintLayers3DRender       = PROPERTY_HINT_LAYERS_3D_RENDER
+	PropertyHintLayers3DPhysics      = PROPERTY_HINT_LAYERS_3D_PHYSICS
+	PropertyHintLayers3DNavigation   = PROPERTY_HINT_LAYERS_3D_NAVIGATION
+	PropertyHintLayersAvoidance      = PROPERTY_HINT_LAYERS_AVOIDANCE
+	PropertyHintFile                 = PROPERTY_HINT_FILE
+	PropertyHintDir                  = PROPERTY_HINT_DIR
+	PropertyHintGlobalFile           = PROPERTY_HINT_GLOBAL_FILE
+	PropertyHintGlobalDir            = PROPERTY_HINT_GLOBAL_DIR
+	PropertyHintResourceType         = PROPERTY_HINT_RESOURCE_TYPE
+	PropertyHintMultilineText        = PROPERTY_HINT_MULTILINE_TEXT
+	PropertyHintExpression           = PROPERTY_HINT_EXPRESSION
+	PropertyHintPlaceholderText      = PROPERTY_HINT_PLACEHOLDER_TEXT
+	PropertyHintColorNoAlpha         = PROPERTY_HINT_COLOR_NO_ALPHA
+	PropertyHintObjectId             = PROPERTY_HINT_OBJECT_ID
+	PropertyHintTypeString           = PROPERTY_HINT_TYPE_STRING
+	PropertyHintNodePathToEditedNode = PROPERTY_HINT_NODE_PATH_TO_EDITED_NODE
+	PropertyHintObjectTooBig         = PROPERTY_HINT_OBJECT_TOO_BIG
+	PropertyHintNodePathValidTypes   = PROPERTY_HINT_NODE_PATH_VALID_TYPES
+	PropertyHintSaveFile             = PROPERTY_HINT_SAVE_FILE
+	PropertyHintGlobalSaveFile       = PROPERTY_HINT_GLOBAL_SAVE_FILE
+	PropertyHintIntIsObjectid        = PROPERTY_HINT_INT_IS_OBJECTID
+	PropertyHintIntIsPointer         = PROPERTY_HINT_INT_IS_POINTER
+	PropertyHintArrayType            = PROPERTY_HINT_ARRAY_TYPE
+	PropertyHintDictionaryType       = PROPERTY_HINT_DICTIONARY_TYPE
+	PropertyHintLocaleId             = PROPERTY_HINT_LOCALE_ID
+	PropertyHintLocalizableString    = PROPERTY_HINT_LOCALIZABLE_STRING
+	PropertyHintNodeType             = PROPERTY_HINT_NODE_TYPE
+	PropertyHintHideQuaternionEdit   = PROPERTY_HINT_HIDE_QUATERNION_EDIT
+	PropertyHintPassword             = PROPERTY_HINT_PASSWORD
+	PropertyHintToolButton           = PROPERTY_HINT_TOOL_BUTTON
+	PropertyHintOneshot              = PROPERTY_HINT_ONESHOT
+	PropertyHintGroupEnable          = PROPERTY_HINT_GROUP_ENABLE
+	PropertyHintInputName            = PROPERTY_HINT_INPUT_NAME
+	PropertyHintFilePath             = PROPERTY_HINT_FILE_PATH
+	PropertyHintMax                  = PROPERTY_HINT_MAX
+)
+
+var propertyHintNames = []enumName{
+	{"PROPERTY_HINT_NONE", 0},
+	{"PROPERTY_HINT_RANGE", 1},
+	{"PROPERTY_HINT_ENUM", 2},
+	{"PROPERTY_HINT_ENUM_SUGGESTION", 3},
+	{"PROPERTY_HINT_EXP_EASING", 4},
+	{"PROPERTY_HINT_LINK", 5},
+	{"PROPERTY_HINT_FLAGS", 6},
+	{"PROPERTY_HINT_LAYERS_2D_RENDER", 7},
+	{"PROPERTY_HINT_LAYERS_2D_PHYSICS", 8},
+	{"PROPERTY_HINT_LAYERS_2D_NAVIGATION", 9},
+	{"PROPERTY_HINT_LAYERS_3D_RENDER", 10},
+	{"PROPERTY_HINT_LAYERS_3D_PHYSICS", 11},
+	{"PROPERTY_HINT_LAYERS_3D_NAVIGATION", 12},
+	{"PROPERTY_HINT_LAYERS_AVOIDANCE", 37},
+	{"PROPERTY_HINT_FILE", 13},
+	{"PROPERTY_HINT_DIR", 14},
+	{"PROPERTY_HINT_GLOBAL_FILE", 15},
+	{"PROPERTY_HINT_GLOBAL_DIR", 16},
+	{"PROPERTY_HINT_RESOURCE_TYPE", 17},
+	{"PROPERTY_HINT_MULTILINE_TEXT", 18},
+	{"PROPERTY_HINT_EXPRESSION", 19},
+	{"PROPERTY_HINT_PLACEHOLDER_TEXT", 20},
+	{"PROPERTY_HINT_COLOR_NO_ALPHA", 21},
+	{"PROPERTY_HINT_OBJECT_ID", 22},
+	{"PROPERTY_HINT_TYPE_STRING", 23},
+	{"PROPERTY_HINT_NODE_PATH_TO_EDITED_NODE", 24},
+	{"PROPERTY_HINT_OBJECT_TOO_BIG", 25},
+	{"PROPERTY_HINT_NODE_PATH_VALID_TYPES", 26},
+	{"PROPERTY_HINT_SAVE_FILE", 27},
+	{"PROPERTY_HINT_GLOBAL_SAVE_FILE", 28},
+	{"PROPERTY_HINT_INT_IS_OBJECTID", 29},
+	{"PROPERTY_HINT_INT_IS_POINTER", 30},
+	{"PROPERTY_HINT_ARRAY_TYPE", 31},
+	{"PROPERTY_HINT_DICTIONARY_TYPE", 38},
+	{"PROPERTY_HINT_LOCALE_ID", 32},
+	{"PROPERTY_HINT_LOCALIZABLE_STRING", 33},
+	{"PROPERTY_HINT_NODE_TYPE", 34},
+	{"PROPERTY_HINT_HIDE_QUATERNION_EDIT", 35},
+	{"PROPERTY_HINT_PASSWORD", 36},
+	{"PROPERTY_HINT_TOOL_BUTTON", 39},
+	{"PROPERTY_HINT_ONESHOT", 40},
+	{"PROPERTY_HINT_GROUP_ENABLE", 42},
+	{"PROPERTY_HINT_INPUT_NAME", 43},
+	{"PROPERTY_HINT_FILE_PATH", 44},
+	{"PROPERTY_HINT_MAX", 45},
+}
+
+func (e PropertyHint) String() string {
+	return formatEnum("PropertyHint", propertyHintNames, int64(e))
+}
+
+// ParsePropertyHint returns the PropertyHint named text, which may also be an integer.
+func ParsePropertyHint(text string) (PropertyHint, error) {
+	v, err := parseEnum("PropertyHint", propertyHintNames, text)
+	return PropertyHint(v), err
+}
+
+func (e PropertyHint) MarshalText() ([]byte, error) {
+	return marshalEnum(propertyHintNames, int64(e)), nil
+}
+
+func (e *PropertyHint) UnmarshalText(text []byte) error {
+	v, err := ParsePropertyHint(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type PropertyUsageFlags int
 
 const (
@@ -543,6 +1905,114 @@ const (
 	PROPERTY_USAGE_NO_EDITOR                 PropertyUsageFlags = 2
 )
 
+const (
+	PropertyUsageFlagsUsageNone                    = PROPERTY_USAGE_NONE
+	PropertyUsageFlagsUsageStorage                 = PROPERTY_USAGE_STORAGE
+	PropertyUsageFlagsUsageEditor                  = PROPERTY_USAGE_EDITOR
+	PropertyUsageFlagsUsageInternal                = PROPERTY_USAGE_INTERNAL
+	PropertyUsageFlagsUsageCheckable               = PROPERTY_USAGE_CHECKABLE
+	PropertyUsageFlagsUsageChecked                 = PROPERTY_USAGE_CHECKED
+	PropertyUsageFlagsUsageGroup                   = PROPERTY_USAGE_GROUP
+	PropertyUsageFlagsUsageCategory                = PROPERTY_USAGE_CATEGORY
+	PropertyUsageFlagsUsageSubgroup                = PROPERTY_USAGE_SUBGROUP
+	PropertyUsageFlagsUsageClassIsBitfield         = PROPERTY_USAGE_CLASS_IS_BITFIELD
+	PropertyUsageFlagsUsageNoInstanceState         = PROPERTY_USAGE_NO_INSTANCE_STATE
+	PropertyUsageFlagsUsageRestartIfChanged        = PROPERTY_USAGE_RESTART_IF_CHANGED
+	PropertyUsageFlagsUsageScriptVariable          = PROPERTY_USAGE_SCRIPT_VARIABLE
+	PropertyUsageFlagsUsageStoreIfNull             = PROPERTY_USAGE_STORE_IF_NULL
+	PropertyUsageFlagsUsageUpdateAllIfModified     = PROPERTY_USAGE_UPDATE_ALL_IF_MODIFIED
+	PropertyUsageFlagsUsageScriptDefaultValue      = PROPERTY_USAGE_SCRIPT_DEFAULT_VALUE
+	PropertyUsageFlagsUsageClassIsEnum             = PROPERTY_USAGE_CLASS_IS_ENUM
+	PropertyUsageFlagsUsageNilIsVariant            = PROPERTY_USAGE_NIL_IS_VARIANT
+	PropertyUsageFlagsUsageArray                   = PROPERTY_USAGE_ARRAY
+	PropertyUsageFlagsUsageAlwaysDuplicate         = PROPERTY_USAGE_ALWAYS_DUPLICATE
+	PropertyUsageFlagsUsageNeverDuplicate          = PROPERTY_USAGE_NEVER_DUPLICATE
+	PropertyUsageFlagsUsageHighEndGfx              = PROPERTY_USAGE_HIGH_END_GFX
+	PropertyUsageFlagsUsageNodePathFromSceneRoot   = PROPERTY_USAGE_NODE_PATH_FROM_SCENE_ROOT
+	PropertyUsageFlagsUsageResourceNotPersistent   = PROPERTY_USAGE_RESOURCE_NOT_PERSISTENT
+	PropertyUsageFlagsUsageKeyingIncrements        = PROPERTY_USAGE_KEYING_INCREMENTS
+	PropertyUsageFlagsUsageDeferredSetResource     = PROPERTY_USAGE_DEFERRED_SET_RESOURCE
+	PropertyUsageFlagsUsageEditorInstantiateObject = PROPERTY_USAGE_EDITOR_INSTANTIATE_OBJECT
+	PropertyUsageFlagsUsageEditorBasicSetting      = PROPERTY_USAGE_EDITOR_BASIC_SETTING
+	PropertyUsageFlagsUsageReadOnly                = PROPERTY_USAGE_READ_ONLY
+	PropertyUsageFlagsUsageSecret                  = PROPERTY_USAGE_SECRET
+	PropertyUsageFlagsUsageDefault                 = PROPERTY_USAGE_DEFAULT
+	PropertyUsageFlagsUsageNoEditor                = PROPERTY_USAGE_NO_EDITOR
+)
+
+var propertyUsageFlagsNames = []enumName{
+	{"PROPERTY_USAGE_NONE", 0},
+	{"PROPERTY_USAGE_STORAGE", 2},
+	{"PROPERTY_USAGE_EDITOR", 4},
+	{"PROPERTY_USAGE_INTERNAL", 8},
+	{"PROPERTY_USAGE_CHECKABLE", 16},
+	{"PROPERTY_USAGE_CHECKED", 32},
+	{"PROPERTY_USAGE_GROUP", 64},
+	{"PROPERTY_USAGE_CATEGORY", 128},
+	{"PROPERTY_USAGE_SUBGROUP", 256},
+	{"PROPERTY_USAGE_CLASS_IS_BITFIELD", 512},
+	{"PROPERTY_USAGE_NO_INSTANCE_STATE", 1024},
+	{"PROPERTY_USAGE_RESTART_IF_CHANGED", 2048},
+	{"PROPERTY_USAGE_SCRIPT_VARIABLE", 4096},
+	{"PROPERTY_USAGE_STORE_IF_NULL", 8192},
+	{"PROPERTY_USAGE_UPDATE_ALL_IF_MODIFIED", 16384},
+	{"PROPERTY_USAGE_SCRIPT_DEFAULT_VALUE", 32768},
+	{"PROPERTY_USAGE_CLASS_IS_ENUM", 65536},
+	{"PROPERTY_USAGE_NIL_IS_VARIANT", 131072},
+	{"PROPERTY_USAGE_ARRAY", 262144},
+	{"PROPERTY_USAGE_ALWAYS_DUPLICATE", 524288},
+	{"PROPERTY_USAGE_NEVER_DUPLICATE", 1048576},
+	{"PROPERTY_USAGE_HIGH_END_GFX", 2097152},
+	{"PROPERTY_USAGE_NODE_PATH_FROM_SCENE_ROOT", 4194304},
+	{"PROPERTY_USAGE_RESOURCE_NOT_PERSISTENT", 8388608},
+	{"PROPERTY_USAGE_KEYING_INCREMENTS", 16777216},
+	{"PROPERTY_USAGE_DEFERRED_SET_RESOURCE", 33554432},
+	{"PROPERTY_USAGE_EDITOR_INSTANTIATE_OBJECT", 67108864},
+	{"PROPERTY_USAGE_EDITOR_BASIC_SETTING", 134217728},
+	{"PROPERTY_USAGE_READ_ONLY", 268435456},
+	{"PROPERTY_USAGE_SECRET", 536870912},
+	{"PROPERTY_USAGE_DEFAULT", 6},
+	{"PROPERTY_USAGE_NO_EDITOR", 2},
+}
+
+func (e PropertyUsageFlags) String() string {
+	return formatBitfield("PropertyUsageFlags", propertyUsageFlagsNames, int64(e))
+}
+
+// ParsePropertyUsageFlags returns the PropertyUsageFlags named text, which may also be an integer or several flags separated by "|".
+func ParsePropertyUsageFlags(text string) (PropertyUsageFlags, error) {
+	v, err := parseBitfield("PropertyUsageFlags", propertyUsageFlagsNames, text)
+	return PropertyUsageFlags(v), err
+}
+
+func (e PropertyUsageFlags) MarshalText() ([]byte, error) {
+	return marshalBitfield(propertyUsageFlagsNames, int64(e)), nil
+}
+
+func (e *PropertyUsageFlags) UnmarshalText(text []byte) error {
+	v, err := ParsePropertyUsageFlags(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// Has reports whether all of flags are set in e.
+func (e PropertyUsageFlags) Has(flags PropertyUsageFlags) bool {
+	return e&flags == flags
+}
+
+// Set returns e with flags set.
+func (e PropertyUsageFlags) Set(flags PropertyUsageFlags) PropertyUsageFlags {
+	return e | flags
+}
+
+// Clear returns e with flags cleared.
+func (e PropertyUsageFlags) Clear(flags PropertyUsageFlags) PropertyUsageFlags {
+	return e &^ flags
+}
+
 type MethodFlags int
 
 const (
@@ -557,6 +2027,68 @@ const (
 	METHOD_FLAGS_DEFAULT         MethodFlags = 1
 )
 
+const (
+	MethodFlagsFlagNormal          = METHOD_FLAG_NORMAL
+	MethodFlagsFlagEditor          = METHOD_FLAG_EDITOR
+	MethodFlagsFlagConst           = METHOD_FLAG_CONST
+	MethodFlagsFlagVirtual         = METHOD_FLAG_VIRTUAL
+	MethodFlagsFlagVararg          = METHOD_FLAG_VARARG
+	MethodFlagsFlagStatic          = METHOD_FLAG_STATIC
+	MethodFlagsFlagObjectCore      = METHOD_FLAG_OBJECT_CORE
+	MethodFlagsFlagVirtualRequired = METHOD_FLAG_VIRTUAL_REQUIRED
+	MethodFlagsDefault             = METHOD_FLAGS_DEFAULT
+)
+
+var methodFlagsNames = []enumName{
+	{"METHOD_FLAG_NORMAL", 1},
+	{"METHOD_FLAG_EDITOR", 2},
+	{"METHOD_FLAG_CONST", 4},
+	{"METHOD_FLAG_VIRTUAL", 8},
+	{"METHOD_FLAG_VARARG", 16},
+	{"METHOD_FLAG_STATIC", 32},
+	{"METHOD_FLAG_OBJECT_CORE", 64},
+	{"METHOD_FLAG_VIRTUAL_REQUIRED", 128},
+	{"METHOD_FLAGS_DEFAULT", 1},
+}
+
+func (e MethodFlags) String() string {
+	return formatBitfield("MethodFlags", methodFlagsNames, int64(e))
+}
+
+// ParseMethodFlags returns the MethodFlags named text, which may also be an integer or several flags separated by "|".
+func ParseMethodFlags(text string) (MethodFlags, error) {
+	v, err := parseBitfield("MethodFlags", methodFlagsNames, text)
+	return MethodFlags(v), err
+}
+
+func (e MethodFlags) MarshalText() ([]byte, error) {
+	return marshalBitfield(methodFlagsNames, int64(e)), nil
+}
+
+func (e *MethodFlags) UnmarshalText(text []byte) error {
+	v, err := ParseMethodFlags(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// Has reports whether all of flags are set in e.
+func (e MethodFlags) Has(flags MethodFlags) bool {
+	return e&flags == flags
+}
+
+// Set returns e with flags set.
+func (e MethodFlags) Set(flags MethodFlags) MethodFlags {
+	return e | flags
+}
+
+// Clear returns e with flags cleared.
+func (e MethodFlags) Clear(flags MethodFlags) MethodFlags {
+	return e &^ flags
+}
+
 type VariantType int
 
 const (
@@ -602,6 +2134,115 @@ const (
 	TYPE_MAX                  VariantType = 39
 )
 
+const (
+	VariantTypeNil                = TYPE_NIL
+	VariantTypeBool               = TYPE_BOOL
+	VariantTypeInt                = TYPE_INT
+	VariantTypeFloat              = TYPE_FLOAT
+	VariantTypeString             = TYPE_STRING
+	VariantTypeVector2            = TYPE_VECTOR2
+	VariantTypeVector2i           = TYPE_VECTOR2I
+	VariantTypeRect2              = TYPE_RECT2
+	VariantTypeRect2i             = TYPE_RECT2I
+	VariantTypeVector3            = TYPE_VECTOR3
+	VariantTypeVector3i           = TYPE_VECTOR3I
+	VariantTypeTransform2d        = TYPE_TRANSFORM2D
+	VariantTypeVector4            = TYPE_VECTOR4
+	VariantTypeVector4i           = TYPE_VECTOR4I
+	VariantTypePlane              = TYPE_PLANE
+	VariantTypeQuaternion         = TYPE_QUATERNION
+	VariantTypeAabb               = TYPE_AABB
+	VariantTypeBasis              = TYPE_BASIS
+	VariantTypeTransform3d        = TYPE_TRANSFORM3D
+	VariantTypeProjection         = TYPE_PROJECTION
+	VariantTypeColor              = TYPE_COLOR
+	VariantTypeStringName         = TYPE_STRING_NAME
+	VariantTypeNodePath           = TYPE_NODE_PATH
+	VariantTypeRid                = TYPE_RID
+	VariantTypeObject             = TYPE_OBJECT
+	VariantTypeCallable           = TYPE_CALLABLE
+	VariantTypeSignal             = TYPE_SIGNAL
+	VariantTypeDictionary         = TYPE_DICTIONARY
+	VariantTypeArray              = TYPE_ARRAY
+	VariantTypePackedByteArray    = TYPE_PACKED_BYTE_ARRAY
+	VariantTypePackedInt32Array   = TYPE_PACKED_INT32_ARRAY
+	VariantTypePackedInt64Array   = TYPE_PACKED_INT64_ARRAY
+	VariantTypePackedFloat32Array = TYPE_PACKED_FLOAT32_ARRAY
+	VariantTypePackedFloat64Array = TYPE_PACKED_FLOAT64_ARRAY
+	VariantTypePackedStringArray  = TYPE_PACKED_STRING_ARRAY
+	VariantTypePackedVector2Array = TYPE_PACKED_VECTOR2_ARRAY
+	VariantTypePackedVector3Array = TYPE_PACKED_VECTOR3_ARRAY
+	VariantTypePackedColorArray   = TYPE_PACKED_COLOR_ARRAY
+	VariantTypePackedVector4Array = TYPE_PACKED_VECTOR4_ARRAY
+	VariantTypeMax                = TYPE_MAX
+)
+
+var variantTypeNames = []enumName{
+	{"TYPE_NIL", 0},
+	{"TYPE_BOOL", 1},
+	{"TYPE_INT", 2},
+	{"TYPE_FLOAT", 3},
+	{"TYPE_STRING", 4},
+	{"TYPE_VECTOR2", 5},
+	{"TYPE_VECTOR2I", 6},
+	{"TYPE_RECT2", 7},
+	{"TYPE_RECT2I", 8},
+	{"TYPE_VECTOR3", 9},
+	{"TYPE_VECTOR3I", 10},
+	{"TYPE_TRANSFORM2D", 11},
+	{"TYPE_VECTOR4", 12},
+	{"TYPE_VECTOR4I", 13},
+	{"TYPE_PLANE", 14},
+	{"TYPE_QUATERNION", 15},
+	{"TYPE_AABB", 16},
+	{"TYPE_BASIS", 17},
+	{"TYPE_TRANSFORM3D", 18},
+	{"TYPE_PROJECTION", 19},
+	{"TYPE_COLOR", 20},
+	{"TYPE_STRING_NAME", 21},
+	{"TYPE_NODE_PATH", 22},
+	{"TYPE_RID", 23},
+	{"TYPE_OBJECT", 24},
+	{"TYPE_CALLABLE", 25},
+	{"TYPE_SIGNAL", 26},
+	{"TYPE_DICTIONARY", 27},
+	{"TYPE_ARRAY", 28},
+	{"TYPE_PACKED_BYTE_ARRAY", 29},
+	{"TYPE_PACKED_INT32_ARRAY", 30},
+	{"TYPE_PACKED_INT64_ARRAY", 31},
+	{"TYPE_PACKED_FLOAT32_ARRAY", 32},
+	{"TYPE_PACKED_FLOAT64_ARRAY", 33},
+	{"TYPE_PACKED_STRING_ARRAY", 34},
+	{"TYPE_PACKED_VECTOR2_ARRAY", 35},
+	{"TYPE_PACKED_VECTOR3_ARRAY", 36},
+	{"TYPE_PACKED_COLOR_ARRAY", 37},
+	{"TYPE_PACKED_VECTOR4_ARRAY", 38},
+	{"TYPE_MAX", 39},
+}
+
+func (e VariantType) String() string {
+	return formatEnum("VariantType", variantTypeNames, int64(e))
+}
+
+// ParseVariantType returns the VariantType named text, which may also be an integer.
+func ParseVariantType(text string) (VariantType, error) {
+	v, err := parseEnum("VariantType", variantTypeNames, text)
+	return VariantType(v), err
+}
+
+func (e VariantType) MarshalText() ([]byte, error) {
+	return marshalEnum(variantTypeNames, int64(e)), nil
+}
+
+func (e *VariantType) UnmarshalText(text []byte) error {
+	v, err := ParseVariantType(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
 type VariantOperator int
 
 const (
@@ -632,3 +2273,84 @@ const (
 	OP_IN            VariantOperator = 24
 	OP_MAX           VariantOperator = 25
 )
+
+const (
+	VariantOperatorEqual        = OP_EQUAL
+	VariantOperatorNotEqual     = OP_NOT_EQUAL
+	VariantOperatorLess         = OP_LESS
+	VariantOperatorLessEqual    = OP_LESS_EQUAL
+	VariantOperatorGreater      = OP_GREATER
+	VariantOperatorGreaterEqual = OP_GREATER_EQUAL
+	VariantOperatorAdd          = OP_ADD
+	VariantOperatorSubtract     = OP_SUBTRACT
+	VariantOperatorMultiply     = OP_MULTIPLY
+	VariantOperatorDivide       = OP_DIVIDE
+	VariantOperatorNegate       = OP_NEGATE
+	VariantOperatorPositive     = OP_POSITIVE
+	VariantOperatorModule       = OP_MODULE
+	VariantOperatorPower        = OP_POWER
+	VariantOperatorShiftLeft    = OP_SHIFT_LEFT
+	VariantOperatorShiftRight   = OP_SHIFT_RIGHT
+	VariantOperatorBitAnd       = OP_BIT_AND
+	VariantOperatorBitOr        = OP_BIT_OR
+	VariantOperatorBitXor       = OP_BIT_XOR
+	VariantOperatorBitNegate    = OP_BIT_NEGATE
+	VariantOperatorAnd          = OP_AND
+	VariantOperatorOr           = OP_OR
+	VariantOperatorXor          = OP_XOR
+	VariantOperatorNot          = OP_NOT
+	VariantOperatorIn           = OP_IN
+	VariantOperatorMax          = OP_MAX
+)
+
+var variantOperatorNames = []enumName{
+	{"OP_EQUAL", 0},
+	{"OP_NOT_EQUAL", 1},
+	{"OP_LESS", 2},
+	{"OP_LESS_EQUAL", 3},
+	{"OP_GREATER", 4},
+	{"OP_GREATER_EQUAL", 5},
+	{"OP_ADD", 6},
+	{"OP_SUBTRACT", 7},
+	{"OP_MULTIPLY", 8},
+	{"OP_DIVIDE", 9},
+	{"OP_NEGATE", 10},
+	{"OP_POSITIVE", 11},
+	{"OP_MODULE", 12},
+	{"OP_POWER", 13},
+	{"OP_SHIFT_LEFT", 14},
+	{"OP_SHIFT_RIGHT", 15},
+	{"OP_BIT_AND", 16},
+	{"OP_BIT_OR", 17},
+	{"OP_BIT_XOR", 18},
+	{"OP_BIT_NEGATE", 19},
+	{"OP_AND", 20},
+	{"OP_OR", 21},
+	{"OP_XOR", 22},
+	{"OP_NOT", 23},
+	{"OP_IN", 24},
+	{"OP_MAX", 25},
+}
+
+func (e VariantOperator) String() string {
+	return formatEnum("VariantOperator", variantOperatorNames, int64(e))
+}
+
+// ParseVariantOperator returns the VariantOperator named text, which may also be an integer.
+func ParseVariantOperator(text string) (VariantOperator, error) {
+	v, err := parseEnum("VariantOperator", variantOperatorNames, text)
+	return VariantOperator(v), err
+}
+
+func (e VariantOperator) MarshalText() ([]byte, error) {
+	return marshalEnum(variantOperatorNames, int64(e)), nil
+}
+
+func (e *VariantOperator) UnmarshalText(text []byte) error {
+	v, err := ParseVariantOperator(string(text))
+	if err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
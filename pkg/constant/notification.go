@@ -0,0 +1,7 @@
+package constant
+
+// Notification is the what argument of Object._notification, e.g.
+// NODE_NOTIFICATION_READY. Values are only unique within a class and the
+// classes it inherits from, so the constants carry the class that declares
+// them.
+type Notification int32
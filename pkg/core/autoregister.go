@@ -0,0 +1,177 @@
+package core
+
+import (
+	"reflect"
+	"strings"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	"github.com/godot-go/godot-go/pkg/log"
+	"github.com/godot-go/godot-go/pkg/util"
+	"go.uber.org/zap"
+)
+
+// AutoRegister registers the GDClass T by reflecting over its struct instead
+// of a hand-written bind function. Instances are constructed with new(T), so
+// T needs no constructor either.
+//
+//   - Exported methods declared on T are bound under their snake_case name;
+//     Heal becomes heal. Methods promoted from the embedded engine class are
+//     not bound again.
+//   - V_ methods are bound as virtuals; V_PhysicsProcess overrides
+//     _physics_process. Methods of the hook interfaces T implements, such as
+//     GDClassNotification or GDClassReloadState, are not bound.
+//   - Fields tagged `godot:"export"` become properties named in snake_case,
+//     accessed through get_speed and set_speed. Declaring GetSpeed or SetSpeed
+//     on T replaces the accessor generated for the field.
+//   - SignalN fields become signals, as with ClassDBRegisterClass.
+//
+// For example:
+//
+//	type Player struct {
+//		CharacterBody2DImpl
+//		Speed float32        `godot:"export"`
+//		Hit   Signal1[int64] `signal:"hit,damage"`
+//	}
+//
+//	func (p *Player) V_PhysicsProcess(delta float64) { ... }
+//	func (p *Player) Heal(amount int64) { ... }
+//
+//	AutoRegister[*Player]()
+func AutoRegister[T Object]() {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+		log.Panic("AutoRegister expects a pointer to a struct",
+			zap.Any("type", t),
+		)
+	}
+	classType := t.Elem()
+	constructor := func(owner *GodotObject) GDClass {
+		inst := reflect.New(classType).Interface().(GDClass)
+		inst.SetGodotObjectOwner(owner)
+		return inst
+	}
+	ClassDBRegisterClass(constructor, nil, nil, func(inst T) {
+		className := inst.GetClassName()
+		autoBindMethods(className, t)
+		autoBindProperties(inst, className, t)
+	})
+}
+
+func autoBindMethods(className string, t reflect.Type) {
+	for i := 0; i < t.NumMethod(); i++ {
+		m := t.Method(i)
+		if isPromotedMethod(t.Elem(), m.Name) {
+			continue
+		}
+		if isHookMethod(t, m.Name) {
+			continue
+		}
+		flags := METHOD_FLAGS_DEFAULT
+		gdName := util.SnakeCase(m.Name)
+		if strings.HasPrefix(m.Name, "V_") {
+			flags = METHOD_FLAG_VIRTUAL
+			gdName = "_" + util.SnakeCase(strings.TrimPrefix(m.Name, "V_"))
+		} else if m.Type.IsVariadic() {
+			flags = METHOD_FLAG_VARARG
+		}
+		classDBBindReflectMethod(className, m, m.Name, gdName, flags, nil, nil, nil)
+	}
+}
+
+// hookInterfaces are the interfaces through which engine callbacks reach a
+// GDClass; their methods are called directly and never bound.
+var hookInterfaces = []reflect.Type{
+	reflect.TypeFor[GDClassNotification](),
+	reflect.TypeFor[GDClassPropertyCanRevert](),
+	reflect.TypeFor[GDClassPropertyGetRevert](),
+	reflect.TypeFor[GDClassPropertyList](),
+	reflect.TypeFor[GDClassReloadState](),
+}
+
+// isHookMethod reports whether name is a method of a hook interface that t
+// implements.
+func isHookMethod(t reflect.Type, name string) bool {
+	for _, hook := range hookInterfaces {
+		if !t.Implements(hook) {
+			continue
+		}
+		if _, ok := hook.MethodByName(name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isPromotedMethod reports whether the method name of a struct comes from one
+// of its embedded fields, such as the engine class it extends.
+func isPromotedMethod(classType reflect.Type, name string) bool {
+	for i := 0; i < classType.NumField(); i++ {
+		f := classType.Field(i)
+		if !f.Anonymous {
+			continue
+		}
+		ft := f.Type
+		if ft.Kind() != reflect.Pointer {
+			ft = reflect.PointerTo(ft)
+		}
+		if _, ok := ft.MethodByName(name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func autoBindProperties(inst GDClass, className string, t reflect.Type) {
+	ci, ok := Internal.GDRegisteredGDClasses.Get(className)
+	if !ok {
+		log.Panic("Class doesn't exist.", zap.String("class", className))
+	}
+	for _, f := range reflect.VisibleFields(t.Elem()) {
+		if !f.IsExported() || !hasExportTag(f) {
+			continue
+		}
+		name := util.SnakeCase(f.Name)
+		getter := "get_" + name
+		setter := "set_" + name
+		if _, ok := ci.MethodMap[getter]; !ok {
+			classDBBindReflectMethod(className, fieldGetter(t, f), "Get"+f.Name, getter, METHOD_FLAGS_DEFAULT, nil, nil, nil)
+		}
+		if _, ok := ci.MethodMap[setter]; !ok {
+			classDBBindReflectMethod(className, fieldSetter(t, f), "Set"+f.Name, setter, METHOD_FLAGS_DEFAULT, []string{"value"}, nil, nil)
+		}
+		ClassDBAddProperty(inst, ReflectTypeToGDExtensionVariantType(f.Type), name, setter, getter)
+	}
+}
+
+func hasExportTag(f reflect.StructField) bool {
+	tag, ok := f.Tag.Lookup("godot")
+	if !ok {
+		return false
+	}
+	for _, opt := range strings.Split(tag, ",") {
+		if opt == "export" {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldGetter synthesizes func(recv) T returning field f.
+func fieldGetter(recv reflect.Type, f reflect.StructField) reflect.Method {
+	ft := reflect.FuncOf([]reflect.Type{recv}, []reflect.Type{f.Type}, false)
+	fn := reflect.MakeFunc(ft, func(args []reflect.Value) []reflect.Value {
+		return []reflect.Value{args[0].Elem().FieldByIndex(f.Index)}
+	})
+	return reflect.Method{Name: "Get" + f.Name, Type: ft, Func: fn}
+}
+
+// fieldSetter synthesizes func(recv, T) assigning field f.
+func fieldSetter(recv reflect.Type, f reflect.StructField) reflect.Method {
+	ft := reflect.FuncOf([]reflect.Type{recv, f.Type}, nil, false)
+	fn := reflect.MakeFunc(ft, func(args []reflect.Value) []reflect.Value {
+		args[0].Elem().FieldByIndex(f.Index).Set(args[1])
+		return nil
+	})
+	return reflect.Method{Name: "Set" + f.Name, Type: ft, Func: fn}
+}
@@ -0,0 +1,123 @@
+package core
+
+import (
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// Await is the Go counterpart of GDScript's `await obj.signal`. Go cannot
+// suspend a function on Godot's main thread without blocking the engine, so
+// the code after the await is passed in as fn instead:
+//
+//	Await(n, door, "opened", func(args []Variant) {
+//		n.GetAnimationPlayer().Play(...)
+//	})
+//
+// fn runs once, on the main thread, in the idle frame after obj emits signal.
+// The arguments are only valid for the duration of fn; copy them to keep them.
+// Awaiting is cancelled, and fn never runs, when owner leaves the scene tree
+// or cancel is called. Await and cancel must be called on the main thread.
+func Await(owner Node, obj Object, signal string, fn func(args []Variant)) (cancel func()) {
+	if !owner.IsInsideTree() {
+		log.Warn("Await called on a node outside the scene tree",
+			zap.String("signal", signal),
+		)
+		return func() {}
+	}
+	a := &awaiter{
+		owner:   owner,
+		ownerID: CallFunc_GDExtensionInterfaceObjectGetInstanceId(owner.AsGDExtensionConstObjectPtr()),
+		obj:     obj,
+		objID:   CallFunc_GDExtensionInterfaceObjectGetInstanceId(obj.AsGDExtensionConstObjectPtr()),
+		signal:  signal,
+	}
+	var err error
+	if a.resume, err = newGoCallable(func(args ...Variant) {
+		if a.finish() {
+			fn(args)
+		}
+	}); err != nil {
+		log.Panic("unable to create await callable", zap.Error(err))
+	}
+	if a.exit, err = newGoCallable(func() {
+		a.finish()
+	}); err != nil {
+		log.Panic("unable to create await callable", zap.Error(err))
+	}
+	// deferred calls are flushed at the end of the frame, which is where
+	// GDScript resumes a coroutine as well
+	if !connectGoCallable(obj, signal, a.resume, OBJECT_CONNECT_FLAGS_CONNECT_DEFERRED|OBJECT_CONNECT_FLAGS_CONNECT_ONE_SHOT) {
+		return func() {}
+	}
+	if !connectGoCallable(owner, treeExitingSignal, a.exit, OBJECT_CONNECT_FLAGS_CONNECT_ONE_SHOT) {
+		a.finish()
+		return func() {}
+	}
+	return func() {
+		a.finish()
+	}
+}
+
+// AwaitTimer is the Go counterpart of GDScript's
+// `await get_tree().create_timer(seconds).timeout`. fn runs on the main
+// thread once the timer times out, unless owner leaves the scene tree or
+// cancel is called first.
+func AwaitTimer(owner Node, seconds float64, fn func()) (cancel func()) {
+	if !owner.IsInsideTree() {
+		log.Warn("AwaitTimer called on a node outside the scene tree",
+			zap.Float64("seconds", seconds),
+		)
+		return func() {}
+	}
+	timer := owner.GetTree().CreateTimer(seconds)
+	// the scene tree keeps its own reference until the timer times out
+	defer timer.Unref()
+	return Await(owner, timer.TypedPtr(), "timeout", func([]Variant) {
+		fn()
+	})
+}
+
+const treeExitingSignal = "tree_exiting"
+
+type awaiter struct {
+	owner   Node
+	ownerID GDObjectInstanceID
+	obj     Object
+	objID   GDObjectInstanceID
+	signal  string
+	resume  *goCallable
+	exit    *goCallable
+	done    bool
+}
+
+// finish reports whether the awaiter was still pending and drops whichever
+// connections are left. Awaiters only live on the main thread, so done needs
+// no lock.
+func (a *awaiter) finish() bool {
+	if a.done {
+		return false
+	}
+	a.done = true
+	disconnectGoCallable(a.obj, a.objID, a.signal, a.resume)
+	disconnectGoCallable(a.owner, a.ownerID, treeExitingSignal, a.exit)
+	return true
+}
+
+// connectGoCallable hands gc to Godot and connects it to signal on obj.
+func connectGoCallable(obj Object, signal string, gc *goCallable, flags ObjectConnectFlags) bool {
+	callable := gc.newCallable()
+	defer callable.Destroy()
+	sn := NewStringNameWithLatin1Chars(signal)
+	defer sn.Destroy()
+	if err := obj.ConnectOpt(sn, callable, uint32(flags)); err != OK {
+		log.Error("unable to connect signal",
+			zap.String("signal", signal),
+			zap.Any("error", err),
+		)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+#include "callable_custom.h"
+#include <godot/gdextension_interface.h>
+
+extern void GoCallback_CallableCustomCall(
+    void *callable_userdata, const GDExtensionConstVariantPtr *p_args,
+    GDExtensionInt p_argument_count, GDExtensionVariantPtr r_return,
+    GDExtensionCallError *r_error);
+extern void GoCallback_CallableCustomFree(void *callable_userdata);
+extern uint32_t GoCallback_CallableCustomHash(void *callable_userdata);
+extern GDExtensionBool GoCallback_CallableCustomEqual(void *callable_userdata_a,
+                                                      void *callable_userdata_b);
+extern GDExtensionBool
+GoCallback_CallableCustomLessThan(void *callable_userdata_a,
+                                  void *callable_userdata_b);
+extern void GoCallback_CallableCustomToString(void *callable_userdata,
+                                              GDExtensionBool *r_is_valid,
+                                              GDExtensionStringPtr r_out);
+extern GDExtensionInt
+GoCallback_CallableCustomGetArgumentCount(void *callable_userdata,
+                                          GDExtensionBool *r_is_valid);
+
+void cgo_callable_custom_call(void *callable_userdata,
+                              const GDExtensionConstVariantPtr *p_args,
+                              GDExtensionInt p_argument_count,
+                              GDExtensionVariantPtr r_return,
+                              GDExtensionCallError *r_error) {
+  GoCallback_CallableCustomCall(callable_userdata, p_args, p_argument_count,
+                                r_return, r_error);
+}
+
+void cgo_callable_custom_free(void *callable_userdata) {
+  GoCallback_CallableCustomFree(callable_userdata);
+}
+
+uint32_t cgo_callable_custom_hash(void *callable_userdata) {
+  return GoCallback_CallableCustomHash(callable_userdata);
+}
+
+GDExtensionBool cgo_callable_custom_equal(void *callable_userdata_a,
+                                          void *callable_userdata_b) {
+  return GoCallback_CallableCustomEqual(callable_userdata_a,
+                                        callable_userdata_b);
+}
+
+GDExtensionBool cgo_callable_custom_less_than(void *callable_userdata_a,
+                                              void *callable_userdata_b) {
+  return GoCallback_CallableCustomLessThan(callable_userdata_a,
+                                           callable_userdata_b);
+}
+
+void cgo_callable_custom_to_string(void *callable_userdata,
+                                   GDExtensionBool *r_is_valid,
+                                   GDExtensionStringPtr r_out) {
+  GoCallback_CallableCustomToString(callable_userdata, r_is_valid, r_out);
+}
+
+GDExtensionInt
+cgo_callable_custom_get_argument_count(void *callable_userdata,
+                                       GDExtensionBool *r_is_valid) {
+  return GoCallback_CallableCustomGetArgumentCount(callable_userdata,
+                                                   r_is_valid);
+}
@@ -0,0 +1,305 @@
+package core
+
+// #include <godot/gdextension_interface.h>
+// #include "callable_custom.h"
+// #include <stdio.h>
+// #include <stdlib.h>
+import "C"
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/cgo"
+	"sync/atomic"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// goCallable is the callable_userdata behind a Callable created by
+// NewCallableFromFunc.
+type goCallable struct {
+	Func       reflect.Value
+	Name       string
+	ArgTypes   []reflect.Type
+	HasReturn  bool
+	IsVariadic bool
+	// OnFree, if set, runs once Godot frees the last copy of the callable.
+	OnFree func()
+	handle cgo.Handle
+}
+
+// liveGoCallables counts the Go funcs still referenced by a Godot Callable.
+var liveGoCallables atomic.Int64
+
+// LiveGoCallableCount returns how many callables created by
+// NewCallableFromFunc Godot has not freed yet.
+func LiveGoCallableCount() int64 {
+	return liveGoCallables.Load()
+}
+
+// NewCallableFromFunc wraps a Go function in a Godot Callable. Arguments are
+// converted with the same decoders used for bound methods; fn may return at
+// most one value, which is encoded back into the returned Variant. A variadic
+// fn must take ...Variant and receives every argument passed by the caller.
+//
+// The returned Callable owns a reference to fn until Godot frees the last
+// copy of it.
+func NewCallableFromFunc(fn any) Callable {
+	gc, err := newGoCallable(fn)
+	if err != nil {
+		log.Panic("unable to create callable from func", zap.Error(err))
+	}
+	return gc.newCallable()
+}
+
+// newCallable hands gc to Godot as a new custom Callable. It must be called
+// at most once per goCallable.
+func (gc *goCallable) newCallable() Callable {
+	gc.handle = cgo.NewHandle(gc)
+	liveGoCallables.Add(1)
+	return gc.createCallable((GDExtensionCallableCustomFree)(C.cgo_callable_custom_free))
+}
+
+// keyCallable returns a Callable that compares and hashes equal to the one
+// returned by newCallable without owning gc, for looking up a connection
+// again after the Go copy of the original was destroyed.
+func (gc *goCallable) keyCallable() Callable {
+	return gc.createCallable(nil)
+}
+
+func (gc *goCallable) createCallable(freeFunc GDExtensionCallableCustomFree) Callable {
+	info := NewGDExtensionCallableCustomInfo2(
+		unsafe.Pointer(gc.handle),
+		unsafe.Pointer(FFI.Library),
+		0,
+		(GDExtensionCallableCustomCall)(C.cgo_callable_custom_call),
+		(GDExtensionCallableCustomIsValid)(nil),
+		freeFunc,
+		(GDExtensionCallableCustomHash)(C.cgo_callable_custom_hash),
+		(GDExtensionCallableCustomEqual)(C.cgo_callable_custom_equal),
+		(GDExtensionCallableCustomLessThan)(C.cgo_callable_custom_less_than),
+		(GDExtensionCallableCustomToString)(C.cgo_callable_custom_to_string),
+		(GDExtensionCallableCustomGetArgumentCount)(C.cgo_callable_custom_get_argument_count),
+	)
+	ret := Callable{}
+	CallFunc_GDExtensionInterfaceCallableCustomCreate2(
+		(GDExtensionUninitializedTypePtr)(ret.NativePtr()),
+		&info,
+	)
+	return ret
+}
+
+func newGoCallable(fn any) (*goCallable, error) {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return nil, fmt.Errorf("expected a non-nil func, got %T", fn)
+	}
+	t := v.Type()
+	if t.NumOut() > 1 {
+		return nil, fmt.Errorf("func %s returns %d values; at most 1 is supported", t, t.NumOut())
+	}
+	argTypes := make([]reflect.Type, t.NumIn())
+	for i := range argTypes {
+		argTypes[i] = t.In(i)
+	}
+	if t.IsVariadic() && (t.NumIn() != 1 || t.In(0).Elem() != gdVariantType) {
+		return nil, fmt.Errorf("variadic func %s must take ...Variant as its only argument", t)
+	}
+	name := t.String()
+	if f := runtime.FuncForPC(v.Pointer()); f != nil {
+		name = f.Name()
+	}
+	return &goCallable{
+		Func:       v,
+		Name:       name,
+		ArgTypes:   argTypes,
+		HasReturn:  t.NumOut() == 1,
+		IsVariadic: t.IsVariadic(),
+	}, nil
+}
+
+// Call invokes the wrapped func with arguments supplied by Godot.
+func (gc *goCallable) Call(args []Variant) (reflect.Value, *GDExtensionCallError) {
+	if gc.IsVariadic {
+		ret := gc.Func.CallSlice([]reflect.Value{reflect.ValueOf(args)})
+		return gc.returnValue(ret), nil
+	}
+	expected := len(gc.ArgTypes)
+	switch {
+	case len(args) < expected:
+		err := &GDExtensionCallError{}
+		err.SetErrorFields(GDEXTENSION_CALL_ERROR_TOO_FEW_ARGUMENTS, int32(len(args)), int32(expected))
+		return reflect.Value{}, err
+	case len(args) > expected:
+		err := &GDExtensionCallError{}
+		err.SetErrorFields(GDEXTENSION_CALL_ERROR_TOO_MANY_ARGUMENTS, int32(len(args)), int32(expected))
+		return reflect.Value{}, err
+	}
+	in := make([]reflect.Value, expected)
+	for i, t := range gc.ArgTypes {
+		v, convErr := convertVariantToGoTypeReflectValue(args[i], t)
+		if convErr != nil {
+			log.Error("error converting callable argument",
+				zap.String("callable", gc.Name),
+				zap.Int("arg_index", i),
+				zap.Error(convErr),
+			)
+			err := &GDExtensionCallError{}
+			err.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_ARGUMENT, int32(i), int32(ReflectTypeToGDExtensionVariantType(t)))
+			return reflect.Value{}, err
+		}
+		in[i] = v
+	}
+	return gc.returnValue(gc.Func.Call(in)), nil
+}
+
+func (gc *goCallable) returnValue(ret []reflect.Value) reflect.Value {
+	if !gc.HasReturn {
+		return reflect.Value{}
+	}
+	return ret[0]
+}
+
+func (gc *goCallable) String() string {
+	return fmt.Sprintf("GoCallable(%s)", gc.Name)
+}
+
+// goCallableFromUserdata resolves the callable_userdata handle. The callbacks
+// below run on the engine's stack, so a stale handle is reported instead of
+// panicking across the cgo boundary.
+func goCallableFromUserdata(userdata unsafe.Pointer) (gc *goCallable, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("invalid callable userdata", zap.Any("panic", r))
+			gc, ok = nil, false
+		}
+	}()
+	gc, ok = cgo.Handle(userdata).Value().(*goCallable)
+	if !ok || gc == nil {
+		log.Error("unable to retrieve callable userdata")
+		return nil, false
+	}
+	return gc, true
+}
+
+//export GoCallback_CallableCustomCall
+func GoCallback_CallableCustomCall(
+	userdata unsafe.Pointer,
+	argPtrs *C.GDExtensionConstVariantPtr,
+	argumentCount C.GDExtensionInt,
+	rReturn C.GDExtensionVariantPtr,
+	rError *C.GDExtensionCallError,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic(r, "GoCallback_CallableCustomCall", nil)
+			callErr := (*GDExtensionCallError)(unsafe.Pointer(rError))
+			callErr.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
+		}
+	}()
+	gc, ok := goCallableFromUserdata(userdata)
+	if !ok {
+		callErr := (*GDExtensionCallError)(unsafe.Pointer(rError))
+		callErr.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
+		return
+	}
+	argPtrSlice := unsafe.Slice((*GDExtensionConstVariantPtr)(argPtrs), int(argumentCount))
+	args := make([]Variant, argumentCount)
+	for i := range argPtrSlice {
+		args[i] = NewVariantCopyWithGDExtensionConstVariantPtr(argPtrSlice[i])
+	}
+	log.Debug("GoCallback_CallableCustomCall called",
+		zap.String("callable", gc.String()),
+		zap.String("args", VariantSliceToString(args)),
+	)
+	ret, callErr := gc.Call(args)
+	if callErr != nil {
+		*(*GDExtensionCallError)(unsafe.Pointer(rError)) = *callErr
+		return
+	}
+	if ret.IsValid() {
+		GDExtensionVariantPtrFromReflectValue(ret, (GDExtensionUninitializedVariantPtr)(unsafe.Pointer(rReturn)))
+	}
+}
+
+//export GoCallback_CallableCustomFree
+func GoCallback_CallableCustomFree(userdata unsafe.Pointer) {
+	defer recoverCallback("GoCallback_CallableCustomFree", nil)
+	gc, ok := goCallableFromUserdata(userdata)
+	if !ok {
+		return
+	}
+	log.Debug("GoCallback_CallableCustomFree called",
+		zap.String("callable", gc.String()),
+	)
+	cgo.Handle(userdata).Delete()
+	liveGoCallables.Add(-1)
+	if gc.OnFree != nil {
+		gc.OnFree()
+	}
+}
+
+// GoCallback_CallableCustomHash hashes the callable's identity. Go funcs are
+// not comparable, so two callables are only equal if they come from the same
+// NewCallableFromFunc call.
+//
+//export GoCallback_CallableCustomHash
+func GoCallback_CallableCustomHash(userdata unsafe.Pointer) C.uint32_t {
+	defer recoverCallback("GoCallback_CallableCustomHash", nil)
+	h := uint64(uintptr(userdata))
+	return C.uint32_t(h ^ (h >> 32))
+}
+
+//export GoCallback_CallableCustomEqual
+func GoCallback_CallableCustomEqual(a unsafe.Pointer, b unsafe.Pointer) C.GDExtensionBool {
+	defer recoverCallback("GoCallback_CallableCustomEqual", nil)
+	if a == b {
+		return 1
+	}
+	return 0
+}
+
+//export GoCallback_CallableCustomLessThan
+func GoCallback_CallableCustomLessThan(a unsafe.Pointer, b unsafe.Pointer) C.GDExtensionBool {
+	defer recoverCallback("GoCallback_CallableCustomLessThan", nil)
+	if uintptr(a) < uintptr(b) {
+		return 1
+	}
+	return 0
+}
+
+//export GoCallback_CallableCustomToString
+func GoCallback_CallableCustomToString(
+	userdata unsafe.Pointer,
+	rIsValid *C.GDExtensionBool,
+	rOut C.GDExtensionStringPtr,
+) {
+	defer recoverCallback("GoCallback_CallableCustomToString", nil)
+	gc, ok := goCallableFromUserdata(userdata)
+	if !ok {
+		*rIsValid = 0
+		return
+	}
+	GDExtensionStringPtrWithLatin1Chars((GDExtensionStringPtr)(rOut), gc.String())
+	*rIsValid = 1
+}
+
+//export GoCallback_CallableCustomGetArgumentCount
+func GoCallback_CallableCustomGetArgumentCount(
+	userdata unsafe.Pointer,
+	rIsValid *C.GDExtensionBool,
+) C.GDExtensionInt {
+	defer recoverCallback("GoCallback_CallableCustomGetArgumentCount", nil)
+	gc, ok := goCallableFromUserdata(userdata)
+	if !ok || gc.IsVariadic {
+		*rIsValid = 0
+		return 0
+	}
+	*rIsValid = 1
+	return C.GDExtensionInt(len(gc.ArgTypes))
+}
@@ -0,0 +1,24 @@
+#ifndef CGO_GODOT_GO_CALLABLE_CUSTOM_H
+#define CGO_GODOT_GO_CALLABLE_CUSTOM_H
+
+#include <godot/gdextension_interface.h>
+
+void cgo_callable_custom_call(void *callable_userdata,
+                              const GDExtensionConstVariantPtr *p_args,
+                              GDExtensionInt p_argument_count,
+                              GDExtensionVariantPtr r_return,
+                              GDExtensionCallError *r_error);
+void cgo_callable_custom_free(void *callable_userdata);
+uint32_t cgo_callable_custom_hash(void *callable_userdata);
+GDExtensionBool cgo_callable_custom_equal(void *callable_userdata_a,
+                                          void *callable_userdata_b);
+GDExtensionBool cgo_callable_custom_less_than(void *callable_userdata_a,
+                                              void *callable_userdata_b);
+void cgo_callable_custom_to_string(void *callable_userdata,
+                                   GDExtensionBool *r_is_valid,
+                                   GDExtensionStringPtr r_out);
+GDExtensionInt
+cgo_callable_custom_get_argument_count(void *callable_userdata,
+                                       GDExtensionBool *r_is_valid);
+
+#endif
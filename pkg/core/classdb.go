@@ -10,6 +10,7 @@ import "C"
 import (
 	"fmt"
 	"reflect"
+	"runtime"
 	"strings"
 	"unsafe"
 
@@ -69,13 +70,49 @@ func ClassDBAddPropertySubgroup(t GDClass, p_name string, p_prefix string) {
 	)
 }
 
-// ClassDBAddProperty default p_index = -1
+// classProperty is a property added with ClassDBAddProperty.
+type classProperty struct {
+	Name    string
+	Type    GDExtensionVariantType
+	Options PropertyOptions
+	Setter  *GoMethodMetadata
+	Getter  *GoMethodMetadata
+	// Indexed is set when the getter and setter take Options.Index.
+	Indexed bool
+}
+
+func (p *classProperty) get(inst GDClass) (Variant, *GDExtensionCallError) {
+	if !p.Indexed {
+		return p.Getter.Call(inst)
+	}
+	index := NewVariantInt64(int64(p.Options.Index))
+	defer index.Destroy()
+	return p.Getter.Call(inst, index)
+}
+
+func (p *classProperty) set(inst GDClass, v Variant) *GDExtensionCallError {
+	var err *GDExtensionCallError
+	if !p.Indexed {
+		_, err = p.Setter.Call(inst, v)
+		return err
+	}
+	index := NewVariantInt64(int64(p.Options.Index))
+	defer index.Destroy()
+	_, err = p.Setter.Call(inst, index, v)
+	return err
+}
+
+// ClassDBAddProperty adds a property backed by bound getter and setter
+// methods; the setter may be empty for a read-only property. opts takes at
+// most one PropertyOptions for hints, usage flags, the class name of an
+// Object property or the index of an indexed property.
 func ClassDBAddProperty(
 	inst GDClass,
 	p_property_type GDExtensionVariantType,
 	p_property_name string,
 	p_setter string,
 	p_getter string,
+	opts ...PropertyOptions,
 ) {
 	t := reflect.TypeOf(inst)
 	cn := inst.GetClassName()
@@ -91,6 +128,24 @@ func ClassDBAddProperty(
 	if _, ok := ci.PropertyNameSet[pn]; ok {
 		panic(fmt.Sprintf(`Property "%s" already exists in class "%s".`, pn, cn))
 	}
+	var options PropertyOptions
+	switch len(opts) {
+	case 0:
+	case 1:
+		options = opts[0]
+	default:
+		log.Panic("only one PropertyOptions may be given",
+			zap.String("property", pn),
+		)
+	}
+	if options.Default != nil {
+		if _, ok := options.Default.(Variant); !ok && ReflectTypeToGDExtensionVariantType(reflect.TypeOf(options.Default)) != p_property_type {
+			log.Panic("default value does not match the property type",
+				zap.String("property", pn),
+				zap.Any("default", options.Default),
+			)
+		}
+	}
 	var (
 		setter *GoMethodMetadata
 		getter *GoMethodMetadata
@@ -105,8 +160,10 @@ func ClassDBAddProperty(
 		)
 	}
 	getter = mci.GoMethodMetadata
-	if len(getter.GoArgumentTypes) != 0 {
-		panic(fmt.Sprintf(`getter method "%s" must take a single argument.`, p_getter))
+	// an indexed getter takes the index
+	indexed := len(getter.GoArgumentTypes) == 1
+	if len(getter.GoArgumentTypes) > 1 {
+		panic(fmt.Sprintf(`getter method "%s" must take no argument, or the index of an indexed property.`, p_getter))
 	}
 	// specifying a setter is optional
 	if len(p_setter) > 0 {
@@ -117,49 +174,63 @@ func ClassDBAddProperty(
 			)
 		}
 		setter = mci.GoMethodMetadata
-		if len(setter.GoArgumentTypes) != 1 {
+		if indexed && len(setter.GoArgumentTypes) != 2 {
+			panic(fmt.Sprintf(`Setter method "%s" of indexed property must take the index and the value.`, p_setter))
+		}
+		if !indexed && len(setter.GoArgumentTypes) != 1 {
 			panic(fmt.Sprintf(`Setter method "%s" must take a single argument.`, p_setter))
 		}
 	}
 	// register property with plugin
 	ci.PropertyNameSet[pn] = struct{}{}
-	className := NewStringNameWithLatin1Chars(cn)
-	defer className.Destroy()
-	propName := NewStringNameWithLatin1Chars(pn)
-	defer propName.Destroy()
-	hint := NewStringWithUtf8Chars("")
-	defer hint.Destroy()
+	ci.Properties = append(ci.Properties, &classProperty{
+		Name:    pn,
+		Type:    p_property_type,
+		Options: options,
+		Setter:  setter,
+		Getter:  getter,
+		Indexed: indexed,
+	})
 	// register with Godot
-	prop_info := NewGDExtensionPropertyInfo(
-		className.AsGDExtensionConstStringNamePtr(),
-		p_property_type,
-		propName.AsGDExtensionConstStringNamePtr(),
-		uint32(PROPERTY_HINT_NONE),
-		hint.AsGDExtensionConstStringPtr(),
-		uint32(PROPERTY_USAGE_DEFAULT),
-	)
-	snSetterGDName := NewStringNameWithLatin1Chars(setter.GdMethodName)
+	prop_info := NewGDExtensionPropertyInfoWithOptions(p_property_type, pn, options)
+	defer prop_info.Destroy()
+	var setterGDName string
+	if setter != nil {
+		setterGDName = setter.GdMethodName
+	}
+	snSetterGDName := NewStringNameWithLatin1Chars(setterGDName)
 	defer snSetterGDName.Destroy()
 	snGetterGDName := NewStringNameWithLatin1Chars(getter.GdMethodName)
 	defer snGetterGDName.Destroy()
+	index := GDExtensionInt(-1)
+	if indexed {
+		index = GDExtensionInt(options.Index)
+	}
 	log.Info("register property",
 		zap.String("class", cn),
 		zap.String("name", p_property_name),
 		zap.Int("variant_type", int(p_property_type)),
+		zap.Int("hint", int(options.Hint)),
+		zap.String("hint_string", options.HintString),
+		zap.Int("index", int(index)),
 	)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	prop_info.Pin(&pinner)
 	CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassPropertyIndexed(
 		FFI.Library,
 		ci.NameAsStringNamePtr,
 		&prop_info,
 		snSetterGDName.AsGDExtensionConstStringNamePtr(),
 		snGetterGDName.AsGDExtensionConstStringNamePtr(),
-		-1,
+		index,
 	)
 }
 
 type SignalParam struct {
-	Type GDExtensionVariantType
-	Name string
+	Type    GDExtensionVariantType
+	Name    string
+	Options PropertyOptions
 }
 
 func ClassDBAddSignal(t GDClass, signalName string, params ...SignalParam) {
@@ -167,7 +238,10 @@ func ClassDBAddSignal(t GDClass, signalName string, params ...SignalParam) {
 		zap.String("signalName", signalName),
 		zap.Any("params", params),
 	)
-	typeName := t.GetClassName()
+	classDBAddSignal(t.GetClassName(), signalName, params...)
+}
+
+func classDBAddSignal(typeName string, signalName string, params ...SignalParam) {
 	ci, ok := Internal.GDRegisteredGDClasses.Get(typeName)
 	if !ok {
 		log.Panic("Class doesn't exist.", zap.String("class", typeName))
@@ -180,22 +254,13 @@ func ClassDBAddSignal(t GDClass, signalName string, params ...SignalParam) {
 	ci.SignalNameSet[signalName] = struct{}{}
 	paramArr := make([]GDExtensionPropertyInfo, len(params))
 	for i, p := range params {
-		snTypeName := NewStringNameWithLatin1Chars(typeName)
-		defer snTypeName.Destroy()
-		snName := NewStringNameWithLatin1Chars(p.Name)
-		defer snName.Destroy()
-		hintString := NewStringWithUtf8Chars("")
-		defer hintString.Destroy()
-		paramArr[i] = NewGDExtensionPropertyInfo(
-			snTypeName.AsGDExtensionConstStringNamePtr(),
-			p.Type,
-			snName.AsGDExtensionConstStringNamePtr(),
-			(uint32)(PROPERTY_HINT_NONE),
-			hintString.AsGDExtensionConstStringPtr(),
-			(uint32)(PROPERTY_USAGE_DEFAULT),
-		)
-		// Note: PropertyInfo.Destroy() must NOT be called here - the StringName/String
-		// objects above own the memory and their defers handle cleanup.
+		paramArr[i] = NewGDExtensionPropertyInfoWithOptions(p.Type, p.Name, p.Options)
+		defer paramArr[i].Destroy()
+	}
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	for i := range paramArr {
+		paramArr[i].Pin(&pinner)
 	}
 	var pi *GDExtensionPropertyInfo
 	if len(paramArr) > 0 {
@@ -226,13 +291,58 @@ func ClassDBBindMethod[T GDClass](
 	argNames []string,
 	defaultValues []Variant,
 ) {
-	classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAGS_DEFAULT, argNames, defaultValues)
+	classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAGS_DEFAULT, argNames, nil, defaultValues)
 }
 
-// TODO: golang does not have static methods
-// func ClassDBBindMethodStatic(inst GDClass, goMethodName string, gdMethodName string, argNames []string, defaultValues []Variant) *MethodBindImpl {
-// 	return classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAG_STATIC, argNames, defaultValues)
-// }
+// ClassDBBindMethodWithOptions binds a method like ClassDBBindMethod.
+// argOptions holds the PropertyOptions of the leading arguments, such as the
+// class name of an Object argument or the hint an editor plugin shows.
+func ClassDBBindMethodWithOptions[T GDClass](
+	inst T,
+	goMethodName string,
+	gdMethodName string,
+	argNames []string,
+	argOptions []PropertyOptions,
+	defaultValues []Variant,
+) {
+	classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAGS_DEFAULT, argNames, argOptions, defaultValues)
+}
+
+// ClassDBBindMethodStatic binds the package function fn as the static method
+// gdMethodName of the class of inst. Go has no static methods, so fn takes
+// no receiver:
+//
+//	func ExampleTestStatic(a, b int32) int32 { return a + b }
+//
+//	ClassDBBindMethodStatic(t, ExampleTestStatic, "test_static", []string{"a", "b"}, nil)
+func ClassDBBindMethodStatic[T GDClass](
+	inst T,
+	fn any,
+	gdMethodName string,
+	argNames []string,
+	defaultValues []Variant,
+) {
+	fv := reflect.ValueOf(fn)
+	if fv.Kind() != reflect.Func {
+		log.Panic("static method must be a function",
+			zap.String("class", inst.GetClassName()),
+			zap.String("gd_method_name", gdMethodName),
+			zap.String("type", fmt.Sprintf("%T", fn)),
+		)
+	}
+	goMethodName := runtime.FuncForPC(fv.Pointer()).Name()
+	goMethodName = goMethodName[strings.LastIndex(goMethodName, ".")+1:]
+	methodFlags := METHOD_FLAGS_DEFAULT | METHOD_FLAG_STATIC
+	if fv.Type().IsVariadic() {
+		methodFlags |= METHOD_FLAG_VARARG
+	}
+	m := reflect.Method{
+		Name: goMethodName,
+		Type: fv.Type(),
+		Func: fv,
+	}
+	classDBBindReflectMethod(inst.GetClassName(), m, goMethodName, gdMethodName, methodFlags, argNames, nil, defaultValues)
+}
 
 func ClassDBBindMethodVirtual[T GDClass](
 	inst T,
@@ -241,7 +351,7 @@ func ClassDBBindMethodVirtual[T GDClass](
 	argNames []string,
 	defaultValues []Variant,
 ) {
-	classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAG_VIRTUAL, argNames, defaultValues)
+	classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAG_VIRTUAL, argNames, nil, defaultValues)
 }
 
 func ClassDBBindMethodVarargs[T GDClass](
@@ -251,7 +361,7 @@ func ClassDBBindMethodVarargs[T GDClass](
 	argNames []string,
 	defaultValues []Variant,
 ) {
-	classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAG_VARARG, argNames, defaultValues)
+	classDBBindMethod(inst, goMethodName, gdMethodName, METHOD_FLAG_VARARG, argNames, nil, defaultValues)
 }
 
 func classDBBindMethod[T GDClass](
@@ -260,6 +370,7 @@ func classDBBindMethod[T GDClass](
 	gdMethodName string,
 	methodFlags MethodFlags,
 	argNames []string,
+	argOptions []PropertyOptions,
 	defaultValues []Variant,
 ) {
 	t := reflect.TypeFor[T]()
@@ -283,7 +394,22 @@ func classDBBindMethod[T GDClass](
 	log.Debug("method found",
 		zap.Reflect("method", m),
 	)
-	md := NewGoMethodMetadata(m, className, gdMethodName, goMethodName, argNames, defaultValues, methodFlags)
+	classDBBindReflectMethod(className, m, goMethodName, gdMethodName, methodFlags, argNames, argOptions, defaultValues)
+}
+
+// classDBBindReflectMethod binds m, whose first argument is the class
+// receiver, as gdMethodName on className.
+func classDBBindReflectMethod(
+	className string,
+	m reflect.Method,
+	goMethodName string,
+	gdMethodName string,
+	methodFlags MethodFlags,
+	argNames []string,
+	argOptions []PropertyOptions,
+	defaultValues []Variant,
+) {
+	md := NewGoMethodMetadata(m, className, gdMethodName, goMethodName, argNames, argOptions, defaultValues, methodFlags)
 	if md.IsVirtual {
 		if !strings.HasPrefix(goMethodName, "V_") {
 			log.Panic(`virtual method name must have a prefix of "V_".`)
@@ -332,7 +458,11 @@ func classDBBindMethod[T GDClass](
 			zap.Bool("has_varargs", hasVarargs),
 		)
 	}
-	// and register with godot
+	// and register with godot; the engine copies the method info, so the Go
+	// memory it refers to only has to stay pinned for this call
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	cmi.Pin(&pinner)
 	CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassMethod(
 		FFI.Library,
 		ci.NameAsStringNamePtr,
@@ -409,6 +539,28 @@ func ClassDBRegisterClass[T Object](
 	propertyList []GDExtensionPropertyInfo,
 	validateProperty func(*GDExtensionPropertyInfo),
 	bindMethodsFunc func(t T),
+) {
+	classDBRegisterClass(constructor, propertyList, validateProperty, bindMethodsFunc, false)
+}
+
+// ClassDBRegisterRuntimeClass registers a class like ClassDBRegisterClass,
+// but as a runtime class: in the editor, instances are placeholders that keep
+// their property values without running any Go code.
+func ClassDBRegisterRuntimeClass[T Object](
+	constructor GDClassGoConstructorFromOwner,
+	propertyList []GDExtensionPropertyInfo,
+	validateProperty func(*GDExtensionPropertyInfo),
+	bindMethodsFunc func(t T),
+) {
+	classDBRegisterClass(constructor, propertyList, validateProperty, bindMethodsFunc, true)
+}
+
+func classDBRegisterClass[T Object](
+	constructor GDClassGoConstructorFromOwner,
+	propertyList []GDExtensionPropertyInfo,
+	validateProperty func(*GDExtensionPropertyInfo),
+	bindMethodsFunc func(t T),
+	isRuntime bool,
 ) {
 	t := reflect.TypeFor[T]()
 	objectInst := reflect.Zero(t).Interface().(Object)
@@ -474,12 +626,16 @@ func ClassDBRegisterClass[T Object](
 	GDRegisteredGDClassEncoders.Set(className, CreateObjectEncoder[T]())
 	GDClassRegisterInstanceBindingCallbacks(className)
 	cName := C.CString(className)
+	var isRuntimeClass GDExtensionBool
+	if isRuntime {
+		isRuntimeClass = GDExtensionBool(1)
+	}
 	// Register this class with Godot
 	info := NewGDExtensionClassCreationInfo4(
 		GDExtensionBool(0),
 		GDExtensionBool(0),
 		GDExtensionBool(1),
-		GDExtensionBool(0),
+		isRuntimeClass,
 		(GDExtensionConstStringPtr)(nil),
 		(GDExtensionClassSet)(C.cgo_classcreationinfo_set),
 		(GDExtensionClassGet)(C.cgo_classcreationinfo_get),
@@ -494,7 +650,7 @@ func ClassDBRegisterClass[T Object](
 		(GDExtensionClassUnreference)(nil),
 		(GDExtensionClassCreateInstance2)(C.cgo_classcreationinfo_createinstance),
 		(GDExtensionClassFreeInstance)(C.cgo_classcreationinfo_freeinstance),
-		(GDExtensionClassRecreateInstance)(nil),
+		(GDExtensionClassRecreateInstance)(C.cgo_classcreationinfo_recreateinstance),
 		(GDExtensionClassGetVirtualCallData2)(C.cgo_classcreationinfo_getvirtualcallwithdata2),
 		(GDExtensionClassCallVirtualWithData)(C.cgo_classcreationinfo_callvirtualwithdata),
 		unsafe.Pointer(cName),
@@ -514,6 +670,9 @@ func ClassDBRegisterClass[T Object](
 		snParentName.AsGDExtensionConstStringNamePtr(),
 		&info,
 	)
+	// SignalN fields are registered before bindMethodsFunc, so methods bound
+	// there can already refer to them
+	cl.SignalFields = registerSignalFields(className, classType)
 	// call bindMethodsFunc as a callback for users to register their methods on the class
 	bindMethodsFunc(inst)
 }
@@ -522,7 +681,10 @@ func ClassDBUnregisterClass[T Object]() {
 	t := reflect.TypeFor[T]()
 	objectInst := reflect.Zero(t).Interface().(Object)
 	inst := objectInst.(T)
-	className := inst.GetClassName()
+	classDBUnregisterClass(inst.GetClassName())
+}
+
+func classDBUnregisterClass(className string) {
 	log.Info("ClassDBUnregisterClass called",
 		zap.String("class", className),
 	)
@@ -48,11 +48,14 @@ GoCallback_ClassCreationInfoCreateInstance(void *data);
 extern void
 GoCallback_ClassCreationInfoFreeInstance(void *data,
                                          GDExtensionClassInstancePtr ptr);
+extern GDExtensionClassInstancePtr
+GoCallback_ClassCreationInfoRecreateInstance(void *data,
+                                             GDExtensionObjectPtr obj);
 
-void cgo_classcreationinfo_getpropertylist(
+const GDExtensionPropertyInfo *cgo_classcreationinfo_getpropertylist(
     GDExtensionClassInstancePtr p_instance, uint32_t *r_count) {
   printStacktrace();
-  GoCallback_ClassCreationInfoGetPropertyList(p_instance, r_count);
+  return GoCallback_ClassCreationInfoGetPropertyList(p_instance, r_count);
 }
 
 void cgo_classcreationinfo_freepropertylist2(
@@ -125,6 +128,12 @@ void cgo_classcreationinfo_freeinstance(void *data,
   GoCallback_ClassCreationInfoFreeInstance(data, ptr);
 }
 
+GDExtensionClassInstancePtr
+cgo_classcreationinfo_recreateinstance(void *data, GDExtensionObjectPtr obj) {
+  printStacktrace();
+  return GoCallback_ClassCreationInfoRecreateInstance(data, obj);
+}
+
 GDExtensionBool
 cgo_classcreationinfo_get(GDExtensionClassInstancePtr p_instance,
                           GDExtensionConstStringNamePtr p_name,
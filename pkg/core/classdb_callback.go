@@ -15,6 +15,7 @@ import (
 	"unsafe"
 
 	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
 	. "github.com/godot-go/godot-go/pkg/ffi"
 	"github.com/godot-go/godot-go/pkg/log"
 	"github.com/godot-go/godot-go/pkg/util"
@@ -27,16 +28,18 @@ func GoCallback_ClassCreationInfoToString(
 	r_is_valid *C.GDExtensionBool,
 	p_out C.GDExtensionStringPtr,
 ) {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ClassCreationInfoToString", &inst)
 	wci := cgo.Handle(p_instance).Value().(*WrappedClassInstance)
 	if wci == nil {
 		log.Panic("wci should not be null")
 	}
+	inst = wci.Instance
 	log.Info("GoCallback_ClassCreationInfoToString",
 		zap.String("class_name", wci.Instance.GetClassName()),
 	)
-	inst := wci.Instance
-	className := inst.GetClassName()
-	instanceId := inst.GetInstanceId()
+	className := wci.Instance.GetClassName()
+	instanceId := wci.Instance.GetInstanceId()
 	value := fmt.Sprintf("[ GDExtension::%s <--> Instance ID:%d ]", className, instanceId)
 	GDExtensionStringPtrWithLatin1Chars((GDExtensionStringPtr)(p_out), value)
 	var isValid C.uchar = 1
@@ -45,6 +48,7 @@ func GoCallback_ClassCreationInfoToString(
 
 //export GoCallback_ClassCreationInfoGetVirtualCallWithData
 func GoCallback_ClassCreationInfoGetVirtualCallWithData(pUserdata unsafe.Pointer, pName C.GDExtensionConstStringNamePtr) unsafe.Pointer {
+	defer recoverCallback("GoCallback_ClassCreationInfoGetVirtualCallWithData", nil)
 	name := C.GoString((*C.char)(pUserdata))
 	snMethodName := (*StringName)(pName)
 	sMethodName := snMethodName.AsString()
@@ -63,11 +67,13 @@ func GoCallback_ClassCreationInfoCallVirtualWithData(pInstance C.GDExtensionClas
 	// if inst == nil {
 	// 	log.Panic("GDExtensionClassInstancePtr cannot be null")
 	// }
+	var inst GDClass
+	defer recoverCallback("GoCallback_ClassCreationInfoCallVirtualWithData", &inst)
 	wci := cgo.Handle(pInstance).Value().(*WrappedClassInstance)
 	if wci == nil {
 		log.Panic("wci should not be null")
 	}
-	inst := wci.Instance
+	inst = wci.Instance
 	className := inst.GetClassName()
 	snMethodName := (*StringName)(pName)
 	sMethodName := snMethodName.AsString()
@@ -92,6 +98,10 @@ func GoCallback_ClassCreationInfoCallVirtualWithData(pInstance C.GDExtensionClas
 		log.Warn("class not found", zap.String("className", className))
 		return
 	}
+	if fn, ok := ci.PtrcallVirtualMethodMap[methodName]; ok {
+		fn(inst, ptrcallArgs{p_args}, (GDExtensionTypePtr)(rRet))
+		return
+	}
 	m, ok := ci.VirtualMethodMap[methodName]
 	if !ok {
 		log.Debug("no virtual method found",
@@ -116,6 +126,7 @@ func GoCallback_ClassCreationInfoCallVirtualWithData(pInstance C.GDExtensionClas
 //
 //export GoCallback_ClassCreationInfoCreateInstance
 func GoCallback_ClassCreationInfoCreateInstance(data unsafe.Pointer) C.GDExtensionObjectPtr {
+	defer recoverCallback("GoCallback_ClassCreationInfoCreateInstance", nil)
 	tn := C.GoString((*C.char)(data))
 	inst := CreateGDClassInstance(tn)
 	return (C.GDExtensionObjectPtr)(unsafe.Pointer(inst.GetGodotObjectOwner()))
@@ -123,6 +134,7 @@ func GoCallback_ClassCreationInfoCreateInstance(data unsafe.Pointer) C.GDExtensi
 
 //export GoCallback_ClassCreationInfoFreeInstance
 func GoCallback_ClassCreationInfoFreeInstance(data unsafe.Pointer, ptr C.GDExtensionClassInstancePtr) {
+	defer recoverCallback("GoCallback_ClassCreationInfoFreeInstance", nil)
 	tn := C.GoString((*C.char)(data))
 	// ptr is assigned in function WrappedPostInitialize as a (*Wrapped)
 	inst := ObjectClassFromGDExtensionClassInstancePtr((GDExtensionClassInstancePtr)(ptr))
@@ -143,13 +155,35 @@ func GoCallback_ClassCreationInfoFreeInstance(data unsafe.Pointer, ptr C.GDExten
 	log.Info("GDClass instance freed", zap.Any("id", id))
 }
 
+//export GoCallback_ClassCreationInfoRecreateInstance
+func GoCallback_ClassCreationInfoRecreateInstance(data unsafe.Pointer, obj C.GDExtensionObjectPtr) C.GDExtensionClassInstancePtr {
+	defer recoverCallback("GoCallback_ClassCreationInfoRecreateInstance", nil)
+	tn := C.GoString((*C.char)(data))
+	ci, ok := Internal.GDRegisteredGDClasses.Get(tn)
+	if !ok {
+		log.Panic("type not found", zap.String("name", tn))
+	}
+	// the object outlived the previous build of the extension; bind a new Go
+	// value to it and restore what was saved before the reload
+	inst, instPtr := newGDClassInstanceWithOwner(ci, (GDExtensionObjectPtr)(unsafe.Pointer(obj)))
+	restoreReloadState(ci, inst)
+	log.Info("GDClass instance recreated",
+		zap.String("type_name", tn),
+		zap.String("inst", fmt.Sprintf("%p", inst)),
+	)
+	return (C.GDExtensionClassInstancePtr)(unsafe.Pointer(instPtr))
+}
+
 //export GoCallback_ClassCreationInfoGetPropertyList
 func GoCallback_ClassCreationInfoGetPropertyList(pInstance C.GDExtensionClassInstancePtr, rCount *C.uint32_t) *C.GDExtensionPropertyInfo {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ClassCreationInfoGetPropertyList", &inst)
 	wci := cgo.Handle(pInstance).Value().(*WrappedClassInstance)
 	if wci == nil {
 		*rCount = (C.uint32_t)(0)
 		return (*C.GDExtensionPropertyInfo)(nil)
 	}
+	inst = wci.Instance
 	gdStrClass := wci.Instance.GetClass()
 	defer gdStrClass.Destroy()
 	className := gdStrClass.ToUtf8()
@@ -162,47 +196,114 @@ func GoCallback_ClassCreationInfoGetPropertyList(pInstance C.GDExtensionClassIns
 			zap.String("class", className),
 		)
 	}
-	if ci.PropertyList == nil {
-		*rCount = (C.uint32_t)(0)
-		return (*C.GDExtensionPropertyInfo)(nil)
-	}
-	*rCount = (C.uint32_t)(len(ci.PropertyList))
-	ptr := unsafe.SliceData(ci.PropertyList)
-	pnr.Pin(ptr)
+	ptr, count := newInstancePropertyList(wci.Instance, ci.PropertyList)
+	*rCount = (C.uint32_t)(count)
 	return (*C.GDExtensionPropertyInfo)(unsafe.Pointer(ptr))
 }
 
 //export GoCallback_ClassCreationInfoFreePropertyList2
 func GoCallback_ClassCreationInfoFreePropertyList2(pInstance C.GDExtensionClassInstancePtr, pList *C.GDExtensionPropertyInfo, pCount C.uint32_t) {
-	listSlice := unsafe.Slice(pList, pCount)
-	for i := range listSlice {
-		sn := (*StringName)(listSlice[i].class_name)
-		sn.Destroy()
-		n := (*StringName)(listSlice[i].name)
-		n.Destroy()
-		h := (*String)(listSlice[i].hint_string)
-		if h != nil {
-			h.Destroy()
+	defer recoverCallback("GoCallback_ClassCreationInfoFreePropertyList2", nil)
+	freeInstancePropertyList((*GDExtensionPropertyInfo)(unsafe.Pointer(pList)), int(pCount))
+}
+
+// GDClassPropertyCanRevert is implemented by classes that decide per
+// instance whether the inspector shows a revert button for a property.
+type GDClassPropertyCanRevert interface {
+	V_PropertyCanRevert(name StringName) bool
+}
+
+// GDClassPropertyGetRevert is implemented by classes that return the value a
+// property reverts to per instance, along with whether it has one.
+type GDClassPropertyGetRevert interface {
+	V_PropertyGetRevert(name StringName) (Variant, bool)
+}
+
+// propertyCanRevert reports whether the inspector shows a revert button for
+// the property name of inst: V_PropertyCanRevert decides if the class
+// implements it, otherwise a property added with a Default can be reverted.
+func (c *ClassInfo) propertyCanRevert(inst GDClass, name *StringName) bool {
+	if r, ok := inst.(GDClassPropertyCanRevert); ok && r.V_PropertyCanRevert(*name) {
+		return true
+	}
+	p := c.findProperty(name.ToUtf8())
+	return p != nil && p.Options.Default != nil
+}
+
+// propertyGetRevert returns the value propertyCanRevert reverts to.
+func (c *ClassInfo) propertyGetRevert(inst GDClass, name *StringName) (Variant, bool) {
+	if r, ok := inst.(GDClassPropertyGetRevert); ok {
+		if v, ok := r.V_PropertyGetRevert(*name); ok {
+			return v, true
 		}
 	}
+	p := c.findProperty(name.ToUtf8())
+	if p == nil || p.Options.Default == nil {
+		return Variant{}, false
+	}
+	v := Variant{}
+	GDExtensionVariantPtrFromReflectValue(
+		reflect.ValueOf(p.Options.Default),
+		(GDExtensionUninitializedVariantPtr)(unsafe.Pointer(v.NativePtr())),
+	)
+	return v, true
 }
 
 //export GoCallback_ClassCreationInfoPropertyCanRevert
 func GoCallback_ClassCreationInfoPropertyCanRevert(p_instance C.GDExtensionClassInstancePtr, p_name C.GDExtensionConstStringNamePtr) C.GDExtensionBool {
-	return 0
+	var inst GDClass
+	defer recoverCallback("GoCallback_ClassCreationInfoPropertyCanRevert", &inst)
+	wci := cgo.Handle(p_instance).Value().(*WrappedClassInstance)
+	if wci == nil {
+		return 0
+	}
+	inst = wci.Instance
+	className := wci.Instance.GetClassName()
+	ci, ok := Internal.GDRegisteredGDClasses.Get(className)
+	if !ok {
+		log.Panic("invalid registered GDClass",
+			zap.String("class", className),
+		)
+	}
+	if !ci.propertyCanRevert(wci.Instance, (*StringName)(p_name)) {
+		return 0
+	}
+	return 1
 }
 
 //export GoCallback_ClassCreationInfoPropertyGetRevert
 func GoCallback_ClassCreationInfoPropertyGetRevert(p_instance C.GDExtensionClassInstancePtr, p_name C.GDExtensionConstStringNamePtr, r_ret C.GDExtensionVariantPtr) C.GDExtensionBool {
-	return 0
+	var inst GDClass
+	defer recoverCallback("GoCallback_ClassCreationInfoPropertyGetRevert", &inst)
+	wci := cgo.Handle(p_instance).Value().(*WrappedClassInstance)
+	if wci == nil {
+		return 0
+	}
+	inst = wci.Instance
+	className := wci.Instance.GetClassName()
+	ci, ok := Internal.GDRegisteredGDClasses.Get(className)
+	if !ok {
+		log.Panic("invalid registered GDClass",
+			zap.String("class", className),
+		)
+	}
+	v, ok := ci.propertyGetRevert(wci.Instance, (*StringName)(p_name))
+	if !ok {
+		return 0
+	}
+	*(*Variant)(unsafe.Pointer(r_ret)) = v
+	return 1
 }
 
 //export GoCallback_ClassCreationInfoValidateProperty
 func GoCallback_ClassCreationInfoValidateProperty(pInstance C.GDExtensionClassInstancePtr, pProperty *C.GDExtensionPropertyInfo) C.GDExtensionBool {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ClassCreationInfoValidateProperty", &inst)
 	wci := cgo.Handle(pInstance).Value().(*WrappedClassInstance)
 	if wci == nil {
 		return 0
 	}
+	inst = wci.Instance
 	gdStrClass := wci.Instance.GetClass()
 	defer gdStrClass.Destroy()
 	className := gdStrClass.ToUtf8()
@@ -225,15 +326,39 @@ func GoCallback_ClassCreationInfoValidateProperty(pInstance C.GDExtensionClassIn
 }
 
 //export GoCallback_ClassCreationInfoNotification
-func GoCallback_ClassCreationInfoNotification(p_instance C.GDExtensionClassInstancePtr, p_what C.int32_t, p_reversed C.GDExtensionBool) {
+func GoCallback_ClassCreationInfoNotification(pInstance C.GDExtensionClassInstancePtr, pWhat C.int32_t, pReversed C.GDExtensionBool) {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ClassCreationInfoNotification", &inst)
+	wci := cgo.Handle(pInstance).Value().(*WrappedClassInstance)
+	if wci == nil {
+		return
+	}
+	inst = wci.Instance
+	notify(wci.Instance, int32(pWhat))
+}
+
+// GDClassNotification is implemented by classes that handle the
+// notifications sent to their object, such as NODE_NOTIFICATION_READY.
+type GDClassNotification interface {
+	V_Notification(what Notification)
+}
+
+// notify calls V_Notification if inst implements GDClassNotification.
+func notify(inst GDClass, what int32) {
+	if n, ok := inst.(GDClassNotification); ok {
+		n.V_Notification(Notification(what))
+	}
 }
 
 //export GoCallback_ClassCreationInfoGet
 func GoCallback_ClassCreationInfoGet(pInstance C.GDExtensionClassInstancePtr, pName C.GDExtensionConstStringNamePtr, rRet C.GDExtensionVariantPtr) C.GDExtensionBool {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ClassCreationInfoGet", &inst)
 	wci := cgo.Handle(pInstance).Value().(*WrappedClassInstance)
 	if wci == nil {
 		return 0
 	}
+	inst = wci.Instance
 	gdStrClass := wci.Instance.GetClass()
 	defer gdStrClass.Destroy()
 	className := gdStrClass.ToUtf8()
@@ -250,19 +375,31 @@ func GoCallback_ClassCreationInfoGet(pInstance C.GDExtensionClassInstancePtr, pN
 			zap.String("method_name", name),
 		)
 	}
-	mcmi, ok := ci.VirtualMethodMap["_get"]
+	v, ok := ci.callGet(wci.Instance, name)
 	if !ok {
+		return 0
+	}
+	*(*Variant)(unsafe.Pointer(rRet)) = v
+	return 1
+}
+
+// callGet calls the V_Get method of the class or of its registered parents,
+// if any, for properties that are not added with ClassDBAddProperty such as
+// the ones reported by V_GetPropertyList.
+func (c *ClassInfo) callGet(inst GDClass, name string) (Variant, bool) {
+	md := c.findMethod("_get")
+	if md == nil {
 		log.Info("no V_Get method registered",
-			zap.String("class", className),
+			zap.String("class", c.Name),
 			zap.String("method_name", name),
 		)
-		return 0
+		return Variant{}, false
 	}
 	args := []reflect.Value{
-		reflect.ValueOf(wci.Instance),
+		reflect.ValueOf(inst),
 		reflect.ValueOf(name),
 	}
-	reflectedRet := mcmi.GoMethodMetadata.Func.Call(args)
+	reflectedRet := md.Func.Call(args)
 	v, ok := reflectedRet[0].Interface().(Variant)
 	if !ok {
 		log.Panic("invalid return value: expected Variant",
@@ -271,7 +408,7 @@ func GoCallback_ClassCreationInfoGet(pInstance C.GDExtensionClassInstancePtr, pN
 	}
 	if !reflectedRet[1].Bool() {
 		log.Debug("_get call returned false")
-		return 0
+		return Variant{}, false
 	}
 	gdStrV := v.ToString()
 	defer gdStrV.Destroy()
@@ -279,16 +416,18 @@ func GoCallback_ClassCreationInfoGet(pInstance C.GDExtensionClassInstancePtr, pN
 		zap.String("ret", util.ReflectValueSliceToString(reflectedRet)),
 		zap.String("v", gdStrV.ToUtf8()),
 	)
-	*(*Variant)(unsafe.Pointer(rRet)) = v
-	return 1
+	return v, true
 }
 
 //export GoCallback_ClassCreationInfoSet
 func GoCallback_ClassCreationInfoSet(pInstance C.GDExtensionClassInstancePtr, pName C.GDExtensionConstStringNamePtr, pValue C.GDExtensionConstVariantPtr) C.GDExtensionBool {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ClassCreationInfoSet", &inst)
 	wci := cgo.Handle(pInstance).Value().(*WrappedClassInstance)
 	if wci == nil {
 		return 0
 	}
+	inst = wci.Instance
 	gdStrClass := wci.Instance.GetClass()
 	defer gdStrClass.Destroy()
 	className := gdStrClass.ToUtf8()
@@ -306,24 +445,31 @@ func GoCallback_ClassCreationInfoSet(pInstance C.GDExtensionClassInstancePtr, pN
 			zap.String("class", name),
 		)
 	}
-	mcmi, ok := ci.VirtualMethodMap["_set"]
-	if !ok {
+	if !ci.callSet(wci.Instance, name, v) {
+		return 0
+	}
+	return 1
+}
+
+// callSet calls the V_Set method of the class or of its registered parents, if
+// any; see callGet.
+func (c *ClassInfo) callSet(inst GDClass, name string, v Variant) bool {
+	md := c.findMethod("_set")
+	if md == nil {
 		log.Info("no V_Set method registered",
-			zap.String("class", name),
+			zap.String("class", c.Name),
+			zap.String("name", name),
 		)
-		return 0
+		return false
 	}
 	args := []reflect.Value{
-		reflect.ValueOf(wci.Instance),
+		reflect.ValueOf(inst),
 		reflect.ValueOf(name),
 		reflect.ValueOf(v),
 	}
-	reflectedRet := mcmi.GoMethodMetadata.Func.Call(args)
+	reflectedRet := md.Func.Call(args)
 	log.Info("reflect method called",
 		zap.String("ret", util.ReflectValueSliceToString(reflectedRet)),
 	)
-	if !reflectedRet[0].Bool() {
-		return 0
-	}
-	return 1
+	return reflectedRet[0].Bool()
 }
@@ -6,7 +6,7 @@
 // typedef const GDExtensionPropertyInfo
 // *(*GDExtensionClassGetPropertyList)(GDExtensionClassInstancePtr p_instance,
 // uint32_t *r_count)
-void cgo_classcreationinfo_getpropertylist(
+const GDExtensionPropertyInfo *cgo_classcreationinfo_getpropertylist(
     GDExtensionClassInstancePtr p_instance, uint32_t *r_count);
 
 // typedef void (*GDExtensionClassFreePropertyList)(GDExtensionClassInstancePtr
@@ -71,6 +71,11 @@ GDExtensionObjectPtr cgo_classcreationinfo_createinstance(void *data);
 void cgo_classcreationinfo_freeinstance(void *data,
                                         GDExtensionClassInstancePtr ptr);
 
+// cgo_classcreationinfo_recreateinstance signature should match
+// GDExtensionClassRecreateInstance
+GDExtensionClassInstancePtr
+cgo_classcreationinfo_recreateinstance(void *data, GDExtensionObjectPtr obj);
+
 // TODO: implement code to utilize _get _set below
 
 // cgo_classdb_get_func should match GDExtensionClassGet
@@ -14,9 +14,8 @@ import (
 
 	. "github.com/godot-go/godot-go/pkg/builtin"
 	. "github.com/godot-go/godot-go/pkg/ffi"
-	"github.com/godot-go/godot-go/pkg/gdclassimpl"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
 	. "github.com/godot-go/godot-go/pkg/gdclassinit"
-	"github.com/godot-go/godot-go/pkg/gdutilfunc"
 	"github.com/godot-go/godot-go/pkg/log"
 	. "github.com/godot-go/godot-go/pkg/util"
 	"go.uber.org/zap"
@@ -35,6 +34,9 @@ func _GDExtensionBindingInit(
 	Internal.GDClassConstructors = NewSyncMap[string, GDClassGoConstructorFromOwner]()
 
 	FFI.LoadProcAddresses(pGetProcAddress, pLibrary)
+	SetMainThread()
+	// from here on warnings and errors go to the editor's Output panel
+	log.SetGodotPrinter(GodotLogPrinter{})
 
 	// Load the Godot version.
 	CallFunc_GDExtensionInterfaceGetGodotVersion(FFI.GodotVersion)
@@ -73,6 +75,7 @@ func _GDExtensionBindingInit(
 //export GDExtensionBindingInitializeLevel
 func GDExtensionBindingInitializeLevel(userdata unsafe.Pointer, pLevel C.GDExtensionInitializationLevel) {
 	classdbCurrentLevel = (GDExtensionInitializationLevel)(pLevel)
+	reportMissingMethodBinds()
 
 	if fn := GDExtensionBindingInitCallbacks[pLevel]; fn != nil {
 		log.Debug("GDExtensionBindingInitializeLevel init", zap.Int32("level", (int32)(pLevel)))
@@ -80,21 +83,35 @@ func GDExtensionBindingInitializeLevel(userdata unsafe.Pointer, pLevel C.GDExten
 	}
 }
 
+// reportMissingMethodBinds lists the engine methods of the classes registered
+// at the current level that the running Godot version does not have, in one
+// message rather than a panic at the first call of each.
+func reportMissingMethodBinds() {
+	missing := CheckMethodBinds()
+	if len(missing) == 0 {
+		return
+	}
+	log.Warn("engine methods not found in this Godot version",
+		zap.Int32("major", FFI.GodotVersion.GetMajor()),
+		zap.Int32("minor", FFI.GodotVersion.GetMinor()),
+		zap.Strings("methods", missing),
+	)
+}
+
 //export GDExtensionBindingDeinitializeLevel
 func GDExtensionBindingDeinitializeLevel(userdata unsafe.Pointer, pLevel C.GDExtensionInitializationLevel) {
 	classdbCurrentLevel = (GDExtensionInitializationLevel)(pLevel)
 
+	// the editor deinitializes the extension before hot reloading it; keep
+	// what the recreated instances need while the classes are registered
+	saveReloadStates(classdbCurrentLevel)
 	if GDExtensionBindingTerminateCallbacks[pLevel] != nil {
 		GDExtensionBindingTerminateCallbacks[pLevel]()
 	}
-
-	if (GDExtensionInitializationLevel)(pLevel) == GDEXTENSION_INITIALIZATION_CORE {
-		UnpinCorePins()
-		UnpinBuiltinPins()
-		UnpinFFIPins()
-		UnpinGDClassInitPins()
-		gdclassimpl.UnpinGDClassImplPins()
-		gdutilfunc.UnpinGDUtilFuncPins()
+	unregisterClasses(classdbCurrentLevel)
+	if classdbCurrentLevel == GDEXTENSION_INITIALIZATION_CORE {
+		// the extension interface may be gone by the time Go logs again
+		log.SetGodotPrinter(nil)
 	}
 }
 
@@ -11,7 +11,6 @@ import "C"
 import (
 	"fmt"
 	"reflect"
-	"runtime"
 	"unsafe"
 
 	. "github.com/godot-go/godot-go/pkg/builtin"
@@ -32,14 +31,8 @@ var (
 	Internal                             InternalImpl
 	GDExtensionBindingInitCallbacks      [GDEXTENSION_MAX_INITIALIZATION_LEVEL]GDExtensionBindingCallback
 	GDExtensionBindingTerminateCallbacks [GDEXTENSION_MAX_INITIALIZATION_LEVEL]GDExtensionBindingCallback
-	pnr                                  runtime.Pinner
 )
 
-// UnpinCorePins releases pins held for the extension lifetime during shutdown.
-func UnpinCorePins() {
-	pnr.Unpin()
-}
-
 func CreateGDClassInstance(tn string) GDClass {
 	ci, ok := Internal.GDRegisteredGDClasses.Get(tn)
 	if !ok {
@@ -60,6 +53,14 @@ func CreateGDClassInstance(tn string) GDClass {
 	if owner == nil {
 		log.Panic("owner is nil", zap.String("type_name", tn))
 	}
+	inst, _ := newGDClassInstanceWithOwner(ci, owner)
+	return inst
+}
+
+// newGDClassInstanceWithOwner creates the Go value of a registered class for
+// an engine object and binds it as the object's extension instance.
+func newGDClassInstanceWithOwner(ci *ClassInfo, owner GDExtensionObjectPtr) (GDClass, GDExtensionClassInstancePtr) {
+	tn := ci.Name
 	// create GDClass
 	reflectedInst := reflect.New(ci.ClassType)
 	inst, ok := reflectedInst.Interface().(GDClass)
@@ -69,7 +70,8 @@ func CreateGDClassInstance(tn string) GDClass {
 	object := (*GodotObject)(owner)
 	id := CallFunc_GDExtensionInterfaceObjectGetInstanceId((GDExtensionConstObjectPtr)(unsafe.Pointer(owner)))
 	inst.SetGodotObjectOwner(object)
-	WrappedPostInitialize(tn, inst)
+	bindSignalFields(ci, inst)
+	instPtr := WrappedPostInitialize(tn, inst)
 	Internal.GDClassInstances.Set(id, inst)
 	log.Info("GDClass instance created",
 		zap.Any("object_id", id),
@@ -80,5 +82,5 @@ func CreateGDClassInstance(tn string) GDClass {
 		zap.String("object", fmt.Sprintf("%p", object)),
 		zap.String("inst.GetGodotObjectOwner", fmt.Sprintf("%p", inst.GetGodotObjectOwner())),
 	)
-	return inst
+	return inst, instPtr
 }
@@ -0,0 +1,54 @@
+package core
+
+import (
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// RunOnMainThread queues fn to run on Godot's main thread during the next
+// idle frame and returns immediately. Most engine APIs, and everything that
+// touches the scene tree, must only be called from the main thread; goroutines
+// use RunOnMainThread to hand such work back:
+//
+//	go func() {
+//		level := loadLevelData(path)
+//		RunOnMainThread(func() {
+//			n.AddChild(level.Build())
+//		})
+//	}()
+//
+// fn is queued with call_deferred, which is safe from any thread.
+func RunOnMainThread(fn func()) {
+	callable := NewCallableFromFunc(fn)
+	defer callable.Destroy()
+	v := NewVariantCallable(callable)
+	defer v.Destroy()
+	ret, err := v.Call("call_deferred", nil)
+	if err != nil {
+		log.Error("call_deferred failed", zap.Error(err))
+		return
+	}
+	ret.Destroy()
+}
+
+// RunOnMainThreadSync runs fn on the main thread and waits for it to return.
+// Called from the main thread, it runs fn right away, since waiting for the
+// next idle frame would deadlock. A panic in fn is re-raised in the caller.
+func RunOnMainThreadSync(fn func()) {
+	if IsMainThread() {
+		fn()
+		return
+	}
+	done := make(chan any, 1)
+	RunOnMainThread(func() {
+		defer func() {
+			done <- recover()
+		}()
+		fn()
+	})
+	if r := <-done; r != nil {
+		panic(r)
+	}
+}
@@ -26,8 +26,11 @@ const (
 	NoneReturnStyle ReturnStyle = iota
 	ValueReturnStyle
 	ValueAndBoolReturnStyle
-	// ValueAndErrorReturnStyle
-	// ErrorReturnStyle
+	// ValueAndErrorReturnStyle returns the value, or a call error if the
+	// error is not nil; the Error code instead when the value is an Error.
+	ValueAndErrorReturnStyle
+	// ErrorReturnStyle returns the error to Godot as an Error code.
+	ErrorReturnStyle
 )
 
 // GoMethodMetadata is used as method_userdata in callbacks called from godot into Go.
@@ -42,6 +45,7 @@ type GoMethodMetadata struct {
 	DefaultArguments       []Variant
 	IsVariadic             bool
 	IsVirtual              bool
+	IsStatic               bool
 	MethodFlags            MethodFlags
 	gdeReturnType          GDExtensionVariantType
 	gdeReturnPropertyInfo  GDExtensionPropertyInfo
@@ -57,21 +61,29 @@ func NewGoMethodMetadata(
 	gdMethodName string,
 	goMethodName string,
 	argumentNames []string,
+	argumentOptions []PropertyOptions,
 	defaultArguments []Variant,
 	methodFlags MethodFlags,
 ) *GoMethodMetadata {
 	mt := method.Type
 	fn := method.Func
-	recv := mt.In(0)
-	if recv.Kind() == reflect.Pointer {
-		recv = recv.Elem()
-	}
-	if className != recv.Name() {
-		log.Panic("class name did not match reciever type",
-			zap.String("class", className),
-			zap.String("method", gdMethodName),
-			zap.String("reciover", recv.Name()),
-		)
+	isStatic := (methodFlags & METHOD_FLAG_STATIC) == METHOD_FLAG_STATIC
+	// index of the first argument after the receiver
+	argOffset := 1
+	if isStatic {
+		argOffset = 0
+	} else {
+		recv := mt.In(0)
+		if recv.Kind() == reflect.Pointer {
+			recv = recv.Elem()
+		}
+		if className != recv.Name() {
+			log.Panic("class name did not match reciever type",
+				zap.String("class", className),
+				zap.String("method", gdMethodName),
+				zap.String("reciover", recv.Name()),
+			)
+		}
 	}
 	isVariadicTyped := mt.IsVariadic()
 	isVariadicFlaged := (methodFlags & METHOD_FLAG_VARARG) == METHOD_FLAG_VARARG
@@ -97,14 +109,21 @@ func NewGoMethodMetadata(
 	switch returnCount {
 	case 0:
 	case 1:
-		// HACK: the second return value is ignored
 		goReturnType = mt.Out(0)
 		returnStyle = ValueReturnStyle
+		if goReturnType == errorType {
+			goReturnType = errorEnumType
+			returnStyle = ErrorReturnStyle
+		}
 	case 2:
 		goReturnType = mt.Out(0)
-		returnStyle = ValueAndBoolReturnStyle
-		if mt.Out(1).Kind() != reflect.Bool {
-			log.Panic("method 2nd return value must be of type bool",
+		switch {
+		case mt.Out(1).Kind() == reflect.Bool:
+			returnStyle = ValueAndBoolReturnStyle
+		case mt.Out(1) == errorType:
+			returnStyle = ValueAndErrorReturnStyle
+		default:
+			log.Panic("method 2nd return value must be of type bool or error",
 				zap.String("method", gdMethodName),
 			)
 		}
@@ -119,16 +138,28 @@ func NewGoMethodMetadata(
 		zap.Any("type", goReturnType),
 	)
 	returnType := ReflectTypeToGDExtensionVariantType(goReturnType)
-	if returnType != GDEXTENSION_VARIANT_TYPE_NIL {
-		returnPropertyInfo = NewSimpleGDExtensionPropertyInfo(className, returnType, goReturnType.Name())
+	switch {
+	case goReturnType == errorEnumType:
+		returnPropertyInfo = NewGDExtensionPropertyInfoWithOptions(returnType, "", PropertyOptions{
+			ClassName: "Error",
+			Usage:     PROPERTY_USAGE_DEFAULT | PROPERTY_USAGE_CLASS_IS_ENUM,
+		})
+	case returnType != GDEXTENSION_VARIANT_TYPE_NIL:
+		returnPropertyInfo = NewSimpleGDExtensionPropertyInfo("", returnType, goReturnType.Name())
 	}
-	argumentCount := mt.NumIn() - 1
+	argumentCount := mt.NumIn() - argOffset
 	if len(argumentNames) > argumentCount {
 		log.Panic(`Method definition has more arguments than the actual method.`,
 			zap.String("method", gdMethodName),
 			zap.Int("argument_count", argumentCount),
 		)
 	}
+	if len(argumentOptions) > argumentCount {
+		log.Panic(`Method definition has more argument options than the actual method.`,
+			zap.String("method", gdMethodName),
+			zap.Int("argument_count", argumentCount),
+		)
+	}
 	defaultArgumentPtrs := make([]GDExtensionVariantPtr, len(defaultArguments))
 	for i := range defaultArgumentPtrs {
 		defaultArgumentPtrs[i] = (GDExtensionVariantPtr)(defaultArguments[i].NativePtr())
@@ -138,10 +169,18 @@ func NewGoMethodMetadata(
 	argumentsInfo := make([]GDExtensionPropertyInfo, argumentCount)
 	argumentsMetadata := make([]GDExtensionClassMethodArgumentMetadata, argumentCount)
 	for i := 0; i < argumentCount; i++ {
-		t := mt.In(i + 1)
+		t := mt.In(i + argOffset)
 		goArgumentTypes[i] = t
 		variantTypes[i] = ReflectTypeToGDExtensionVariantType(t)
-		argumentsInfo[i] = NewSimpleGDExtensionPropertyInfo(className, variantTypes[i], t.Name())
+		argName := t.Name()
+		if i < len(argumentNames) {
+			argName = argumentNames[i]
+		}
+		var argOptions PropertyOptions
+		if i < len(argumentOptions) {
+			argOptions = argumentOptions[i]
+		}
+		argumentsInfo[i] = NewGDExtensionPropertyInfoWithOptions(variantTypes[i], argName, argOptions)
 		argumentsMetadata[i] = GDEXTENSION_METHOD_ARGUMENT_METADATA_NONE
 	}
 	ret := &GoMethodMetadata{
@@ -155,6 +194,7 @@ func NewGoMethodMetadata(
 		DefaultArguments:       defaultArguments,
 		IsVariadic:             isVariadicFlaged,
 		IsVirtual:              isVirtual,
+		IsStatic:               isStatic,
 		MethodFlags:            methodFlags,
 		gdeReturnType:          returnType,
 		gdeReturnPropertyInfo:  returnPropertyInfo,
@@ -163,8 +203,6 @@ func NewGoMethodMetadata(
 		gdeArgumentTypes:       variantTypes,
 		gdeDefaultArgumentPtrs: defaultArgumentPtrs,
 	}
-	pnr.Pin(&returnPropertyInfo)
-	pnr.Pin(ret)
 	return ret
 }
 
@@ -198,43 +236,14 @@ func (md *GoMethodMetadata) Call(inst GDClass, gdArgs ...Variant) (Variant, *GDE
 			reflect.ValueOf(inst),
 			reflect.ValueOf(gdArgs),
 		}
-		ret := md.Func.CallSlice(args)
+		ret := md.Func.CallSlice(md.receiverArgs(args))
 		log.Info("Call Variadic",
 			zap.String("bind", md.String()),
 			zap.String("gd_args", VariantSliceToString(gdArgs)),
 			zap.String("resolved_args", VariantSliceToString(callArgs)),
 			zap.String("ret", util.ReflectValueSliceToString(ret)),
 		)
-		switch md.GoReturnStyle {
-		case NoneReturnStyle:
-		case ValueAndBoolReturnStyle:
-			// Check if second return value (error) is non-nil
-			if len(ret) > 1 && !ret[1].IsNil() {
-				log.Error("Go method returned error",
-					zap.String("bind", md.String()),
-					zap.Any("error", ret[1].Interface()),
-				)
-				err := &GDExtensionCallError{}
-				err.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
-				return NewVariantNil(), err
-			}
-			fallthrough
-		case ValueReturnStyle:
-			// return ret[0].Interface().(Variant)
-			v := Variant{}
-			ptr := (GDExtensionUninitializedVariantPtr)(unsafe.Pointer(v.NativePtr()))
-			GDExtensionVariantPtrFromReflectValue(ret[0], ptr)
-			return v, nil
-		default:
-			log.Error("unexpected MethodBindReturnStyle",
-				zap.Any("value", ret),
-				zap.Any("style", md.GoReturnStyle),
-			)
-			err := &GDExtensionCallError{}
-			err.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
-			return NewVariantNil(), err
-		}
-		return NewVariantNil(), nil
+		return md.callReturn(ret)
 	} else {
 		args := reflectFuncCallArgsFromGDExtensionConstVariantPtrSliceArgs(inst, callArgs, exepctedTypes)
 		log.Debug("Calling",
@@ -242,51 +251,75 @@ func (md *GoMethodMetadata) Call(inst GDClass, gdArgs ...Variant) (Variant, *GDE
 			zap.String("gd_args", VariantSliceToString(gdArgs)),
 			zap.String("resolved_args", VariantSliceToString(callArgs)),
 		)
-		ret := md.Func.Call(args)
+		ret := md.Func.Call(md.receiverArgs(args))
 		log.Info("Call",
 			zap.String("bind", md.String()),
 			zap.String("gd_args", VariantSliceToString(gdArgs)),
 			zap.String("resolved_args", VariantSliceToString(callArgs)),
 			zap.String("ret", util.ReflectValueSliceToString(ret)),
 		)
-		switch md.GoReturnStyle {
-		case NoneReturnStyle:
-		case ValueAndBoolReturnStyle:
-			// Check if second return value (error) is non-nil
-			if len(ret) > 1 && !ret[1].IsNil() {
-				log.Error("Go method returned error",
-					zap.String("bind", md.String()),
-					zap.Any("error", ret[1].Interface()),
-				)
-				err := &GDExtensionCallError{}
-				err.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
-				return NewVariantNil(), err
-			}
-			fallthrough
-		case ValueReturnStyle:
-			v := Variant{}
-			ptr := (GDExtensionUninitializedVariantPtr)(unsafe.Pointer(v.NativePtr()))
-			pnr.Pin(ptr)
-			GDExtensionVariantPtrFromReflectValue(ret[0], ptr)
-			return v, nil
-		default:
-			log.Error("unexpected MethodBindReturnStyle",
-				zap.Any("value", ret),
-				zap.Any("style", md.GoReturnStyle),
-			)
+		return md.callReturn(ret)
+	}
+}
+
+// receiverArgs drops the receiver, args[0], for a static method.
+func (md *GoMethodMetadata) receiverArgs(args []reflect.Value) []reflect.Value {
+	if md.IsStatic {
+		return args[1:]
+	}
+	return args
+}
+
+// callReturn converts the values returned by the Go method to the result of
+// Call.
+func (md *GoMethodMetadata) callReturn(ret []reflect.Value) (Variant, *GDExtensionCallError) {
+	switch md.GoReturnStyle {
+	case NoneReturnStyle:
+		return NewVariantNil(), nil
+	case ErrorReturnStyle:
+		ret = []reflect.Value{md.errorCodeValue(ret[0])}
+	case ValueAndErrorReturnStyle:
+		if !ret[1].IsNil() && md.GoReturnType != errorEnumType {
+			reportMethodError(md, ret[1].Interface().(error))
 			err := &GDExtensionCallError{}
 			err.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
 			return NewVariantNil(), err
 		}
+		if !ret[1].IsNil() {
+			ret = []reflect.Value{md.errorCodeValue(ret[1])}
+		}
+	case ValueAndBoolReturnStyle, ValueReturnStyle:
+	default:
+		log.Error("unexpected MethodBindReturnStyle",
+			zap.Any("value", ret),
+			zap.Any("style", md.GoReturnStyle),
+		)
+		err := &GDExtensionCallError{}
+		err.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
+		return NewVariantNil(), err
 	}
-	return NewVariantNil(), nil
+	v := Variant{}
+	ptr := (GDExtensionUninitializedVariantPtr)(unsafe.Pointer(v.NativePtr()))
+	GDExtensionVariantPtrFromReflectValue(ret[0], ptr)
+	return v, nil
+}
+
+// errorCodeValue reports a non-nil error returned by the Go method and
+// returns its Error code.
+func (md *GoMethodMetadata) errorCodeValue(errValue reflect.Value) reflect.Value {
+	if errValue.IsNil() {
+		return reflect.ValueOf(OK)
+	}
+	err := errValue.Interface().(error)
+	reportMethodError(md, err)
+	return reflect.ValueOf(ErrorCode(err))
 }
 
 // Ptrcall is called by GDScript to call into Go
 func (md *GoMethodMetadata) Ptrcall(inst GDClass, gdArgs []GDExtensionConstTypePtr, rReturn GDExtensionUninitializedTypePtr) {
 	exepctedArgTypes := md.GoArgumentTypes
 	args := reflectFuncCallArgsFromGDExtensionConstTypePtrSliceArgs(inst, gdArgs, exepctedArgTypes)
-	ret := md.Func.Call(args)
+	ret := md.Func.Call(md.receiverArgs(args))
 	log.Info("Ptrcall",
 		zap.String("bind", md.String()),
 		zap.String("ret", util.ReflectValueSliceToString(ret)),
@@ -298,6 +331,19 @@ func (md *GoMethodMetadata) Ptrcall(inst GDClass, gdArgs []GDExtensionConstTypeP
 	}
 	switch md.GoReturnStyle {
 	case NoneReturnStyle:
+	case ErrorReturnStyle:
+		GDExtensionTypePtrFromReflectValue(md.errorCodeValue(ret[0]), rReturn)
+	case ValueAndErrorReturnStyle:
+		switch {
+		case ret[1].IsNil():
+			GDExtensionTypePtrFromReflectValue(ret[0], rReturn)
+		case md.GoReturnType == errorEnumType:
+			GDExtensionTypePtrFromReflectValue(md.errorCodeValue(ret[1]), rReturn)
+		default:
+			// a ptrcall cannot fail; the caller gets the zero value
+			reportMethodError(md, ret[1].Interface().(error))
+			GDExtensionTypePtrFromReflectValue(reflect.Zero(md.GoReturnType), rReturn)
+		}
 	case ValueAndBoolReturnStyle:
 		log.Warn("second return value ignored")
 		fallthrough
@@ -349,10 +395,9 @@ func NewGDExtensionClassMethodInfoFromMethodBind(md *GoMethodMetadata) *GDExtens
 		defaultArgumentCount   uint32
 	)
 	returnPropertyInfoPtr := &md.gdeReturnPropertyInfo
-	pnr.Pin(returnPropertyInfoPtr)
 	if md.IsVariadic {
 		argumentsInfo := []GDExtensionPropertyInfo{
-			NewSimpleGDExtensionPropertyInfo(md.ClassName, GDEXTENSION_VARIANT_TYPE_NIL, "varargs"),
+			NewSimpleGDExtensionPropertyInfo("", GDEXTENSION_VARIANT_TYPE_NIL, "varargs"),
 		}
 		argumentInfoCount = (uint32)(len(argumentsInfo))
 		argumentInfosPtr = unsafe.SliceData(argumentsInfo)
@@ -372,12 +417,6 @@ func NewGDExtensionClassMethodInfoFromMethodBind(md *GoMethodMetadata) *GDExtens
 		defaultArgumentCount = (uint32)(len(md.gdeDefaultArgumentPtrs))
 		defaultArgumentPtrsPtr = unsafe.SliceData(md.gdeDefaultArgumentPtrs)
 	}
-	pnr.Pin(argumentInfosPtr)
-	pnr.Pin(argumentsMetadataPtr)
-	pnr.Pin(defaultArgumentPtrsPtr)
-	for i := range md.gdeDefaultArgumentPtrs {
-		pnr.Pin(md.gdeDefaultArgumentPtrs[i])
-	}
 	gdMethodNameStringName := NewStringNameWithLatin1Chars(md.GdMethodName)
 	// Note: StringName intentionally NOT destroyed - Godot keeps a reference
 	// to this pointer in the method info for the lifetime of the class.
@@ -396,6 +435,5 @@ func NewGDExtensionClassMethodInfoFromMethodBind(md *GoMethodMetadata) *GDExtens
 		defaultArgumentCount,
 		(*GDExtensionVariantPtr)(defaultArgumentPtrsPtr),
 	)
-	pnr.Pin(ret)
 	return ret
 }
@@ -27,13 +27,10 @@ func GoCallback_MethodBindMethodCall(
 	rReturn C.GDExtensionVariantPtr,
 	rError *C.GDExtensionCallError,
 ) {
-	// Add panic recovery to catch any panics and report them via rError
+	var inst Object
 	defer func() {
 		if r := recover(); r != nil {
-			log.Error("panic in Go method callback",
-				zap.Any("panic", r),
-				zap.Stack("stack"),
-			)
+			handlePanic(r, "GoCallback_MethodBindMethodCall", inst)
 			// Set rError to indicate method call failed
 			callErr := (*GDExtensionCallError)(unsafe.Pointer(rError))
 			callErr.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
@@ -48,26 +45,24 @@ func GoCallback_MethodBindMethodCall(
 		callErr.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
 		return
 	}
-	pnr.Pin(instPtr)
-	inst := ObjectClassFromGDExtensionClassInstancePtr((GDExtensionClassInstancePtr)(instPtr))
-	if inst == nil {
-		log.Error("GDExtensionClassInstancePtr cannot be null")
-		callErr := (*GDExtensionCallError)(unsafe.Pointer(rError))
-		callErr.SetErrorFields(GDEXTENSION_CALL_ERROR_INSTANCE_IS_NULL, 0, 0)
-		return
+	// static methods are called without an instance
+	if !bind.IsStatic {
+		inst = ObjectClassFromGDExtensionClassInstancePtr((GDExtensionClassInstancePtr)(instPtr))
+		if inst == nil {
+			log.Error("GDExtensionClassInstancePtr cannot be null")
+			callErr := (*GDExtensionCallError)(unsafe.Pointer(rError))
+			callErr.SetErrorFields(GDEXTENSION_CALL_ERROR_INSTANCE_IS_NULL, 0, 0)
+			return
+		}
 	}
-	pnr.Pin(inst)
-	cn := inst.GetClass()
-	defer cn.Destroy()
 	log.Debug("GoCallback_MethodBindMethodCall called",
-		zap.String("class", cn.ToUtf8()),
+		zap.String("class", bind.ClassName),
 		zap.String("method", bind.GdMethodName),
 		zap.String("bind", bind.String()),
 	)
 	argPtrSlice := unsafe.Slice((*GDExtensionConstVariantPtr)(argPtrs), int(argumentCount))
 	args := make([]Variant, argumentCount)
 	for i := range argPtrSlice {
-		pnr.Pin(argPtrSlice[i])
 		args[i] = NewVariantCopyWithGDExtensionConstVariantPtr(argPtrSlice[i])
 	}
 
@@ -75,7 +70,7 @@ func GoCallback_MethodBindMethodCall(
 	retCall, callErr := bind.Call(inst, args...)
 	if callErr != nil {
 		log.Error("method call failed",
-			zap.String("class", cn.ToUtf8()),
+			zap.String("class", bind.ClassName),
 			zap.String("method", bind.GdMethodName),
 			zap.Error(callErr),
 		)
@@ -85,7 +80,6 @@ func GoCallback_MethodBindMethodCall(
 	}
 
 	*(*Variant)(unsafe.Pointer(rReturn)) = retCall
-	pnr.Pin(rReturn)
 }
 
 // called when godot calls into golang code
@@ -97,19 +91,22 @@ func GoCallback_MethodBindMethodPtrcall(
 	argPtrs *C.GDExtensionConstTypePtr,
 	rReturn C.GDExtensionTypePtr,
 ) {
+	var inst GDClass
+	defer recoverCallback("GoCallback_MethodBindMethodPtrcall", &inst)
 	ud := (cgo.Handle)(methodUserData)
 	bind, ok := ud.Value().(*GoMethodMetadata)
 	if !ok || bind == nil {
 		log.Panic("unable to retrieve methodUserData")
 	}
-	inst := ObjectClassFromGDExtensionClassInstancePtr((GDExtensionClassInstancePtr)(instPtr))
-	if inst == nil {
-		log.Panic("GDExtensionClassInstancePtr canoot be null")
+	// static methods are called without an instance
+	if !bind.IsStatic {
+		inst = ObjectClassFromGDExtensionClassInstancePtr((GDExtensionClassInstancePtr)(instPtr))
+		if inst == nil {
+			log.Panic("GDExtensionClassInstancePtr canoot be null")
+		}
 	}
-	cn := inst.GetClass()
-	defer cn.Destroy()
 	log.Debug("GoCallback_MethodBindMethodPtrcall called",
-		zap.String("class", cn.ToUtf8()),
+		zap.String("class", bind.ClassName),
 		zap.String("method", bind.String()),
 	)
 	sliceLen := len(bind.GoArgumentTypes)
@@ -119,5 +116,4 @@ func GoCallback_MethodBindMethodPtrcall(
 		argsSlice,
 		(GDExtensionUninitializedTypePtr)(rReturn),
 	)
-	pnr.Pin(rReturn)
 }
@@ -40,7 +40,6 @@ func refValueFromVariant(arg Variant, expectedType reflect.Type) (reflect.Value,
 	}
 	snClassName := StringName{}
 	snClassNamePtr := snClassName.NativePtr()
-	pnr.Pin(snClassNamePtr)
 	cok := CallFunc_GDExtensionInterfaceObjectGetClassName(
 		(GDExtensionConstObjectPtr)(owner),
 		FFI.Library,
@@ -272,24 +271,6 @@ func convertVariantToGoTypeReflectValue(arg Variant, t reflect.Type) (reflect.Va
 		case Variant:
 			v := NewVariantCopyWithGDExtensionConstVariantPtr(arg.NativeConstPtr())
 			return reflect.ValueOf(v), nil
-		case Vector2:
-			v := arg.ToVector2()
-			return reflect.ValueOf(v), nil
-		case Vector2i:
-			v := arg.ToVector2i()
-			return reflect.ValueOf(v), nil
-		case Vector3:
-			v := arg.ToVector3()
-			return reflect.ValueOf(v), nil
-		case Vector3i:
-			v := arg.ToVector3i()
-			return reflect.ValueOf(v), nil
-		case Vector4:
-			v := arg.ToVector4()
-			return reflect.ValueOf(v), nil
-		case Vector4i:
-			v := arg.ToVector4i()
-			return reflect.ValueOf(v), nil
 		case PackedByteArray:
 			v := arg.ToPackedByteArray()
 			return reflect.ValueOf(v), nil
@@ -355,8 +336,7 @@ func convertVariantToGoTypeReflectValue(arg Variant, t reflect.Type) (reflect.Va
 			)
 		}
 	case reflect.Struct:
-		switch {
-		case t.Implements(refType):
+		if t.Implements(refType) {
 			refValue, ok := refValueFromVariant(arg, t)
 			if ok {
 				return refValue, nil
@@ -365,6 +345,56 @@ func convertVariantToGoTypeReflectValue(arg Variant, t reflect.Type) (reflect.Va
 				zap.String("type", t.Name()),
 			)
 			return reflect.Zero(t), nil
+		}
+		switch reflect.Zero(t).Interface().(type) {
+		case Vector2:
+			v := Vector2Encoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Vector2i:
+			v := Vector2iEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Rect2:
+			v := Rect2Encoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Rect2i:
+			v := Rect2iEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Vector3:
+			v := Vector3Encoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Vector3i:
+			v := Vector3iEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Transform2D:
+			v := Transform2DEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Vector4:
+			v := Vector4Encoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Vector4i:
+			v := Vector4iEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Plane:
+			v := PlaneEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Quaternion:
+			v := QuaternionEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case AABB:
+			v := AABBEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Basis:
+			v := BasisEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Transform3D:
+			v := Transform3DEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Projection:
+			v := ProjectionEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
+		case Color:
+			v := ColorEncoder.DecodeVariantPtr(arg.NativeConstPtr())
+			return reflect.ValueOf(v), nil
 		default:
 			log.Panic("unsupported struct type",
 				zap.Any("type", t),
@@ -599,26 +629,6 @@ func reflectFuncCallArgsFromGDExtensionConstTypePtrSliceArgs(inst GDClass, suppl
 			v := reflect.Zero(t)
 			inst := v.Interface()
 			switch inst.(type) {
-			case Vector4:
-				pV := (*Vector4)(unsafe.Pointer(arg))
-				if pV == nil {
-					log.Panic("GDExtensionConstTypePtr is nil",
-						zap.Int("arg_index", i),
-						zap.Any("type", t),
-					)
-				}
-				v := NewVector4WithVector4(*pV)
-				args[i+1] = reflect.ValueOf(v)
-			case Vector2:
-				pV := (*Vector2)(unsafe.Pointer(arg))
-				if pV == nil {
-					log.Panic("GDExtensionConstTypePtr is nil",
-						zap.Int("arg_index", i),
-						zap.Any("type", t),
-					)
-				}
-				v := NewVector2WithVector2(*pV)
-				args[i+1] = reflect.ValueOf(v)
 			case Variant:
 				v := NewVariantCopyWithGDExtensionConstVariantPtr((GDExtensionConstVariantPtr)(arg))
 				args[i+1] = reflect.ValueOf(v)
@@ -637,31 +647,62 @@ func reflectFuncCallArgsFromGDExtensionConstTypePtrSliceArgs(inst GDClass, suppl
 				log.Panic(fmt.Sprintf("MethodBind.Ptrcall reflected as array does not support type: %s", t.Name()))
 			}
 		case reflect.Struct:
+			if arg == nil {
+				log.Panic("GDExtensionConstTypePtr is nil",
+					zap.Int("arg_index", i),
+					zap.Any("type", t),
+				)
+			}
 			v := reflect.Zero(t)
 			inst := v.Interface()
 			switch inst.(type) {
+			case Vector2:
+				v := Vector2Encoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Vector2i:
+				v := Vector2iEncoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Rect2:
+				v := Rect2Encoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Rect2i:
+				v := Rect2iEncoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Vector3:
+				v := Vector3Encoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Vector3i:
+				v := Vector3iEncoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Transform2D:
+				v := Transform2DEncoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
 			case Vector4:
-				pV := (*Vector4)(unsafe.Pointer(arg))
-				if pV == nil {
-					log.Panic("GDExtensionConstTypePtr is nil",
-						zap.Int("arg_index", i),
-						zap.Any("type", t),
-					)
-				}
-				v := NewVector4WithVector4(*pV)
+				v := Vector4Encoder.DecodeTypePtr(arg)
 				args[i+1] = reflect.ValueOf(v)
-			case Vector2:
-				pV := (*Vector2)(unsafe.Pointer(arg))
-				if pV == nil {
-					log.Panic("GDExtensionConstTypePtr is nil",
-						zap.Int("arg_index", i),
-						zap.Any("type", t),
-					)
-				}
-				v := NewVector2WithVector2(*pV)
+			case Vector4i:
+				v := Vector4iEncoder.DecodeTypePtr(arg)
 				args[i+1] = reflect.ValueOf(v)
-			case Variant:
-				v := NewVariantCopyWithGDExtensionConstVariantPtr((GDExtensionConstVariantPtr)(arg))
+			case Plane:
+				v := PlaneEncoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Quaternion:
+				v := QuaternionEncoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case AABB:
+				v := AABBEncoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Basis:
+				v := BasisEncoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Transform3D:
+				v := Transform3DEncoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Projection:
+				v := ProjectionEncoder.DecodeTypePtr(arg)
+				args[i+1] = reflect.ValueOf(v)
+			case Color:
+				v := ColorEncoder.DecodeTypePtr(arg)
 				args[i+1] = reflect.ValueOf(v)
 			default:
 				if strings.HasPrefix(t.String(), "gdextension.Ref") {
@@ -734,6 +775,9 @@ func reflectFuncCallArgsFromGDExtensionConstTypePtrSliceArgs(inst GDClass, suppl
 				}
 				owner := (**GodotObject)(arg)
 				obj := constructor(*owner)
+				if ci, ok := Internal.GDRegisteredGDClasses.Get(className); ok {
+					bindSignalFields(ci, obj)
+				}
 				args[i+1] = reflect.ValueOf(obj)
 			default:
 				log.Panic("unsupported pointer type",
@@ -776,10 +820,18 @@ func validateReturnValues(reflectedRet []reflect.Value, returnStyle ReturnStyle,
 					zap.String("type", reflectedRet[0].Type().Name()),
 				)
 			}
+		case ErrorReturnStyle:
 		default:
 			log.Panic("unexpected value returned")
 		}
 		return nil
+	case 2:
+		switch returnStyle {
+		case ValueAndBoolReturnStyle, ValueAndErrorReturnStyle:
+		default:
+			log.Panic("unexpected second value returned")
+		}
+		return nil
 	default:
 		log.Panic("too many values returned", zap.Any("ret", reflectedRet))
 	}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+)
+
+var errorEnumType = reflect.TypeOf((*Error)(nil)).Elem()
+
+type codedError struct {
+	code Error
+	err  error
+}
+
+func (e *codedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *codedError) Unwrap() error {
+	return e.err
+}
+
+// WithErrorCode attaches the Error returned to GDScript when a bound method
+// fails with err. Errors without a code are returned as FAILED:
+//
+//	func (s *Save) Load(path string) error {
+//		data, err := os.ReadFile(path)
+//		if err != nil {
+//			return WithErrorCode(err, ERR_FILE_CANT_OPEN)
+//		}
+//		...
+//	}
+func WithErrorCode(err error, code Error) error {
+	if err == nil {
+		return nil
+	}
+	return &codedError{code: code, err: err}
+}
+
+// ErrorCode returns the Error attached to err with WithErrorCode, OK for a
+// nil err and FAILED otherwise.
+func ErrorCode(err error) Error {
+	if err == nil {
+		return OK
+	}
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	return FAILED
+}
+
+// reportMethodError prints the error returned by a bound method to the
+// editor's Debugger, pointing at the Go method rather than the binding.
+func reportMethodError(md *GoMethodMetadata, err error) {
+	var (
+		function = md.GoMethodName
+		file     string
+		line     int
+	)
+	if f := runtime.FuncForPC(md.Func.Pointer()); f != nil {
+		function = f.Name()
+		file, line = f.FileLine(f.Entry())
+	}
+	CallFunc_GDExtensionInterfacePrintErrorWithMessage(
+		err.Error(),
+		fmt.Sprintf("%s.%s returned an error", md.ClassName, md.GdMethodName),
+		function,
+		file,
+		int32(line),
+		0,
+	)
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// PanicPolicy decides what happens after Go code called by the engine, such
+// as a bound method or V_PhysicsProcess, panics. The panic and its Go stack
+// are reported to the editor's Debugger under every policy.
+type PanicPolicy uint32
+
+const (
+	// PanicLog returns to the engine as if the call failed.
+	PanicLog PanicPolicy = iota
+	// PanicDisableInstance also disables the processing of the Node the
+	// panicking code was called on, so a panic in V_Process is not repeated
+	// every frame.
+	PanicDisableInstance
+	// PanicCrash aborts the process with a core dump, for example to fail a
+	// CI run.
+	PanicCrash
+)
+
+func (p PanicPolicy) String() string {
+	switch p {
+	case PanicLog:
+		return "log"
+	case PanicDisableInstance:
+		return "disable_instance"
+	case PanicCrash:
+		return "crash"
+	default:
+		return fmt.Sprintf("PanicPolicy(%d)", uint32(p))
+	}
+}
+
+var panicPolicy atomic.Uint32
+
+// SetPanicPolicy sets the PanicPolicy; the default is PanicLog.
+func SetPanicPolicy(p PanicPolicy) {
+	panicPolicy.Store(uint32(p))
+}
+
+// GetPanicPolicy returns the PanicPolicy set with SetPanicPolicy.
+func GetPanicPolicy() PanicPolicy {
+	return PanicPolicy(panicPolicy.Load())
+}
+
+// recoverCallback recovers a panic of a callback called by the engine, which
+// would otherwise unwind into the engine and take the process down. It must
+// be deferred directly by the callback, before anything that can panic. inst
+// points to the instance the callback is called on, set once it is resolved,
+// or is nil.
+func recoverCallback(callback string, inst *GDClass) {
+	if r := recover(); r != nil {
+		var gdInst GDClass
+		if inst != nil {
+			gdInst = *inst
+		}
+		handlePanic(r, callback, gdInst)
+	}
+}
+
+func init() {
+	// the callbacks of pkg/builtin and pkg/gdclassinit cannot import this
+	// package
+	CallbackPanicHandler = func(r any, callback string) {
+		handlePanic(r, callback, nil)
+	}
+}
+
+// handlePanic reports the recovered value r and applies the PanicPolicy.
+func handlePanic(r any, callback string, inst GDClass) {
+	policy := GetPanicPolicy()
+	fields := []zap.Field{
+		zap.String("callback", callback),
+		zap.Any("panic", r),
+		zap.Stringer("policy", policy),
+		zap.ByteString("stack", debug.Stack()),
+	}
+	if inst != nil {
+		fields = append(fields, zap.String("class", inst.GetClassName()))
+	}
+	log.Error("panic in Go callback", fields...)
+	switch policy {
+	case PanicDisableInstance:
+		node, ok := inst.(Node)
+		if !ok {
+			return
+		}
+		node.SetProcessMode(NODE_PROCESS_MODE_PROCESS_MODE_DISABLED)
+		log.Warn("processing disabled after panic",
+			zap.String("callback", callback),
+			zap.String("class", inst.GetClassName()),
+		)
+	case PanicCrash:
+		debug.SetTraceback("crash")
+		panic(r)
+	}
+}
@@ -0,0 +1,97 @@
+package core
+
+import (
+	"sync"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// PropertyInfo describes a property reported by V_GetPropertyList.
+type PropertyInfo struct {
+	Type    GDExtensionVariantType
+	Name    string
+	Options PropertyOptions
+}
+
+// GDClassPropertyList is implemented by classes whose properties depend on
+// the instance, such as one property per item of a list. The properties are
+// listed after the ones registered with the class and are read and written
+// through V_Get and V_Set.
+//
+// V_GetPropertyList is not bound with ClassDBBindMethodVirtual; implementing
+// the method is enough.
+type GDClassPropertyList interface {
+	V_GetPropertyList() []PropertyInfo
+}
+
+// allocatedPropertyLists maps the property lists allocated by
+// newInstancePropertyList to the index of their first entry owned by the
+// list. Entries before it are copies of registration-owned property infos.
+var allocatedPropertyLists sync.Map
+
+// newInstancePropertyList returns static followed by the properties reported
+// by the V_GetPropertyList of inst, allocated in engine memory. static is
+// returned as is if inst does not implement GDClassPropertyList.
+func newInstancePropertyList(inst GDClass, static []GDExtensionPropertyInfo) (*GDExtensionPropertyInfo, int) {
+	provider, ok := inst.(GDClassPropertyList)
+	if !ok {
+		if len(static) == 0 {
+			return nil, 0
+		}
+		return unsafe.SliceData(static), len(static)
+	}
+	dynamic := provider.V_GetPropertyList()
+	count := len(static) + len(dynamic)
+	if count == 0 {
+		return nil, 0
+	}
+	// one block holds the property infos followed by the name, class name
+	// and hint string of each dynamic property
+	infoSize := int(unsafe.Sizeof(GDExtensionPropertyInfo{}))
+	valueSize := 2*StringNameSize + StringSize
+	block := AllocZeros(count*infoSize + len(dynamic)*valueSize)
+	list := unsafe.Slice((*GDExtensionPropertyInfo)(block), count)
+	copy(list, static)
+	values := unsafe.Add(block, count*infoSize)
+	for i, p := range dynamic {
+		name := (*StringName)(values)
+		className := (*StringName)(unsafe.Add(values, StringNameSize))
+		hintString := (*String)(unsafe.Add(values, 2*StringNameSize))
+		values = unsafe.Add(values, valueSize)
+		*name = NewStringNameWithUtf8Chars(p.Name)
+		*className = NewStringNameWithLatin1Chars(p.Options.className())
+		*hintString = NewStringWithUtf8Chars(p.Options.HintString)
+		list[len(static)+i] = NewGDExtensionPropertyInfo(
+			className.AsGDExtensionConstStringNamePtr(),
+			p.Type,
+			name.AsGDExtensionConstStringNamePtr(),
+			uint32(p.Options.Hint),
+			hintString.AsGDExtensionConstStringPtr(),
+			uint32(p.Options.usage()),
+		)
+	}
+	ptr := unsafe.SliceData(list)
+	allocatedPropertyLists.Store(ptr, len(static))
+	return ptr, count
+}
+
+// freeInstancePropertyList frees a list returned by newInstancePropertyList.
+// Registration-owned lists are left alone.
+func freeInstancePropertyList(ptr *GDExtensionPropertyInfo, count int) {
+	owned, ok := allocatedPropertyLists.LoadAndDelete(ptr)
+	if !ok {
+		return
+	}
+	list := unsafe.Slice(ptr, count)
+	for i := owned.(int); i < count; i++ {
+		list[i].Destroy()
+	}
+	Free(unsafe.Pointer(ptr))
+	log.Debug("instance property list freed",
+		zap.Int("count", count),
+	)
+}
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+)
+
+// PropertyOptions describes how the editor presents and stores a property,
+// or a signal or method argument. The zero value is a plain property that is
+// shown in the inspector and saved with the scene.
+type PropertyOptions struct {
+	Hint PropertyHint
+	// HintString is interpreted according to Hint, e.g. "0,100,1" for
+	// PROPERTY_HINT_RANGE.
+	HintString string
+	// Usage is PROPERTY_USAGE_DEFAULT when zero.
+	Usage PropertyUsageFlags
+	// ClassName is the class of an Object property. It defaults to
+	// HintString for PROPERTY_HINT_RESOURCE_TYPE.
+	ClassName string
+	// Index is passed to the getter and setter of an indexed property, whose
+	// getter takes the index and whose setter takes the index and the value.
+	Index int
+	// Default is the value the inspector's revert button restores, given as
+	// a Go value such as float32(1) or a Variant. A nil Default leaves the
+	// property without a revert button unless the class implements
+	// GDClassPropertyCanRevert and GDClassPropertyGetRevert.
+	Default any
+}
+
+func (o PropertyOptions) usage() PropertyUsageFlags {
+	if o.Usage == 0 {
+		return PROPERTY_USAGE_DEFAULT
+	}
+	return o.Usage
+}
+
+func (o PropertyOptions) className() string {
+	// behavior ported from godot-cpp
+	if o.Hint == PROPERTY_HINT_RESOURCE_TYPE && len(o.ClassName) == 0 {
+		return o.HintString
+	}
+	return o.ClassName
+}
+
+// HintRange limits a number to min..max in steps of step, e.g.
+// HintRange(0, 100, 1). Extra hints such as "or_greater" or "suffix:px" are
+// appended to the hint string.
+func HintRange(min, max, step float64, extra ...string) PropertyOptions {
+	parts := []string{
+		strconv.FormatFloat(min, 'g', -1, 64),
+		strconv.FormatFloat(max, 'g', -1, 64),
+		strconv.FormatFloat(step, 'g', -1, 64),
+	}
+	return PropertyOptions{
+		Hint:       PROPERTY_HINT_RANGE,
+		HintString: strings.Join(append(parts, extra...), ","),
+	}
+}
+
+// HintEnum picks an int or a String from values. For an int, a value may be
+// given as "Name:Value" to skip numbers.
+func HintEnum(values ...string) PropertyOptions {
+	return PropertyOptions{
+		Hint:       PROPERTY_HINT_ENUM,
+		HintString: strings.Join(values, ","),
+	}
+}
+
+// HintFlags edits an int as a set of bit flags named by values.
+func HintFlags(values ...string) PropertyOptions {
+	return PropertyOptions{
+		Hint:       PROPERTY_HINT_FLAGS,
+		HintString: strings.Join(values, ","),
+	}
+}
+
+// HintFile picks a project file for a String, optionally filtered by
+// patterns such as "*.png".
+func HintFile(filters ...string) PropertyOptions {
+	return PropertyOptions{
+		Hint:       PROPERTY_HINT_FILE,
+		HintString: strings.Join(filters, ","),
+	}
+}
+
+// HintResourceType restricts an Object property to resources of className.
+func HintResourceType(className string) PropertyOptions {
+	return PropertyOptions{
+		Hint:       PROPERTY_HINT_RESOURCE_TYPE,
+		HintString: className,
+	}
+}
+
+// HintNodePathTypes restricts a NodePath to nodes of one of classNames.
+func HintNodePathTypes(classNames ...string) PropertyOptions {
+	return PropertyOptions{
+		Hint:       PROPERTY_HINT_NODE_PATH_VALID_TYPES,
+		HintString: strings.Join(classNames, ","),
+	}
+}
+
+// HintMultilineText edits a String in a multiline text box.
+func HintMultilineText() PropertyOptions {
+	return PropertyOptions{
+		Hint: PROPERTY_HINT_MULTILINE_TEXT,
+	}
+}
+
+// NewGDExtensionPropertyInfoWithOptions creates a property info that owns
+// its name, class name and hint string; Destroy frees them.
+func NewGDExtensionPropertyInfoWithOptions(
+	variantType GDExtensionVariantType,
+	name string,
+	opts PropertyOptions,
+) GDExtensionPropertyInfo {
+	classNameStringName := NewStringNameWithLatin1Chars(opts.className())
+	nameStringName := NewStringNameWithUtf8Chars(name)
+	hintString := NewStringWithUtf8Chars(opts.HintString)
+	return NewGDExtensionPropertyInfo(
+		classNameStringName.AsGDExtensionConstStringNamePtr(),
+		variantType,
+		nameStringName.AsGDExtensionConstStringNamePtr(),
+		uint32(opts.Hint),
+		hintString.AsGDExtensionConstStringPtr(),
+		uint32(opts.usage()),
+	)
+}
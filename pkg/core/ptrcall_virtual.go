@@ -0,0 +1,136 @@
+package core
+
+// #include <godot/gdextension_interface.h>
+import "C"
+
+import (
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// ptrcallVirtual implements an engine virtual method on its raw ptrcall
+// arguments and return value. It covers the signatures the reflection based
+// ClassDBBindMethodVirtual cannot decode, such as StringName,
+// PackedStringArray, Ref and pointer arguments and return values.
+//
+// ret points to a value the engine already constructed: builtin types are
+// destroyed before being overwritten, int and enum returns are int64 and
+// object returns are the bare object pointer.
+type ptrcallVirtual func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr)
+
+// ptrcallArgs is the argument array of a virtual call.
+type ptrcallArgs struct {
+	ptr *C.GDExtensionConstTypePtr
+}
+
+func (a ptrcallArgs) at(i int) unsafe.Pointer {
+	return unsafe.Pointer(unsafe.Slice(a.ptr, i+1)[i])
+}
+
+func (a ptrcallArgs) StringArg(i int) string {
+	return (*String)(a.at(i)).ToUtf8()
+}
+
+func (a ptrcallArgs) StringNameArg(i int) string {
+	return (*StringName)(a.at(i)).ToUtf8()
+}
+
+func (a ptrcallArgs) BoolArg(i int) bool {
+	return *(*bool)(a.at(i))
+}
+
+// ObjectArg returns an Object* argument, which is passed as an Object**.
+func (a ptrcallArgs) ObjectArg(i int) GDExtensionObjectPtr {
+	return *(*GDExtensionObjectPtr)(a.at(i))
+}
+
+// RefObjectArg returns the object held by a Ref<T> argument.
+func (a ptrcallArgs) RefObjectArg(i int) GDExtensionObjectPtr {
+	return CallFunc_GDExtensionInterfaceRefGetObject((GDExtensionConstRefPtr)(a.at(i)))
+}
+
+func ptrRetBool(ret GDExtensionTypePtr, v bool) {
+	*(*bool)(ret) = v
+}
+
+func ptrRetInt(ret GDExtensionTypePtr, v int64) {
+	*(*int64)(ret) = v
+}
+
+func ptrRetString(ret GDExtensionTypePtr, v string) {
+	dst := (*String)(ret)
+	dst.Destroy()
+	*dst = NewStringWithUtf8Chars(v)
+}
+
+func ptrRetStringName(ret GDExtensionTypePtr, v string) {
+	dst := (*StringName)(ret)
+	dst.Destroy()
+	*dst = NewStringNameWithUtf8Chars(v)
+}
+
+func ptrRetPackedStringArray(ret GDExtensionTypePtr, v []string) {
+	arr := NewPackedStringArray()
+	for _, s := range v {
+		gdStr := NewStringWithUtf8Chars(s)
+		arr.Append(gdStr)
+		gdStr.Destroy()
+	}
+	dst := (*PackedStringArray)(ret)
+	dst.Destroy()
+	*dst = arr
+}
+
+// ptrRetDictionary moves v into ret.
+func ptrRetDictionary(ret GDExtensionTypePtr, v Dictionary) {
+	dst := (*Dictionary)(ret)
+	dst.Destroy()
+	*dst = v
+}
+
+// ptrRetArray moves v into ret.
+func ptrRetArray(ret GDExtensionTypePtr, v Array) {
+	dst := (*Array)(ret)
+	dst.Destroy()
+	*dst = v
+}
+
+// ptrRetVariant moves v into ret.
+func ptrRetVariant(ret GDExtensionTypePtr, v Variant) {
+	dst := (*Variant)(ret)
+	dst.Destroy()
+	*dst = v
+}
+
+func ptrRetObject(ret GDExtensionTypePtr, obj GDExtensionObjectPtr) {
+	*(*GDExtensionObjectPtr)(ret) = obj
+}
+
+// ptrRetRef points the Ref<T> at ret to obj, taking a reference.
+func ptrRetRef(ret GDExtensionTypePtr, obj GDExtensionObjectPtr) {
+	CallFunc_GDExtensionInterfaceRefSetObject((GDExtensionRefPtr)(ret), obj)
+}
+
+func ptrRetPointer(ret GDExtensionTypePtr, p unsafe.Pointer) {
+	*(*unsafe.Pointer)(ret) = p
+}
+
+// classDBBindPtrcallVirtual implements the engine virtual method gdMethodName
+// of a registered class with fn.
+func classDBBindPtrcallVirtual(className string, gdMethodName string, fn ptrcallVirtual) {
+	ci, ok := Internal.GDRegisteredGDClasses.Get(className)
+	if !ok {
+		log.Panic("Class doesn't exist.", zap.String("class", className))
+	}
+	if _, ok := ci.PtrcallVirtualMethodMap[gdMethodName]; ok {
+		log.Panic("Virtual method already bound.",
+			zap.String("class", className),
+			zap.String("gd_name", gdMethodName),
+		)
+	}
+	ci.PtrcallVirtualMethodMap[gdMethodName] = fn
+}
@@ -0,0 +1,180 @@
+package core
+
+import (
+	"sort"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// reloadStateMeta is the object metadata that carries the state of a Go
+	// instance across a hot reload. It is stored on the engine object so it
+	// survives the Go runtime of the old build; the leading underscore keeps
+	// it out of the inspector.
+	reloadStateMeta = "_godot_go_reload_state"
+	// reloadStateCustomKey holds the result of SaveReloadState.
+	reloadStateCustomKey = "_custom"
+)
+
+// saveReloadStates saves the property values of every instance of a class
+// registered at level, along with its GDClassReloadState, before the
+// extension is deinitialized. Extensions are only reloaded by the editor.
+func saveReloadStates(level GDExtensionInitializationLevel) {
+	var (
+		classes   []*ClassInfo
+		instances []GDClass
+	)
+	for _, inst := range Internal.GDClassInstances.Values() {
+		ci, ok := Internal.GDRegisteredGDClasses.Get(inst.GetClassName())
+		if !ok || ci.Level != level {
+			continue
+		}
+		classes = append(classes, ci)
+		instances = append(instances, inst)
+	}
+	if len(instances) == 0 || !GetEngineSingleton().IsEditorHint() {
+		return
+	}
+	for i, inst := range instances {
+		saveReloadState(classes[i], inst)
+	}
+}
+
+func saveReloadState(ci *ClassInfo, inst GDClass) {
+	obj, ok := inst.(Object)
+	if !ok {
+		return
+	}
+	state := NewDictionary()
+	defer state.Destroy()
+	for c := ci; c != nil; c = c.ParentPtr {
+		for _, p := range c.Properties {
+			v, err := p.get(inst)
+			if err != nil {
+				log.Warn("unable to save property for reload",
+					zap.String("class", ci.Name),
+					zap.String("name", p.Name),
+					zap.Error(err),
+				)
+				continue
+			}
+			state.SetKeyed(p.Name, v)
+			v.Destroy()
+		}
+	}
+	if provider, ok := inst.(GDClassPropertyList); ok {
+		for _, p := range provider.V_GetPropertyList() {
+			if v, ok := ci.callGet(inst, p.Name); ok {
+				state.SetKeyed(p.Name, v)
+				v.Destroy()
+			}
+		}
+	}
+	if r, ok := inst.(GDClassReloadState); ok {
+		custom := r.SaveReloadState()
+		v := NewVariantDictionary(custom)
+		state.SetKeyed(reloadStateCustomKey, v)
+		v.Destroy()
+		custom.Destroy()
+	}
+	name := NewStringNameWithLatin1Chars(reloadStateMeta)
+	defer name.Destroy()
+	v := NewVariantDictionary(state)
+	defer v.Destroy()
+	obj.SetMeta(name, v)
+}
+
+// restoreReloadState applies the state saved by saveReloadState to the
+// instance recreated for the same object.
+func restoreReloadState(ci *ClassInfo, inst GDClass) {
+	obj, ok := inst.(Object)
+	if !ok {
+		return
+	}
+	name := NewStringNameWithLatin1Chars(reloadStateMeta)
+	defer name.Destroy()
+	if !obj.HasMeta(name) {
+		return
+	}
+	nilValue := NewVariantNil()
+	defer nilValue.Destroy()
+	v := obj.GetMetaOpt(name, nilValue)
+	defer v.Destroy()
+	obj.RemoveMeta(name)
+	state := v.ToDictionary()
+	defer state.Destroy()
+	for c := ci; c != nil; c = c.ParentPtr {
+		for _, p := range c.Properties {
+			if p.Setter == nil || !dictionaryHasKey(&state, p.Name) {
+				continue
+			}
+			pv := state.GetKeyed(p.Name)
+			if err := p.set(inst, pv); err != nil {
+				log.Warn("unable to restore property after reload",
+					zap.String("class", ci.Name),
+					zap.String("name", p.Name),
+					zap.Error(err),
+				)
+			}
+			pv.Destroy()
+		}
+	}
+	// the restored properties may decide which dynamic properties exist
+	if provider, ok := inst.(GDClassPropertyList); ok {
+		for _, p := range provider.V_GetPropertyList() {
+			if !dictionaryHasKey(&state, p.Name) {
+				continue
+			}
+			pv := state.GetKeyed(p.Name)
+			ci.callSet(inst, p.Name, pv)
+			pv.Destroy()
+		}
+	}
+	if r, ok := inst.(GDClassReloadState); ok && dictionaryHasKey(&state, reloadStateCustomKey) {
+		cv := state.GetKeyed(reloadStateCustomKey)
+		custom := cv.ToDictionary()
+		r.RestoreReloadState(custom)
+		custom.Destroy()
+		cv.Destroy()
+	}
+}
+
+func dictionaryHasKey(d *Dictionary, key string) bool {
+	gdStr := NewStringWithUtf8Chars(key)
+	defer gdStr.Destroy()
+	v := NewVariantString(gdStr)
+	defer v.Destroy()
+	return d.Has(v)
+}
+
+// unregisterClasses unregisters the classes registered at level that the
+// terminator left registered. The engine refuses to unregister a class
+// while classes inheriting from it are registered, so subclasses go first.
+func unregisterClasses(level GDExtensionInitializationLevel) {
+	var classes []*ClassInfo
+	for _, ci := range Internal.GDRegisteredGDClasses.Values() {
+		if ci.Level == level {
+			classes = append(classes, ci)
+		}
+	}
+	depth := func(ci *ClassInfo) int {
+		n := 0
+		for c := ci.ParentPtr; c != nil; c = c.ParentPtr {
+			n++
+		}
+		return n
+	}
+	sort.SliceStable(classes, func(i, j int) bool {
+		return depth(classes[i]) > depth(classes[j])
+	})
+	for _, ci := range classes {
+		log.Debug("unregistering class left registered by the terminator",
+			zap.String("class", ci.Name),
+		)
+		classDBUnregisterClass(ci.Name)
+	}
+}
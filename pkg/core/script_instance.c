@@ -0,0 +1,176 @@
+#include "script_instance.h"
+#include <godot/gdextension_interface.h>
+
+extern GDExtensionBool
+GoCallback_ScriptInstanceSet(GDExtensionScriptInstanceDataPtr p_instance,
+                             GDExtensionConstStringNamePtr p_name,
+                             GDExtensionConstVariantPtr p_value);
+extern GDExtensionBool
+GoCallback_ScriptInstanceGet(GDExtensionScriptInstanceDataPtr p_instance,
+                             GDExtensionConstStringNamePtr p_name,
+                             GDExtensionVariantPtr r_ret);
+extern GDExtensionPropertyInfo *GoCallback_ScriptInstanceGetPropertyList(
+    GDExtensionScriptInstanceDataPtr p_instance, uint32_t *r_count);
+extern void GoCallback_ScriptInstanceFreePropertyList2(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    const GDExtensionPropertyInfo *p_list, uint32_t p_count);
+extern GDExtensionBool GoCallback_ScriptInstancePropertyCanRevert(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionConstStringNamePtr p_name);
+extern GDExtensionBool GoCallback_ScriptInstancePropertyGetRevert(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionConstStringNamePtr p_name, GDExtensionVariantPtr r_ret);
+extern GDExtensionObjectPtr
+GoCallback_ScriptInstanceGetOwner(GDExtensionScriptInstanceDataPtr p_instance);
+extern void GoCallback_ScriptInstanceGetPropertyState(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionScriptInstancePropertyStateAdd p_add_func, void *p_userdata);
+extern GDExtensionVariantType GoCallback_ScriptInstanceGetPropertyType(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionConstStringNamePtr p_name, GDExtensionBool *r_is_valid);
+extern GDExtensionBool
+GoCallback_ScriptInstanceHasMethod(GDExtensionScriptInstanceDataPtr p_instance,
+                                   GDExtensionConstStringNamePtr p_name);
+extern GDExtensionInt GoCallback_ScriptInstanceGetMethodArgumentCount(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionConstStringNamePtr p_name, GDExtensionBool *r_is_valid);
+extern void
+GoCallback_ScriptInstanceCall(GDExtensionScriptInstanceDataPtr p_self,
+                              GDExtensionConstStringNamePtr p_method,
+                              const GDExtensionConstVariantPtr *p_args,
+                              GDExtensionInt p_argument_count,
+                              GDExtensionVariantPtr r_return,
+                              GDExtensionCallError *r_error);
+extern void GoCallback_ScriptInstanceNotification(
+    GDExtensionScriptInstanceDataPtr p_instance, int32_t p_what,
+    GDExtensionBool p_reversed);
+extern void
+GoCallback_ScriptInstanceToString(GDExtensionScriptInstanceDataPtr p_instance,
+                                  GDExtensionBool *r_is_valid,
+                                  GDExtensionStringPtr r_out);
+extern GDExtensionObjectPtr
+GoCallback_ScriptInstanceGetScript(GDExtensionScriptInstanceDataPtr p_instance);
+extern GDExtensionBool GoCallback_ScriptInstanceIsPlaceholder(
+    GDExtensionScriptInstanceDataPtr p_instance);
+extern GDExtensionScriptLanguagePtr GoCallback_ScriptInstanceGetLanguage(
+    GDExtensionScriptInstanceDataPtr p_instance);
+extern void
+GoCallback_ScriptInstanceFree(GDExtensionScriptInstanceDataPtr p_instance);
+
+GDExtensionBool
+cgo_scriptinstance_set(GDExtensionScriptInstanceDataPtr p_instance,
+                       GDExtensionConstStringNamePtr p_name,
+                       GDExtensionConstVariantPtr p_value) {
+  return GoCallback_ScriptInstanceSet(p_instance, p_name, p_value);
+}
+
+GDExtensionBool
+cgo_scriptinstance_get(GDExtensionScriptInstanceDataPtr p_instance,
+                       GDExtensionConstStringNamePtr p_name,
+                       GDExtensionVariantPtr r_ret) {
+  return GoCallback_ScriptInstanceGet(p_instance, p_name, r_ret);
+}
+
+const GDExtensionPropertyInfo *
+cgo_scriptinstance_getpropertylist(GDExtensionScriptInstanceDataPtr p_instance,
+                                   uint32_t *r_count) {
+  return GoCallback_ScriptInstanceGetPropertyList(p_instance, r_count);
+}
+
+void cgo_scriptinstance_freepropertylist2(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    const GDExtensionPropertyInfo *p_list, uint32_t p_count) {
+  GoCallback_ScriptInstanceFreePropertyList2(p_instance, p_list, p_count);
+}
+
+GDExtensionBool cgo_scriptinstance_propertycanrevert(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionConstStringNamePtr p_name) {
+  return GoCallback_ScriptInstancePropertyCanRevert(p_instance, p_name);
+}
+
+GDExtensionBool cgo_scriptinstance_propertygetrevert(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionConstStringNamePtr p_name, GDExtensionVariantPtr r_ret) {
+  return GoCallback_ScriptInstancePropertyGetRevert(p_instance, p_name, r_ret);
+}
+
+GDExtensionObjectPtr
+cgo_scriptinstance_getowner(GDExtensionScriptInstanceDataPtr p_instance) {
+  return GoCallback_ScriptInstanceGetOwner(p_instance);
+}
+
+void cgo_scriptinstance_getpropertystate(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionScriptInstancePropertyStateAdd p_add_func, void *p_userdata) {
+  GoCallback_ScriptInstanceGetPropertyState(p_instance, p_add_func, p_userdata);
+}
+
+GDExtensionVariantType
+cgo_scriptinstance_getpropertytype(GDExtensionScriptInstanceDataPtr p_instance,
+                                   GDExtensionConstStringNamePtr p_name,
+                                   GDExtensionBool *r_is_valid) {
+  return GoCallback_ScriptInstanceGetPropertyType(p_instance, p_name,
+                                                  r_is_valid);
+}
+
+GDExtensionBool
+cgo_scriptinstance_hasmethod(GDExtensionScriptInstanceDataPtr p_instance,
+                             GDExtensionConstStringNamePtr p_name) {
+  return GoCallback_ScriptInstanceHasMethod(p_instance, p_name);
+}
+
+GDExtensionInt cgo_scriptinstance_getmethodargumentcount(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionConstStringNamePtr p_name, GDExtensionBool *r_is_valid) {
+  return GoCallback_ScriptInstanceGetMethodArgumentCount(p_instance, p_name,
+                                                         r_is_valid);
+}
+
+void cgo_scriptinstance_call(GDExtensionScriptInstanceDataPtr p_self,
+                             GDExtensionConstStringNamePtr p_method,
+                             const GDExtensionConstVariantPtr *p_args,
+                             GDExtensionInt p_argument_count,
+                             GDExtensionVariantPtr r_return,
+                             GDExtensionCallError *r_error) {
+  GoCallback_ScriptInstanceCall(p_self, p_method, p_args, p_argument_count,
+                                r_return, r_error);
+}
+
+void
+cgo_scriptinstance_notification(GDExtensionScriptInstanceDataPtr p_instance,
+                                int32_t p_what, GDExtensionBool p_reversed) {
+  GoCallback_ScriptInstanceNotification(p_instance, p_what, p_reversed);
+}
+
+void cgo_scriptinstance_tostring(GDExtensionScriptInstanceDataPtr p_instance,
+                                 GDExtensionBool *r_is_valid,
+                                 GDExtensionStringPtr r_out) {
+  GoCallback_ScriptInstanceToString(p_instance, r_is_valid, r_out);
+}
+
+GDExtensionObjectPtr
+cgo_scriptinstance_getscript(GDExtensionScriptInstanceDataPtr p_instance) {
+  return GoCallback_ScriptInstanceGetScript(p_instance);
+}
+
+GDExtensionBool
+cgo_scriptinstance_isplaceholder(GDExtensionScriptInstanceDataPtr p_instance) {
+  return GoCallback_ScriptInstanceIsPlaceholder(p_instance);
+}
+
+GDExtensionScriptLanguagePtr
+cgo_scriptinstance_getlanguage(GDExtensionScriptInstanceDataPtr p_instance) {
+  return GoCallback_ScriptInstanceGetLanguage(p_instance);
+}
+
+void cgo_scriptinstance_free(GDExtensionScriptInstanceDataPtr p_instance) {
+  GoCallback_ScriptInstanceFree(p_instance);
+}
+
+void cgo_scriptinstance_propertystateadd(
+    GDExtensionScriptInstancePropertyStateAdd p_add_func,
+    GDExtensionConstStringNamePtr p_name, GDExtensionConstVariantPtr p_value,
+    void *p_userdata) {
+  p_add_func(p_name, p_value, p_userdata);
+}
@@ -0,0 +1,490 @@
+package core
+
+// #include <godot/gdextension_interface.h>
+// #include "script_instance.h"
+// #include <stdlib.h>
+import "C"
+
+import (
+	"reflect"
+	"runtime/cgo"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// goScriptInstance is the script instance data of a node with a GoScript
+// attached. inst is a Go value of the script's class whose owner is the node
+// itself; unlike an instance of the registered class, it is not tracked in
+// Internal.GDClassInstances.
+type goScriptInstance struct {
+	script *GoScript
+	class  *ClassInfo
+	owner  *GodotObject
+	id     GDObjectInstanceID
+	inst   GDClass
+	handle cgo.Handle
+}
+
+// goScriptInstanceInfo is handed to every ScriptInstanceCreate3 call. Godot
+// keeps the pointer, so it lives in engine memory between
+// RegisterGoScriptLanguage and UnregisterGoScriptLanguage.
+var goScriptInstanceInfo *GDExtensionScriptInstanceInfo3
+
+func allocGoScriptInstanceInfo() {
+	info := NewGDExtensionScriptInstanceInfo3(
+		(GDExtensionScriptInstanceSet)(C.cgo_scriptinstance_set),
+		(GDExtensionScriptInstanceGet)(C.cgo_scriptinstance_get),
+		(GDExtensionScriptInstanceGetPropertyList)(C.cgo_scriptinstance_getpropertylist),
+		(GDExtensionScriptInstanceFreePropertyList2)(C.cgo_scriptinstance_freepropertylist2),
+		(GDExtensionScriptInstanceGetClassCategory)(nil),
+		(GDExtensionScriptInstancePropertyCanRevert)(C.cgo_scriptinstance_propertycanrevert),
+		(GDExtensionScriptInstancePropertyGetRevert)(C.cgo_scriptinstance_propertygetrevert),
+		(GDExtensionScriptInstanceGetOwner)(C.cgo_scriptinstance_getowner),
+		(GDExtensionScriptInstanceGetPropertyState)(C.cgo_scriptinstance_getpropertystate),
+		(GDExtensionScriptInstanceGetMethodList)(nil),
+		(GDExtensionScriptInstanceFreeMethodList2)(nil),
+		(GDExtensionScriptInstanceGetPropertyType)(C.cgo_scriptinstance_getpropertytype),
+		(GDExtensionScriptInstanceValidateProperty)(nil),
+		(GDExtensionScriptInstanceHasMethod)(C.cgo_scriptinstance_hasmethod),
+		(GDExtensionScriptInstanceGetMethodArgumentCount)(C.cgo_scriptinstance_getmethodargumentcount),
+		(GDExtensionScriptInstanceCall)(C.cgo_scriptinstance_call),
+		(GDExtensionScriptInstanceNotification2)(C.cgo_scriptinstance_notification),
+		(GDExtensionScriptInstanceToString)(C.cgo_scriptinstance_tostring),
+		(GDExtensionScriptInstanceRefCountIncremented)(nil),
+		(GDExtensionScriptInstanceRefCountDecremented)(nil),
+		(GDExtensionScriptInstanceGetScript)(C.cgo_scriptinstance_getscript),
+		(GDExtensionScriptInstanceIsPlaceholder)(C.cgo_scriptinstance_isplaceholder),
+		(GDExtensionScriptInstanceSet)(nil),
+		(GDExtensionScriptInstanceGet)(nil),
+		(GDExtensionScriptInstanceGetLanguage)(C.cgo_scriptinstance_getlanguage),
+		(GDExtensionScriptInstanceFree)(C.cgo_scriptinstance_free),
+	)
+	goScriptInstanceInfo = (*GDExtensionScriptInstanceInfo3)(AllocCopy(unsafe.Pointer(&info), int(unsafe.Sizeof(info))))
+}
+
+func freeGoScriptInstanceInfo() {
+	if goScriptInstanceInfo == nil {
+		return
+	}
+	CallFunc_GDExtensionInterfaceMemFree(unsafe.Pointer(goScriptInstanceInfo))
+	goScriptInstanceInfo = nil
+}
+
+// newGoScriptInstance creates the Go value of ci backing owner.
+func newGoScriptInstance(script *GoScript, ci *ClassInfo, owner GDExtensionObjectPtr) *goScriptInstance {
+	inst, ok := reflect.New(ci.ClassType).Interface().(GDClass)
+	if !ok {
+		log.Panic("instance not a GDClass", zap.String("class", ci.Name))
+	}
+	object := (*GodotObject)(unsafe.Pointer(owner))
+	inst.SetGodotObjectOwner(object)
+	bindSignalFields(ci, inst)
+	si := &goScriptInstance{
+		script: script,
+		class:  ci,
+		owner:  object,
+		id:     CallFunc_GDExtensionInterfaceObjectGetInstanceId((GDExtensionConstObjectPtr)(owner)),
+		inst:   inst,
+	}
+	si.handle = cgo.NewHandle(si)
+	return si
+}
+
+// findProperty looks up a property added with ClassDBAddProperty on the class
+// or one of its registered parents.
+func (c *ClassInfo) findProperty(name string) *classProperty {
+	for ci := c; ci != nil; ci = ci.ParentPtr {
+		for _, p := range ci.Properties {
+			if p.Name == name {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+// findMethod looks up a bound method or engine virtual method by its Godot
+// name on the class or one of its registered parents.
+func (c *ClassInfo) findMethod(name string) *GoMethodMetadata {
+	for ci := c; ci != nil; ci = ci.ParentPtr {
+		if m, ok := ci.MethodMap[name]; ok {
+			return m.GoMethodMetadata
+		}
+		if m, ok := ci.VirtualMethodMap[name]; ok {
+			return m.GoMethodMetadata
+		}
+	}
+	return nil
+}
+
+// getScriptPropertyList returns the properties of the class and its
+// registered parents as script instances report them.
+func (c *ClassInfo) getScriptPropertyList() []GDExtensionPropertyInfo {
+	c.scriptPropertyListOnce.Do(func() {
+		var props []*classProperty
+		for ci := c; ci != nil; ci = ci.ParentPtr {
+			props = append(props, ci.Properties...)
+		}
+		if len(props) == 0 {
+			return
+		}
+		list := make([]GDExtensionPropertyInfo, len(props))
+		for i, p := range props {
+			list[i] = NewGDExtensionPropertyInfoWithOptions(p.Type, p.Name, p.Options)
+		}
+		c.scriptPropertyListPinner.Pin(unsafe.SliceData(list))
+		for i := range list {
+			list[i].Pin(&c.scriptPropertyListPinner)
+		}
+		c.scriptPropertyList = list
+	})
+	return c.scriptPropertyList
+}
+
+// goScriptInstanceFromData resolves the instance data handle. The callbacks
+// below run on the engine's stack, so a stale handle is reported instead of
+// panicking across the cgo boundary.
+func goScriptInstanceFromData(data C.GDExtensionScriptInstanceDataPtr) (si *goScriptInstance, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("invalid script instance data", zap.Any("panic", r))
+			si, ok = nil, false
+		}
+	}()
+	si, ok = cgo.Handle(data).Value().(*goScriptInstance)
+	if !ok || si == nil {
+		log.Error("unable to retrieve script instance data")
+		return nil, false
+	}
+	return si, true
+}
+
+//export GoCallback_ScriptInstanceSet
+func GoCallback_ScriptInstanceSet(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr, pValue C.GDExtensionConstVariantPtr) C.GDExtensionBool {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ScriptInstanceSet", &inst)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		return 0
+	}
+	inst = si.inst
+	name := (*StringName)(pName).ToUtf8()
+	prop := si.class.findProperty(name)
+	v := NewVariantCopyWithGDExtensionConstVariantPtr((GDExtensionConstVariantPtr)(pValue))
+	if prop == nil {
+		if !si.class.callSet(si.inst, name, v) {
+			return 0
+		}
+		return 1
+	}
+	if prop.Setter == nil {
+		return 0
+	}
+	log.Debug("GoCallback_ScriptInstanceSet called",
+		zap.String("class", si.class.Name),
+		zap.String("name", name),
+	)
+	if err := prop.set(si.inst, v); err != nil {
+		log.Error("script property setter failed",
+			zap.String("class", si.class.Name),
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return 0
+	}
+	return 1
+}
+
+//export GoCallback_ScriptInstanceGet
+func GoCallback_ScriptInstanceGet(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr, rRet C.GDExtensionVariantPtr) C.GDExtensionBool {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ScriptInstanceGet", &inst)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		return 0
+	}
+	inst = si.inst
+	name := (*StringName)(pName).ToUtf8()
+	prop := si.class.findProperty(name)
+	if prop == nil {
+		v, ok := si.class.callGet(si.inst, name)
+		if !ok {
+			return 0
+		}
+		*(*Variant)(unsafe.Pointer(rRet)) = v
+		return 1
+	}
+	v, err := prop.get(si.inst)
+	if err != nil {
+		log.Error("script property getter failed",
+			zap.String("class", si.class.Name),
+			zap.String("name", name),
+			zap.Error(err),
+		)
+		return 0
+	}
+	*(*Variant)(unsafe.Pointer(rRet)) = v
+	return 1
+}
+
+//export GoCallback_ScriptInstanceGetPropertyList
+func GoCallback_ScriptInstanceGetPropertyList(pInstance C.GDExtensionScriptInstanceDataPtr, rCount *C.uint32_t) *C.GDExtensionPropertyInfo {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ScriptInstanceGetPropertyList", &inst)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		*rCount = 0
+		return nil
+	}
+	inst = si.inst
+	ptr, count := newInstancePropertyList(si.inst, si.class.getScriptPropertyList())
+	*rCount = (C.uint32_t)(count)
+	return (*C.GDExtensionPropertyInfo)(unsafe.Pointer(ptr))
+}
+
+//export GoCallback_ScriptInstanceFreePropertyList2
+func GoCallback_ScriptInstanceFreePropertyList2(pInstance C.GDExtensionScriptInstanceDataPtr, pList *C.GDExtensionPropertyInfo, pCount C.uint32_t) {
+	defer recoverCallback("GoCallback_ScriptInstanceFreePropertyList2", nil)
+	freeInstancePropertyList((*GDExtensionPropertyInfo)(unsafe.Pointer(pList)), int(pCount))
+}
+
+//export GoCallback_ScriptInstancePropertyCanRevert
+func GoCallback_ScriptInstancePropertyCanRevert(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr) C.GDExtensionBool {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ScriptInstancePropertyCanRevert", &inst)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		return 0
+	}
+	inst = si.inst
+	if !si.class.propertyCanRevert(si.inst, (*StringName)(pName)) {
+		return 0
+	}
+	return 1
+}
+
+//export GoCallback_ScriptInstancePropertyGetRevert
+func GoCallback_ScriptInstancePropertyGetRevert(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr, rRet C.GDExtensionVariantPtr) C.GDExtensionBool {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ScriptInstancePropertyGetRevert", &inst)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		return 0
+	}
+	inst = si.inst
+	v, ok := si.class.propertyGetRevert(si.inst, (*StringName)(pName))
+	if !ok {
+		return 0
+	}
+	*(*Variant)(unsafe.Pointer(rRet)) = v
+	return 1
+}
+
+//export GoCallback_ScriptInstanceGetOwner
+func GoCallback_ScriptInstanceGetOwner(pInstance C.GDExtensionScriptInstanceDataPtr) C.GDExtensionObjectPtr {
+	defer recoverCallback("GoCallback_ScriptInstanceGetOwner", nil)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		return nil
+	}
+	return (C.GDExtensionObjectPtr)(unsafe.Pointer(si.owner))
+}
+
+// GoCallback_ScriptInstanceGetPropertyState reports the value of every
+// property, which Godot uses to keep state across script reloads.
+//
+//export GoCallback_ScriptInstanceGetPropertyState
+func GoCallback_ScriptInstanceGetPropertyState(pInstance C.GDExtensionScriptInstanceDataPtr, pAddFunc C.GDExtensionScriptInstancePropertyStateAdd, pUserdata unsafe.Pointer) {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ScriptInstanceGetPropertyState", &inst)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		return
+	}
+	inst = si.inst
+	for ci := si.class; ci != nil; ci = ci.ParentPtr {
+		for _, p := range ci.Properties {
+			v, err := p.get(si.inst)
+			if err != nil {
+				continue
+			}
+			name := NewStringNameWithUtf8Chars(p.Name)
+			C.cgo_scriptinstance_propertystateadd(
+				pAddFunc,
+				(C.GDExtensionConstStringNamePtr)(unsafe.Pointer(name.AsGDExtensionConstStringNamePtr())),
+				(C.GDExtensionConstVariantPtr)(unsafe.Pointer(v.NativeConstPtr())),
+				pUserdata,
+			)
+			name.Destroy()
+			v.Destroy()
+		}
+	}
+}
+
+//export GoCallback_ScriptInstanceGetPropertyType
+func GoCallback_ScriptInstanceGetPropertyType(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr, rIsValid *C.GDExtensionBool) C.GDExtensionVariantType {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ScriptInstanceGetPropertyType", &inst)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		*rIsValid = 0
+		return (C.GDExtensionVariantType)(GDEXTENSION_VARIANT_TYPE_NIL)
+	}
+	inst = si.inst
+	prop := si.class.findProperty((*StringName)(pName).ToUtf8())
+	if prop == nil {
+		*rIsValid = 0
+		return (C.GDExtensionVariantType)(GDEXTENSION_VARIANT_TYPE_NIL)
+	}
+	*rIsValid = 1
+	return (C.GDExtensionVariantType)(prop.Type)
+}
+
+//export GoCallback_ScriptInstanceHasMethod
+func GoCallback_ScriptInstanceHasMethod(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr) C.GDExtensionBool {
+	defer recoverCallback("GoCallback_ScriptInstanceHasMethod", nil)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok || si.class.findMethod((*StringName)(pName).ToUtf8()) == nil {
+		return 0
+	}
+	return 1
+}
+
+//export GoCallback_ScriptInstanceGetMethodArgumentCount
+func GoCallback_ScriptInstanceGetMethodArgumentCount(pInstance C.GDExtensionScriptInstanceDataPtr, pName C.GDExtensionConstStringNamePtr, rIsValid *C.GDExtensionBool) C.GDExtensionInt {
+	defer recoverCallback("GoCallback_ScriptInstanceGetMethodArgumentCount", nil)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		*rIsValid = 0
+		return 0
+	}
+	md := si.class.findMethod((*StringName)(pName).ToUtf8())
+	if md == nil {
+		*rIsValid = 0
+		return 0
+	}
+	*rIsValid = 1
+	return (C.GDExtensionInt)(len(md.GoArgumentTypes))
+}
+
+// GoCallback_ScriptInstanceCall is called for every method call on the node,
+// including engine virtual methods such as _ready. Methods the Go class does
+// not have report GDEXTENSION_CALL_ERROR_INVALID_METHOD, so Godot falls back
+// to the node's own class.
+//
+//export GoCallback_ScriptInstanceCall
+func GoCallback_ScriptInstanceCall(
+	pSelf C.GDExtensionScriptInstanceDataPtr,
+	pMethod C.GDExtensionConstStringNamePtr,
+	pArgs *C.GDExtensionConstVariantPtr,
+	pArgumentCount C.GDExtensionInt,
+	rReturn C.GDExtensionVariantPtr,
+	rError *C.GDExtensionCallError,
+) {
+	callErr := (*GDExtensionCallError)(unsafe.Pointer(rError))
+	var inst GDClass
+	defer func() {
+		if r := recover(); r != nil {
+			handlePanic(r, "GoCallback_ScriptInstanceCall", inst)
+			callErr.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
+		}
+	}()
+	si, ok := goScriptInstanceFromData(pSelf)
+	if !ok {
+		callErr.SetErrorFields(GDEXTENSION_CALL_ERROR_INSTANCE_IS_NULL, 0, 0)
+		return
+	}
+	inst = si.inst
+	method := (*StringName)(pMethod).ToUtf8()
+	md := si.class.findMethod(method)
+	if md == nil {
+		callErr.SetErrorFields(GDEXTENSION_CALL_ERROR_INVALID_METHOD, 0, 0)
+		return
+	}
+	argPtrSlice := unsafe.Slice((*GDExtensionConstVariantPtr)(pArgs), int(pArgumentCount))
+	args := make([]Variant, pArgumentCount)
+	for i := range argPtrSlice {
+		args[i] = NewVariantCopyWithGDExtensionConstVariantPtr(argPtrSlice[i])
+	}
+	log.Debug("GoCallback_ScriptInstanceCall called",
+		zap.String("class", si.class.Name),
+		zap.String("method", method),
+	)
+	ret, err := md.Call(si.inst, args...)
+	if err != nil {
+		log.Error("script method call failed",
+			zap.String("class", si.class.Name),
+			zap.String("method", method),
+			zap.Error(err),
+		)
+		*callErr = *err
+		return
+	}
+	*(*Variant)(unsafe.Pointer(rReturn)) = ret
+}
+
+// GoCallback_ScriptInstanceNotification forwards notifications to
+// GDClassNotification, as for extension classes.
+//
+//export GoCallback_ScriptInstanceNotification
+func GoCallback_ScriptInstanceNotification(pInstance C.GDExtensionScriptInstanceDataPtr, pWhat C.int32_t, pReversed C.GDExtensionBool) {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ScriptInstanceNotification", &inst)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		return
+	}
+	inst = si.inst
+	notify(si.inst, int32(pWhat))
+}
+
+//export GoCallback_ScriptInstanceToString
+func GoCallback_ScriptInstanceToString(pInstance C.GDExtensionScriptInstanceDataPtr, rIsValid *C.GDExtensionBool, rOut C.GDExtensionStringPtr) {
+	defer recoverCallback("GoCallback_ScriptInstanceToString", nil)
+	*rIsValid = 0
+}
+
+//export GoCallback_ScriptInstanceGetScript
+func GoCallback_ScriptInstanceGetScript(pInstance C.GDExtensionScriptInstanceDataPtr) C.GDExtensionObjectPtr {
+	defer recoverCallback("GoCallback_ScriptInstanceGetScript", nil)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		return nil
+	}
+	return (C.GDExtensionObjectPtr)(unsafe.Pointer(si.script.AsGDExtensionObjectPtr()))
+}
+
+//export GoCallback_ScriptInstanceIsPlaceholder
+func GoCallback_ScriptInstanceIsPlaceholder(pInstance C.GDExtensionScriptInstanceDataPtr) C.GDExtensionBool {
+	defer recoverCallback("GoCallback_ScriptInstanceIsPlaceholder", nil)
+	return 0
+}
+
+//export GoCallback_ScriptInstanceGetLanguage
+func GoCallback_ScriptInstanceGetLanguage(pInstance C.GDExtensionScriptInstanceDataPtr) C.GDExtensionScriptLanguagePtr {
+	defer recoverCallback("GoCallback_ScriptInstanceGetLanguage", nil)
+	if goScriptLanguage == nil {
+		return nil
+	}
+	return (C.GDExtensionScriptLanguagePtr)(unsafe.Pointer(goScriptLanguage.AsGDExtensionObjectPtr()))
+}
+
+//export GoCallback_ScriptInstanceFree
+func GoCallback_ScriptInstanceFree(pInstance C.GDExtensionScriptInstanceDataPtr) {
+	var inst GDClass
+	defer recoverCallback("GoCallback_ScriptInstanceFree", &inst)
+	si, ok := goScriptInstanceFromData(pInstance)
+	if !ok {
+		return
+	}
+	inst = si.inst
+	log.Debug("GoCallback_ScriptInstanceFree called",
+		zap.String("class", si.class.Name),
+	)
+	si.script.removeInstance(si)
+	si.handle.Delete()
+}
@@ -0,0 +1,68 @@
+#ifndef CGO_GODOT_GO_SCRIPT_INSTANCE_H
+#define CGO_GODOT_GO_SCRIPT_INSTANCE_H
+
+#include <godot/gdextension_interface.h>
+
+GDExtensionBool
+cgo_scriptinstance_set(GDExtensionScriptInstanceDataPtr p_instance,
+                       GDExtensionConstStringNamePtr p_name,
+                       GDExtensionConstVariantPtr p_value);
+GDExtensionBool
+cgo_scriptinstance_get(GDExtensionScriptInstanceDataPtr p_instance,
+                       GDExtensionConstStringNamePtr p_name,
+                       GDExtensionVariantPtr r_ret);
+const GDExtensionPropertyInfo *
+cgo_scriptinstance_getpropertylist(GDExtensionScriptInstanceDataPtr p_instance,
+                                   uint32_t *r_count);
+void cgo_scriptinstance_freepropertylist2(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    const GDExtensionPropertyInfo *p_list, uint32_t p_count);
+GDExtensionBool cgo_scriptinstance_propertycanrevert(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionConstStringNamePtr p_name);
+GDExtensionBool cgo_scriptinstance_propertygetrevert(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionConstStringNamePtr p_name, GDExtensionVariantPtr r_ret);
+GDExtensionObjectPtr
+cgo_scriptinstance_getowner(GDExtensionScriptInstanceDataPtr p_instance);
+void cgo_scriptinstance_getpropertystate(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionScriptInstancePropertyStateAdd p_add_func, void *p_userdata);
+GDExtensionVariantType
+cgo_scriptinstance_getpropertytype(GDExtensionScriptInstanceDataPtr p_instance,
+                                   GDExtensionConstStringNamePtr p_name,
+                                   GDExtensionBool *r_is_valid);
+GDExtensionBool
+cgo_scriptinstance_hasmethod(GDExtensionScriptInstanceDataPtr p_instance,
+                             GDExtensionConstStringNamePtr p_name);
+GDExtensionInt cgo_scriptinstance_getmethodargumentcount(
+    GDExtensionScriptInstanceDataPtr p_instance,
+    GDExtensionConstStringNamePtr p_name, GDExtensionBool *r_is_valid);
+void cgo_scriptinstance_call(GDExtensionScriptInstanceDataPtr p_self,
+                             GDExtensionConstStringNamePtr p_method,
+                             const GDExtensionConstVariantPtr *p_args,
+                             GDExtensionInt p_argument_count,
+                             GDExtensionVariantPtr r_return,
+                             GDExtensionCallError *r_error);
+void
+cgo_scriptinstance_notification(GDExtensionScriptInstanceDataPtr p_instance,
+                                int32_t p_what, GDExtensionBool p_reversed);
+void cgo_scriptinstance_tostring(GDExtensionScriptInstanceDataPtr p_instance,
+                                 GDExtensionBool *r_is_valid,
+                                 GDExtensionStringPtr r_out);
+GDExtensionObjectPtr
+cgo_scriptinstance_getscript(GDExtensionScriptInstanceDataPtr p_instance);
+GDExtensionBool
+cgo_scriptinstance_isplaceholder(GDExtensionScriptInstanceDataPtr p_instance);
+GDExtensionScriptLanguagePtr
+cgo_scriptinstance_getlanguage(GDExtensionScriptInstanceDataPtr p_instance);
+void cgo_scriptinstance_free(GDExtensionScriptInstanceDataPtr p_instance);
+
+// cgo_scriptinstance_propertystateadd calls the p_add_func handed to
+// get_property_state, which Go cannot call directly.
+void cgo_scriptinstance_propertystateadd(
+    GDExtensionScriptInstancePropertyStateAdd p_add_func,
+    GDExtensionConstStringNamePtr p_name, GDExtensionConstVariantPtr p_value,
+    void *p_userdata);
+
+#endif
@@ -0,0 +1,545 @@
+package core
+
+import (
+	"path"
+	"strings"
+	"sync"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// goScriptExtension is the file extension of Go scripts. A .gogd file holds
+// the name of the registered Go class it attaches, e.g. "Player".
+const goScriptExtension = "gogd"
+
+var (
+	goScriptLanguage       *GoScriptLanguage
+	goScriptResourceLoader RefResourceFormatLoader
+	goScriptResourceSaver  RefResourceFormatSaver
+)
+
+// RegisterGoScriptLanguage registers "Go" as a script language, so a
+// registered Go class can be attached to an existing node as a script
+// through a .gogd file instead of replacing the node with an instance of the
+// class. The node must be of the class's parent type or inherit from it.
+//
+// Property get/set, method calls and notifications of the node are forwarded
+// to a Go value of the class. In the editor the script gets a placeholder
+// instance that lists the class's properties without running Go code.
+//
+// Call it from the scene initializer after registering the classes, and
+// UnregisterGoScriptLanguage from the scene terminator before unregistering
+// them.
+func RegisterGoScriptLanguage() {
+	ClassDBRegisterClass(newGoScriptLanguageFromOwnerObject, nil, nil, bindGoScriptLanguage)
+	ClassDBRegisterClass(newGoScriptFromOwnerObject, nil, nil, bindGoScript)
+	ClassDBRegisterClass(newGoScriptResourceLoaderFromOwnerObject, nil, nil, bindGoScriptResourceLoader)
+	ClassDBRegisterClass(newGoScriptResourceSaverFromOwnerObject, nil, nil, bindGoScriptResourceSaver)
+	allocGoScriptInstanceInfo()
+	goScriptLanguage = CreateGDClassInstance("GoScriptLanguage").(*GoScriptLanguage)
+	if err := GetEngineSingleton().RegisterScriptLanguage(goScriptLanguage); err != OK {
+		log.Panic("unable to register script language",
+			zap.String("language", "Go"),
+			zap.Any("error", err),
+		)
+	}
+	loader := CreateGDClassInstance("GoScriptResourceLoader").(*GoScriptResourceLoader)
+	goScriptResourceLoader = NewRefResourceFormatLoader(loader)
+	GetResourceLoaderSingleton().AddResourceFormatLoader(goScriptResourceLoader)
+	saver := CreateGDClassInstance("GoScriptResourceSaver").(*GoScriptResourceSaver)
+	goScriptResourceSaver = NewRefResourceFormatSaver(saver)
+	GetResourceSaverSingleton().AddResourceFormatSaver(goScriptResourceSaver)
+	log.Info("Go script language registered")
+}
+
+// UnregisterGoScriptLanguage reverts RegisterGoScriptLanguage.
+func UnregisterGoScriptLanguage() {
+	GetResourceSaverSingleton().RemoveResourceFormatSaver(goScriptResourceSaver)
+	goScriptResourceSaver.Unref()
+	goScriptResourceSaver = nil
+	GetResourceLoaderSingleton().RemoveResourceFormatLoader(goScriptResourceLoader)
+	goScriptResourceLoader.Unref()
+	goScriptResourceLoader = nil
+	GetEngineSingleton().UnregisterScriptLanguage(goScriptLanguage)
+	CallFunc_GDExtensionInterfaceObjectDestroy(goScriptLanguage.AsGDExtensionObjectPtr())
+	goScriptLanguage = nil
+	freeGoScriptInstanceInfo()
+	ClassDBUnregisterClass[*GoScriptResourceSaver]()
+	ClassDBUnregisterClass[*GoScriptResourceLoader]()
+	ClassDBUnregisterClass[*GoScript]()
+	ClassDBUnregisterClass[*GoScriptLanguage]()
+	log.Info("Go script language unregistered")
+}
+
+func hasGoScriptExtension(p string) bool {
+	return strings.EqualFold(strings.TrimPrefix(path.Ext(p), "."), goScriptExtension)
+}
+
+func newVariantGoString(s string) Variant {
+	gdStr := NewStringWithUtf8Chars(s)
+	defer gdStr.Destroy()
+	return NewVariantString(gdStr)
+}
+
+// newInfoDictionary builds the Dictionary form of a PropertyInfo or a
+// MethodInfo, destroying the values once they are copied in.
+func newInfoDictionary(fields map[string]Variant) Dictionary {
+	d := NewDictionary()
+	for k, v := range fields {
+		d.SetKeyed(k, v)
+		v.Destroy()
+	}
+	return d
+}
+
+// appendDictionary appends a copy of d to arr and destroys d.
+func appendDictionary(arr *Array, d Dictionary) {
+	v := NewVariantDictionary(d)
+	arr.Append(v)
+	v.Destroy()
+	d.Destroy()
+}
+
+// GoScriptLanguage is the "Go" script language. See RegisterGoScriptLanguage.
+type GoScriptLanguage struct {
+	ScriptLanguageExtensionImpl
+}
+
+func (l *GoScriptLanguage) GetClassName() string {
+	return "GoScriptLanguage"
+}
+
+func (l *GoScriptLanguage) GetParentClassName() string {
+	return "ScriptLanguageExtension"
+}
+
+func newGoScriptLanguageFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &GoScriptLanguage{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+// bindGoScriptLanguage implements the language virtual methods the engine
+// relies on; the others keep their zero return values.
+func bindGoScriptLanguage(t *GoScriptLanguage) {
+	cn := t.GetClassName()
+	classDBBindPtrcallVirtual(cn, "_get_name", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetString(ret, "Go")
+	})
+	classDBBindPtrcallVirtual(cn, "_get_type", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetString(ret, "GoScript")
+	})
+	classDBBindPtrcallVirtual(cn, "_get_extension", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetString(ret, goScriptExtension)
+	})
+	classDBBindPtrcallVirtual(cn, "_get_recognized_extensions", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetPackedStringArray(ret, []string{goScriptExtension})
+	})
+	classDBBindPtrcallVirtual(cn, "_get_comment_delimiters", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetPackedStringArray(ret, []string{"//", "/* */"})
+	})
+	classDBBindPtrcallVirtual(cn, "_get_string_delimiters", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetPackedStringArray(ret, []string{"\" \"", "` `"})
+	})
+	classDBBindPtrcallVirtual(cn, "_create_script", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetObject(ret, newGoScript("").AsGDExtensionObjectPtr())
+	})
+	// the script created for a new file attaches the class named in the
+	// "Create Script" dialog
+	classDBBindPtrcallVirtual(cn, "_make_template", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetRef(ret, newGoScript(args.StringArg(1)).AsGDExtensionObjectPtr())
+	})
+	classDBBindPtrcallVirtual(cn, "_validate", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		className := strings.TrimSpace(args.StringArg(0))
+		_, valid := Internal.GDRegisteredGDClasses.Get(className)
+		result := NewDictionary()
+		vValid := NewVariantBool(valid)
+		result.SetKeyed("valid", vValid)
+		vValid.Destroy()
+		if !valid {
+			errs := NewArray()
+			appendDictionary(&errs, newInfoDictionary(map[string]Variant{
+				"line":    NewVariantInt64(1),
+				"column":  NewVariantInt64(1),
+				"message": newVariantGoString("no Go class named \"" + className + "\" is registered"),
+			}))
+			vErrs := NewVariantArray(errs)
+			result.SetKeyed("errors", vErrs)
+			vErrs.Destroy()
+			errs.Destroy()
+		}
+		ptrRetDictionary(ret, result)
+	})
+}
+
+// GoScript is a script resource that attaches a registered Go class. See
+// RegisterGoScriptLanguage.
+type GoScript struct {
+	ScriptExtensionImpl
+	// className is the whole source code of the script.
+	className string
+	mu        sync.Mutex
+	instances map[GDObjectInstanceID]*goScriptInstance
+}
+
+func (s *GoScript) GetClassName() string {
+	return "GoScript"
+}
+
+func (s *GoScript) GetParentClassName() string {
+	return "ScriptExtension"
+}
+
+func newGoScriptFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &GoScript{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+func newGoScript(className string) *GoScript {
+	s := CreateGDClassInstance("GoScript").(*GoScript)
+	s.className = className
+	return s
+}
+
+// goScriptFromObject returns the GoScript of obj, if it is one.
+func goScriptFromObject(obj GDExtensionObjectPtr) (*GoScript, bool) {
+	if obj == nil {
+		return nil, false
+	}
+	id := CallFunc_GDExtensionInterfaceObjectGetInstanceId((GDExtensionConstObjectPtr)(obj))
+	inst, ok := Internal.GDClassInstances.Get(id)
+	if !ok {
+		return nil, false
+	}
+	s, ok := inst.(*GoScript)
+	return s, ok
+}
+
+// classInfo returns the registered class the script attaches.
+func (s *GoScript) classInfo() (*ClassInfo, bool) {
+	if len(s.className) == 0 {
+		return nil, false
+	}
+	return Internal.GDRegisteredGDClasses.Get(s.className)
+}
+
+func (s *GoScript) instanceCreate(owner GDExtensionObjectPtr) unsafe.Pointer {
+	ci, ok := s.classInfo()
+	if !ok {
+		log.Error("Go script class is not registered",
+			zap.String("class", s.className),
+		)
+		return nil
+	}
+	si := newGoScriptInstance(s, ci, owner)
+	s.mu.Lock()
+	if s.instances == nil {
+		s.instances = map[GDObjectInstanceID]*goScriptInstance{}
+	}
+	s.instances[si.id] = si
+	s.mu.Unlock()
+	log.Debug("Go script instance created",
+		zap.String("class", ci.Name),
+		zap.Any("object_id", si.id),
+	)
+	return unsafe.Pointer(CallFunc_GDExtensionInterfaceScriptInstanceCreate3(
+		goScriptInstanceInfo,
+		(GDExtensionScriptInstanceDataPtr)(unsafe.Pointer(si.handle)),
+	))
+}
+
+func (s *GoScript) removeInstance(si *goScriptInstance) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.instances, si.id)
+}
+
+func (s *GoScript) instanceHas(obj GDExtensionObjectPtr) bool {
+	id := CallFunc_GDExtensionInterfaceObjectGetInstanceId((GDExtensionConstObjectPtr)(obj))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.instances[id]
+	return ok
+}
+
+// placeholderInstanceCreate creates the instance used in the editor, which
+// stores the class's properties without running Go code.
+func (s *GoScript) placeholderInstanceCreate(owner GDExtensionObjectPtr) unsafe.Pointer {
+	placeholder := CallFunc_GDExtensionInterfacePlaceHolderScriptInstanceCreate(
+		goScriptLanguage.AsGDExtensionObjectPtr(),
+		s.AsGDExtensionObjectPtr(),
+		owner,
+	)
+	props := s.propertyList()
+	defer props.Destroy()
+	values := NewDictionary()
+	defer values.Destroy()
+	CallFunc_GDExtensionInterfacePlaceHolderScriptInstanceUpdate(
+		placeholder,
+		props.NativeConstPtr(),
+		values.NativeConstPtr(),
+	)
+	return unsafe.Pointer(placeholder)
+}
+
+func (s *GoScript) propertyList() Array {
+	arr := NewArray()
+	ci, ok := s.classInfo()
+	if !ok {
+		return arr
+	}
+	for ; ci != nil; ci = ci.ParentPtr {
+		for _, p := range ci.Properties {
+			appendDictionary(&arr, newInfoDictionary(map[string]Variant{
+				"name":        newVariantGoString(p.Name),
+				"type":        NewVariantInt64(int64(p.Type)),
+				"class_name":  newVariantGoString(p.Options.className()),
+				"hint":        NewVariantInt64(int64(p.Options.Hint)),
+				"hint_string": newVariantGoString(p.Options.HintString),
+				"usage":       NewVariantInt64(int64(p.Options.usage())),
+			}))
+		}
+	}
+	return arr
+}
+
+func (s *GoScript) methodList() Array {
+	arr := NewArray()
+	ci, ok := s.classInfo()
+	if !ok {
+		return arr
+	}
+	for ; ci != nil; ci = ci.ParentPtr {
+		for name := range ci.MethodMap {
+			appendDictionary(&arr, newInfoDictionary(map[string]Variant{
+				"name": newVariantGoString(name),
+			}))
+		}
+	}
+	return arr
+}
+
+func (s *GoScript) signalList() Array {
+	arr := NewArray()
+	ci, ok := s.classInfo()
+	if !ok {
+		return arr
+	}
+	for ; ci != nil; ci = ci.ParentPtr {
+		for name := range ci.SignalNameSet {
+			appendDictionary(&arr, newInfoDictionary(map[string]Variant{
+				"name": newVariantGoString(name),
+			}))
+		}
+	}
+	return arr
+}
+
+func (s *GoScript) hasSignal(name string) bool {
+	ci, ok := s.classInfo()
+	if !ok {
+		return false
+	}
+	for ; ci != nil; ci = ci.ParentPtr {
+		if _, ok := ci.SignalNameSet[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func bindGoScript(t *GoScript) {
+	cn := t.GetClassName()
+	script := func(inst GDClass) *GoScript {
+		return inst.(*GoScript)
+	}
+	classDBBindPtrcallVirtual(cn, "_has_source_code", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetBool(ret, len(script(inst).className) > 0)
+	})
+	classDBBindPtrcallVirtual(cn, "_get_source_code", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetString(ret, script(inst).className)
+	})
+	classDBBindPtrcallVirtual(cn, "_set_source_code", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		script(inst).className = strings.TrimSpace(args.StringArg(0))
+	})
+	classDBBindPtrcallVirtual(cn, "_reload", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		if _, ok := script(inst).classInfo(); !ok {
+			ptrRetInt(ret, int64(ERR_PARSE_ERROR))
+			return
+		}
+		ptrRetInt(ret, int64(OK))
+	})
+	classDBBindPtrcallVirtual(cn, "_is_valid", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		_, ok := script(inst).classInfo()
+		ptrRetBool(ret, ok)
+	})
+	classDBBindPtrcallVirtual(cn, "_can_instantiate", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		_, ok := script(inst).classInfo()
+		ptrRetBool(ret, ok && !GetEngineSingleton().IsEditorHint())
+	})
+	classDBBindPtrcallVirtual(cn, "_get_instance_base_type", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		if ci, ok := script(inst).classInfo(); ok {
+			ptrRetStringName(ret, ci.ParentName)
+		}
+	})
+	classDBBindPtrcallVirtual(cn, "_instance_create", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetPointer(ret, script(inst).instanceCreate(args.ObjectArg(0)))
+	})
+	classDBBindPtrcallVirtual(cn, "_placeholder_instance_create", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetPointer(ret, script(inst).placeholderInstanceCreate(args.ObjectArg(0)))
+	})
+	classDBBindPtrcallVirtual(cn, "_instance_has", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetBool(ret, script(inst).instanceHas(args.ObjectArg(0)))
+	})
+	classDBBindPtrcallVirtual(cn, "_get_language", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetObject(ret, goScriptLanguage.AsGDExtensionObjectPtr())
+	})
+	classDBBindPtrcallVirtual(cn, "_has_method", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ci, ok := script(inst).classInfo()
+		ptrRetBool(ret, ok && ci.findMethod(args.StringNameArg(0)) != nil)
+	})
+	classDBBindPtrcallVirtual(cn, "_has_script_signal", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetBool(ret, script(inst).hasSignal(args.StringNameArg(0)))
+	})
+	classDBBindPtrcallVirtual(cn, "_get_script_property_list", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetArray(ret, script(inst).propertyList())
+	})
+	classDBBindPtrcallVirtual(cn, "_get_script_method_list", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetArray(ret, script(inst).methodList())
+	})
+	classDBBindPtrcallVirtual(cn, "_get_script_signal_list", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetArray(ret, script(inst).signalList())
+	})
+	classDBBindPtrcallVirtual(cn, "_editor_can_reload_from_file", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetBool(ret, true)
+	})
+	classDBBindPtrcallVirtual(cn, "_get_member_line", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetInt(ret, -1)
+	})
+}
+
+// GoScriptResourceLoader loads .gogd files as GoScript resources.
+type GoScriptResourceLoader struct {
+	ResourceFormatLoaderImpl
+}
+
+func (l *GoScriptResourceLoader) GetClassName() string {
+	return "GoScriptResourceLoader"
+}
+
+func (l *GoScriptResourceLoader) GetParentClassName() string {
+	return "ResourceFormatLoader"
+}
+
+func newGoScriptResourceLoaderFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &GoScriptResourceLoader{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+func bindGoScriptResourceLoader(t *GoScriptResourceLoader) {
+	cn := t.GetClassName()
+	classDBBindPtrcallVirtual(cn, "_get_recognized_extensions", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetPackedStringArray(ret, []string{goScriptExtension})
+	})
+	classDBBindPtrcallVirtual(cn, "_recognize_path", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		ptrRetBool(ret, hasGoScriptExtension(args.StringArg(0)))
+	})
+	classDBBindPtrcallVirtual(cn, "_handles_type", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		typeName := args.StringNameArg(0)
+		ptrRetBool(ret, typeName == "Script" || typeName == "GoScript")
+	})
+	classDBBindPtrcallVirtual(cn, "_get_resource_type", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		if hasGoScriptExtension(args.StringArg(0)) {
+			ptrRetString(ret, "GoScript")
+		}
+	})
+	classDBBindPtrcallVirtual(cn, "_get_resource_uid", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		// ResourceUID.INVALID_ID
+		ptrRetInt(ret, -1)
+	})
+	classDBBindPtrcallVirtual(cn, "_exists", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		p := NewStringWithUtf8Chars(args.StringArg(0))
+		defer p.Destroy()
+		ptrRetBool(ret, FileAccessFileExists(p))
+	})
+	classDBBindPtrcallVirtual(cn, "_load", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		p := NewStringWithUtf8Chars(args.StringArg(0))
+		defer p.Destroy()
+		source := FileAccessGetFileAsString(p)
+		defer source.Destroy()
+		s := newGoScript(strings.TrimSpace(source.ToUtf8()))
+		log.Debug("Go script loaded",
+			zap.String("path", args.StringArg(0)),
+			zap.String("class", s.className),
+		)
+		ptrRetVariant(ret, NewVariantGodotObject(s.GetGodotObjectOwner()))
+	})
+}
+
+// GoScriptResourceSaver saves GoScript resources as .gogd files.
+type GoScriptResourceSaver struct {
+	ResourceFormatSaverImpl
+}
+
+func (l *GoScriptResourceSaver) GetClassName() string {
+	return "GoScriptResourceSaver"
+}
+
+func (l *GoScriptResourceSaver) GetParentClassName() string {
+	return "ResourceFormatSaver"
+}
+
+func newGoScriptResourceSaverFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &GoScriptResourceSaver{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+func bindGoScriptResourceSaver(t *GoScriptResourceSaver) {
+	cn := t.GetClassName()
+	classDBBindPtrcallVirtual(cn, "_recognize", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		_, ok := goScriptFromObject(args.RefObjectArg(0))
+		ptrRetBool(ret, ok)
+	})
+	classDBBindPtrcallVirtual(cn, "_get_recognized_extensions", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		if _, ok := goScriptFromObject(args.RefObjectArg(0)); ok {
+			ptrRetPackedStringArray(ret, []string{goScriptExtension})
+		}
+	})
+	classDBBindPtrcallVirtual(cn, "_recognize_path", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		_, ok := goScriptFromObject(args.RefObjectArg(0))
+		ptrRetBool(ret, ok && hasGoScriptExtension(args.StringArg(1)))
+	})
+	classDBBindPtrcallVirtual(cn, "_save", func(inst GDClass, args ptrcallArgs, ret GDExtensionTypePtr) {
+		s, ok := goScriptFromObject(args.RefObjectArg(0))
+		if !ok {
+			ptrRetInt(ret, int64(ERR_INVALID_PARAMETER))
+			return
+		}
+		p := NewStringWithUtf8Chars(args.StringArg(1))
+		defer p.Destroy()
+		f := FileAccessOpen(p, FILE_ACCESS_MODE_FLAGS_WRITE)
+		if f.TypedPtr().GetGodotObjectOwner() == nil {
+			ptrRetInt(ret, int64(ERR_FILE_CANT_WRITE))
+			return
+		}
+		defer f.Unref()
+		source := NewStringWithUtf8Chars(s.className + "\n")
+		defer source.Destroy()
+		written := f.TypedPtr().StoreString(source)
+		f.TypedPtr().Close()
+		if !written {
+			ptrRetInt(ret, int64(ERR_FILE_CANT_WRITE))
+			return
+		}
+		ptrRetInt(ret, int64(OK))
+	})
+}
@@ -0,0 +1,286 @@
+package core
+
+import (
+	"reflect"
+	"runtime"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// scriptVirtualMethod is a virtual method declared by a Go class for scripts
+// to override, together with the Go method used when no script does.
+type scriptVirtualMethod struct {
+	GdMethodName string
+	Method       reflect.Method
+	ArgTypes     []reflect.Type
+	ReturnType   reflect.Type
+}
+
+// ClassDBAddVirtualMethod declares gdMethodName as a virtual method of the
+// class that scripts extending it can override, with the signature of the Go
+// method goMethodName:
+//
+//	func (b *Bumper) OnHit(strength float32) bool {
+//		return true
+//	}
+//
+//	ClassDBAddVirtualMethod(t, "OnHit", "_on_hit", []string{"strength"})
+//
+// The Go method is the default implementation; Go code dispatches to the
+// script override, or to the default, with CallVirtual:
+//
+//	accepted := CallVirtualResult[bool](b, "_on_hit", float32(2))
+//
+// Virtual methods for engine callbacks such as _ready are bound with
+// ClassDBBindMethodVirtual instead.
+func ClassDBAddVirtualMethod[T GDClass](
+	inst T,
+	goMethodName string,
+	gdMethodName string,
+	argNames []string,
+) {
+	className := inst.GetClassName()
+	ci, ok := Internal.GDRegisteredGDClasses.Get(className)
+	if !ok {
+		log.Panic("Class doesn't exist.", zap.String("class", className))
+	}
+	if _, ok := ci.ScriptVirtualMethodMap[gdMethodName]; ok {
+		log.Panic("Virtual method already declared.",
+			zap.String("class", className),
+			zap.String("gd_name", gdMethodName),
+		)
+	}
+	m, ok := reflect.TypeFor[T]().MethodByName(goMethodName)
+	if !ok {
+		log.Panic("unable to find function",
+			zap.String("gdclass", className),
+			zap.String("method_name", goMethodName),
+			zap.String("gd_method_name", gdMethodName),
+		)
+	}
+	mt := m.Type
+	if mt.IsVariadic() || mt.NumOut() > 1 {
+		log.Panic("virtual method must not be variadic and may return at most 1 value",
+			zap.String("method", goMethodName),
+		)
+	}
+	argTypes := make([]reflect.Type, mt.NumIn()-1)
+	for i := range argTypes {
+		argTypes[i] = mt.In(i + 1)
+	}
+	if len(argNames) != len(argTypes) {
+		log.Panic("virtual method argument names do not match the Go method",
+			zap.String("method", goMethodName),
+			zap.Int("argument_count", len(argTypes)),
+			zap.Strings("arg_names", argNames),
+		)
+	}
+	sv := &scriptVirtualMethod{
+		GdMethodName: gdMethodName,
+		Method:       m,
+		ArgTypes:     argTypes,
+	}
+	if mt.NumOut() == 1 {
+		sv.ReturnType = mt.Out(0)
+	}
+	ci.ScriptVirtualMethodMap[gdMethodName] = sv
+	registerScriptVirtualMethod(ci, sv, argNames)
+}
+
+func registerScriptVirtualMethod(ci *ClassInfo, sv *scriptVirtualMethod, argNames []string) {
+	returnType := GDEXTENSION_VARIANT_TYPE_NIL
+	if sv.ReturnType != nil {
+		returnType = ReflectTypeToGDExtensionVariantType(sv.ReturnType)
+	}
+	returnInfo := NewSimpleGDExtensionPropertyInfo("", returnType, "")
+	defer returnInfo.Destroy()
+	argsInfo := make([]GDExtensionPropertyInfo, len(sv.ArgTypes))
+	argsMetadata := make([]GDExtensionClassMethodArgumentMetadata, len(sv.ArgTypes))
+	for i, t := range sv.ArgTypes {
+		argsInfo[i] = NewSimpleGDExtensionPropertyInfo("", ReflectTypeToGDExtensionVariantType(t), argNames[i])
+		argsMetadata[i] = GDEXTENSION_METHOD_ARGUMENT_METADATA_NONE
+	}
+	defer func() {
+		for i := range argsInfo {
+			argsInfo[i].Destroy()
+		}
+	}()
+	var (
+		argsInfoPtr     *GDExtensionPropertyInfo
+		argsMetadataPtr *GDExtensionClassMethodArgumentMetadata
+	)
+	if len(argsInfo) > 0 {
+		argsInfoPtr = unsafe.SliceData(argsInfo)
+		argsMetadataPtr = unsafe.SliceData(argsMetadata)
+	}
+	name := NewStringNameWithLatin1Chars(sv.GdMethodName)
+	defer name.Destroy()
+	info := NewGDExtensionClassVirtualMethodInfo(
+		name.AsGDExtensionConstStringNamePtr(),
+		uint32(METHOD_FLAGS_DEFAULT|METHOD_FLAG_VIRTUAL),
+		returnInfo,
+		GDEXTENSION_METHOD_ARGUMENT_METADATA_NONE,
+		uint32(len(argsInfo)),
+		argsInfoPtr,
+		argsMetadataPtr,
+	)
+	log.Info("register class script virtual method",
+		zap.String("class", ci.Name),
+		zap.String("gd_name", sv.GdMethodName),
+		zap.String("go_name", sv.Method.Name),
+	)
+	// the engine copies the method info during the call
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	info.Pin(&pinner)
+	CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassVirtualMethod(
+		FFI.Library,
+		ci.NameAsStringNamePtr,
+		&info,
+	)
+}
+
+// CallVirtual calls a virtual method declared with ClassDBAddVirtualMethod
+// and ignores its return value: the override of the script attached to inst
+// if it has one, the Go default otherwise. Arguments are converted to the
+// parameter types of the Go default.
+func CallVirtual(inst GDClass, gdMethodName string, args ...any) {
+	callVirtual(inst, gdMethodName, reflect.Value{}, args)
+}
+
+// CallVirtualResult is CallVirtual for methods with a return value.
+func CallVirtualResult[R any](inst GDClass, gdMethodName string, args ...any) R {
+	var ret R
+	callVirtual(inst, gdMethodName, reflect.ValueOf(&ret).Elem(), args)
+	return ret
+}
+
+func callVirtual(inst GDClass, gdMethodName string, ret reflect.Value, args []any) {
+	sv := findScriptVirtualMethod(inst.GetClassName(), gdMethodName)
+	if sv == nil {
+		log.Panic("virtual method not declared with ClassDBAddVirtualMethod",
+			zap.String("class", inst.GetClassName()),
+			zap.String("gd_name", gdMethodName),
+		)
+	}
+	if len(args) != len(sv.ArgTypes) {
+		log.Panic("wrong number of arguments for virtual method",
+			zap.String("gd_name", gdMethodName),
+			zap.Int("expected", len(sv.ArgTypes)),
+			zap.Int("actual", len(args)),
+		)
+	}
+	in := make([]reflect.Value, len(args))
+	for i, a := range args {
+		in[i] = virtualArgValue(a, sv.ArgTypes[i])
+	}
+	sn := NewStringNameWithLatin1Chars(gdMethodName)
+	defer sn.Destroy()
+	owner := inst.AsGDExtensionObjectPtr()
+	if CallFunc_GDExtensionInterfaceObjectHasScriptMethod((GDExtensionConstObjectPtr)(owner), sn.AsGDExtensionConstStringNamePtr()) == 0 {
+		out := sv.Method.Func.Call(append([]reflect.Value{reflect.ValueOf(inst)}, in...))
+		if ret.IsValid() && len(out) == 1 {
+			setVirtualReturn(ret, out[0], gdMethodName)
+		}
+		return
+	}
+	variants := make([]Variant, len(in))
+	defer func() {
+		for i := range variants {
+			variants[i].Destroy()
+		}
+	}()
+	argPtrs := make([]GDExtensionConstVariantPtr, len(in))
+	for i, v := range in {
+		GDExtensionVariantPtrFromReflectValue(v, (GDExtensionUninitializedVariantPtr)(variants[i].NativePtr()))
+		argPtrs[i] = variants[i].NativeConstPtr()
+	}
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	PinArgs(&pinner, argPtrs)
+	var (
+		result  Variant
+		callErr GDExtensionCallError
+	)
+	defer result.Destroy()
+	CallFunc_GDExtensionInterfaceObjectCallScriptMethod(
+		owner,
+		sn.AsGDExtensionConstStringNamePtr(),
+		unsafe.SliceData(argPtrs),
+		(GDExtensionInt)(len(argPtrs)),
+		(GDExtensionUninitializedVariantPtr)(result.NativePtr()),
+		&callErr,
+	)
+	if !callErr.Ok() {
+		log.Error("script override of virtual method failed",
+			zap.String("gd_name", gdMethodName),
+			zap.Error(&callErr),
+		)
+		return
+	}
+	if !ret.IsValid() {
+		return
+	}
+	v, err := convertVariantToGoTypeReflectValue(result, ret.Type())
+	if err != nil {
+		log.Error("unable to convert the return value of a script override",
+			zap.String("gd_name", gdMethodName),
+			zap.Error(err),
+		)
+		return
+	}
+	setVirtualReturn(ret, v, gdMethodName)
+}
+
+// findScriptVirtualMethod looks the method up on the class and the Go classes
+// it extends.
+func findScriptVirtualMethod(className, gdMethodName string) *scriptVirtualMethod {
+	ci, ok := Internal.GDRegisteredGDClasses.Get(className)
+	if !ok {
+		return nil
+	}
+	for ; ci != nil; ci = ci.ParentPtr {
+		if sv, ok := ci.ScriptVirtualMethodMap[gdMethodName]; ok {
+			return sv
+		}
+	}
+	return nil
+}
+
+func virtualArgValue(a any, t reflect.Type) reflect.Value {
+	if a == nil {
+		return reflect.Zero(t)
+	}
+	v := reflect.ValueOf(a)
+	if v.Type() == t {
+		return v
+	}
+	if v.Type().ConvertibleTo(t) {
+		return v.Convert(t)
+	}
+	log.Panic("virtual method argument has the wrong type",
+		zap.Any("expected", t),
+		zap.Any("actual", v.Type()),
+	)
+	return reflect.Value{}
+}
+
+func setVirtualReturn(ret reflect.Value, v reflect.Value, gdMethodName string) {
+	switch {
+	case v.Type().AssignableTo(ret.Type()):
+		ret.Set(v)
+	case v.Type().ConvertibleTo(ret.Type()):
+		ret.Set(v.Convert(ret.Type()))
+	default:
+		log.Error("virtual method return type does not match",
+			zap.String("gd_name", gdMethodName),
+			zap.Any("expected", ret.Type()),
+			zap.Any("actual", v.Type()),
+		)
+	}
+}
@@ -0,0 +1,233 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/constant"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"github.com/godot-go/godot-go/pkg/util"
+	"go.uber.org/zap"
+)
+
+// Signal0 through Signal4 declare a signal as a struct field of a GDClass:
+//
+//	type Bumper struct {
+//		Area2DImpl
+//		Hit Signal2[float32, Vector2] `signal:"hit,strength,position"`
+//	}
+//
+// ClassDBRegisterClass registers every signal field with Godot. The tag holds
+// the signal name followed by the argument names; without a tag the signal is
+// named after the field in snake_case and the arguments are arg0, arg1, ...
+// Argument types are derived from the type parameters.
+type Signal0 struct{ signal }
+
+type Signal1[A any] struct{ signal }
+
+type Signal2[A, B any] struct{ signal }
+
+type Signal3[A, B, C any] struct{ signal }
+
+type Signal4[A, B, C, D any] struct{ signal }
+
+func (s *Signal0) Emit() Error {
+	return s.emit()
+}
+
+func (s *Signal0) Connect(fn func()) Error {
+	return s.connect(fn)
+}
+
+func (s *Signal1[A]) Emit(a A) Error {
+	return s.emit(valueOf(a))
+}
+
+func (s *Signal1[A]) Connect(fn func(A)) Error {
+	return s.connect(fn)
+}
+
+func (s *Signal2[A, B]) Emit(a A, b B) Error {
+	return s.emit(valueOf(a), valueOf(b))
+}
+
+func (s *Signal2[A, B]) Connect(fn func(A, B)) Error {
+	return s.connect(fn)
+}
+
+func (s *Signal3[A, B, C]) Emit(a A, b B, c C) Error {
+	return s.emit(valueOf(a), valueOf(b), valueOf(c))
+}
+
+func (s *Signal3[A, B, C]) Connect(fn func(A, B, C)) Error {
+	return s.connect(fn)
+}
+
+func (s *Signal4[A, B, C, D]) Emit(a A, b B, c C, d D) Error {
+	return s.emit(valueOf(a), valueOf(b), valueOf(c), valueOf(d))
+}
+
+func (s *Signal4[A, B, C, D]) Connect(fn func(A, B, C, D)) Error {
+	return s.connect(fn)
+}
+
+func (s *Signal0) argTypes() []reflect.Type { return nil }
+
+func (s *Signal1[A]) argTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeFor[A]()}
+}
+
+func (s *Signal2[A, B]) argTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeFor[A](), reflect.TypeFor[B]()}
+}
+
+func (s *Signal3[A, B, C]) argTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeFor[A](), reflect.TypeFor[B](), reflect.TypeFor[C]()}
+}
+
+func (s *Signal4[A, B, C, D]) argTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeFor[A](), reflect.TypeFor[B](), reflect.TypeFor[C](), reflect.TypeFor[D]()}
+}
+
+// signalField is implemented by a pointer to any of the SignalN types.
+type signalField interface {
+	argTypes() []reflect.Type
+	bind(owner Object, name string)
+}
+
+var signalFieldType = reflect.TypeOf((*signalField)(nil)).Elem()
+
+// signal is the untyped state shared by the SignalN types. It is bound to its
+// owning object when the GDClass instance is created.
+type signal struct {
+	owner Object
+	name  string
+}
+
+func (s *signal) bind(owner Object, name string) {
+	s.owner = owner
+	s.name = name
+}
+
+// Name returns the Godot name of the signal.
+func (s *signal) Name() string {
+	return s.name
+}
+
+func (s *signal) emit(values ...reflect.Value) Error {
+	s.mustBeBound()
+	args := make([]Variant, len(values))
+	for i, v := range values {
+		// a nil Object is left as a nil Variant
+		if v.Kind() == reflect.Interface && v.IsNil() {
+			continue
+		}
+		GDExtensionVariantPtrFromReflectValue(v, (GDExtensionUninitializedVariantPtr)(args[i].NativePtr()))
+	}
+	defer func() {
+		for i := range args {
+			args[i].Destroy()
+		}
+	}()
+	sn := NewStringNameWithLatin1Chars(s.name)
+	defer sn.Destroy()
+	return s.owner.EmitSignal(sn, args...)
+}
+
+func (s *signal) connect(fn any) Error {
+	s.mustBeBound()
+	callable := NewCallableFromFunc(fn)
+	defer callable.Destroy()
+	sn := NewStringNameWithLatin1Chars(s.name)
+	defer sn.Destroy()
+	return s.owner.Connect(sn, callable)
+}
+
+func (s *signal) mustBeBound() {
+	if s.owner == nil {
+		log.Panic("signal field used before its instance was created; construct GDClass instances through Godot")
+	}
+}
+
+// valueOf keeps the static type of v, so a nil interface still reflects as
+// the interface type instead of an invalid value.
+func valueOf[T any](v T) reflect.Value {
+	return reflect.ValueOf(&v).Elem()
+}
+
+// signalFieldInfo describes one SignalN field of a registered GDClass.
+type signalFieldInfo struct {
+	Index []int
+	Name  string
+}
+
+// registerSignalFields registers every SignalN field of classType with Godot
+// and returns where to find them on new instances.
+func registerSignalFields(className string, classType reflect.Type) []signalFieldInfo {
+	var fields []signalFieldInfo
+	for _, f := range reflect.VisibleFields(classType) {
+		if f.Anonymous || !f.IsExported() || !reflect.PointerTo(f.Type).Implements(signalFieldType) {
+			continue
+		}
+		argTypes := reflect.New(f.Type).Interface().(signalField).argTypes()
+		name, argNames := parseSignalTag(f, len(argTypes))
+		params := make([]SignalParam, len(argTypes))
+		for i, t := range argTypes {
+			params[i] = SignalParam{
+				Type: ReflectTypeToGDExtensionVariantType(t),
+				Name: argNames[i],
+			}
+		}
+		classDBAddSignal(className, name, params...)
+		fields = append(fields, signalFieldInfo{Index: f.Index, Name: name})
+	}
+	return fields
+}
+
+func parseSignalTag(f reflect.StructField, argCount int) (string, []string) {
+	name := util.SnakeCase(f.Name)
+	argNames := make([]string, argCount)
+	for i := range argNames {
+		argNames[i] = fmt.Sprintf("arg%d", i)
+	}
+	tag, ok := f.Tag.Lookup("signal")
+	if !ok {
+		return name, argNames
+	}
+	parts := strings.Split(tag, ",")
+	if parts[0] != "" {
+		name = parts[0]
+	}
+	if len(parts) > 1 {
+		if len(parts)-1 != argCount {
+			log.Panic("signal tag argument names do not match the signal type",
+				zap.String("field", f.Name),
+				zap.String("tag", tag),
+				zap.Int("arg_count", argCount),
+			)
+		}
+		copy(argNames, parts[1:])
+	}
+	return name, argNames
+}
+
+// bindSignalFields points the SignalN fields of a new instance at its owner.
+func bindSignalFields(ci *ClassInfo, inst GDClass) {
+	if len(ci.SignalFields) == 0 {
+		return
+	}
+	owner, ok := inst.(Object)
+	if !ok {
+		log.Panic("GDClass with signal fields must implement Object",
+			zap.String("class", ci.Name),
+		)
+	}
+	v := reflect.ValueOf(inst).Elem()
+	for _, sf := range ci.SignalFields {
+		f := v.FieldByIndex(sf.Index).Addr().Interface().(signalField)
+		f.bind(owner, sf.Name)
+	}
+}
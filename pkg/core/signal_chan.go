@@ -0,0 +1,142 @@
+package core
+
+import (
+	"sync"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// SignalChan connects signal on obj to a channel, so a goroutine can wait for
+// it with a plain receive:
+//
+//	drained, cancel := SignalChan(drain, "ball_drained", 1)
+//	defer cancel()
+//	go func() {
+//		for args := range drained {
+//			DestroyVariants(args)
+//			resetBall()
+//		}
+//	}()
+//
+// Every emission delivers a copy of the signal arguments made on the emitting
+// thread; the receiver owns the copies and must Destroy them. Emissions that
+// find the buffer full are dropped, since blocking would stall the engine.
+//
+// The channel is closed when cancel is called or when Godot frees obj (which
+// drops the connection). cancel disconnects from the main thread on the next
+// idle frame and may be called from any goroutine, any number of times.
+func SignalChan(obj Object, signal string, buf int) (<-chan []Variant, func()) {
+	sc := &signalChan{
+		ch: make(chan []Variant, buf),
+	}
+	gc, err := newGoCallable(sc.receive)
+	if err != nil {
+		log.Panic("unable to create signal channel", zap.Error(err))
+	}
+	gc.OnFree = sc.onFree
+	id := CallFunc_GDExtensionInterfaceObjectGetInstanceId(obj.AsGDExtensionConstObjectPtr())
+	// Godot keeps its own copy of the callable while connected; once it drops
+	// that copy the callable is freed and sc is closed
+	connectGoCallable(obj, signal, gc, 0)
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			sc.close()
+			RunOnMainThread(func() {
+				sc.disconnect(obj, id, signal, gc)
+			})
+		})
+	}
+	return sc.ch, cancel
+}
+
+// DestroyVariants destroys every Variant in values, for example the argument
+// copies received from SignalChan.
+func DestroyVariants(values []Variant) {
+	for i := range values {
+		values[i].Destroy()
+	}
+}
+
+type signalChan struct {
+	mu     sync.Mutex
+	ch     chan []Variant
+	closed bool
+	freed  bool
+}
+
+func (sc *signalChan) receive(args ...Variant) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.closed {
+		return
+	}
+	// args only borrow the engine's variants for the duration of the call
+	copies := make([]Variant, len(args))
+	for i := range args {
+		copies[i] = NewVariantNativeCopy(args[i].NativeConstPtr())
+	}
+	select {
+	case sc.ch <- copies:
+	default:
+		DestroyVariants(copies)
+		log.Warn("signal channel full, dropping emission",
+			zap.Int("capacity", cap(sc.ch)),
+		)
+	}
+}
+
+func (sc *signalChan) close() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.closeLocked()
+}
+
+func (sc *signalChan) closeLocked() {
+	if !sc.closed {
+		sc.closed = true
+		close(sc.ch)
+	}
+}
+
+// onFree runs when Godot drops the connection, either after a disconnect or
+// because the emitter was freed.
+func (sc *signalChan) onFree() {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.freed = true
+	sc.closeLocked()
+}
+
+func (sc *signalChan) isFreed() bool {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	return sc.freed
+}
+
+func (sc *signalChan) disconnect(obj Object, id GDObjectInstanceID, signal string, gc *goCallable) {
+	if sc.isFreed() {
+		return
+	}
+	disconnectGoCallable(obj, id, signal, gc)
+}
+
+// disconnectGoCallable disconnects the callable created from gc from signal
+// on obj, unless obj, identified by id, has been freed in the meantime. It
+// must run on the main thread.
+func disconnectGoCallable(obj Object, id GDObjectInstanceID, signal string, gc *goCallable) {
+	if CallFunc_GDExtensionInterfaceObjectGetInstanceFromId(id) == nil {
+		// the emitter is gone and took the connection with it
+		return
+	}
+	key := gc.keyCallable()
+	defer key.Destroy()
+	sn := NewStringNameWithLatin1Chars(signal)
+	defer sn.Destroy()
+	if obj.IsConnected(sn, key) {
+		obj.Disconnect(sn, key)
+	}
+}
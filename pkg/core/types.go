@@ -2,7 +2,9 @@ package core
 
 import (
 	"reflect"
+	"runtime"
 	"strings"
+	"sync"
 	"unsafe"
 
 	. "github.com/godot-go/godot-go/pkg/builtin"
@@ -42,6 +44,25 @@ type ClassInfo struct {
 	InheritType               reflect.Type
 	PropertyList              []GDExtensionPropertyInfo
 	ValidateProperty          func(*GDExtensionPropertyInfo)
+	// SignalFields lists the SignalN struct fields bound on every new instance.
+	SignalFields []signalFieldInfo
+	// ScriptVirtualMethodMap holds the virtual methods declared for scripts
+	// to override with ClassDBAddVirtualMethod.
+	ScriptVirtualMethodMap map[string]*scriptVirtualMethod
+	// propertyListPinner keeps PropertyList pinned while the class is
+	// registered; the engine reads it through get_property_list.
+	propertyListPinner runtime.Pinner
+	// Properties lists the properties added with ClassDBAddProperty in the
+	// order they were added.
+	Properties []*classProperty
+	// PtrcallVirtualMethodMap holds the engine virtual methods implemented
+	// directly on their ptrcall arguments.
+	PtrcallVirtualMethodMap map[string]ptrcallVirtual
+	// scriptPropertyList is Properties as handed to the engine by script
+	// instances of the class; built on first use.
+	scriptPropertyList       []GDExtensionPropertyInfo
+	scriptPropertyListOnce   sync.Once
+	scriptPropertyListPinner runtime.Pinner
 }
 
 func (c *ClassInfo) String() string {
@@ -64,10 +85,16 @@ func (c *ClassInfo) Destroy() {
 		parentName.Destroy()
 	}
 
+	c.propertyListPinner.Unpin()
 	for i := range c.PropertyList {
 		c.PropertyList[i].Destroy()
 	}
 
+	c.scriptPropertyListPinner.Unpin()
+	for i := range c.scriptPropertyList {
+		c.scriptPropertyList[i].Destroy()
+	}
+
 	// for _, v := range c.VirtualMethodMap {
 	// 	v.ClassMethodInfo.Destroy()
 	// }
@@ -102,7 +129,14 @@ func NewClassInfo(
 		PropertyList:        propertyList,
 		ValidateProperty:    validateProperty,
 	}
-	pnr.Pin(ret)
+	ret.ScriptVirtualMethodMap = map[string]*scriptVirtualMethod{}
+	ret.PtrcallVirtualMethodMap = map[string]ptrcallVirtual{}
+	if len(propertyList) > 0 {
+		ret.propertyListPinner.Pin(unsafe.SliceData(propertyList))
+		for i := range propertyList {
+			propertyList[i].Pin(&ret.propertyListPinner)
+		}
+	}
 	return ret
 }
 
@@ -55,12 +55,7 @@ func GDExtensionTypePtrFromReflectValue(value reflect.Value, rOut GDExtensionUni
 				zap.Any("kind", k))
 		}
 	case reflect.Struct:
-		// TODO: add all types supported by variant
 		switch inst := value.Interface().(type) {
-		case Variant:
-			VariantEncoder.EncodeTypePtrArg(inst, rOut)
-		case String:
-			StringEncoder.EncodeTypePtrArg(inst, rOut)
 		case Vector2:
 			Vector2Encoder.EncodeTypePtrArg(inst, rOut)
 		case Vector2i:
@@ -93,38 +88,6 @@ func GDExtensionTypePtrFromReflectValue(value reflect.Value, rOut GDExtensionUni
 			ProjectionEncoder.EncodeTypePtrArg(inst, rOut)
 		case Color:
 			ColorEncoder.EncodeTypePtrArg(inst, rOut)
-		case StringName:
-			StringNameEncoder.EncodeTypePtrArg(inst, rOut)
-		case NodePath:
-			NodePathEncoder.EncodeTypePtrArg(inst, rOut)
-		case RID:
-			RIDEncoder.EncodeTypePtrArg(inst, rOut)
-		case Callable:
-			CallableEncoder.EncodeTypePtrArg(inst, rOut)
-		case Signal:
-			SignalEncoder.EncodeTypePtrArg(inst, rOut)
-		case Dictionary:
-			DictionaryEncoder.EncodeTypePtrArg(inst, rOut)
-		case Array:
-			ArrayEncoder.EncodeTypePtrArg(inst, rOut)
-		case PackedByteArray:
-			PackedByteArrayEncoder.EncodeTypePtrArg(inst, rOut)
-		case PackedInt32Array:
-			PackedInt32ArrayEncoder.EncodeTypePtrArg(inst, rOut)
-		case PackedInt64Array:
-			PackedInt64ArrayEncoder.EncodeTypePtrArg(inst, rOut)
-		case PackedFloat32Array:
-			PackedFloat32ArrayEncoder.EncodeTypePtrArg(inst, rOut)
-		case PackedFloat64Array:
-			PackedFloat64ArrayEncoder.EncodeTypePtrArg(inst, rOut)
-		case PackedStringArray:
-			PackedStringArrayEncoder.EncodeTypePtrArg(inst, rOut)
-		case PackedVector2Array:
-			PackedVector2ArrayEncoder.EncodeTypePtrArg(inst, rOut)
-		case PackedVector3Array:
-			PackedVector3ArrayEncoder.EncodeTypePtrArg(inst, rOut)
-		case PackedColorArray:
-			PackedColorArrayEncoder.EncodeTypePtrArg(inst, rOut)
 		default:
 			log.Panic("unhandled go struct to GDExtensionTypePtr",
 				zap.Any("value", value),
@@ -147,38 +110,6 @@ func GDExtensionTypePtrFromReflectValue(value reflect.Value, rOut GDExtensionUni
 			VariantEncoder.EncodeTypePtrArg(inst, rOut)
 		case String:
 			StringEncoder.EncodeTypePtrArg(inst, rOut)
-		case Vector2:
-			Vector2Encoder.EncodeTypePtrArg(inst, rOut)
-		case Vector2i:
-			Vector2iEncoder.EncodeTypePtrArg(inst, rOut)
-		case Rect2:
-			Rect2Encoder.EncodeTypePtrArg(inst, rOut)
-		case Rect2i:
-			Rect2iEncoder.EncodeTypePtrArg(inst, rOut)
-		case Vector3:
-			Vector3Encoder.EncodeTypePtrArg(inst, rOut)
-		case Vector3i:
-			Vector3iEncoder.EncodeTypePtrArg(inst, rOut)
-		case Transform2D:
-			Transform2DEncoder.EncodeTypePtrArg(inst, rOut)
-		case Vector4:
-			Vector4Encoder.EncodeTypePtrArg(inst, rOut)
-		case Vector4i:
-			Vector4iEncoder.EncodeTypePtrArg(inst, rOut)
-		case Plane:
-			PlaneEncoder.EncodeTypePtrArg(inst, rOut)
-		case Quaternion:
-			QuaternionEncoder.EncodeTypePtrArg(inst, rOut)
-		case AABB:
-			AABBEncoder.EncodeTypePtrArg(inst, rOut)
-		case Basis:
-			BasisEncoder.EncodeTypePtrArg(inst, rOut)
-		case Transform3D:
-			Transform3DEncoder.EncodeTypePtrArg(inst, rOut)
-		case Projection:
-			ProjectionEncoder.EncodeTypePtrArg(inst, rOut)
-		case Color:
-			ColorEncoder.EncodeTypePtrArg(inst, rOut)
 		case StringName:
 			StringNameEncoder.EncodeTypePtrArg(inst, rOut)
 		case NodePath:
@@ -274,40 +205,8 @@ func GDExtensionVariantPtrFromReflectValue(value reflect.Value, rOut GDExtension
 		switch inst := value.Interface().(type) {
 		case Variant:
 			VariantEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Vector2:
-			Vector2Encoder.EncodeVariantPtrArg(inst, rOut)
 		case String:
 			StringEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Vector2i:
-			Vector2iEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Rect2:
-			Rect2Encoder.EncodeVariantPtrArg(inst, rOut)
-		case Rect2i:
-			Rect2iEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Vector3:
-			Vector3Encoder.EncodeVariantPtrArg(inst, rOut)
-		case Vector3i:
-			Vector3iEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Transform2D:
-			Transform2DEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Vector4:
-			Vector4Encoder.EncodeVariantPtrArg(inst, rOut)
-		case Vector4i:
-			Vector4iEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Plane:
-			PlaneEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Quaternion:
-			QuaternionEncoder.EncodeVariantPtrArg(inst, rOut)
-		case AABB:
-			AABBEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Basis:
-			BasisEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Transform3D:
-			Transform3DEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Projection:
-			ProjectionEncoder.EncodeVariantPtrArg(inst, rOut)
-		case Color:
-			ColorEncoder.EncodeVariantPtrArg(inst, rOut)
 		case StringName:
 			StringNameEncoder.EncodeVariantPtrArg(inst, rOut)
 		case NodePath:
@@ -356,15 +255,50 @@ func GDExtensionVariantPtrFromReflectValue(value reflect.Value, rOut GDExtension
 			)
 		}
 	case reflect.Struct:
-		className := value.Type().Name()
-		encoder, ok := GDRegisteredGDClassEncoders.Get(className)
-		if ok {
-			encoder.EncodeReflectVariantPtrArg(value, rOut)
-			return
+		switch inst := value.Interface().(type) {
+		case Vector2:
+			Vector2Encoder.EncodeVariantPtrArg(inst, rOut)
+		case Vector2i:
+			Vector2iEncoder.EncodeVariantPtrArg(inst, rOut)
+		case Rect2:
+			Rect2Encoder.EncodeVariantPtrArg(inst, rOut)
+		case Rect2i:
+			Rect2iEncoder.EncodeVariantPtrArg(inst, rOut)
+		case Vector3:
+			Vector3Encoder.EncodeVariantPtrArg(inst, rOut)
+		case Vector3i:
+			Vector3iEncoder.EncodeVariantPtrArg(inst, rOut)
+		case Transform2D:
+			Transform2DEncoder.EncodeVariantPtrArg(inst, rOut)
+		case Vector4:
+			Vector4Encoder.EncodeVariantPtrArg(inst, rOut)
+		case Vector4i:
+			Vector4iEncoder.EncodeVariantPtrArg(inst, rOut)
+		case Plane:
+			PlaneEncoder.EncodeVariantPtrArg(inst, rOut)
+		case Quaternion:
+			QuaternionEncoder.EncodeVariantPtrArg(inst, rOut)
+		case AABB:
+			AABBEncoder.EncodeVariantPtrArg(inst, rOut)
+		case Basis:
+			BasisEncoder.EncodeVariantPtrArg(inst, rOut)
+		case Transform3D:
+			Transform3DEncoder.EncodeVariantPtrArg(inst, rOut)
+		case Projection:
+			ProjectionEncoder.EncodeVariantPtrArg(inst, rOut)
+		case Color:
+			ColorEncoder.EncodeVariantPtrArg(inst, rOut)
+		default:
+			className := value.Type().Name()
+			encoder, ok := GDRegisteredGDClassEncoders.Get(className)
+			if ok {
+				encoder.EncodeReflectVariantPtrArg(value, rOut)
+				return
+			}
+			log.Panic("unhandled go struct to GDExtensionTypePtr",
+				zap.Any("value", value),
+				zap.Any("kind", k))
 		}
-		log.Panic("unhandled go struct to GDExtensionTypePtr",
-			zap.Any("value", value),
-			zap.Any("kind", k))
 	default:
 		log.Panic("unhandled native value to GDExtensionTypePtr",
 			zap.Any("value", value),
@@ -35,36 +35,6 @@ func ReflectTypeToGDExtensionVariantType(t reflect.Type) GDExtensionVariantType
 			switch inst.(type) {
 			case String:
 				return GDEXTENSION_VARIANT_TYPE_STRING
-			case Vector2:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR2
-			case Vector2i:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR2I
-			case Rect2:
-				return GDEXTENSION_VARIANT_TYPE_RECT2
-			case Rect2i:
-				return GDEXTENSION_VARIANT_TYPE_RECT2I
-			case Vector3:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR3
-			case Vector3i:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR3I
-			case Vector4:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR4
-			case Vector4i:
-				return GDEXTENSION_VARIANT_TYPE_VECTOR4I
-			case Transform2D:
-				return GDEXTENSION_VARIANT_TYPE_TRANSFORM2D
-			case Plane:
-				return GDEXTENSION_VARIANT_TYPE_PLANE
-			case Quaternion:
-				return GDEXTENSION_VARIANT_TYPE_QUATERNION
-			case AABB:
-				return GDEXTENSION_VARIANT_TYPE_AABB
-			case Basis:
-				return GDEXTENSION_VARIANT_TYPE_BASIS
-			case Transform3D:
-				return GDEXTENSION_VARIANT_TYPE_TRANSFORM3D
-			case Color:
-				return GDEXTENSION_VARIANT_TYPE_COLOR
 			case StringName:
 				return GDEXTENSION_VARIANT_TYPE_STRING_NAME
 			case NodePath:
@@ -195,6 +165,38 @@ func ReflectTypeToGDExtensionVariantType(t reflect.Type) GDExtensionVariantType
 		zero := reflect.Zero(t)
 		inst := zero.Interface()
 		switch inst.(type) {
+		case Vector2:
+			return GDEXTENSION_VARIANT_TYPE_VECTOR2
+		case Vector2i:
+			return GDEXTENSION_VARIANT_TYPE_VECTOR2I
+		case Rect2:
+			return GDEXTENSION_VARIANT_TYPE_RECT2
+		case Rect2i:
+			return GDEXTENSION_VARIANT_TYPE_RECT2I
+		case Vector3:
+			return GDEXTENSION_VARIANT_TYPE_VECTOR3
+		case Vector3i:
+			return GDEXTENSION_VARIANT_TYPE_VECTOR3I
+		case Vector4:
+			return GDEXTENSION_VARIANT_TYPE_VECTOR4
+		case Vector4i:
+			return GDEXTENSION_VARIANT_TYPE_VECTOR4I
+		case Transform2D:
+			return GDEXTENSION_VARIANT_TYPE_TRANSFORM2D
+		case Plane:
+			return GDEXTENSION_VARIANT_TYPE_PLANE
+		case Quaternion:
+			return GDEXTENSION_VARIANT_TYPE_QUATERNION
+		case AABB:
+			return GDEXTENSION_VARIANT_TYPE_AABB
+		case Basis:
+			return GDEXTENSION_VARIANT_TYPE_BASIS
+		case Transform3D:
+			return GDEXTENSION_VARIANT_TYPE_TRANSFORM3D
+		case Color:
+			return GDEXTENSION_VARIANT_TYPE_COLOR
+		case Projection:
+			return GDEXTENSION_VARIANT_TYPE_PROJECTION
 		default:
 			log.Panic("unhandled go struct", zap.Any("type", t))
 		}
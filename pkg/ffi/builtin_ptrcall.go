@@ -1,39 +1,60 @@
 package ffi
 
 import (
+	"runtime"
 	"unsafe"
 
 	"github.com/godot-go/godot-go/pkg/log"
 )
 
+// PinArgs pins the Go memory every argument points to, so an array of argument
+// pointers can be handed to the engine. T must be a pointer type, either one of
+// the unsafe.Pointer based GDExtension*Ptr types or a typed pointer such as
+// *Variant. Nil pointers and pointers into C memory are ignored. The caller
+// owns the pinner and must Unpin it as soon as the call returns:
+//
+//	var pinner runtime.Pinner
+//	defer pinner.Unpin()
+//	PinArgs(&pinner, args)
+func PinArgs[T any](pinner *runtime.Pinner, args []T) {
+	for _, a := range args {
+		pinner.Pin(a)
+	}
+}
+
 func CallBuiltinConstructor(constructor GDExtensionPtrConstructor, base GDExtensionUninitializedTypePtr, args ...GDExtensionConstTypePtr) {
 	if constructor == nil {
 		log.Panic("constructor is null")
 	}
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	PinArgs(&pinner, args)
 	argsPtr := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(argsPtr)
 	CallFunc_GDExtensionPtrConstructor(constructor, base, argsPtr)
 }
 
 func CallBuiltinMethodPtrRet[T any](method GDExtensionPtrBuiltInMethod, base GDExtensionTypePtr, args ...GDExtensionTypePtr) T {
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	PinArgs(&pinner, args)
 	m := (GDExtensionPtrBuiltInMethod)(method)
 	b := (GDExtensionTypePtr)(base)
 	a := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
 	ca := (int32)(len(args))
 	var ret T
 	ptr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
-	pnr.Pin(a)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionPtrBuiltInMethod(m, b, a, ptr, ca)
 	return ret
 }
 
 func CallBuiltinMethodPtrNoRet(method GDExtensionPtrBuiltInMethod, base GDExtensionTypePtr, args ...GDExtensionTypePtr) {
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	PinArgs(&pinner, args)
 	m := (GDExtensionPtrBuiltInMethod)(method)
 	b := (GDExtensionTypePtr)(base)
 	a := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
 	ca := (int32)(len(args))
-	pnr.Pin(a)
 	CallFunc_GDExtensionPtrBuiltInMethod(m, b, a, nil, ca)
 }
 
@@ -43,9 +64,6 @@ func CallBuiltinOperatorPtr[T any](operator GDExtensionPtrOperatorEvaluator, lef
 	r := (GDExtensionConstTypePtr)(right)
 	var ret T
 	ptr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
-	pnr.Pin(l)
-	pnr.Pin(r)
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionPtrOperatorEvaluator(op, l, r, ptr)
 	return ret
 }
@@ -55,7 +73,6 @@ func CallBuiltinPtrGetter[T any](getter GDExtensionPtrGetter, base GDExtensionCo
 	b := (GDExtensionConstTypePtr)(base)
 	var ret T
 	ptr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionPtrGetter(g, b, ptr)
 	return ret
 }
@@ -65,7 +82,6 @@ func CallBuiltinPtrSetter[T any](setter GDExtensionPtrSetter, base GDExtensionTy
 	b := (GDExtensionTypePtr)(base)
 	var ret T
 	ptr := (GDExtensionConstTypePtr)(unsafe.Pointer(&ret))
-	pnr.Pin(ptr)
 	CallFunc_GDExtensionPtrSetter(g, b, ptr)
 	return ret
 }
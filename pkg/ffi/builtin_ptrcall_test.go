@@ -0,0 +1,48 @@
+package ffi
+
+import (
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/godot-go/godot-go/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGoArgs() []GDExtensionConstTypePtr {
+	a, b := new(int64), new(float64)
+	return []GDExtensionConstTypePtr{
+		(GDExtensionConstTypePtr)(unsafe.Pointer(a)),
+		(GDExtensionConstTypePtr)(unsafe.Pointer(b)),
+		nil,
+	}
+}
+
+func TestPinArgsAllowsPassingArgumentArray(t *testing.T) {
+	args := newGoArgs()
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	PinArgs(&pinner, args)
+	assert.NotPanics(t, func() {
+		util.CgoTestCall(unsafe.Pointer(unsafe.SliceData(args)))
+	})
+}
+
+func TestPinArgsAcceptsTypedPointers(t *testing.T) {
+	args := []*[16]uint8{new([16]uint8), new([16]uint8)}
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+	PinArgs(&pinner, args)
+	assert.NotPanics(t, func() {
+		util.CgoTestCall(unsafe.Pointer(unsafe.SliceData(args)))
+	})
+}
+
+func TestUnpinnedArgumentArrayIsRejected(t *testing.T) {
+	// cgocheck refuses Go memory holding unpinned Go pointers; this is what
+	// PinArgs guards against
+	args := newGoArgs()
+	assert.Panics(t, func() {
+		util.CgoTestCall(unsafe.Pointer(unsafe.SliceData(args)))
+	})
+}
@@ -0,0 +1,45 @@
+package ffi
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../../../godot_headers -I${SRCDIR}/../../../pkg/log -I${SRCDIR}/../../../pkg/gdextension/ffi
+#include <godot/gdextension_interface.h>
+#include "ffi_wrapper.gen.h"
+#include <stdlib.h>
+#include <string.h>
+*/
+import "C"
+
+import (
+	"unsafe"
+)
+
+// NewGDExtensionCallableCustomInfo2 builds the info struct passed to
+// callable_custom_create2. Godot copies the struct, so it does not need to
+// outlive the create call; callableUserdata is handed back to every callback.
+func NewGDExtensionCallableCustomInfo2(
+	callableUserdata unsafe.Pointer,
+	token unsafe.Pointer,
+	objectId uint64,
+	callFunc GDExtensionCallableCustomCall,
+	isValidFunc GDExtensionCallableCustomIsValid,
+	freeFunc GDExtensionCallableCustomFree,
+	hashFunc GDExtensionCallableCustomHash,
+	equalFunc GDExtensionCallableCustomEqual,
+	lessThanFunc GDExtensionCallableCustomLessThan,
+	toStringFunc GDExtensionCallableCustomToString,
+	getArgumentCountFunc GDExtensionCallableCustomGetArgumentCount,
+) GDExtensionCallableCustomInfo2 {
+	return (GDExtensionCallableCustomInfo2)(C.GDExtensionCallableCustomInfo2{
+		callable_userdata:       callableUserdata,
+		token:                   token,
+		object_id:               (C.GDObjectInstanceID)(objectId),
+		call_func:               (C.GDExtensionCallableCustomCall)(callFunc),
+		is_valid_func:           (C.GDExtensionCallableCustomIsValid)(isValidFunc),
+		free_func:               (C.GDExtensionCallableCustomFree)(freeFunc),
+		hash_func:               (C.GDExtensionCallableCustomHash)(hashFunc),
+		equal_func:              (C.GDExtensionCallableCustomEqual)(equalFunc),
+		less_than_func:          (C.GDExtensionCallableCustomLessThan)(lessThanFunc),
+		to_string_func:          (C.GDExtensionCallableCustomToString)(toStringFunc),
+		get_argument_count_func: (C.GDExtensionCallableCustomGetArgumentCount)(getArgumentCountFunc),
+	})
+}
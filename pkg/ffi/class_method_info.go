@@ -10,6 +10,7 @@ package ffi
 import "C"
 
 import (
+	"runtime"
 	"unsafe"
 
 	"github.com/godot-go/godot-go/pkg/log"
@@ -56,10 +57,37 @@ func NewGDExtensionClassMethodInfo(
 		default_argument_count: (C.uint32_t)(defaultArgumentCount),
 		default_arguments:      (*C.GDExtensionVariantPtr)(defaultArguments),
 	})
-	pnr.Pin(ret)
 	return ret
 }
 
+// Pin pins the Go memory the method info refers to: the method name, the
+// property infos of the return value and arguments, the argument metadata and
+// the default arguments. The engine copies all of it during
+// classdb_register_extension_class_method, so the pins only have to be held
+// for that call.
+func (m *GDExtensionClassMethodInfo) Pin(pinner *runtime.Pinner) {
+	cm := (*C.GDExtensionClassMethodInfo)(m)
+	pinner.Pin(unsafe.Pointer(cm.name))
+	if cm.return_value_info != nil {
+		pinner.Pin(cm.return_value_info)
+		(*GDExtensionPropertyInfo)(cm.return_value_info).Pin(pinner)
+	}
+	if cm.argument_count > 0 && cm.arguments_info != nil {
+		pinner.Pin(cm.arguments_info)
+		argSlice := unsafe.Slice(cm.arguments_info, cm.argument_count)
+		for i := range argSlice {
+			(*GDExtensionPropertyInfo)(unsafe.Pointer(&argSlice[i])).Pin(pinner)
+		}
+	}
+	if cm.arguments_metadata != nil {
+		pinner.Pin(cm.arguments_metadata)
+	}
+	if cm.default_argument_count > 0 && cm.default_arguments != nil {
+		pinner.Pin(cm.default_arguments)
+		PinArgs(pinner, unsafe.Slice(cm.default_arguments, cm.default_argument_count))
+	}
+}
+
 func (m *GDExtensionClassMethodInfo) Destroy() {
 	stringDestructor := (GDExtensionPtrDestructor)(CallFunc_GDExtensionInterfaceVariantGetPtrDestructor(GDEXTENSION_VARIANT_TYPE_STRING))
 	if stringDestructor == nil {
@@ -0,0 +1,55 @@
+package ffi
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../../godot_headers -I${SRCDIR}/../../pkg/log -I${SRCDIR}/../../pkg/ffi
+#include <godot/gdextension_interface.h>
+#include "ffi_wrapper.gen.h"
+#include <stdlib.h>
+#include <string.h>
+*/
+import "C"
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+// NewGDExtensionClassVirtualMethodInfo builds the info passed to
+// classdb_register_extension_class_virtual_method. The engine copies it, so
+// like GDExtensionClassMethodInfo it only has to stay pinned for the call.
+func NewGDExtensionClassVirtualMethodInfo(
+	name GDExtensionConstStringNamePtr,
+	methodFlags uint32,
+	returnValue GDExtensionPropertyInfo,
+	returnValueMetadata GDExtensionClassMethodArgumentMetadata,
+	argumentCount uint32,
+	arguments *GDExtensionPropertyInfo,
+	argumentsMetadata *GDExtensionClassMethodArgumentMetadata,
+) GDExtensionClassVirtualMethodInfo {
+	return (GDExtensionClassVirtualMethodInfo)(C.GDExtensionClassVirtualMethodInfo{
+		name:                  (C.GDExtensionStringNamePtr)(name),
+		method_flags:          (C.uint32_t)(methodFlags),
+		return_value:          (C.GDExtensionPropertyInfo)(returnValue),
+		return_value_metadata: (C.GDExtensionClassMethodArgumentMetadata)(returnValueMetadata),
+		argument_count:        (C.uint32_t)(argumentCount),
+		arguments:             (*C.GDExtensionPropertyInfo)(arguments),
+		arguments_metadata:    (*C.GDExtensionClassMethodArgumentMetadata)(argumentsMetadata),
+	})
+}
+
+// Pin pins the Go memory the virtual method info refers to.
+func (m *GDExtensionClassVirtualMethodInfo) Pin(pinner *runtime.Pinner) {
+	cm := (*C.GDExtensionClassVirtualMethodInfo)(m)
+	pinner.Pin(unsafe.Pointer(cm.name))
+	(*GDExtensionPropertyInfo)(&cm.return_value).Pin(pinner)
+	if cm.argument_count > 0 && cm.arguments != nil {
+		pinner.Pin(cm.arguments)
+		argSlice := unsafe.Slice(cm.arguments, cm.argument_count)
+		for i := range argSlice {
+			(*GDExtensionPropertyInfo)(unsafe.Pointer(&argSlice[i])).Pin(pinner)
+		}
+	}
+	if cm.arguments_metadata != nil {
+		pinner.Pin(cm.arguments_metadata)
+	}
+}
@@ -380,6 +380,861 @@ func (x *GDExtensionInterface) LoadProcAddresses(
 	x.RegisterMainLoopCallbacks = (GDExtensionInterfaceRegisterMainLoopCallbacks)(LoadProcAddress("register_main_loop_callbacks"))
 }
 
+// HasGetGodotVersion reports whether the running Godot version has get_godot_version, added in Godot 4.1.
+func (x *GDExtensionInterface) HasGetGodotVersion() bool {
+	return x.GetGodotVersion != nil
+}
+
+// HasGetGodotVersion2 reports whether the running Godot version has get_godot_version2, added in Godot 4.5.
+func (x *GDExtensionInterface) HasGetGodotVersion2() bool {
+	return x.GetGodotVersion2 != nil
+}
+
+// HasMemAlloc reports whether the running Godot version has mem_alloc, added in Godot 4.1.
+func (x *GDExtensionInterface) HasMemAlloc() bool {
+	return x.MemAlloc != nil
+}
+
+// HasMemRealloc reports whether the running Godot version has mem_realloc, added in Godot 4.1.
+func (x *GDExtensionInterface) HasMemRealloc() bool {
+	return x.MemRealloc != nil
+}
+
+// HasMemFree reports whether the running Godot version has mem_free, added in Godot 4.1.
+func (x *GDExtensionInterface) HasMemFree() bool {
+	return x.MemFree != nil
+}
+
+// HasPrintError reports whether the running Godot version has print_error, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPrintError() bool {
+	return x.PrintError != nil
+}
+
+// HasPrintErrorWithMessage reports whether the running Godot version has print_error_with_message, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPrintErrorWithMessage() bool {
+	return x.PrintErrorWithMessage != nil
+}
+
+// HasPrintWarning reports whether the running Godot version has print_warning, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPrintWarning() bool {
+	return x.PrintWarning != nil
+}
+
+// HasPrintWarningWithMessage reports whether the running Godot version has print_warning_with_message, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPrintWarningWithMessage() bool {
+	return x.PrintWarningWithMessage != nil
+}
+
+// HasPrintScriptError reports whether the running Godot version has print_script_error, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPrintScriptError() bool {
+	return x.PrintScriptError != nil
+}
+
+// HasPrintScriptErrorWithMessage reports whether the running Godot version has print_script_error_with_message, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPrintScriptErrorWithMessage() bool {
+	return x.PrintScriptErrorWithMessage != nil
+}
+
+// HasGetNativeStructSize reports whether the running Godot version has get_native_struct_size, added in Godot 4.1.
+func (x *GDExtensionInterface) HasGetNativeStructSize() bool {
+	return x.GetNativeStructSize != nil
+}
+
+// HasVariantNewCopy reports whether the running Godot version has variant_new_copy, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantNewCopy() bool {
+	return x.VariantNewCopy != nil
+}
+
+// HasVariantNewNil reports whether the running Godot version has variant_new_nil, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantNewNil() bool {
+	return x.VariantNewNil != nil
+}
+
+// HasVariantDestroy reports whether the running Godot version has variant_destroy, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantDestroy() bool {
+	return x.VariantDestroy != nil
+}
+
+// HasVariantCall reports whether the running Godot version has variant_call, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantCall() bool {
+	return x.VariantCall != nil
+}
+
+// HasVariantCallStatic reports whether the running Godot version has variant_call_static, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantCallStatic() bool {
+	return x.VariantCallStatic != nil
+}
+
+// HasVariantEvaluate reports whether the running Godot version has variant_evaluate, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantEvaluate() bool {
+	return x.VariantEvaluate != nil
+}
+
+// HasVariantSet reports whether the running Godot version has variant_set, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantSet() bool {
+	return x.VariantSet != nil
+}
+
+// HasVariantSetNamed reports whether the running Godot version has variant_set_named, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantSetNamed() bool {
+	return x.VariantSetNamed != nil
+}
+
+// HasVariantSetKeyed reports whether the running Godot version has variant_set_keyed, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantSetKeyed() bool {
+	return x.VariantSetKeyed != nil
+}
+
+// HasVariantSetIndexed reports whether the running Godot version has variant_set_indexed, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantSetIndexed() bool {
+	return x.VariantSetIndexed != nil
+}
+
+// HasVariantGet reports whether the running Godot version has variant_get, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGet() bool {
+	return x.VariantGet != nil
+}
+
+// HasVariantGetNamed reports whether the running Godot version has variant_get_named, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetNamed() bool {
+	return x.VariantGetNamed != nil
+}
+
+// HasVariantGetKeyed reports whether the running Godot version has variant_get_keyed, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetKeyed() bool {
+	return x.VariantGetKeyed != nil
+}
+
+// HasVariantGetIndexed reports whether the running Godot version has variant_get_indexed, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetIndexed() bool {
+	return x.VariantGetIndexed != nil
+}
+
+// HasVariantIterInit reports whether the running Godot version has variant_iter_init, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantIterInit() bool {
+	return x.VariantIterInit != nil
+}
+
+// HasVariantIterNext reports whether the running Godot version has variant_iter_next, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantIterNext() bool {
+	return x.VariantIterNext != nil
+}
+
+// HasVariantIterGet reports whether the running Godot version has variant_iter_get, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantIterGet() bool {
+	return x.VariantIterGet != nil
+}
+
+// HasVariantHash reports whether the running Godot version has variant_hash, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantHash() bool {
+	return x.VariantHash != nil
+}
+
+// HasVariantRecursiveHash reports whether the running Godot version has variant_recursive_hash, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantRecursiveHash() bool {
+	return x.VariantRecursiveHash != nil
+}
+
+// HasVariantHashCompare reports whether the running Godot version has variant_hash_compare, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantHashCompare() bool {
+	return x.VariantHashCompare != nil
+}
+
+// HasVariantBooleanize reports whether the running Godot version has variant_booleanize, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantBooleanize() bool {
+	return x.VariantBooleanize != nil
+}
+
+// HasVariantDuplicate reports whether the running Godot version has variant_duplicate, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantDuplicate() bool {
+	return x.VariantDuplicate != nil
+}
+
+// HasVariantStringify reports whether the running Godot version has variant_stringify, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantStringify() bool {
+	return x.VariantStringify != nil
+}
+
+// HasVariantGetType reports whether the running Godot version has variant_get_type, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetType() bool {
+	return x.VariantGetType != nil
+}
+
+// HasVariantHasMethod reports whether the running Godot version has variant_has_method, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantHasMethod() bool {
+	return x.VariantHasMethod != nil
+}
+
+// HasVariantHasMember reports whether the running Godot version has variant_has_member, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantHasMember() bool {
+	return x.VariantHasMember != nil
+}
+
+// HasVariantHasKey reports whether the running Godot version has variant_has_key, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantHasKey() bool {
+	return x.VariantHasKey != nil
+}
+
+// HasVariantGetObjectInstanceId reports whether the running Godot version has variant_get_object_instance_id, added in Godot 4.4.
+func (x *GDExtensionInterface) HasVariantGetObjectInstanceId() bool {
+	return x.VariantGetObjectInstanceId != nil
+}
+
+// HasVariantGetTypeName reports whether the running Godot version has variant_get_type_name, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetTypeName() bool {
+	return x.VariantGetTypeName != nil
+}
+
+// HasVariantCanConvert reports whether the running Godot version has variant_can_convert, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantCanConvert() bool {
+	return x.VariantCanConvert != nil
+}
+
+// HasVariantCanConvertStrict reports whether the running Godot version has variant_can_convert_strict, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantCanConvertStrict() bool {
+	return x.VariantCanConvertStrict != nil
+}
+
+// HasGetVariantFromTypeConstructor reports whether the running Godot version has get_variant_from_type_constructor, added in Godot 4.1.
+func (x *GDExtensionInterface) HasGetVariantFromTypeConstructor() bool {
+	return x.GetVariantFromTypeConstructor != nil
+}
+
+// HasGetVariantToTypeConstructor reports whether the running Godot version has get_variant_to_type_constructor, added in Godot 4.1.
+func (x *GDExtensionInterface) HasGetVariantToTypeConstructor() bool {
+	return x.GetVariantToTypeConstructor != nil
+}
+
+// HasGetVariantGetInternalPtrFunc reports whether the running Godot version has variant_get_ptr_internal_getter, added in Godot 4.4.
+func (x *GDExtensionInterface) HasGetVariantGetInternalPtrFunc() bool {
+	return x.GetVariantGetInternalPtrFunc != nil
+}
+
+// HasVariantGetPtrOperatorEvaluator reports whether the running Godot version has variant_get_ptr_operator_evaluator, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrOperatorEvaluator() bool {
+	return x.VariantGetPtrOperatorEvaluator != nil
+}
+
+// HasVariantGetPtrBuiltinMethod reports whether the running Godot version has variant_get_ptr_builtin_method, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrBuiltinMethod() bool {
+	return x.VariantGetPtrBuiltinMethod != nil
+}
+
+// HasVariantGetPtrConstructor reports whether the running Godot version has variant_get_ptr_constructor, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrConstructor() bool {
+	return x.VariantGetPtrConstructor != nil
+}
+
+// HasVariantGetPtrDestructor reports whether the running Godot version has variant_get_ptr_destructor, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrDestructor() bool {
+	return x.VariantGetPtrDestructor != nil
+}
+
+// HasVariantConstruct reports whether the running Godot version has variant_construct, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantConstruct() bool {
+	return x.VariantConstruct != nil
+}
+
+// HasVariantGetPtrSetter reports whether the running Godot version has variant_get_ptr_setter, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrSetter() bool {
+	return x.VariantGetPtrSetter != nil
+}
+
+// HasVariantGetPtrGetter reports whether the running Godot version has variant_get_ptr_getter, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrGetter() bool {
+	return x.VariantGetPtrGetter != nil
+}
+
+// HasVariantGetPtrIndexedSetter reports whether the running Godot version has variant_get_ptr_indexed_setter, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrIndexedSetter() bool {
+	return x.VariantGetPtrIndexedSetter != nil
+}
+
+// HasVariantGetPtrIndexedGetter reports whether the running Godot version has variant_get_ptr_indexed_getter, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrIndexedGetter() bool {
+	return x.VariantGetPtrIndexedGetter != nil
+}
+
+// HasVariantGetPtrKeyedSetter reports whether the running Godot version has variant_get_ptr_keyed_setter, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrKeyedSetter() bool {
+	return x.VariantGetPtrKeyedSetter != nil
+}
+
+// HasVariantGetPtrKeyedGetter reports whether the running Godot version has variant_get_ptr_keyed_getter, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrKeyedGetter() bool {
+	return x.VariantGetPtrKeyedGetter != nil
+}
+
+// HasVariantGetPtrKeyedChecker reports whether the running Godot version has variant_get_ptr_keyed_checker, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrKeyedChecker() bool {
+	return x.VariantGetPtrKeyedChecker != nil
+}
+
+// HasVariantGetConstantValue reports whether the running Godot version has variant_get_constant_value, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetConstantValue() bool {
+	return x.VariantGetConstantValue != nil
+}
+
+// HasVariantGetPtrUtilityFunction reports whether the running Godot version has variant_get_ptr_utility_function, added in Godot 4.1.
+func (x *GDExtensionInterface) HasVariantGetPtrUtilityFunction() bool {
+	return x.VariantGetPtrUtilityFunction != nil
+}
+
+// HasStringNewWithLatin1Chars reports whether the running Godot version has string_new_with_latin1_chars, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringNewWithLatin1Chars() bool {
+	return x.StringNewWithLatin1Chars != nil
+}
+
+// HasStringNewWithUtf8Chars reports whether the running Godot version has string_new_with_utf8_chars, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringNewWithUtf8Chars() bool {
+	return x.StringNewWithUtf8Chars != nil
+}
+
+// HasStringNewWithUtf16Chars reports whether the running Godot version has string_new_with_utf16_chars, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringNewWithUtf16Chars() bool {
+	return x.StringNewWithUtf16Chars != nil
+}
+
+// HasStringNewWithUtf32Chars reports whether the running Godot version has string_new_with_utf32_chars, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringNewWithUtf32Chars() bool {
+	return x.StringNewWithUtf32Chars != nil
+}
+
+// HasStringNewWithWideChars reports whether the running Godot version has string_new_with_wide_chars, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringNewWithWideChars() bool {
+	return x.StringNewWithWideChars != nil
+}
+
+// HasStringNewWithLatin1CharsAndLen reports whether the running Godot version has string_new_with_latin1_chars_and_len, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringNewWithLatin1CharsAndLen() bool {
+	return x.StringNewWithLatin1CharsAndLen != nil
+}
+
+// HasStringNewWithUtf8CharsAndLen reports whether the running Godot version has string_new_with_utf8_chars_and_len, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringNewWithUtf8CharsAndLen() bool {
+	return x.StringNewWithUtf8CharsAndLen != nil
+}
+
+// HasStringNewWithUtf8CharsAndLen2 reports whether the running Godot version has string_new_with_utf8_chars_and_len2, added in Godot 4.3.
+func (x *GDExtensionInterface) HasStringNewWithUtf8CharsAndLen2() bool {
+	return x.StringNewWithUtf8CharsAndLen2 != nil
+}
+
+// HasStringNewWithUtf16CharsAndLen reports whether the running Godot version has string_new_with_utf16_chars_and_len, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringNewWithUtf16CharsAndLen() bool {
+	return x.StringNewWithUtf16CharsAndLen != nil
+}
+
+// HasStringNewWithUtf16CharsAndLen2 reports whether the running Godot version has string_new_with_utf16_chars_and_len2, added in Godot 4.3.
+func (x *GDExtensionInterface) HasStringNewWithUtf16CharsAndLen2() bool {
+	return x.StringNewWithUtf16CharsAndLen2 != nil
+}
+
+// HasStringNewWithUtf32CharsAndLen reports whether the running Godot version has string_new_with_utf32_chars_and_len, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringNewWithUtf32CharsAndLen() bool {
+	return x.StringNewWithUtf32CharsAndLen != nil
+}
+
+// HasStringNewWithWideCharsAndLen reports whether the running Godot version has string_new_with_wide_chars_and_len, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringNewWithWideCharsAndLen() bool {
+	return x.StringNewWithWideCharsAndLen != nil
+}
+
+// HasStringToLatin1Chars reports whether the running Godot version has string_to_latin1_chars, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringToLatin1Chars() bool {
+	return x.StringToLatin1Chars != nil
+}
+
+// HasStringToUtf8Chars reports whether the running Godot version has string_to_utf8_chars, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringToUtf8Chars() bool {
+	return x.StringToUtf8Chars != nil
+}
+
+// HasStringToUtf16Chars reports whether the running Godot version has string_to_utf16_chars, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringToUtf16Chars() bool {
+	return x.StringToUtf16Chars != nil
+}
+
+// HasStringToUtf32Chars reports whether the running Godot version has string_to_utf32_chars, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringToUtf32Chars() bool {
+	return x.StringToUtf32Chars != nil
+}
+
+// HasStringToWideChars reports whether the running Godot version has string_to_wide_chars, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringToWideChars() bool {
+	return x.StringToWideChars != nil
+}
+
+// HasStringOperatorIndex reports whether the running Godot version has string_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringOperatorIndex() bool {
+	return x.StringOperatorIndex != nil
+}
+
+// HasStringOperatorIndexConst reports whether the running Godot version has string_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringOperatorIndexConst() bool {
+	return x.StringOperatorIndexConst != nil
+}
+
+// HasStringOperatorPlusEqString reports whether the running Godot version has string_operator_plus_eq_string, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringOperatorPlusEqString() bool {
+	return x.StringOperatorPlusEqString != nil
+}
+
+// HasStringOperatorPlusEqChar reports whether the running Godot version has string_operator_plus_eq_char, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringOperatorPlusEqChar() bool {
+	return x.StringOperatorPlusEqChar != nil
+}
+
+// HasStringOperatorPlusEqCstr reports whether the running Godot version has string_operator_plus_eq_cstr, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringOperatorPlusEqCstr() bool {
+	return x.StringOperatorPlusEqCstr != nil
+}
+
+// HasStringOperatorPlusEqWcstr reports whether the running Godot version has string_operator_plus_eq_wcstr, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringOperatorPlusEqWcstr() bool {
+	return x.StringOperatorPlusEqWcstr != nil
+}
+
+// HasStringOperatorPlusEqC32str reports whether the running Godot version has string_operator_plus_eq_c32str, added in Godot 4.1.
+func (x *GDExtensionInterface) HasStringOperatorPlusEqC32str() bool {
+	return x.StringOperatorPlusEqC32str != nil
+}
+
+// HasStringResize reports whether the running Godot version has string_resize, added in Godot 4.2.
+func (x *GDExtensionInterface) HasStringResize() bool {
+	return x.StringResize != nil
+}
+
+// HasStringNameNewWithLatin1Chars reports whether the running Godot version has string_name_new_with_latin1_chars, added in Godot 4.2.
+func (x *GDExtensionInterface) HasStringNameNewWithLatin1Chars() bool {
+	return x.StringNameNewWithLatin1Chars != nil
+}
+
+// HasStringNameNewWithUtf8Chars reports whether the running Godot version has string_name_new_with_utf8_chars, added in Godot 4.2.
+func (x *GDExtensionInterface) HasStringNameNewWithUtf8Chars() bool {
+	return x.StringNameNewWithUtf8Chars != nil
+}
+
+// HasStringNameNewWithUtf8CharsAndLen reports whether the running Godot version has string_name_new_with_utf8_chars_and_len, added in Godot 4.2.
+func (x *GDExtensionInterface) HasStringNameNewWithUtf8CharsAndLen() bool {
+	return x.StringNameNewWithUtf8CharsAndLen != nil
+}
+
+// HasXmlParserOpenBuffer reports whether the running Godot version has xml_parser_open_buffer, added in Godot 4.1.
+func (x *GDExtensionInterface) HasXmlParserOpenBuffer() bool {
+	return x.XmlParserOpenBuffer != nil
+}
+
+// HasFileAccessStoreBuffer reports whether the running Godot version has file_access_store_buffer, added in Godot 4.1.
+func (x *GDExtensionInterface) HasFileAccessStoreBuffer() bool {
+	return x.FileAccessStoreBuffer != nil
+}
+
+// HasFileAccessGetBuffer reports whether the running Godot version has file_access_get_buffer, added in Godot 4.1.
+func (x *GDExtensionInterface) HasFileAccessGetBuffer() bool {
+	return x.FileAccessGetBuffer != nil
+}
+
+// HasImagePtrw reports whether the running Godot version has image_ptrw, added in Godot 4.3.
+func (x *GDExtensionInterface) HasImagePtrw() bool {
+	return x.ImagePtrw != nil
+}
+
+// HasImagePtr reports whether the running Godot version has image_ptr, added in Godot 4.3.
+func (x *GDExtensionInterface) HasImagePtr() bool {
+	return x.ImagePtr != nil
+}
+
+// HasWorkerThreadPoolAddNativeGroupTask reports whether the running Godot version has worker_thread_pool_add_native_group_task, added in Godot 4.1.
+func (x *GDExtensionInterface) HasWorkerThreadPoolAddNativeGroupTask() bool {
+	return x.WorkerThreadPoolAddNativeGroupTask != nil
+}
+
+// HasWorkerThreadPoolAddNativeTask reports whether the running Godot version has worker_thread_pool_add_native_task, added in Godot 4.1.
+func (x *GDExtensionInterface) HasWorkerThreadPoolAddNativeTask() bool {
+	return x.WorkerThreadPoolAddNativeTask != nil
+}
+
+// HasPackedByteArrayOperatorIndex reports whether the running Godot version has packed_byte_array_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedByteArrayOperatorIndex() bool {
+	return x.PackedByteArrayOperatorIndex != nil
+}
+
+// HasPackedByteArrayOperatorIndexConst reports whether the running Godot version has packed_byte_array_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedByteArrayOperatorIndexConst() bool {
+	return x.PackedByteArrayOperatorIndexConst != nil
+}
+
+// HasPackedFloat32ArrayOperatorIndex reports whether the running Godot version has packed_float32_array_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedFloat32ArrayOperatorIndex() bool {
+	return x.PackedFloat32ArrayOperatorIndex != nil
+}
+
+// HasPackedFloat32ArrayOperatorIndexConst reports whether the running Godot version has packed_float32_array_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedFloat32ArrayOperatorIndexConst() bool {
+	return x.PackedFloat32ArrayOperatorIndexConst != nil
+}
+
+// HasPackedFloat64ArrayOperatorIndex reports whether the running Godot version has packed_float64_array_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedFloat64ArrayOperatorIndex() bool {
+	return x.PackedFloat64ArrayOperatorIndex != nil
+}
+
+// HasPackedFloat64ArrayOperatorIndexConst reports whether the running Godot version has packed_float64_array_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedFloat64ArrayOperatorIndexConst() bool {
+	return x.PackedFloat64ArrayOperatorIndexConst != nil
+}
+
+// HasPackedInt32ArrayOperatorIndex reports whether the running Godot version has packed_int32_array_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedInt32ArrayOperatorIndex() bool {
+	return x.PackedInt32ArrayOperatorIndex != nil
+}
+
+// HasPackedInt32ArrayOperatorIndexConst reports whether the running Godot version has packed_int32_array_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedInt32ArrayOperatorIndexConst() bool {
+	return x.PackedInt32ArrayOperatorIndexConst != nil
+}
+
+// HasPackedInt64ArrayOperatorIndex reports whether the running Godot version has packed_int64_array_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedInt64ArrayOperatorIndex() bool {
+	return x.PackedInt64ArrayOperatorIndex != nil
+}
+
+// HasPackedInt64ArrayOperatorIndexConst reports whether the running Godot version has packed_int64_array_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedInt64ArrayOperatorIndexConst() bool {
+	return x.PackedInt64ArrayOperatorIndexConst != nil
+}
+
+// HasPackedStringArrayOperatorIndex reports whether the running Godot version has packed_string_array_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedStringArrayOperatorIndex() bool {
+	return x.PackedStringArrayOperatorIndex != nil
+}
+
+// HasPackedStringArrayOperatorIndexConst reports whether the running Godot version has packed_string_array_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedStringArrayOperatorIndexConst() bool {
+	return x.PackedStringArrayOperatorIndexConst != nil
+}
+
+// HasPackedVector2ArrayOperatorIndex reports whether the running Godot version has packed_vector2_array_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedVector2ArrayOperatorIndex() bool {
+	return x.PackedVector2ArrayOperatorIndex != nil
+}
+
+// HasPackedVector2ArrayOperatorIndexConst reports whether the running Godot version has packed_vector2_array_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedVector2ArrayOperatorIndexConst() bool {
+	return x.PackedVector2ArrayOperatorIndexConst != nil
+}
+
+// HasPackedVector3ArrayOperatorIndex reports whether the running Godot version has packed_vector3_array_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedVector3ArrayOperatorIndex() bool {
+	return x.PackedVector3ArrayOperatorIndex != nil
+}
+
+// HasPackedVector3ArrayOperatorIndexConst reports whether the running Godot version has packed_vector3_array_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedVector3ArrayOperatorIndexConst() bool {
+	return x.PackedVector3ArrayOperatorIndexConst != nil
+}
+
+// HasPackedVector4ArrayOperatorIndex reports whether the running Godot version has packed_vector4_array_operator_index, added in Godot 4.3.
+func (x *GDExtensionInterface) HasPackedVector4ArrayOperatorIndex() bool {
+	return x.PackedVector4ArrayOperatorIndex != nil
+}
+
+// HasPackedVector4ArrayOperatorIndexConst reports whether the running Godot version has packed_vector4_array_operator_index_const, added in Godot 4.3.
+func (x *GDExtensionInterface) HasPackedVector4ArrayOperatorIndexConst() bool {
+	return x.PackedVector4ArrayOperatorIndexConst != nil
+}
+
+// HasPackedColorArrayOperatorIndex reports whether the running Godot version has packed_color_array_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedColorArrayOperatorIndex() bool {
+	return x.PackedColorArrayOperatorIndex != nil
+}
+
+// HasPackedColorArrayOperatorIndexConst reports whether the running Godot version has packed_color_array_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasPackedColorArrayOperatorIndexConst() bool {
+	return x.PackedColorArrayOperatorIndexConst != nil
+}
+
+// HasArrayOperatorIndex reports whether the running Godot version has array_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasArrayOperatorIndex() bool {
+	return x.ArrayOperatorIndex != nil
+}
+
+// HasArrayOperatorIndexConst reports whether the running Godot version has array_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasArrayOperatorIndexConst() bool {
+	return x.ArrayOperatorIndexConst != nil
+}
+
+// HasArrayRef reports whether the running Godot version has array_ref, added in Godot 4.1.
+func (x *GDExtensionInterface) HasArrayRef() bool {
+	return x.ArrayRef != nil
+}
+
+// HasArraySetTyped reports whether the running Godot version has array_set_typed, added in Godot 4.1.
+func (x *GDExtensionInterface) HasArraySetTyped() bool {
+	return x.ArraySetTyped != nil
+}
+
+// HasDictionaryOperatorIndex reports whether the running Godot version has dictionary_operator_index, added in Godot 4.1.
+func (x *GDExtensionInterface) HasDictionaryOperatorIndex() bool {
+	return x.DictionaryOperatorIndex != nil
+}
+
+// HasDictionaryOperatorIndexConst reports whether the running Godot version has dictionary_operator_index_const, added in Godot 4.1.
+func (x *GDExtensionInterface) HasDictionaryOperatorIndexConst() bool {
+	return x.DictionaryOperatorIndexConst != nil
+}
+
+// HasDictionarySetTyped reports whether the running Godot version has dictionary_set_typed, added in Godot 4.4.
+func (x *GDExtensionInterface) HasDictionarySetTyped() bool {
+	return x.DictionarySetTyped != nil
+}
+
+// HasObjectMethodBindCall reports whether the running Godot version has object_method_bind_call, added in Godot 4.1.
+func (x *GDExtensionInterface) HasObjectMethodBindCall() bool {
+	return x.ObjectMethodBindCall != nil
+}
+
+// HasObjectMethodBindPtrcall reports whether the running Godot version has object_method_bind_ptrcall, added in Godot 4.1.
+func (x *GDExtensionInterface) HasObjectMethodBindPtrcall() bool {
+	return x.ObjectMethodBindPtrcall != nil
+}
+
+// HasObjectDestroy reports whether the running Godot version has object_destroy, added in Godot 4.1.
+func (x *GDExtensionInterface) HasObjectDestroy() bool {
+	return x.ObjectDestroy != nil
+}
+
+// HasGlobalGetSingleton reports whether the running Godot version has global_get_singleton, added in Godot 4.1.
+func (x *GDExtensionInterface) HasGlobalGetSingleton() bool {
+	return x.GlobalGetSingleton != nil
+}
+
+// HasObjectGetInstanceBinding reports whether the running Godot version has object_get_instance_binding, added in Godot 4.1.
+func (x *GDExtensionInterface) HasObjectGetInstanceBinding() bool {
+	return x.ObjectGetInstanceBinding != nil
+}
+
+// HasObjectSetInstanceBinding reports whether the running Godot version has object_set_instance_binding, added in Godot 4.1.
+func (x *GDExtensionInterface) HasObjectSetInstanceBinding() bool {
+	return x.ObjectSetInstanceBinding != nil
+}
+
+// HasObjectFreeInstanceBinding reports whether the running Godot version has object_free_instance_binding, added in Godot 4.2.
+func (x *GDExtensionInterface) HasObjectFreeInstanceBinding() bool {
+	return x.ObjectFreeInstanceBinding != nil
+}
+
+// HasObjectSetInstance reports whether the running Godot version has object_set_instance, added in Godot 4.1.
+func (x *GDExtensionInterface) HasObjectSetInstance() bool {
+	return x.ObjectSetInstance != nil
+}
+
+// HasObjectGetClassName reports whether the running Godot version has object_get_class_name, added in Godot 4.1.
+func (x *GDExtensionInterface) HasObjectGetClassName() bool {
+	return x.ObjectGetClassName != nil
+}
+
+// HasObjectCastTo reports whether the running Godot version has object_cast_to, added in Godot 4.1.
+func (x *GDExtensionInterface) HasObjectCastTo() bool {
+	return x.ObjectCastTo != nil
+}
+
+// HasObjectGetInstanceFromId reports whether the running Godot version has object_get_instance_from_id, added in Godot 4.1.
+func (x *GDExtensionInterface) HasObjectGetInstanceFromId() bool {
+	return x.ObjectGetInstanceFromId != nil
+}
+
+// HasObjectGetInstanceId reports whether the running Godot version has object_get_instance_id, added in Godot 4.1.
+func (x *GDExtensionInterface) HasObjectGetInstanceId() bool {
+	return x.ObjectGetInstanceId != nil
+}
+
+// HasObjectHasScriptMethod reports whether the running Godot version has object_has_script_method, added in Godot 4.3.
+func (x *GDExtensionInterface) HasObjectHasScriptMethod() bool {
+	return x.ObjectHasScriptMethod != nil
+}
+
+// HasObjectCallScriptMethod reports whether the running Godot version has object_call_script_method, added in Godot 4.3.
+func (x *GDExtensionInterface) HasObjectCallScriptMethod() bool {
+	return x.ObjectCallScriptMethod != nil
+}
+
+// HasRefGetObject reports whether the running Godot version has ref_get_object, added in Godot 4.1.
+func (x *GDExtensionInterface) HasRefGetObject() bool {
+	return x.RefGetObject != nil
+}
+
+// HasRefSetObject reports whether the running Godot version has ref_set_object, added in Godot 4.1.
+func (x *GDExtensionInterface) HasRefSetObject() bool {
+	return x.RefSetObject != nil
+}
+
+// HasScriptInstanceCreate reports whether the running Godot version has script_instance_create, added in Godot 4.1.
+func (x *GDExtensionInterface) HasScriptInstanceCreate() bool {
+	return x.ScriptInstanceCreate != nil
+}
+
+// HasScriptInstanceCreate2 reports whether the running Godot version has script_instance_create2, added in Godot 4.2.
+func (x *GDExtensionInterface) HasScriptInstanceCreate2() bool {
+	return x.ScriptInstanceCreate2 != nil
+}
+
+// HasScriptInstanceCreate3 reports whether the running Godot version has script_instance_create3, added in Godot 4.3.
+func (x *GDExtensionInterface) HasScriptInstanceCreate3() bool {
+	return x.ScriptInstanceCreate3 != nil
+}
+
+// HasPlaceHolderScriptInstanceCreate reports whether the running Godot version has placeholder_script_instance_create, added in Godot 4.2.
+func (x *GDExtensionInterface) HasPlaceHolderScriptInstanceCreate() bool {
+	return x.PlaceHolderScriptInstanceCreate != nil
+}
+
+// HasPlaceHolderScriptInstanceUpdate reports whether the running Godot version has placeholder_script_instance_update, added in Godot 4.2.
+func (x *GDExtensionInterface) HasPlaceHolderScriptInstanceUpdate() bool {
+	return x.PlaceHolderScriptInstanceUpdate != nil
+}
+
+// HasObjectGetScriptInstance reports whether the running Godot version has object_get_script_instance, added in Godot 4.2.
+func (x *GDExtensionInterface) HasObjectGetScriptInstance() bool {
+	return x.ObjectGetScriptInstance != nil
+}
+
+// HasObjectSetScriptInstance reports whether the running Godot version has object_set_script_instance, added in Godot 4.5.
+func (x *GDExtensionInterface) HasObjectSetScriptInstance() bool {
+	return x.ObjectSetScriptInstance != nil
+}
+
+// HasCallableCustomCreate reports whether the running Godot version has callable_custom_create, added in Godot 4.2.
+func (x *GDExtensionInterface) HasCallableCustomCreate() bool {
+	return x.CallableCustomCreate != nil
+}
+
+// HasCallableCustomCreate2 reports whether the running Godot version has callable_custom_create2, added in Godot 4.3.
+func (x *GDExtensionInterface) HasCallableCustomCreate2() bool {
+	return x.CallableCustomCreate2 != nil
+}
+
+// HasCallableCustomGetUserData reports whether the running Godot version has callable_custom_get_userdata, added in Godot 4.2.
+func (x *GDExtensionInterface) HasCallableCustomGetUserData() bool {
+	return x.CallableCustomGetUserData != nil
+}
+
+// HasClassdbConstructObject reports whether the running Godot version has classdb_construct_object, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbConstructObject() bool {
+	return x.ClassdbConstructObject != nil
+}
+
+// HasClassdbConstructObject2 reports whether the running Godot version has classdb_construct_object2, added in Godot 4.4.
+func (x *GDExtensionInterface) HasClassdbConstructObject2() bool {
+	return x.ClassdbConstructObject2 != nil
+}
+
+// HasClassdbGetMethodBind reports whether the running Godot version has classdb_get_method_bind, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbGetMethodBind() bool {
+	return x.ClassdbGetMethodBind != nil
+}
+
+// HasClassdbGetClassTag reports whether the running Godot version has classdb_get_class_tag, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbGetClassTag() bool {
+	return x.ClassdbGetClassTag != nil
+}
+
+// HasClassdbRegisterExtensionClass reports whether the running Godot version has classdb_register_extension_class, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClass() bool {
+	return x.ClassdbRegisterExtensionClass != nil
+}
+
+// HasClassdbRegisterExtensionClass2 reports whether the running Godot version has classdb_register_extension_class2, added in Godot 4.2.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClass2() bool {
+	return x.ClassdbRegisterExtensionClass2 != nil
+}
+
+// HasClassdbRegisterExtensionClass3 reports whether the running Godot version has classdb_register_extension_class3, added in Godot 4.3.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClass3() bool {
+	return x.ClassdbRegisterExtensionClass3 != nil
+}
+
+// HasClassdbRegisterExtensionClass4 reports whether the running Godot version has classdb_register_extension_class4, added in Godot 4.4.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClass4() bool {
+	return x.ClassdbRegisterExtensionClass4 != nil
+}
+
+// HasClassdbRegisterExtensionClass5 reports whether the running Godot version has classdb_register_extension_class5, added in Godot 4.5.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClass5() bool {
+	return x.ClassdbRegisterExtensionClass5 != nil
+}
+
+// HasClassdbRegisterExtensionClassMethod reports whether the running Godot version has classdb_register_extension_class_method, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClassMethod() bool {
+	return x.ClassdbRegisterExtensionClassMethod != nil
+}
+
+// HasClassdbRegisterExtensionClassVirtualMethod reports whether the running Godot version has classdb_register_extension_class_virtual_method, added in Godot 4.3.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClassVirtualMethod() bool {
+	return x.ClassdbRegisterExtensionClassVirtualMethod != nil
+}
+
+// HasClassdbRegisterExtensionClassIntegerConstant reports whether the running Godot version has classdb_register_extension_class_integer_constant, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClassIntegerConstant() bool {
+	return x.ClassdbRegisterExtensionClassIntegerConstant != nil
+}
+
+// HasClassdbRegisterExtensionClassProperty reports whether the running Godot version has classdb_register_extension_class_property, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClassProperty() bool {
+	return x.ClassdbRegisterExtensionClassProperty != nil
+}
+
+// HasClassdbRegisterExtensionClassPropertyIndexed reports whether the running Godot version has classdb_register_extension_class_property_indexed, added in Godot 4.2.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClassPropertyIndexed() bool {
+	return x.ClassdbRegisterExtensionClassPropertyIndexed != nil
+}
+
+// HasClassdbRegisterExtensionClassPropertyGroup reports whether the running Godot version has classdb_register_extension_class_property_group, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClassPropertyGroup() bool {
+	return x.ClassdbRegisterExtensionClassPropertyGroup != nil
+}
+
+// HasClassdbRegisterExtensionClassPropertySubgroup reports whether the running Godot version has classdb_register_extension_class_property_subgroup, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClassPropertySubgroup() bool {
+	return x.ClassdbRegisterExtensionClassPropertySubgroup != nil
+}
+
+// HasClassdbRegisterExtensionClassSignal reports whether the running Godot version has classdb_register_extension_class_signal, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbRegisterExtensionClassSignal() bool {
+	return x.ClassdbRegisterExtensionClassSignal != nil
+}
+
+// HasClassdbUnregisterExtensionClass reports whether the running Godot version has classdb_unregister_extension_class, added in Godot 4.1.
+func (x *GDExtensionInterface) HasClassdbUnregisterExtensionClass() bool {
+	return x.ClassdbUnregisterExtensionClass != nil
+}
+
+// HasGetLibraryPath reports whether the running Godot version has get_library_path, added in Godot 4.1.
+func (x *GDExtensionInterface) HasGetLibraryPath() bool {
+	return x.GetLibraryPath != nil
+}
+
+// HasEditorAddPlugin reports whether the running Godot version has editor_add_plugin, added in Godot 4.1.
+func (x *GDExtensionInterface) HasEditorAddPlugin() bool {
+	return x.EditorAddPlugin != nil
+}
+
+// HasEditorRemovePlugin reports whether the running Godot version has editor_remove_plugin, added in Godot 4.1.
+func (x *GDExtensionInterface) HasEditorRemovePlugin() bool {
+	return x.EditorRemovePlugin != nil
+}
+
+// HasEditorRegisterGetClassesUsedCallback reports whether the running Godot version has editor_register_get_classes_used_callback, added in Godot 4.5.
+func (x *GDExtensionInterface) HasEditorRegisterGetClassesUsedCallback() bool {
+	return x.EditorRegisterGetClassesUsedCallback != nil
+}
+
+// HasRegisterMainLoopCallbacks reports whether the running Godot version has register_main_loop_callbacks, added in Godot 4.5.
+func (x *GDExtensionInterface) HasRegisterMainLoopCallbacks() bool {
+	return x.RegisterMainLoopCallbacks != nil
+}
+
 var (
 	FFI GDExtensionInterface
 )
@@ -387,13 +1242,13 @@ var (
 func LoadProcAddress(funcName string) unsafe.Pointer {
 	ret := CallFunc_GDExtensionInterfaceGetProcAddress(funcName)
 	if ret == nil {
-		log.Warn("GDExtensionInterfaceGetProcAddress Error",
+		// expected for functions added after the running Godot version;
+		// calling them panics with an UnavailableFunctionError
+		log.Debug("GDExtension interface function not found",
 			zap.String("name", funcName),
 		)
 	}
-	ptr := unsafe.Pointer(ret)
-	pnr.Pin(ptr)
-	return ptr
+	return unsafe.Pointer(ret)
 }
 
 func (gv GDExtensionGodotVersion) GetMajor() int32 {
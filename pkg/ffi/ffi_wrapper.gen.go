@@ -620,8 +620,6 @@ func CallFunc_GDExtensionVariantGetInternalPtrFunc(
 
 	ret := C.cgo_callfn_GDExtensionVariantGetInternalPtrFunc(arg0, arg1)
 
-	pnr.Pin(ret)
-
 	return unsafe.Pointer(ret)
 }
 
@@ -845,8 +843,6 @@ func CallFunc_GDExtensionInstanceBindingCreateCallback(
 
 	ret := C.cgo_callfn_GDExtensionInstanceBindingCreateCallback(arg0, arg1, arg2)
 
-	pnr.Pin(ret)
-
 	return unsafe.Pointer(ret)
 }
 
@@ -1233,8 +1229,6 @@ func CallFunc_GDExtensionClassGetVirtualCallData(
 
 	ret := C.cgo_callfn_GDExtensionClassGetVirtualCallData(arg0, arg1, arg2)
 
-	pnr.Pin(ret)
-
 	return unsafe.Pointer(ret)
 }
 
@@ -1253,8 +1247,6 @@ func CallFunc_GDExtensionClassGetVirtualCallData2(
 
 	ret := C.cgo_callfn_GDExtensionClassGetVirtualCallData2(arg0, arg1, arg2, arg3)
 
-	pnr.Pin(ret)
-
 	return unsafe.Pointer(ret)
 }
 
@@ -2053,7 +2045,6 @@ func CallFunc_GDExtensionsInterfaceEditorHelpLoadXmlFromUtf8Chars(
 	C.cgo_callfn_GDExtensionsInterfaceEditorHelpLoadXmlFromUtf8Chars(arg0, arg1)
 
 	C.free(unsafe.Pointer(arg1))
-
 }
 
 func CallFunc_GDExtensionsInterfaceEditorHelpLoadXmlFromUtf8CharsAndLen(
@@ -2090,9 +2081,13 @@ func CallFunc_GDExtensionInterfaceGetProcAddress(
 	return (GDExtensionInterfaceFunctionPtr)(ret)
 }
 
+// Deprecated: in Godot 4.5. Use `get_godot_version2` instead.
 func CallFunc_GDExtensionInterfaceGetGodotVersion(
 	r_godot_version *GDExtensionGodotVersion,
 ) {
+	if FFI.GetGodotVersion == nil {
+		panic(newUnavailableFunctionError("get_godot_version", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceGetGodotVersion)(FFI.GetGodotVersion)
 	arg1 := (*C.GDExtensionGodotVersion)(r_godot_version)
 
@@ -2105,6 +2100,9 @@ func CallFunc_GDExtensionInterfaceGetGodotVersion(
 func CallFunc_GDExtensionInterfaceGetGodotVersion2(
 	r_godot_version *GDExtensionGodotVersion2,
 ) {
+	if FFI.GetGodotVersion2 == nil {
+		panic(newUnavailableFunctionError("get_godot_version2", "4.5"))
+	}
 	arg0 := (C.GDExtensionInterfaceGetGodotVersion2)(FFI.GetGodotVersion2)
 	arg1 := (*C.GDExtensionGodotVersion2)(r_godot_version)
 
@@ -2117,6 +2115,9 @@ func CallFunc_GDExtensionInterfaceGetGodotVersion2(
 func CallFunc_GDExtensionInterfaceMemAlloc(
 	p_bytes uint64,
 ) unsafe.Pointer {
+	if FFI.MemAlloc == nil {
+		panic(newUnavailableFunctionError("mem_alloc", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceMemAlloc)(FFI.MemAlloc)
 	arg1 := (C.size_t)(p_bytes)
 
@@ -2124,8 +2125,6 @@ func CallFunc_GDExtensionInterfaceMemAlloc(
 
 	ret := C.cgo_callfn_GDExtensionInterfaceMemAlloc(arg0, arg1)
 
-	pnr.Pin(ret)
-
 	return unsafe.Pointer(ret)
 }
 
@@ -2133,6 +2132,9 @@ func CallFunc_GDExtensionInterfaceMemRealloc(
 	p_ptr unsafe.Pointer,
 	p_bytes uint64,
 ) unsafe.Pointer {
+	if FFI.MemRealloc == nil {
+		panic(newUnavailableFunctionError("mem_realloc", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceMemRealloc)(FFI.MemRealloc)
 	arg1 := unsafe.Pointer(p_ptr)
 	arg2 := (C.size_t)(p_bytes)
@@ -2141,14 +2143,15 @@ func CallFunc_GDExtensionInterfaceMemRealloc(
 
 	ret := C.cgo_callfn_GDExtensionInterfaceMemRealloc(arg0, arg1, arg2)
 
-	pnr.Pin(ret)
-
 	return unsafe.Pointer(ret)
 }
 
 func CallFunc_GDExtensionInterfaceMemFree(
 	p_ptr unsafe.Pointer,
 ) {
+	if FFI.MemFree == nil {
+		panic(newUnavailableFunctionError("mem_free", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceMemFree)(FFI.MemFree)
 	arg1 := unsafe.Pointer(p_ptr)
 
@@ -2165,6 +2168,9 @@ func CallFunc_GDExtensionInterfacePrintError(
 	p_line int32,
 	p_editor_notify GDExtensionBool,
 ) {
+	if FFI.PrintError == nil {
+		panic(newUnavailableFunctionError("print_error", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePrintError)(FFI.PrintError)
 	arg1 := C.CString(p_description)
 	arg2 := C.CString(p_function)
@@ -2190,6 +2196,9 @@ func CallFunc_GDExtensionInterfacePrintErrorWithMessage(
 	p_line int32,
 	p_editor_notify GDExtensionBool,
 ) {
+	if FFI.PrintErrorWithMessage == nil {
+		panic(newUnavailableFunctionError("print_error_with_message", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePrintErrorWithMessage)(FFI.PrintErrorWithMessage)
 	arg1 := C.CString(p_description)
 	arg2 := C.CString(p_message)
@@ -2216,6 +2225,9 @@ func CallFunc_GDExtensionInterfacePrintWarning(
 	p_line int32,
 	p_editor_notify GDExtensionBool,
 ) {
+	if FFI.PrintWarning == nil {
+		panic(newUnavailableFunctionError("print_warning", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePrintWarning)(FFI.PrintWarning)
 	arg1 := C.CString(p_description)
 	arg2 := C.CString(p_function)
@@ -2241,6 +2253,9 @@ func CallFunc_GDExtensionInterfacePrintWarningWithMessage(
 	p_line int32,
 	p_editor_notify GDExtensionBool,
 ) {
+	if FFI.PrintWarningWithMessage == nil {
+		panic(newUnavailableFunctionError("print_warning_with_message", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePrintWarningWithMessage)(FFI.PrintWarningWithMessage)
 	arg1 := C.CString(p_description)
 	arg2 := C.CString(p_message)
@@ -2267,6 +2282,9 @@ func CallFunc_GDExtensionInterfacePrintScriptError(
 	p_line int32,
 	p_editor_notify GDExtensionBool,
 ) {
+	if FFI.PrintScriptError == nil {
+		panic(newUnavailableFunctionError("print_script_error", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePrintScriptError)(FFI.PrintScriptError)
 	arg1 := C.CString(p_description)
 	arg2 := C.CString(p_function)
@@ -2292,6 +2310,9 @@ func CallFunc_GDExtensionInterfacePrintScriptErrorWithMessage(
 	p_line int32,
 	p_editor_notify GDExtensionBool,
 ) {
+	if FFI.PrintScriptErrorWithMessage == nil {
+		panic(newUnavailableFunctionError("print_script_error_with_message", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePrintScriptErrorWithMessage)(FFI.PrintScriptErrorWithMessage)
 	arg1 := C.CString(p_description)
 	arg2 := C.CString(p_message)
@@ -2314,6 +2335,9 @@ func CallFunc_GDExtensionInterfacePrintScriptErrorWithMessage(
 func CallFunc_GDExtensionInterfaceGetNativeStructSize(
 	p_name GDExtensionConstStringNamePtr,
 ) uint64 {
+	if FFI.GetNativeStructSize == nil {
+		panic(newUnavailableFunctionError("get_native_struct_size", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceGetNativeStructSize)(FFI.GetNativeStructSize)
 	arg1 := (C.GDExtensionConstStringNamePtr)(p_name)
 
@@ -2328,6 +2352,9 @@ func CallFunc_GDExtensionInterfaceVariantNewCopy(
 	r_dest GDExtensionUninitializedVariantPtr,
 	p_src GDExtensionConstVariantPtr,
 ) {
+	if FFI.VariantNewCopy == nil {
+		panic(newUnavailableFunctionError("variant_new_copy", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantNewCopy)(FFI.VariantNewCopy)
 	arg1 := (C.GDExtensionUninitializedVariantPtr)(r_dest)
 	arg2 := (C.GDExtensionConstVariantPtr)(p_src)
@@ -2341,6 +2368,9 @@ func CallFunc_GDExtensionInterfaceVariantNewCopy(
 func CallFunc_GDExtensionInterfaceVariantNewNil(
 	r_dest GDExtensionUninitializedVariantPtr,
 ) {
+	if FFI.VariantNewNil == nil {
+		panic(newUnavailableFunctionError("variant_new_nil", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantNewNil)(FFI.VariantNewNil)
 	arg1 := (C.GDExtensionUninitializedVariantPtr)(r_dest)
 
@@ -2353,6 +2383,9 @@ func CallFunc_GDExtensionInterfaceVariantNewNil(
 func CallFunc_GDExtensionInterfaceVariantDestroy(
 	p_self GDExtensionVariantPtr,
 ) {
+	if FFI.VariantDestroy == nil {
+		panic(newUnavailableFunctionError("variant_destroy", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantDestroy)(FFI.VariantDestroy)
 	arg1 := (C.GDExtensionVariantPtr)(p_self)
 
@@ -2370,6 +2403,9 @@ func CallFunc_GDExtensionInterfaceVariantCall(
 	r_return GDExtensionUninitializedVariantPtr,
 	r_error *GDExtensionCallError,
 ) {
+	if FFI.VariantCall == nil {
+		panic(newUnavailableFunctionError("variant_call", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantCall)(FFI.VariantCall)
 	arg1 := (C.GDExtensionVariantPtr)(p_self)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_method)
@@ -2392,6 +2428,9 @@ func CallFunc_GDExtensionInterfaceVariantCallStatic(
 	r_return GDExtensionUninitializedVariantPtr,
 	r_error *GDExtensionCallError,
 ) {
+	if FFI.VariantCallStatic == nil {
+		panic(newUnavailableFunctionError("variant_call_static", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantCallStatic)(FFI.VariantCallStatic)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_method)
@@ -2413,6 +2452,9 @@ func CallFunc_GDExtensionInterfaceVariantEvaluate(
 	r_return GDExtensionUninitializedVariantPtr,
 	r_valid *GDExtensionBool,
 ) {
+	if FFI.VariantEvaluate == nil {
+		panic(newUnavailableFunctionError("variant_evaluate", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantEvaluate)(FFI.VariantEvaluate)
 	arg1 := (C.GDExtensionVariantOperator)(p_op)
 	arg2 := (C.GDExtensionConstVariantPtr)(p_a)
@@ -2432,6 +2474,9 @@ func CallFunc_GDExtensionInterfaceVariantSet(
 	p_value GDExtensionConstVariantPtr,
 	r_valid *GDExtensionBool,
 ) {
+	if FFI.VariantSet == nil {
+		panic(newUnavailableFunctionError("variant_set", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantSet)(FFI.VariantSet)
 	arg1 := (C.GDExtensionVariantPtr)(p_self)
 	arg2 := (C.GDExtensionConstVariantPtr)(p_key)
@@ -2450,6 +2495,9 @@ func CallFunc_GDExtensionInterfaceVariantSetNamed(
 	p_value GDExtensionConstVariantPtr,
 	r_valid *GDExtensionBool,
 ) {
+	if FFI.VariantSetNamed == nil {
+		panic(newUnavailableFunctionError("variant_set_named", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantSetNamed)(FFI.VariantSetNamed)
 	arg1 := (C.GDExtensionVariantPtr)(p_self)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_key)
@@ -2468,6 +2516,9 @@ func CallFunc_GDExtensionInterfaceVariantSetKeyed(
 	p_value GDExtensionConstVariantPtr,
 	r_valid *GDExtensionBool,
 ) {
+	if FFI.VariantSetKeyed == nil {
+		panic(newUnavailableFunctionError("variant_set_keyed", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantSetKeyed)(FFI.VariantSetKeyed)
 	arg1 := (C.GDExtensionVariantPtr)(p_self)
 	arg2 := (C.GDExtensionConstVariantPtr)(p_key)
@@ -2487,6 +2538,9 @@ func CallFunc_GDExtensionInterfaceVariantSetIndexed(
 	r_valid *GDExtensionBool,
 	r_oob *GDExtensionBool,
 ) {
+	if FFI.VariantSetIndexed == nil {
+		panic(newUnavailableFunctionError("variant_set_indexed", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantSetIndexed)(FFI.VariantSetIndexed)
 	arg1 := (C.GDExtensionVariantPtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -2506,6 +2560,9 @@ func CallFunc_GDExtensionInterfaceVariantGet(
 	r_ret GDExtensionUninitializedVariantPtr,
 	r_valid *GDExtensionBool,
 ) {
+	if FFI.VariantGet == nil {
+		panic(newUnavailableFunctionError("variant_get", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGet)(FFI.VariantGet)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionConstVariantPtr)(p_key)
@@ -2524,6 +2581,9 @@ func CallFunc_GDExtensionInterfaceVariantGetNamed(
 	r_ret GDExtensionUninitializedVariantPtr,
 	r_valid *GDExtensionBool,
 ) {
+	if FFI.VariantGetNamed == nil {
+		panic(newUnavailableFunctionError("variant_get_named", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetNamed)(FFI.VariantGetNamed)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_key)
@@ -2542,6 +2602,9 @@ func CallFunc_GDExtensionInterfaceVariantGetKeyed(
 	r_ret GDExtensionUninitializedVariantPtr,
 	r_valid *GDExtensionBool,
 ) {
+	if FFI.VariantGetKeyed == nil {
+		panic(newUnavailableFunctionError("variant_get_keyed", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetKeyed)(FFI.VariantGetKeyed)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionConstVariantPtr)(p_key)
@@ -2561,6 +2624,9 @@ func CallFunc_GDExtensionInterfaceVariantGetIndexed(
 	r_valid *GDExtensionBool,
 	r_oob *GDExtensionBool,
 ) {
+	if FFI.VariantGetIndexed == nil {
+		panic(newUnavailableFunctionError("variant_get_indexed", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetIndexed)(FFI.VariantGetIndexed)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -2579,6 +2645,9 @@ func CallFunc_GDExtensionInterfaceVariantIterInit(
 	r_iter GDExtensionUninitializedVariantPtr,
 	r_valid *GDExtensionBool,
 ) GDExtensionBool {
+	if FFI.VariantIterInit == nil {
+		panic(newUnavailableFunctionError("variant_iter_init", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantIterInit)(FFI.VariantIterInit)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionUninitializedVariantPtr)(r_iter)
@@ -2596,6 +2665,9 @@ func CallFunc_GDExtensionInterfaceVariantIterNext(
 	r_iter GDExtensionVariantPtr,
 	r_valid *GDExtensionBool,
 ) GDExtensionBool {
+	if FFI.VariantIterNext == nil {
+		panic(newUnavailableFunctionError("variant_iter_next", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantIterNext)(FFI.VariantIterNext)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionVariantPtr)(r_iter)
@@ -2614,6 +2686,9 @@ func CallFunc_GDExtensionInterfaceVariantIterGet(
 	r_ret GDExtensionUninitializedVariantPtr,
 	r_valid *GDExtensionBool,
 ) {
+	if FFI.VariantIterGet == nil {
+		panic(newUnavailableFunctionError("variant_iter_get", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantIterGet)(FFI.VariantIterGet)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionVariantPtr)(r_iter)
@@ -2629,6 +2704,9 @@ func CallFunc_GDExtensionInterfaceVariantIterGet(
 func CallFunc_GDExtensionInterfaceVariantHash(
 	p_self GDExtensionConstVariantPtr,
 ) GDExtensionInt {
+	if FFI.VariantHash == nil {
+		panic(newUnavailableFunctionError("variant_hash", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantHash)(FFI.VariantHash)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 
@@ -2643,6 +2721,9 @@ func CallFunc_GDExtensionInterfaceVariantRecursiveHash(
 	p_self GDExtensionConstVariantPtr,
 	p_recursion_count GDExtensionInt,
 ) GDExtensionInt {
+	if FFI.VariantRecursiveHash == nil {
+		panic(newUnavailableFunctionError("variant_recursive_hash", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantRecursiveHash)(FFI.VariantRecursiveHash)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_recursion_count)
@@ -2658,6 +2739,9 @@ func CallFunc_GDExtensionInterfaceVariantHashCompare(
 	p_self GDExtensionConstVariantPtr,
 	p_other GDExtensionConstVariantPtr,
 ) GDExtensionBool {
+	if FFI.VariantHashCompare == nil {
+		panic(newUnavailableFunctionError("variant_hash_compare", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantHashCompare)(FFI.VariantHashCompare)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionConstVariantPtr)(p_other)
@@ -2672,6 +2756,9 @@ func CallFunc_GDExtensionInterfaceVariantHashCompare(
 func CallFunc_GDExtensionInterfaceVariantBooleanize(
 	p_self GDExtensionConstVariantPtr,
 ) GDExtensionBool {
+	if FFI.VariantBooleanize == nil {
+		panic(newUnavailableFunctionError("variant_booleanize", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantBooleanize)(FFI.VariantBooleanize)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 
@@ -2687,6 +2774,9 @@ func CallFunc_GDExtensionInterfaceVariantDuplicate(
 	r_ret GDExtensionVariantPtr,
 	p_deep GDExtensionBool,
 ) {
+	if FFI.VariantDuplicate == nil {
+		panic(newUnavailableFunctionError("variant_duplicate", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantDuplicate)(FFI.VariantDuplicate)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionVariantPtr)(r_ret)
@@ -2702,6 +2792,9 @@ func CallFunc_GDExtensionInterfaceVariantStringify(
 	p_self GDExtensionConstVariantPtr,
 	r_ret GDExtensionStringPtr,
 ) {
+	if FFI.VariantStringify == nil {
+		panic(newUnavailableFunctionError("variant_stringify", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantStringify)(FFI.VariantStringify)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionStringPtr)(r_ret)
@@ -2715,6 +2808,9 @@ func CallFunc_GDExtensionInterfaceVariantStringify(
 func CallFunc_GDExtensionInterfaceVariantGetType(
 	p_self GDExtensionConstVariantPtr,
 ) GDExtensionVariantType {
+	if FFI.VariantGetType == nil {
+		panic(newUnavailableFunctionError("variant_get_type", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetType)(FFI.VariantGetType)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 
@@ -2729,6 +2825,9 @@ func CallFunc_GDExtensionInterfaceVariantHasMethod(
 	p_self GDExtensionConstVariantPtr,
 	p_method GDExtensionConstStringNamePtr,
 ) GDExtensionBool {
+	if FFI.VariantHasMethod == nil {
+		panic(newUnavailableFunctionError("variant_has_method", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantHasMethod)(FFI.VariantHasMethod)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_method)
@@ -2744,6 +2843,9 @@ func CallFunc_GDExtensionInterfaceVariantHasMember(
 	p_type GDExtensionVariantType,
 	p_member GDExtensionConstStringNamePtr,
 ) GDExtensionBool {
+	if FFI.VariantHasMember == nil {
+		panic(newUnavailableFunctionError("variant_has_member", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantHasMember)(FFI.VariantHasMember)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_member)
@@ -2760,6 +2862,9 @@ func CallFunc_GDExtensionInterfaceVariantHasKey(
 	p_key GDExtensionConstVariantPtr,
 	r_valid *GDExtensionBool,
 ) GDExtensionBool {
+	if FFI.VariantHasKey == nil {
+		panic(newUnavailableFunctionError("variant_has_key", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantHasKey)(FFI.VariantHasKey)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 	arg2 := (C.GDExtensionConstVariantPtr)(p_key)
@@ -2775,6 +2880,9 @@ func CallFunc_GDExtensionInterfaceVariantHasKey(
 func CallFunc_GDExtensionInterfaceVariantGetObjectInstanceId(
 	p_self GDExtensionConstVariantPtr,
 ) GDObjectInstanceID {
+	if FFI.VariantGetObjectInstanceId == nil {
+		panic(newUnavailableFunctionError("variant_get_object_instance_id", "4.4"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetObjectInstanceId)(FFI.VariantGetObjectInstanceId)
 	arg1 := (C.GDExtensionConstVariantPtr)(p_self)
 
@@ -2789,6 +2897,9 @@ func CallFunc_GDExtensionInterfaceVariantGetTypeName(
 	p_type GDExtensionVariantType,
 	r_name GDExtensionUninitializedStringPtr,
 ) {
+	if FFI.VariantGetTypeName == nil {
+		panic(newUnavailableFunctionError("variant_get_type_name", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetTypeName)(FFI.VariantGetTypeName)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 	arg2 := (C.GDExtensionUninitializedStringPtr)(r_name)
@@ -2803,6 +2914,9 @@ func CallFunc_GDExtensionInterfaceVariantCanConvert(
 	p_from GDExtensionVariantType,
 	p_to GDExtensionVariantType,
 ) GDExtensionBool {
+	if FFI.VariantCanConvert == nil {
+		panic(newUnavailableFunctionError("variant_can_convert", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantCanConvert)(FFI.VariantCanConvert)
 	arg1 := (C.GDExtensionVariantType)(p_from)
 	arg2 := (C.GDExtensionVariantType)(p_to)
@@ -2818,6 +2932,9 @@ func CallFunc_GDExtensionInterfaceVariantCanConvertStrict(
 	p_from GDExtensionVariantType,
 	p_to GDExtensionVariantType,
 ) GDExtensionBool {
+	if FFI.VariantCanConvertStrict == nil {
+		panic(newUnavailableFunctionError("variant_can_convert_strict", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantCanConvertStrict)(FFI.VariantCanConvertStrict)
 	arg1 := (C.GDExtensionVariantType)(p_from)
 	arg2 := (C.GDExtensionVariantType)(p_to)
@@ -2832,6 +2949,9 @@ func CallFunc_GDExtensionInterfaceVariantCanConvertStrict(
 func CallFunc_GDExtensionInterfaceGetVariantFromTypeConstructor(
 	p_type GDExtensionVariantType,
 ) GDExtensionVariantFromTypeConstructorFunc {
+	if FFI.GetVariantFromTypeConstructor == nil {
+		panic(newUnavailableFunctionError("get_variant_from_type_constructor", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceGetVariantFromTypeConstructor)(FFI.GetVariantFromTypeConstructor)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 
@@ -2845,6 +2965,9 @@ func CallFunc_GDExtensionInterfaceGetVariantFromTypeConstructor(
 func CallFunc_GDExtensionInterfaceGetVariantToTypeConstructor(
 	p_type GDExtensionVariantType,
 ) GDExtensionTypeFromVariantConstructorFunc {
+	if FFI.GetVariantToTypeConstructor == nil {
+		panic(newUnavailableFunctionError("get_variant_to_type_constructor", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceGetVariantToTypeConstructor)(FFI.GetVariantToTypeConstructor)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 
@@ -2858,6 +2981,9 @@ func CallFunc_GDExtensionInterfaceGetVariantToTypeConstructor(
 func CallFunc_GDExtensionInterfaceGetVariantGetInternalPtrFunc(
 	p_type GDExtensionVariantType,
 ) GDExtensionVariantGetInternalPtrFunc {
+	if FFI.GetVariantGetInternalPtrFunc == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_internal_getter", "4.4"))
+	}
 	arg0 := (C.GDExtensionInterfaceGetVariantGetInternalPtrFunc)(FFI.GetVariantGetInternalPtrFunc)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 
@@ -2873,6 +2999,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrOperatorEvaluator(
 	p_type_a GDExtensionVariantType,
 	p_type_b GDExtensionVariantType,
 ) GDExtensionPtrOperatorEvaluator {
+	if FFI.VariantGetPtrOperatorEvaluator == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_operator_evaluator", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrOperatorEvaluator)(FFI.VariantGetPtrOperatorEvaluator)
 	arg1 := (C.GDExtensionVariantOperator)(p_operator)
 	arg2 := (C.GDExtensionVariantType)(p_type_a)
@@ -2890,6 +3019,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrBuiltinMethod(
 	p_method GDExtensionConstStringNamePtr,
 	p_hash GDExtensionInt,
 ) GDExtensionPtrBuiltInMethod {
+	if FFI.VariantGetPtrBuiltinMethod == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_builtin_method", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrBuiltinMethod)(FFI.VariantGetPtrBuiltinMethod)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_method)
@@ -2906,6 +3038,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrConstructor(
 	p_type GDExtensionVariantType,
 	p_constructor int32,
 ) GDExtensionPtrConstructor {
+	if FFI.VariantGetPtrConstructor == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_constructor", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrConstructor)(FFI.VariantGetPtrConstructor)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 	arg2 := (C.int32_t)(p_constructor)
@@ -2920,6 +3055,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrConstructor(
 func CallFunc_GDExtensionInterfaceVariantGetPtrDestructor(
 	p_type GDExtensionVariantType,
 ) GDExtensionPtrDestructor {
+	if FFI.VariantGetPtrDestructor == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_destructor", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrDestructor)(FFI.VariantGetPtrDestructor)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 
@@ -2937,6 +3075,9 @@ func CallFunc_GDExtensionInterfaceVariantConstruct(
 	p_argument_count int32,
 	r_error *GDExtensionCallError,
 ) {
+	if FFI.VariantConstruct == nil {
+		panic(newUnavailableFunctionError("variant_construct", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantConstruct)(FFI.VariantConstruct)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 	arg2 := (C.GDExtensionUninitializedVariantPtr)(r_base)
@@ -2954,6 +3095,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrSetter(
 	p_type GDExtensionVariantType,
 	p_member GDExtensionConstStringNamePtr,
 ) GDExtensionPtrSetter {
+	if FFI.VariantGetPtrSetter == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_setter", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrSetter)(FFI.VariantGetPtrSetter)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_member)
@@ -2969,6 +3113,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrGetter(
 	p_type GDExtensionVariantType,
 	p_member GDExtensionConstStringNamePtr,
 ) GDExtensionPtrGetter {
+	if FFI.VariantGetPtrGetter == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_getter", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrGetter)(FFI.VariantGetPtrGetter)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_member)
@@ -2983,6 +3130,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrGetter(
 func CallFunc_GDExtensionInterfaceVariantGetPtrIndexedSetter(
 	p_type GDExtensionVariantType,
 ) GDExtensionPtrIndexedSetter {
+	if FFI.VariantGetPtrIndexedSetter == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_indexed_setter", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrIndexedSetter)(FFI.VariantGetPtrIndexedSetter)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 
@@ -2996,6 +3146,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrIndexedSetter(
 func CallFunc_GDExtensionInterfaceVariantGetPtrIndexedGetter(
 	p_type GDExtensionVariantType,
 ) GDExtensionPtrIndexedGetter {
+	if FFI.VariantGetPtrIndexedGetter == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_indexed_getter", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrIndexedGetter)(FFI.VariantGetPtrIndexedGetter)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 
@@ -3009,6 +3162,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrIndexedGetter(
 func CallFunc_GDExtensionInterfaceVariantGetPtrKeyedSetter(
 	p_type GDExtensionVariantType,
 ) GDExtensionPtrKeyedSetter {
+	if FFI.VariantGetPtrKeyedSetter == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_keyed_setter", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrKeyedSetter)(FFI.VariantGetPtrKeyedSetter)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 
@@ -3022,6 +3178,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrKeyedSetter(
 func CallFunc_GDExtensionInterfaceVariantGetPtrKeyedGetter(
 	p_type GDExtensionVariantType,
 ) GDExtensionPtrKeyedGetter {
+	if FFI.VariantGetPtrKeyedGetter == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_keyed_getter", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrKeyedGetter)(FFI.VariantGetPtrKeyedGetter)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 
@@ -3035,6 +3194,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrKeyedGetter(
 func CallFunc_GDExtensionInterfaceVariantGetPtrKeyedChecker(
 	p_type GDExtensionVariantType,
 ) GDExtensionPtrKeyedChecker {
+	if FFI.VariantGetPtrKeyedChecker == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_keyed_checker", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrKeyedChecker)(FFI.VariantGetPtrKeyedChecker)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 
@@ -3050,6 +3212,9 @@ func CallFunc_GDExtensionInterfaceVariantGetConstantValue(
 	p_constant GDExtensionConstStringNamePtr,
 	r_ret GDExtensionUninitializedVariantPtr,
 ) {
+	if FFI.VariantGetConstantValue == nil {
+		panic(newUnavailableFunctionError("variant_get_constant_value", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetConstantValue)(FFI.VariantGetConstantValue)
 	arg1 := (C.GDExtensionVariantType)(p_type)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_constant)
@@ -3065,6 +3230,9 @@ func CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(
 	p_function GDExtensionConstStringNamePtr,
 	p_hash GDExtensionInt,
 ) GDExtensionPtrUtilityFunction {
+	if FFI.VariantGetPtrUtilityFunction == nil {
+		panic(newUnavailableFunctionError("variant_get_ptr_utility_function", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceVariantGetPtrUtilityFunction)(FFI.VariantGetPtrUtilityFunction)
 	arg1 := (C.GDExtensionConstStringNamePtr)(p_function)
 	arg2 := (C.GDExtensionInt)(p_hash)
@@ -3080,6 +3248,9 @@ func CallFunc_GDExtensionInterfaceStringNewWithLatin1Chars(
 	r_dest GDExtensionUninitializedStringPtr,
 	p_contents string,
 ) {
+	if FFI.StringNewWithLatin1Chars == nil {
+		panic(newUnavailableFunctionError("string_new_with_latin1_chars", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithLatin1Chars)(FFI.StringNewWithLatin1Chars)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := C.CString(p_contents)
@@ -3096,6 +3267,9 @@ func CallFunc_GDExtensionInterfaceStringNewWithUtf8Chars(
 	r_dest GDExtensionUninitializedStringPtr,
 	p_contents string,
 ) {
+	if FFI.StringNewWithUtf8Chars == nil {
+		panic(newUnavailableFunctionError("string_new_with_utf8_chars", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithUtf8Chars)(FFI.StringNewWithUtf8Chars)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := C.CString(p_contents)
@@ -3112,6 +3286,9 @@ func CallFunc_GDExtensionInterfaceStringNewWithUtf16Chars(
 	r_dest GDExtensionUninitializedStringPtr,
 	p_contents *Char16T,
 ) {
+	if FFI.StringNewWithUtf16Chars == nil {
+		panic(newUnavailableFunctionError("string_new_with_utf16_chars", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithUtf16Chars)(FFI.StringNewWithUtf16Chars)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := (*C.char16_t)(p_contents)
@@ -3126,6 +3303,9 @@ func CallFunc_GDExtensionInterfaceStringNewWithUtf32Chars(
 	r_dest GDExtensionUninitializedStringPtr,
 	p_contents *Char32T,
 ) {
+	if FFI.StringNewWithUtf32Chars == nil {
+		panic(newUnavailableFunctionError("string_new_with_utf32_chars", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithUtf32Chars)(FFI.StringNewWithUtf32Chars)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := (*C.char32_t)(p_contents)
@@ -3140,6 +3320,9 @@ func CallFunc_GDExtensionInterfaceStringNewWithWideChars(
 	r_dest GDExtensionUninitializedStringPtr,
 	p_contents *WcharT,
 ) {
+	if FFI.StringNewWithWideChars == nil {
+		panic(newUnavailableFunctionError("string_new_with_wide_chars", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithWideChars)(FFI.StringNewWithWideChars)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := (*C.wchar_t)(p_contents)
@@ -3155,6 +3338,9 @@ func CallFunc_GDExtensionInterfaceStringNewWithLatin1CharsAndLen(
 	p_contents string,
 	p_size GDExtensionInt,
 ) {
+	if FFI.StringNewWithLatin1CharsAndLen == nil {
+		panic(newUnavailableFunctionError("string_new_with_latin1_chars_and_len", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithLatin1CharsAndLen)(FFI.StringNewWithLatin1CharsAndLen)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := C.CString(p_contents)
@@ -3168,11 +3354,15 @@ func CallFunc_GDExtensionInterfaceStringNewWithLatin1CharsAndLen(
 
 }
 
+// Deprecated: in Godot 4.3. Use `string_new_with_utf8_chars_and_len2` instead.
 func CallFunc_GDExtensionInterfaceStringNewWithUtf8CharsAndLen(
 	r_dest GDExtensionUninitializedStringPtr,
 	p_contents string,
 	p_size GDExtensionInt,
 ) {
+	if FFI.StringNewWithUtf8CharsAndLen == nil {
+		panic(newUnavailableFunctionError("string_new_with_utf8_chars_and_len", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithUtf8CharsAndLen)(FFI.StringNewWithUtf8CharsAndLen)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := C.CString(p_contents)
@@ -3191,6 +3381,9 @@ func CallFunc_GDExtensionInterfaceStringNewWithUtf8CharsAndLen2(
 	p_contents string,
 	p_size GDExtensionInt,
 ) GDExtensionInt {
+	if FFI.StringNewWithUtf8CharsAndLen2 == nil {
+		panic(newUnavailableFunctionError("string_new_with_utf8_chars_and_len2", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithUtf8CharsAndLen2)(FFI.StringNewWithUtf8CharsAndLen2)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := C.CString(p_contents)
@@ -3205,11 +3398,15 @@ func CallFunc_GDExtensionInterfaceStringNewWithUtf8CharsAndLen2(
 	return (GDExtensionInt)(ret)
 }
 
+// Deprecated: in Godot 4.3. Use `string_new_with_utf16_chars_and_len2` instead.
 func CallFunc_GDExtensionInterfaceStringNewWithUtf16CharsAndLen(
 	r_dest GDExtensionUninitializedStringPtr,
 	p_contents *Char16T,
 	p_char_count GDExtensionInt,
 ) {
+	if FFI.StringNewWithUtf16CharsAndLen == nil {
+		panic(newUnavailableFunctionError("string_new_with_utf16_chars_and_len", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithUtf16CharsAndLen)(FFI.StringNewWithUtf16CharsAndLen)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := (*C.char16_t)(p_contents)
@@ -3227,6 +3424,9 @@ func CallFunc_GDExtensionInterfaceStringNewWithUtf16CharsAndLen2(
 	p_char_count GDExtensionInt,
 	p_default_little_endian GDExtensionBool,
 ) GDExtensionInt {
+	if FFI.StringNewWithUtf16CharsAndLen2 == nil {
+		panic(newUnavailableFunctionError("string_new_with_utf16_chars_and_len2", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithUtf16CharsAndLen2)(FFI.StringNewWithUtf16CharsAndLen2)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := (*C.char16_t)(p_contents)
@@ -3245,6 +3445,9 @@ func CallFunc_GDExtensionInterfaceStringNewWithUtf32CharsAndLen(
 	p_contents *Char32T,
 	p_char_count GDExtensionInt,
 ) {
+	if FFI.StringNewWithUtf32CharsAndLen == nil {
+		panic(newUnavailableFunctionError("string_new_with_utf32_chars_and_len", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithUtf32CharsAndLen)(FFI.StringNewWithUtf32CharsAndLen)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := (*C.char32_t)(p_contents)
@@ -3261,6 +3464,9 @@ func CallFunc_GDExtensionInterfaceStringNewWithWideCharsAndLen(
 	p_contents *WcharT,
 	p_char_count GDExtensionInt,
 ) {
+	if FFI.StringNewWithWideCharsAndLen == nil {
+		panic(newUnavailableFunctionError("string_new_with_wide_chars_and_len", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNewWithWideCharsAndLen)(FFI.StringNewWithWideCharsAndLen)
 	arg1 := (C.GDExtensionUninitializedStringPtr)(r_dest)
 	arg2 := (*C.wchar_t)(p_contents)
@@ -3277,6 +3483,9 @@ func CallFunc_GDExtensionInterfaceStringToLatin1Chars(
 	r_text *Char,
 	p_max_write_length GDExtensionInt,
 ) GDExtensionInt {
+	if FFI.StringToLatin1Chars == nil {
+		panic(newUnavailableFunctionError("string_to_latin1_chars", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringToLatin1Chars)(FFI.StringToLatin1Chars)
 	arg1 := (C.GDExtensionConstStringPtr)(p_self)
 	arg2 := (*C.char)(r_text)
@@ -3294,6 +3503,9 @@ func CallFunc_GDExtensionInterfaceStringToUtf8Chars(
 	r_text *Char,
 	p_max_write_length GDExtensionInt,
 ) GDExtensionInt {
+	if FFI.StringToUtf8Chars == nil {
+		panic(newUnavailableFunctionError("string_to_utf8_chars", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringToUtf8Chars)(FFI.StringToUtf8Chars)
 	arg1 := (C.GDExtensionConstStringPtr)(p_self)
 	arg2 := (*C.char)(r_text)
@@ -3311,6 +3523,9 @@ func CallFunc_GDExtensionInterfaceStringToUtf16Chars(
 	r_text *Char16T,
 	p_max_write_length GDExtensionInt,
 ) GDExtensionInt {
+	if FFI.StringToUtf16Chars == nil {
+		panic(newUnavailableFunctionError("string_to_utf16_chars", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringToUtf16Chars)(FFI.StringToUtf16Chars)
 	arg1 := (C.GDExtensionConstStringPtr)(p_self)
 	arg2 := (*C.char16_t)(r_text)
@@ -3328,6 +3543,9 @@ func CallFunc_GDExtensionInterfaceStringToUtf32Chars(
 	r_text *Char32T,
 	p_max_write_length GDExtensionInt,
 ) GDExtensionInt {
+	if FFI.StringToUtf32Chars == nil {
+		panic(newUnavailableFunctionError("string_to_utf32_chars", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringToUtf32Chars)(FFI.StringToUtf32Chars)
 	arg1 := (C.GDExtensionConstStringPtr)(p_self)
 	arg2 := (*C.char32_t)(r_text)
@@ -3345,6 +3563,9 @@ func CallFunc_GDExtensionInterfaceStringToWideChars(
 	r_text *WcharT,
 	p_max_write_length GDExtensionInt,
 ) GDExtensionInt {
+	if FFI.StringToWideChars == nil {
+		panic(newUnavailableFunctionError("string_to_wide_chars", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringToWideChars)(FFI.StringToWideChars)
 	arg1 := (C.GDExtensionConstStringPtr)(p_self)
 	arg2 := (*C.wchar_t)(r_text)
@@ -3361,6 +3582,9 @@ func CallFunc_GDExtensionInterfaceStringOperatorIndex(
 	p_self GDExtensionStringPtr,
 	p_index GDExtensionInt,
 ) *Char32T {
+	if FFI.StringOperatorIndex == nil {
+		panic(newUnavailableFunctionError("string_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringOperatorIndex)(FFI.StringOperatorIndex)
 	arg1 := (C.GDExtensionStringPtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3376,6 +3600,9 @@ func CallFunc_GDExtensionInterfaceStringOperatorIndexConst(
 	p_self GDExtensionConstStringPtr,
 	p_index GDExtensionInt,
 ) *Char32T {
+	if FFI.StringOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("string_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringOperatorIndexConst)(FFI.StringOperatorIndexConst)
 	arg1 := (C.GDExtensionConstStringPtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3391,6 +3618,9 @@ func CallFunc_GDExtensionInterfaceStringOperatorPlusEqString(
 	p_self GDExtensionStringPtr,
 	p_b GDExtensionConstStringPtr,
 ) {
+	if FFI.StringOperatorPlusEqString == nil {
+		panic(newUnavailableFunctionError("string_operator_plus_eq_string", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringOperatorPlusEqString)(FFI.StringOperatorPlusEqString)
 	arg1 := (C.GDExtensionStringPtr)(p_self)
 	arg2 := (C.GDExtensionConstStringPtr)(p_b)
@@ -3405,6 +3635,9 @@ func CallFunc_GDExtensionInterfaceStringOperatorPlusEqChar(
 	p_self GDExtensionStringPtr,
 	p_b Char32T,
 ) {
+	if FFI.StringOperatorPlusEqChar == nil {
+		panic(newUnavailableFunctionError("string_operator_plus_eq_char", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringOperatorPlusEqChar)(FFI.StringOperatorPlusEqChar)
 	arg1 := (C.GDExtensionStringPtr)(p_self)
 	arg2 := (C.char32_t)(p_b)
@@ -3419,6 +3652,9 @@ func CallFunc_GDExtensionInterfaceStringOperatorPlusEqCstr(
 	p_self GDExtensionStringPtr,
 	p_b string,
 ) {
+	if FFI.StringOperatorPlusEqCstr == nil {
+		panic(newUnavailableFunctionError("string_operator_plus_eq_cstr", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringOperatorPlusEqCstr)(FFI.StringOperatorPlusEqCstr)
 	arg1 := (C.GDExtensionStringPtr)(p_self)
 	arg2 := C.CString(p_b)
@@ -3435,6 +3671,9 @@ func CallFunc_GDExtensionInterfaceStringOperatorPlusEqWcstr(
 	p_self GDExtensionStringPtr,
 	p_b *WcharT,
 ) {
+	if FFI.StringOperatorPlusEqWcstr == nil {
+		panic(newUnavailableFunctionError("string_operator_plus_eq_wcstr", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringOperatorPlusEqWcstr)(FFI.StringOperatorPlusEqWcstr)
 	arg1 := (C.GDExtensionStringPtr)(p_self)
 	arg2 := (*C.wchar_t)(p_b)
@@ -3449,6 +3688,9 @@ func CallFunc_GDExtensionInterfaceStringOperatorPlusEqC32str(
 	p_self GDExtensionStringPtr,
 	p_b *Char32T,
 ) {
+	if FFI.StringOperatorPlusEqC32str == nil {
+		panic(newUnavailableFunctionError("string_operator_plus_eq_c32str", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringOperatorPlusEqC32str)(FFI.StringOperatorPlusEqC32str)
 	arg1 := (C.GDExtensionStringPtr)(p_self)
 	arg2 := (*C.char32_t)(p_b)
@@ -3463,6 +3705,9 @@ func CallFunc_GDExtensionInterfaceStringResize(
 	p_self GDExtensionStringPtr,
 	p_resize GDExtensionInt,
 ) GDExtensionInt {
+	if FFI.StringResize == nil {
+		panic(newUnavailableFunctionError("string_resize", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringResize)(FFI.StringResize)
 	arg1 := (C.GDExtensionStringPtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_resize)
@@ -3479,6 +3724,9 @@ func CallFunc_GDExtensionInterfaceStringNameNewWithLatin1Chars(
 	p_contents string,
 	p_is_static GDExtensionBool,
 ) {
+	if FFI.StringNameNewWithLatin1Chars == nil {
+		panic(newUnavailableFunctionError("string_name_new_with_latin1_chars", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNameNewWithLatin1Chars)(FFI.StringNameNewWithLatin1Chars)
 	arg1 := (C.GDExtensionUninitializedStringNamePtr)(r_dest)
 	arg2 := C.CString(p_contents)
@@ -3496,6 +3744,9 @@ func CallFunc_GDExtensionInterfaceStringNameNewWithUtf8Chars(
 	r_dest GDExtensionUninitializedStringNamePtr,
 	p_contents string,
 ) {
+	if FFI.StringNameNewWithUtf8Chars == nil {
+		panic(newUnavailableFunctionError("string_name_new_with_utf8_chars", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNameNewWithUtf8Chars)(FFI.StringNameNewWithUtf8Chars)
 	arg1 := (C.GDExtensionUninitializedStringNamePtr)(r_dest)
 	arg2 := C.CString(p_contents)
@@ -3513,6 +3764,9 @@ func CallFunc_GDExtensionInterfaceStringNameNewWithUtf8CharsAndLen(
 	p_contents string,
 	p_size GDExtensionInt,
 ) {
+	if FFI.StringNameNewWithUtf8CharsAndLen == nil {
+		panic(newUnavailableFunctionError("string_name_new_with_utf8_chars_and_len", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceStringNameNewWithUtf8CharsAndLen)(FFI.StringNameNewWithUtf8CharsAndLen)
 	arg1 := (C.GDExtensionUninitializedStringNamePtr)(r_dest)
 	arg2 := C.CString(p_contents)
@@ -3531,6 +3785,9 @@ func CallFunc_GDExtensionInterfaceXmlParserOpenBuffer(
 	p_buffer *Uint8T,
 	p_size uint64,
 ) GDExtensionInt {
+	if FFI.XmlParserOpenBuffer == nil {
+		panic(newUnavailableFunctionError("xml_parser_open_buffer", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceXmlParserOpenBuffer)(FFI.XmlParserOpenBuffer)
 	arg1 := (C.GDExtensionObjectPtr)(p_instance)
 	arg2 := (*C.uint8_t)(p_buffer)
@@ -3548,6 +3805,9 @@ func CallFunc_GDExtensionInterfaceFileAccessStoreBuffer(
 	p_src *Uint8T,
 	p_length Uint64T,
 ) {
+	if FFI.FileAccessStoreBuffer == nil {
+		panic(newUnavailableFunctionError("file_access_store_buffer", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceFileAccessStoreBuffer)(FFI.FileAccessStoreBuffer)
 	arg1 := (C.GDExtensionObjectPtr)(p_instance)
 	arg2 := (*C.uint8_t)(p_src)
@@ -3564,6 +3824,9 @@ func CallFunc_GDExtensionInterfaceFileAccessGetBuffer(
 	p_dst *Uint8T,
 	p_length Uint64T,
 ) uint64 {
+	if FFI.FileAccessGetBuffer == nil {
+		panic(newUnavailableFunctionError("file_access_get_buffer", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceFileAccessGetBuffer)(FFI.FileAccessGetBuffer)
 	arg1 := (C.GDExtensionConstObjectPtr)(p_instance)
 	arg2 := (*C.uint8_t)(p_dst)
@@ -3579,6 +3842,9 @@ func CallFunc_GDExtensionInterfaceFileAccessGetBuffer(
 func CallFunc_GDExtensionInterfaceImagePtrw(
 	p_instance GDExtensionObjectPtr,
 ) *uint8 {
+	if FFI.ImagePtrw == nil {
+		panic(newUnavailableFunctionError("image_ptrw", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfaceImagePtrw)(FFI.ImagePtrw)
 	arg1 := (C.GDExtensionObjectPtr)(p_instance)
 
@@ -3592,6 +3858,9 @@ func CallFunc_GDExtensionInterfaceImagePtrw(
 func CallFunc_GDExtensionInterfaceImagePtr(
 	p_instance GDExtensionObjectPtr,
 ) *uint8 {
+	if FFI.ImagePtr == nil {
+		panic(newUnavailableFunctionError("image_ptr", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfaceImagePtr)(FFI.ImagePtr)
 	arg1 := (C.GDExtensionObjectPtr)(p_instance)
 
@@ -3611,6 +3880,9 @@ func CallFunc_GDExtensionInterfaceWorkerThreadPoolAddNativeGroupTask(
 	p_high_priority GDExtensionBool,
 	p_description GDExtensionConstStringPtr,
 ) int64 {
+	if FFI.WorkerThreadPoolAddNativeGroupTask == nil {
+		panic(newUnavailableFunctionError("worker_thread_pool_add_native_group_task", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceWorkerThreadPoolAddNativeGroupTask)(FFI.WorkerThreadPoolAddNativeGroupTask)
 	arg1 := (C.GDExtensionObjectPtr)(p_instance)
 	arg2 := (C.GDExtensionWorkerThreadPoolGroupTask)(p_func)
@@ -3634,6 +3906,9 @@ func CallFunc_GDExtensionInterfaceWorkerThreadPoolAddNativeTask(
 	p_high_priority GDExtensionBool,
 	p_description GDExtensionConstStringPtr,
 ) int64 {
+	if FFI.WorkerThreadPoolAddNativeTask == nil {
+		panic(newUnavailableFunctionError("worker_thread_pool_add_native_task", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceWorkerThreadPoolAddNativeTask)(FFI.WorkerThreadPoolAddNativeTask)
 	arg1 := (C.GDExtensionObjectPtr)(p_instance)
 	arg2 := (C.GDExtensionWorkerThreadPoolTask)(p_func)
@@ -3652,6 +3927,9 @@ func CallFunc_GDExtensionInterfacePackedByteArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) *uint8 {
+	if FFI.PackedByteArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("packed_byte_array_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedByteArrayOperatorIndex)(FFI.PackedByteArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3667,6 +3945,9 @@ func CallFunc_GDExtensionInterfacePackedByteArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) *uint8 {
+	if FFI.PackedByteArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("packed_byte_array_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedByteArrayOperatorIndexConst)(FFI.PackedByteArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3682,6 +3963,9 @@ func CallFunc_GDExtensionInterfacePackedFloat32ArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) *float32 {
+	if FFI.PackedFloat32ArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("packed_float32_array_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedFloat32ArrayOperatorIndex)(FFI.PackedFloat32ArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3697,6 +3981,9 @@ func CallFunc_GDExtensionInterfacePackedFloat32ArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) *float32 {
+	if FFI.PackedFloat32ArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("packed_float32_array_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedFloat32ArrayOperatorIndexConst)(FFI.PackedFloat32ArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3712,6 +3999,9 @@ func CallFunc_GDExtensionInterfacePackedFloat64ArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) *float64 {
+	if FFI.PackedFloat64ArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("packed_float64_array_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedFloat64ArrayOperatorIndex)(FFI.PackedFloat64ArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3727,6 +4017,9 @@ func CallFunc_GDExtensionInterfacePackedFloat64ArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) *float64 {
+	if FFI.PackedFloat64ArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("packed_float64_array_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedFloat64ArrayOperatorIndexConst)(FFI.PackedFloat64ArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3742,6 +4035,9 @@ func CallFunc_GDExtensionInterfacePackedInt32ArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) *int32 {
+	if FFI.PackedInt32ArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("packed_int32_array_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedInt32ArrayOperatorIndex)(FFI.PackedInt32ArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3757,6 +4053,9 @@ func CallFunc_GDExtensionInterfacePackedInt32ArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) *int32 {
+	if FFI.PackedInt32ArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("packed_int32_array_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedInt32ArrayOperatorIndexConst)(FFI.PackedInt32ArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3772,6 +4071,9 @@ func CallFunc_GDExtensionInterfacePackedInt64ArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) *int64 {
+	if FFI.PackedInt64ArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("packed_int64_array_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedInt64ArrayOperatorIndex)(FFI.PackedInt64ArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3787,6 +4089,9 @@ func CallFunc_GDExtensionInterfacePackedInt64ArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) *int64 {
+	if FFI.PackedInt64ArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("packed_int64_array_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedInt64ArrayOperatorIndexConst)(FFI.PackedInt64ArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3802,6 +4107,9 @@ func CallFunc_GDExtensionInterfacePackedStringArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionStringPtr {
+	if FFI.PackedStringArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("packed_string_array_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedStringArrayOperatorIndex)(FFI.PackedStringArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3817,6 +4125,9 @@ func CallFunc_GDExtensionInterfacePackedStringArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionStringPtr {
+	if FFI.PackedStringArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("packed_string_array_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedStringArrayOperatorIndexConst)(FFI.PackedStringArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3832,6 +4143,9 @@ func CallFunc_GDExtensionInterfacePackedVector2ArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionTypePtr {
+	if FFI.PackedVector2ArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("packed_vector2_array_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedVector2ArrayOperatorIndex)(FFI.PackedVector2ArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3847,6 +4161,9 @@ func CallFunc_GDExtensionInterfacePackedVector2ArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionTypePtr {
+	if FFI.PackedVector2ArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("packed_vector2_array_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedVector2ArrayOperatorIndexConst)(FFI.PackedVector2ArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3862,6 +4179,9 @@ func CallFunc_GDExtensionInterfacePackedVector3ArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionTypePtr {
+	if FFI.PackedVector3ArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("packed_vector3_array_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedVector3ArrayOperatorIndex)(FFI.PackedVector3ArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3877,6 +4197,9 @@ func CallFunc_GDExtensionInterfacePackedVector3ArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionTypePtr {
+	if FFI.PackedVector3ArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("packed_vector3_array_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedVector3ArrayOperatorIndexConst)(FFI.PackedVector3ArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3892,6 +4215,9 @@ func CallFunc_GDExtensionInterfacePackedVector4ArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionTypePtr {
+	if FFI.PackedVector4ArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("packed_vector4_array_operator_index", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedVector4ArrayOperatorIndex)(FFI.PackedVector4ArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3907,6 +4233,9 @@ func CallFunc_GDExtensionInterfacePackedVector4ArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionTypePtr {
+	if FFI.PackedVector4ArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("packed_vector4_array_operator_index_const", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedVector4ArrayOperatorIndexConst)(FFI.PackedVector4ArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3922,6 +4251,9 @@ func CallFunc_GDExtensionInterfacePackedColorArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionTypePtr {
+	if FFI.PackedColorArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("packed_color_array_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedColorArrayOperatorIndex)(FFI.PackedColorArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3937,6 +4269,9 @@ func CallFunc_GDExtensionInterfacePackedColorArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionTypePtr {
+	if FFI.PackedColorArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("packed_color_array_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfacePackedColorArrayOperatorIndexConst)(FFI.PackedColorArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3952,6 +4287,9 @@ func CallFunc_GDExtensionInterfaceArrayOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionVariantPtr {
+	if FFI.ArrayOperatorIndex == nil {
+		panic(newUnavailableFunctionError("array_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceArrayOperatorIndex)(FFI.ArrayOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3967,6 +4305,9 @@ func CallFunc_GDExtensionInterfaceArrayOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_index GDExtensionInt,
 ) GDExtensionVariantPtr {
+	if FFI.ArrayOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("array_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceArrayOperatorIndexConst)(FFI.ArrayOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionInt)(p_index)
@@ -3978,10 +4319,14 @@ func CallFunc_GDExtensionInterfaceArrayOperatorIndexConst(
 	return (GDExtensionVariantPtr)(ret)
 }
 
+// Deprecated: in Godot 4.5. use `Array::operator=` instead.
 func CallFunc_GDExtensionInterfaceArrayRef(
 	p_self GDExtensionTypePtr,
 	p_from GDExtensionConstTypePtr,
 ) {
+	if FFI.ArrayRef == nil {
+		panic(newUnavailableFunctionError("array_ref", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceArrayRef)(FFI.ArrayRef)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionConstTypePtr)(p_from)
@@ -3998,6 +4343,9 @@ func CallFunc_GDExtensionInterfaceArraySetTyped(
 	p_class_name GDExtensionConstStringNamePtr,
 	p_script GDExtensionConstVariantPtr,
 ) {
+	if FFI.ArraySetTyped == nil {
+		panic(newUnavailableFunctionError("array_set_typed", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceArraySetTyped)(FFI.ArraySetTyped)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionVariantType)(p_type)
@@ -4014,6 +4362,9 @@ func CallFunc_GDExtensionInterfaceDictionaryOperatorIndex(
 	p_self GDExtensionTypePtr,
 	p_key GDExtensionConstVariantPtr,
 ) GDExtensionVariantPtr {
+	if FFI.DictionaryOperatorIndex == nil {
+		panic(newUnavailableFunctionError("dictionary_operator_index", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceDictionaryOperatorIndex)(FFI.DictionaryOperatorIndex)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionConstVariantPtr)(p_key)
@@ -4029,6 +4380,9 @@ func CallFunc_GDExtensionInterfaceDictionaryOperatorIndexConst(
 	p_self GDExtensionConstTypePtr,
 	p_key GDExtensionConstVariantPtr,
 ) GDExtensionVariantPtr {
+	if FFI.DictionaryOperatorIndexConst == nil {
+		panic(newUnavailableFunctionError("dictionary_operator_index_const", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceDictionaryOperatorIndexConst)(FFI.DictionaryOperatorIndexConst)
 	arg1 := (C.GDExtensionConstTypePtr)(p_self)
 	arg2 := (C.GDExtensionConstVariantPtr)(p_key)
@@ -4049,6 +4403,9 @@ func CallFunc_GDExtensionInterfaceDictionarySetTyped(
 	p_value_class_name GDExtensionConstStringNamePtr,
 	p_value_script GDExtensionConstVariantPtr,
 ) {
+	if FFI.DictionarySetTyped == nil {
+		panic(newUnavailableFunctionError("dictionary_set_typed", "4.4"))
+	}
 	arg0 := (C.GDExtensionInterfaceDictionarySetTyped)(FFI.DictionarySetTyped)
 	arg1 := (C.GDExtensionTypePtr)(p_self)
 	arg2 := (C.GDExtensionVariantType)(p_key_type)
@@ -4072,6 +4429,9 @@ func CallFunc_GDExtensionInterfaceObjectMethodBindCall(
 	r_ret GDExtensionUninitializedVariantPtr,
 	r_error *GDExtensionCallError,
 ) {
+	if FFI.ObjectMethodBindCall == nil {
+		panic(newUnavailableFunctionError("object_method_bind_call", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectMethodBindCall)(FFI.ObjectMethodBindCall)
 	arg1 := (C.GDExtensionMethodBindPtr)(p_method_bind)
 	arg2 := (C.GDExtensionObjectPtr)(p_instance)
@@ -4092,6 +4452,9 @@ func CallFunc_GDExtensionInterfaceObjectMethodBindPtrcall(
 	p_args *GDExtensionConstTypePtr,
 	r_ret GDExtensionTypePtr,
 ) {
+	if FFI.ObjectMethodBindPtrcall == nil {
+		panic(newUnavailableFunctionError("object_method_bind_ptrcall", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectMethodBindPtrcall)(FFI.ObjectMethodBindPtrcall)
 	arg1 := (C.GDExtensionMethodBindPtr)(p_method_bind)
 	arg2 := (C.GDExtensionObjectPtr)(p_instance)
@@ -4107,6 +4470,9 @@ func CallFunc_GDExtensionInterfaceObjectMethodBindPtrcall(
 func CallFunc_GDExtensionInterfaceObjectDestroy(
 	p_o GDExtensionObjectPtr,
 ) {
+	if FFI.ObjectDestroy == nil {
+		panic(newUnavailableFunctionError("object_destroy", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectDestroy)(FFI.ObjectDestroy)
 	arg1 := (C.GDExtensionObjectPtr)(p_o)
 
@@ -4119,6 +4485,9 @@ func CallFunc_GDExtensionInterfaceObjectDestroy(
 func CallFunc_GDExtensionInterfaceGlobalGetSingleton(
 	p_name GDExtensionConstStringNamePtr,
 ) GDExtensionObjectPtr {
+	if FFI.GlobalGetSingleton == nil {
+		panic(newUnavailableFunctionError("global_get_singleton", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceGlobalGetSingleton)(FFI.GlobalGetSingleton)
 	arg1 := (C.GDExtensionConstStringNamePtr)(p_name)
 
@@ -4134,6 +4503,9 @@ func CallFunc_GDExtensionInterfaceObjectGetInstanceBinding(
 	p_token unsafe.Pointer,
 	p_callbacks *GDExtensionInstanceBindingCallbacks,
 ) unsafe.Pointer {
+	if FFI.ObjectGetInstanceBinding == nil {
+		panic(newUnavailableFunctionError("object_get_instance_binding", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectGetInstanceBinding)(FFI.ObjectGetInstanceBinding)
 	arg1 := (C.GDExtensionObjectPtr)(p_o)
 	arg2 := unsafe.Pointer(p_token)
@@ -4143,8 +4515,6 @@ func CallFunc_GDExtensionInterfaceObjectGetInstanceBinding(
 
 	ret := C.cgo_callfn_GDExtensionInterfaceObjectGetInstanceBinding(arg0, arg1, arg2, arg3)
 
-	pnr.Pin(ret)
-
 	return unsafe.Pointer(ret)
 }
 
@@ -4154,6 +4524,9 @@ func CallFunc_GDExtensionInterfaceObjectSetInstanceBinding(
 	p_binding cgo.Handle,
 	p_callbacks *GDExtensionInstanceBindingCallbacks,
 ) {
+	if FFI.ObjectSetInstanceBinding == nil {
+		panic(newUnavailableFunctionError("object_set_instance_binding", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectSetInstanceBinding)(FFI.ObjectSetInstanceBinding)
 	arg1 := (C.GDExtensionObjectPtr)(p_o)
 	arg2 := unsafe.Pointer(p_token)
@@ -4170,6 +4543,9 @@ func CallFunc_GDExtensionInterfaceObjectFreeInstanceBinding(
 	p_o GDExtensionObjectPtr,
 	p_token unsafe.Pointer,
 ) {
+	if FFI.ObjectFreeInstanceBinding == nil {
+		panic(newUnavailableFunctionError("object_free_instance_binding", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectFreeInstanceBinding)(FFI.ObjectFreeInstanceBinding)
 	arg1 := (C.GDExtensionObjectPtr)(p_o)
 	arg2 := unsafe.Pointer(p_token)
@@ -4185,6 +4561,9 @@ func CallFunc_GDExtensionInterfaceObjectSetInstance(
 	p_classname GDExtensionConstStringNamePtr,
 	p_instance GDExtensionClassInstancePtr,
 ) {
+	if FFI.ObjectSetInstance == nil {
+		panic(newUnavailableFunctionError("object_set_instance", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectSetInstance)(FFI.ObjectSetInstance)
 	arg1 := (C.GDExtensionObjectPtr)(p_o)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_classname)
@@ -4201,6 +4580,9 @@ func CallFunc_GDExtensionInterfaceObjectGetClassName(
 	p_library GDExtensionClassLibraryPtr,
 	r_class_name GDExtensionUninitializedStringNamePtr,
 ) GDExtensionBool {
+	if FFI.ObjectGetClassName == nil {
+		panic(newUnavailableFunctionError("object_get_class_name", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectGetClassName)(FFI.ObjectGetClassName)
 	arg1 := (C.GDExtensionConstObjectPtr)(p_object)
 	arg2 := (C.GDExtensionClassLibraryPtr)(p_library)
@@ -4217,6 +4599,9 @@ func CallFunc_GDExtensionInterfaceObjectCastTo(
 	p_object GDExtensionConstObjectPtr,
 	p_class_tag unsafe.Pointer,
 ) GDExtensionObjectPtr {
+	if FFI.ObjectCastTo == nil {
+		panic(newUnavailableFunctionError("object_cast_to", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectCastTo)(FFI.ObjectCastTo)
 	arg1 := (C.GDExtensionConstObjectPtr)(p_object)
 	arg2 := unsafe.Pointer(p_class_tag)
@@ -4231,6 +4616,9 @@ func CallFunc_GDExtensionInterfaceObjectCastTo(
 func CallFunc_GDExtensionInterfaceObjectGetInstanceFromId(
 	p_instance_id GDObjectInstanceID,
 ) GDExtensionObjectPtr {
+	if FFI.ObjectGetInstanceFromId == nil {
+		panic(newUnavailableFunctionError("object_get_instance_from_id", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectGetInstanceFromId)(FFI.ObjectGetInstanceFromId)
 	arg1 := (C.GDObjectInstanceID)(p_instance_id)
 
@@ -4244,6 +4632,9 @@ func CallFunc_GDExtensionInterfaceObjectGetInstanceFromId(
 func CallFunc_GDExtensionInterfaceObjectGetInstanceId(
 	p_object GDExtensionConstObjectPtr,
 ) GDObjectInstanceID {
+	if FFI.ObjectGetInstanceId == nil {
+		panic(newUnavailableFunctionError("object_get_instance_id", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectGetInstanceId)(FFI.ObjectGetInstanceId)
 	arg1 := (C.GDExtensionConstObjectPtr)(p_object)
 
@@ -4258,6 +4649,9 @@ func CallFunc_GDExtensionInterfaceObjectHasScriptMethod(
 	p_object GDExtensionConstObjectPtr,
 	p_method GDExtensionConstStringNamePtr,
 ) GDExtensionBool {
+	if FFI.ObjectHasScriptMethod == nil {
+		panic(newUnavailableFunctionError("object_has_script_method", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectHasScriptMethod)(FFI.ObjectHasScriptMethod)
 	arg1 := (C.GDExtensionConstObjectPtr)(p_object)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_method)
@@ -4277,6 +4671,9 @@ func CallFunc_GDExtensionInterfaceObjectCallScriptMethod(
 	r_return GDExtensionUninitializedVariantPtr,
 	r_error *GDExtensionCallError,
 ) {
+	if FFI.ObjectCallScriptMethod == nil {
+		panic(newUnavailableFunctionError("object_call_script_method", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectCallScriptMethod)(FFI.ObjectCallScriptMethod)
 	arg1 := (C.GDExtensionObjectPtr)(p_object)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_method)
@@ -4294,6 +4691,9 @@ func CallFunc_GDExtensionInterfaceObjectCallScriptMethod(
 func CallFunc_GDExtensionInterfaceRefGetObject(
 	p_ref GDExtensionConstRefPtr,
 ) GDExtensionObjectPtr {
+	if FFI.RefGetObject == nil {
+		panic(newUnavailableFunctionError("ref_get_object", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceRefGetObject)(FFI.RefGetObject)
 	arg1 := (C.GDExtensionConstRefPtr)(p_ref)
 
@@ -4308,6 +4708,9 @@ func CallFunc_GDExtensionInterfaceRefSetObject(
 	p_ref GDExtensionRefPtr,
 	p_object GDExtensionObjectPtr,
 ) {
+	if FFI.RefSetObject == nil {
+		panic(newUnavailableFunctionError("ref_set_object", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceRefSetObject)(FFI.RefSetObject)
 	arg1 := (C.GDExtensionRefPtr)(p_ref)
 	arg2 := (C.GDExtensionObjectPtr)(p_object)
@@ -4318,10 +4721,14 @@ func CallFunc_GDExtensionInterfaceRefSetObject(
 
 }
 
+// Deprecated: in Godot 4.2. Use `script_instance_create3` instead.
 func CallFunc_GDExtensionInterfaceScriptInstanceCreate(
 	p_info *GDExtensionScriptInstanceInfo,
 	p_instance_data GDExtensionScriptInstanceDataPtr,
 ) GDExtensionScriptInstancePtr {
+	if FFI.ScriptInstanceCreate == nil {
+		panic(newUnavailableFunctionError("script_instance_create", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceScriptInstanceCreate)(FFI.ScriptInstanceCreate)
 	arg1 := (*C.GDExtensionScriptInstanceInfo)(p_info)
 	arg2 := (C.GDExtensionScriptInstanceDataPtr)(p_instance_data)
@@ -4333,10 +4740,14 @@ func CallFunc_GDExtensionInterfaceScriptInstanceCreate(
 	return (GDExtensionScriptInstancePtr)(ret)
 }
 
+// Deprecated: in Godot 4.3. Use `script_instance_create3` instead.
 func CallFunc_GDExtensionInterfaceScriptInstanceCreate2(
 	p_info *GDExtensionScriptInstanceInfo2,
 	p_instance_data GDExtensionScriptInstanceDataPtr,
 ) GDExtensionScriptInstancePtr {
+	if FFI.ScriptInstanceCreate2 == nil {
+		panic(newUnavailableFunctionError("script_instance_create2", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceScriptInstanceCreate2)(FFI.ScriptInstanceCreate2)
 	arg1 := (*C.GDExtensionScriptInstanceInfo2)(p_info)
 	arg2 := (C.GDExtensionScriptInstanceDataPtr)(p_instance_data)
@@ -4352,6 +4763,9 @@ func CallFunc_GDExtensionInterfaceScriptInstanceCreate3(
 	p_info *GDExtensionScriptInstanceInfo3,
 	p_instance_data GDExtensionScriptInstanceDataPtr,
 ) GDExtensionScriptInstancePtr {
+	if FFI.ScriptInstanceCreate3 == nil {
+		panic(newUnavailableFunctionError("script_instance_create3", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfaceScriptInstanceCreate3)(FFI.ScriptInstanceCreate3)
 	arg1 := (*C.GDExtensionScriptInstanceInfo3)(p_info)
 	arg2 := (C.GDExtensionScriptInstanceDataPtr)(p_instance_data)
@@ -4368,6 +4782,9 @@ func CallFunc_GDExtensionInterfacePlaceHolderScriptInstanceCreate(
 	p_script GDExtensionObjectPtr,
 	p_owner GDExtensionObjectPtr,
 ) GDExtensionScriptInstancePtr {
+	if FFI.PlaceHolderScriptInstanceCreate == nil {
+		panic(newUnavailableFunctionError("placeholder_script_instance_create", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfacePlaceHolderScriptInstanceCreate)(FFI.PlaceHolderScriptInstanceCreate)
 	arg1 := (C.GDExtensionObjectPtr)(p_language)
 	arg2 := (C.GDExtensionObjectPtr)(p_script)
@@ -4385,6 +4802,9 @@ func CallFunc_GDExtensionInterfacePlaceHolderScriptInstanceUpdate(
 	p_properties GDExtensionConstTypePtr,
 	p_values GDExtensionConstTypePtr,
 ) {
+	if FFI.PlaceHolderScriptInstanceUpdate == nil {
+		panic(newUnavailableFunctionError("placeholder_script_instance_update", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfacePlaceHolderScriptInstanceUpdate)(FFI.PlaceHolderScriptInstanceUpdate)
 	arg1 := (C.GDExtensionScriptInstancePtr)(p_placeholder)
 	arg2 := (C.GDExtensionConstTypePtr)(p_properties)
@@ -4400,6 +4820,9 @@ func CallFunc_GDExtensionInterfaceObjectGetScriptInstance(
 	p_object GDExtensionConstObjectPtr,
 	p_language GDExtensionObjectPtr,
 ) GDExtensionScriptInstanceDataPtr {
+	if FFI.ObjectGetScriptInstance == nil {
+		panic(newUnavailableFunctionError("object_get_script_instance", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectGetScriptInstance)(FFI.ObjectGetScriptInstance)
 	arg1 := (C.GDExtensionConstObjectPtr)(p_object)
 	arg2 := (C.GDExtensionObjectPtr)(p_language)
@@ -4415,6 +4838,9 @@ func CallFunc_GDExtensionInterfaceObjectSetScriptInstance(
 	p_object GDExtensionObjectPtr,
 	p_script_instance GDExtensionScriptInstanceDataPtr,
 ) {
+	if FFI.ObjectSetScriptInstance == nil {
+		panic(newUnavailableFunctionError("object_set_script_instance", "4.5"))
+	}
 	arg0 := (C.GDExtensionInterfaceObjectSetScriptInstance)(FFI.ObjectSetScriptInstance)
 	arg1 := (C.GDExtensionObjectPtr)(p_object)
 	arg2 := (C.GDExtensionScriptInstanceDataPtr)(p_script_instance)
@@ -4425,10 +4851,14 @@ func CallFunc_GDExtensionInterfaceObjectSetScriptInstance(
 
 }
 
+// Deprecated: in Godot 4.3. Use `callable_custom_create2` instead.
 func CallFunc_GDExtensionInterfaceCallableCustomCreate(
 	r_callable GDExtensionUninitializedTypePtr,
 	p_callable_custom_info *GDExtensionCallableCustomInfo,
 ) {
+	if FFI.CallableCustomCreate == nil {
+		panic(newUnavailableFunctionError("callable_custom_create", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceCallableCustomCreate)(FFI.CallableCustomCreate)
 	arg1 := (C.GDExtensionUninitializedTypePtr)(r_callable)
 	arg2 := (*C.GDExtensionCallableCustomInfo)(p_callable_custom_info)
@@ -4443,6 +4873,9 @@ func CallFunc_GDExtensionInterfaceCallableCustomCreate2(
 	r_callable GDExtensionUninitializedTypePtr,
 	p_callable_custom_info *GDExtensionCallableCustomInfo2,
 ) {
+	if FFI.CallableCustomCreate2 == nil {
+		panic(newUnavailableFunctionError("callable_custom_create2", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfaceCallableCustomCreate2)(FFI.CallableCustomCreate2)
 	arg1 := (C.GDExtensionUninitializedTypePtr)(r_callable)
 	arg2 := (*C.GDExtensionCallableCustomInfo2)(p_callable_custom_info)
@@ -4457,6 +4890,9 @@ func CallFunc_GDExtensionInterfaceCallableCustomGetUserData(
 	p_callable GDExtensionConstTypePtr,
 	p_token unsafe.Pointer,
 ) unsafe.Pointer {
+	if FFI.CallableCustomGetUserData == nil {
+		panic(newUnavailableFunctionError("callable_custom_get_userdata", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceCallableCustomGetUserData)(FFI.CallableCustomGetUserData)
 	arg1 := (C.GDExtensionConstTypePtr)(p_callable)
 	arg2 := unsafe.Pointer(p_token)
@@ -4465,14 +4901,16 @@ func CallFunc_GDExtensionInterfaceCallableCustomGetUserData(
 
 	ret := C.cgo_callfn_GDExtensionInterfaceCallableCustomGetUserData(arg0, arg1, arg2)
 
-	pnr.Pin(ret)
-
 	return unsafe.Pointer(ret)
 }
 
+// Deprecated: in Godot 4.4. Use `classdb_construct_object2` instead.
 func CallFunc_GDExtensionInterfaceClassdbConstructObject(
 	p_classname GDExtensionConstStringNamePtr,
 ) GDExtensionObjectPtr {
+	if FFI.ClassdbConstructObject == nil {
+		panic(newUnavailableFunctionError("classdb_construct_object", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbConstructObject)(FFI.ClassdbConstructObject)
 	arg1 := (C.GDExtensionConstStringNamePtr)(p_classname)
 
@@ -4486,6 +4924,9 @@ func CallFunc_GDExtensionInterfaceClassdbConstructObject(
 func CallFunc_GDExtensionInterfaceClassdbConstructObject2(
 	p_classname GDExtensionConstStringNamePtr,
 ) GDExtensionObjectPtr {
+	if FFI.ClassdbConstructObject2 == nil {
+		panic(newUnavailableFunctionError("classdb_construct_object2", "4.4"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbConstructObject2)(FFI.ClassdbConstructObject2)
 	arg1 := (C.GDExtensionConstStringNamePtr)(p_classname)
 
@@ -4501,6 +4942,9 @@ func CallFunc_GDExtensionInterfaceClassdbGetMethodBind(
 	p_methodname GDExtensionConstStringNamePtr,
 	p_hash GDExtensionInt,
 ) GDExtensionMethodBindPtr {
+	if FFI.ClassdbGetMethodBind == nil {
+		panic(newUnavailableFunctionError("classdb_get_method_bind", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbGetMethodBind)(FFI.ClassdbGetMethodBind)
 	arg1 := (C.GDExtensionConstStringNamePtr)(p_classname)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_methodname)
@@ -4516,6 +4960,9 @@ func CallFunc_GDExtensionInterfaceClassdbGetMethodBind(
 func CallFunc_GDExtensionInterfaceClassdbGetClassTag(
 	p_classname GDExtensionConstStringNamePtr,
 ) unsafe.Pointer {
+	if FFI.ClassdbGetClassTag == nil {
+		panic(newUnavailableFunctionError("classdb_get_class_tag", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbGetClassTag)(FFI.ClassdbGetClassTag)
 	arg1 := (C.GDExtensionConstStringNamePtr)(p_classname)
 
@@ -4523,17 +4970,19 @@ func CallFunc_GDExtensionInterfaceClassdbGetClassTag(
 
 	ret := C.cgo_callfn_GDExtensionInterfaceClassdbGetClassTag(arg0, arg1)
 
-	pnr.Pin(ret)
-
 	return unsafe.Pointer(ret)
 }
 
+// Deprecated: in Godot 4.2. Use `classdb_register_extension_class4` instead.
 func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClass(
 	p_library GDExtensionClassLibraryPtr,
 	p_class_name GDExtensionConstStringNamePtr,
 	p_parent_class_name GDExtensionConstStringNamePtr,
 	p_extension_funcs *GDExtensionClassCreationInfo,
 ) {
+	if FFI.ClassdbRegisterExtensionClass == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClass)(FFI.ClassdbRegisterExtensionClass)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4546,12 +4995,16 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClass(
 
 }
 
+// Deprecated: in Godot 4.3. Use `classdb_register_extension_class4` instead.
 func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClass2(
 	p_library GDExtensionClassLibraryPtr,
 	p_class_name GDExtensionConstStringNamePtr,
 	p_parent_class_name GDExtensionConstStringNamePtr,
 	p_extension_funcs *GDExtensionClassCreationInfo2,
 ) {
+	if FFI.ClassdbRegisterExtensionClass2 == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class2", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClass2)(FFI.ClassdbRegisterExtensionClass2)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4564,12 +5017,16 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClass2(
 
 }
 
+// Deprecated: in Godot 4.4. Use `classdb_register_extension_class4` instead.
 func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClass3(
 	p_library GDExtensionClassLibraryPtr,
 	p_class_name GDExtensionConstStringNamePtr,
 	p_parent_class_name GDExtensionConstStringNamePtr,
 	p_extension_funcs *GDExtensionClassCreationInfo3,
 ) {
+	if FFI.ClassdbRegisterExtensionClass3 == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class3", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClass3)(FFI.ClassdbRegisterExtensionClass3)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4582,12 +5039,16 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClass3(
 
 }
 
+// Deprecated: in Godot 4.5. Use `classdb_register_extension_class5` instead.
 func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClass4(
 	p_library GDExtensionClassLibraryPtr,
 	p_class_name GDExtensionConstStringNamePtr,
 	p_parent_class_name GDExtensionConstStringNamePtr,
 	p_extension_funcs *GDExtensionClassCreationInfo4,
 ) {
+	if FFI.ClassdbRegisterExtensionClass4 == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class4", "4.4"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClass4)(FFI.ClassdbRegisterExtensionClass4)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4606,6 +5067,9 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClass5(
 	p_parent_class_name GDExtensionConstStringNamePtr,
 	p_extension_funcs *GDExtensionClassCreationInfo5,
 ) {
+	if FFI.ClassdbRegisterExtensionClass5 == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class5", "4.5"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClass5)(FFI.ClassdbRegisterExtensionClass5)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4623,6 +5087,9 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassMethod(
 	p_class_name GDExtensionConstStringNamePtr,
 	p_method_info *GDExtensionClassMethodInfo,
 ) {
+	if FFI.ClassdbRegisterExtensionClassMethod == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class_method", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClassMethod)(FFI.ClassdbRegisterExtensionClassMethod)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4639,6 +5106,9 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassVirtualMethod(
 	p_class_name GDExtensionConstStringNamePtr,
 	p_method_info *GDExtensionClassVirtualMethodInfo,
 ) {
+	if FFI.ClassdbRegisterExtensionClassVirtualMethod == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class_virtual_method", "4.3"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClassVirtualMethod)(FFI.ClassdbRegisterExtensionClassVirtualMethod)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4658,6 +5128,9 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassIntegerConstant(
 	p_constant_value GDExtensionInt,
 	p_is_bitfield GDExtensionBool,
 ) {
+	if FFI.ClassdbRegisterExtensionClassIntegerConstant == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class_integer_constant", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClassIntegerConstant)(FFI.ClassdbRegisterExtensionClassIntegerConstant)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4679,6 +5152,9 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassProperty(
 	p_setter GDExtensionConstStringNamePtr,
 	p_getter GDExtensionConstStringNamePtr,
 ) {
+	if FFI.ClassdbRegisterExtensionClassProperty == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class_property", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClassProperty)(FFI.ClassdbRegisterExtensionClassProperty)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4700,6 +5176,9 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassPropertyIndexed(
 	p_getter GDExtensionConstStringNamePtr,
 	p_index GDExtensionInt,
 ) {
+	if FFI.ClassdbRegisterExtensionClassPropertyIndexed == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class_property_indexed", "4.2"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClassPropertyIndexed)(FFI.ClassdbRegisterExtensionClassPropertyIndexed)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4720,6 +5199,9 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassPropertyGroup(
 	p_group_name GDExtensionConstStringPtr,
 	p_prefix GDExtensionConstStringPtr,
 ) {
+	if FFI.ClassdbRegisterExtensionClassPropertyGroup == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class_property_group", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClassPropertyGroup)(FFI.ClassdbRegisterExtensionClassPropertyGroup)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4738,6 +5220,9 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassPropertySubgroup(
 	p_subgroup_name GDExtensionConstStringPtr,
 	p_prefix GDExtensionConstStringPtr,
 ) {
+	if FFI.ClassdbRegisterExtensionClassPropertySubgroup == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class_property_subgroup", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClassPropertySubgroup)(FFI.ClassdbRegisterExtensionClassPropertySubgroup)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4757,6 +5242,9 @@ func CallFunc_GDExtensionInterfaceClassdbRegisterExtensionClassSignal(
 	p_argument_info *GDExtensionPropertyInfo,
 	p_argument_count GDExtensionInt,
 ) {
+	if FFI.ClassdbRegisterExtensionClassSignal == nil {
+		panic(newUnavailableFunctionError("classdb_register_extension_class_signal", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbRegisterExtensionClassSignal)(FFI.ClassdbRegisterExtensionClassSignal)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4774,6 +5262,9 @@ func CallFunc_GDExtensionInterfaceClassdbUnregisterExtensionClass(
 	p_library GDExtensionClassLibraryPtr,
 	p_class_name GDExtensionConstStringNamePtr,
 ) {
+	if FFI.ClassdbUnregisterExtensionClass == nil {
+		panic(newUnavailableFunctionError("classdb_unregister_extension_class", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceClassdbUnregisterExtensionClass)(FFI.ClassdbUnregisterExtensionClass)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionConstStringNamePtr)(p_class_name)
@@ -4788,6 +5279,9 @@ func CallFunc_GDExtensionInterfaceGetLibraryPath(
 	p_library GDExtensionClassLibraryPtr,
 	r_path GDExtensionUninitializedStringPtr,
 ) {
+	if FFI.GetLibraryPath == nil {
+		panic(newUnavailableFunctionError("get_library_path", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceGetLibraryPath)(FFI.GetLibraryPath)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionUninitializedStringPtr)(r_path)
@@ -4801,6 +5295,9 @@ func CallFunc_GDExtensionInterfaceGetLibraryPath(
 func CallFunc_GDExtensionInterfaceEditorAddPlugin(
 	p_class_name GDExtensionConstStringNamePtr,
 ) {
+	if FFI.EditorAddPlugin == nil {
+		panic(newUnavailableFunctionError("editor_add_plugin", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceEditorAddPlugin)(FFI.EditorAddPlugin)
 	arg1 := (C.GDExtensionConstStringNamePtr)(p_class_name)
 
@@ -4813,6 +5310,9 @@ func CallFunc_GDExtensionInterfaceEditorAddPlugin(
 func CallFunc_GDExtensionInterfaceEditorRemovePlugin(
 	p_class_name GDExtensionConstStringNamePtr,
 ) {
+	if FFI.EditorRemovePlugin == nil {
+		panic(newUnavailableFunctionError("editor_remove_plugin", "4.1"))
+	}
 	arg0 := (C.GDExtensionInterfaceEditorRemovePlugin)(FFI.EditorRemovePlugin)
 	arg1 := (C.GDExtensionConstStringNamePtr)(p_class_name)
 
@@ -4826,6 +5326,9 @@ func CallFunc_GDExtensionInterfaceEditorRegisterGetClassesUsedCallback(
 	p_library GDExtensionClassLibraryPtr,
 	p_callback GDExtensionEditorGetClassesUsedCallback,
 ) {
+	if FFI.EditorRegisterGetClassesUsedCallback == nil {
+		panic(newUnavailableFunctionError("editor_register_get_classes_used_callback", "4.5"))
+	}
 	arg0 := (C.GDExtensionInterfaceEditorRegisterGetClassesUsedCallback)(FFI.EditorRegisterGetClassesUsedCallback)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (C.GDExtensionEditorGetClassesUsedCallback)(p_callback)
@@ -4840,6 +5343,9 @@ func CallFunc_GDExtensionInterfaceRegisterMainLoopCallbacks(
 	p_library GDExtensionClassLibraryPtr,
 	p_callbacks *GDExtensionMainLoopCallbacks,
 ) {
+	if FFI.RegisterMainLoopCallbacks == nil {
+		panic(newUnavailableFunctionError("register_main_loop_callbacks", "4.5"))
+	}
 	arg0 := (C.GDExtensionInterfaceRegisterMainLoopCallbacks)(FFI.RegisterMainLoopCallbacks)
 	arg1 := (C.GDExtensionClassLibraryPtr)(p_library)
 	arg2 := (*C.GDExtensionMainLoopCallbacks)(p_callbacks)
@@ -11,27 +11,25 @@ package ffi
 import "C"
 
 import (
-	"runtime"
+	"unsafe"
 	// "github.com/CannibalVox/cgoalloc"
 )
 
-var pnr = runtime.Pinner{}
-
-// UnpinFFIPins releases pins held for the extension lifetime during shutdown.
-func UnpinFFIPins() {
-	pnr.Unpin()
-}
-
+// NewGDExtensionInstanceBindingCallbacks allocates the callbacks in C memory.
+// The engine keeps the pointer passed to object_get_instance_binding for as
+// long as the binding exists, so it cannot point into the Go heap.
 func NewGDExtensionInstanceBindingCallbacks(
 	createCallback GDExtensionInstanceBindingCreateCallback,
 	freeCallback GDExtensionInstanceBindingFreeCallback,
 	referenceCallback GDExtensionInstanceBindingReferenceCallback,
-) GDExtensionInstanceBindingCallbacks {
-	return GDExtensionInstanceBindingCallbacks{
+) *GDExtensionInstanceBindingCallbacks {
+	cbs := (*GDExtensionInstanceBindingCallbacks)(C.malloc(C.size_t(unsafe.Sizeof(GDExtensionInstanceBindingCallbacks{}))))
+	*cbs = GDExtensionInstanceBindingCallbacks{
 		create_callback:    (C.GDExtensionInstanceBindingCreateCallback)(createCallback),
 		free_callback:      (C.GDExtensionInstanceBindingFreeCallback)(freeCallback),
 		reference_callback: (C.GDExtensionInstanceBindingReferenceCallback)(referenceCallback),
 	}
+	return cbs
 }
 
 func (e *GDExtensionInitialization) SetCallbacks(
@@ -48,7 +46,6 @@ func (e *GDExtensionInitialization) SetInitializationLevel(level GDExtensionInit
 
 func init() {
 	FFI.GodotVersion = new(GDExtensionGodotVersion)
-	pnr.Pin(FFI.GodotVersion)
 	// mem := cgoalloc.DefaultAllocator{}
 
 	// FFI.GodotVersion = (*GDExtensionGodotVersion)(mem.Malloc(int(unsafe.Sizeof(GDExtensionGodotVersion{}))))
@@ -0,0 +1,29 @@
+package ffi
+
+// GodotLogPrinter implements log.GodotPrinter with the engine's print_error
+// family of functions.
+type GodotLogPrinter struct{}
+
+func (GodotLogPrinter) PrintWarning(description, message, function, file string, line int32) {
+	if message == "" {
+		CallFunc_GDExtensionInterfacePrintWarning(description, function, file, line, 0)
+		return
+	}
+	CallFunc_GDExtensionInterfacePrintWarningWithMessage(description, message, function, file, line, 0)
+}
+
+func (GodotLogPrinter) PrintError(description, message, function, file string, line int32) {
+	if message == "" {
+		CallFunc_GDExtensionInterfacePrintError(description, function, file, line, 0)
+		return
+	}
+	CallFunc_GDExtensionInterfacePrintErrorWithMessage(description, message, function, file, line, 0)
+}
+
+func (GodotLogPrinter) PrintScriptError(description, message, function, file string, line int32) {
+	if message == "" {
+		CallFunc_GDExtensionInterfacePrintScriptError(description, function, file, line, 0)
+		return
+	}
+	CallFunc_GDExtensionInterfacePrintScriptErrorWithMessage(description, message, function, file, line, 0)
+}
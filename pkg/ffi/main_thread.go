@@ -0,0 +1,78 @@
+package ffi
+
+/*
+#include <stdint.h>
+#ifdef _WIN32
+#include <windows.h>
+static uint64_t cgo_current_thread_id(void) {
+	return (uint64_t)GetCurrentThreadId();
+}
+#else
+#include <pthread.h>
+static uint64_t cgo_current_thread_id(void) {
+	return (uint64_t)(uintptr_t)pthread_self();
+}
+#endif
+*/
+import "C"
+
+import (
+	"os"
+	"sync/atomic"
+
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+const envMainThreadChecks = "GODOT_GO_MAIN_THREAD_CHECKS"
+
+var (
+	mainThreadID     atomic.Uint64
+	mainThreadKnown  atomic.Bool
+	mainThreadChecks atomic.Bool
+)
+
+func init() {
+	if v, ok := os.LookupEnv(envMainThreadChecks); ok && v != "" && v != "0" {
+		mainThreadChecks.Store(true)
+	}
+}
+
+// SetMainThread records the calling OS thread as Godot's main thread. It is
+// called from the extension entry point, which Godot runs on the main thread.
+// A goroutine handling an engine callback stays on the thread that made the
+// call, so the check holds for all Go code called from the engine.
+func SetMainThread() {
+	mainThreadID.Store(uint64(C.cgo_current_thread_id()))
+	mainThreadKnown.Store(true)
+}
+
+// IsMainThread reports whether the caller runs on Godot's main thread. It
+// returns true until SetMainThread has been called.
+func IsMainThread() bool {
+	if !mainThreadKnown.Load() {
+		return true
+	}
+	return uint64(C.cgo_current_thread_id()) == mainThreadID.Load()
+}
+
+// SetMainThreadChecks turns the main thread assertion in the generated
+// engine wrappers on or off. It is off by default; setting the environment
+// variable GODOT_GO_MAIN_THREAD_CHECKS=1 turns it on at startup.
+func SetMainThreadChecks(enabled bool) {
+	mainThreadChecks.Store(enabled)
+}
+
+// AssertMainThread logs an error with the Go stack trace when main thread
+// checks are enabled and the caller is not on the main thread. The generated
+// wrappers of Node and its subclasses call it before every engine call.
+func AssertMainThread(className, methodName string) {
+	if !mainThreadChecks.Load() || IsMainThread() {
+		return
+	}
+	log.Error("engine method called off the main thread; use RunOnMainThread",
+		zap.String("class", className),
+		zap.String("method", methodName),
+		zap.Stack("stack"),
+	)
+}
@@ -10,6 +10,7 @@ package ffi
 import "C"
 
 import (
+	"runtime"
 	"unsafe"
 
 	"github.com/godot-go/godot-go/pkg/constant"
@@ -44,6 +45,16 @@ func (p *GDExtensionPropertyInfo) Name() GDExtensionStringNamePtr {
 	return (GDExtensionStringNamePtr)(typed.name)
 }
 
+// Pin pins the Go memory of the name, class name and hint string so the
+// property info can be handed to the engine. The pointers it refers to are
+// typically StringName and String values allocated by Go.
+func (p *GDExtensionPropertyInfo) Pin(pinner *runtime.Pinner) {
+	cp := (*C.GDExtensionPropertyInfo)(p)
+	pinner.Pin(unsafe.Pointer(cp.name))
+	pinner.Pin(unsafe.Pointer(cp.class_name))
+	pinner.Pin(unsafe.Pointer(cp.hint_string))
+}
+
 func (p *GDExtensionPropertyInfo) Destroy() {
 	cp := (*C.GDExtensionPropertyInfo)(p)
 	stringNameDestructor := (GDExtensionPtrDestructor)(CallFunc_GDExtensionInterfaceVariantGetPtrDestructor(GDEXTENSION_VARIANT_TYPE_STRING_NAME))
@@ -0,0 +1,72 @@
+package ffi
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../../godot_headers -I${SRCDIR}/../../pkg/log -I${SRCDIR}/../../pkg/ffi
+#include <godot/gdextension_interface.h>
+#include "ffi_wrapper.gen.h"
+#include <stdlib.h>
+#include <string.h>
+*/
+import "C"
+
+// NewGDExtensionScriptInstanceInfo3 builds the info passed to
+// script_instance_create3. Unlike the class creation info, Godot keeps the
+// pointer it is given for as long as the script instance lives, so the struct
+// has to be copied to memory the Go garbage collector does not move or free.
+func NewGDExtensionScriptInstanceInfo3(
+	setFunc GDExtensionScriptInstanceSet,
+	getFunc GDExtensionScriptInstanceGet,
+	getPropertyListFunc GDExtensionScriptInstanceGetPropertyList,
+	freePropertyListFunc GDExtensionScriptInstanceFreePropertyList2,
+	getClassCategoryFunc GDExtensionScriptInstanceGetClassCategory,
+	propertyCanRevertFunc GDExtensionScriptInstancePropertyCanRevert,
+	propertyGetRevertFunc GDExtensionScriptInstancePropertyGetRevert,
+	getOwnerFunc GDExtensionScriptInstanceGetOwner,
+	getPropertyStateFunc GDExtensionScriptInstanceGetPropertyState,
+	getMethodListFunc GDExtensionScriptInstanceGetMethodList,
+	freeMethodListFunc GDExtensionScriptInstanceFreeMethodList2,
+	getPropertyTypeFunc GDExtensionScriptInstanceGetPropertyType,
+	validatePropertyFunc GDExtensionScriptInstanceValidateProperty,
+	hasMethodFunc GDExtensionScriptInstanceHasMethod,
+	getMethodArgumentCountFunc GDExtensionScriptInstanceGetMethodArgumentCount,
+	callFunc GDExtensionScriptInstanceCall,
+	notificationFunc GDExtensionScriptInstanceNotification2,
+	toStringFunc GDExtensionScriptInstanceToString,
+	refcountIncrementedFunc GDExtensionScriptInstanceRefCountIncremented,
+	refcountDecrementedFunc GDExtensionScriptInstanceRefCountDecremented,
+	getScriptFunc GDExtensionScriptInstanceGetScript,
+	isPlaceholderFunc GDExtensionScriptInstanceIsPlaceholder,
+	setFallbackFunc GDExtensionScriptInstanceSet,
+	getFallbackFunc GDExtensionScriptInstanceGet,
+	getLanguageFunc GDExtensionScriptInstanceGetLanguage,
+	freeFunc GDExtensionScriptInstanceFree,
+) GDExtensionScriptInstanceInfo3 {
+	return (GDExtensionScriptInstanceInfo3)(C.GDExtensionScriptInstanceInfo3{
+		set_func:                       (C.GDExtensionScriptInstanceSet)(setFunc),
+		get_func:                       (C.GDExtensionScriptInstanceGet)(getFunc),
+		get_property_list_func:         (C.GDExtensionScriptInstanceGetPropertyList)(getPropertyListFunc),
+		free_property_list_func:        (C.GDExtensionScriptInstanceFreePropertyList2)(freePropertyListFunc),
+		get_class_category_func:        (C.GDExtensionScriptInstanceGetClassCategory)(getClassCategoryFunc),
+		property_can_revert_func:       (C.GDExtensionScriptInstancePropertyCanRevert)(propertyCanRevertFunc),
+		property_get_revert_func:       (C.GDExtensionScriptInstancePropertyGetRevert)(propertyGetRevertFunc),
+		get_owner_func:                 (C.GDExtensionScriptInstanceGetOwner)(getOwnerFunc),
+		get_property_state_func:        (C.GDExtensionScriptInstanceGetPropertyState)(getPropertyStateFunc),
+		get_method_list_func:           (C.GDExtensionScriptInstanceGetMethodList)(getMethodListFunc),
+		free_method_list_func:          (C.GDExtensionScriptInstanceFreeMethodList2)(freeMethodListFunc),
+		get_property_type_func:         (C.GDExtensionScriptInstanceGetPropertyType)(getPropertyTypeFunc),
+		validate_property_func:         (C.GDExtensionScriptInstanceValidateProperty)(validatePropertyFunc),
+		has_method_func:                (C.GDExtensionScriptInstanceHasMethod)(hasMethodFunc),
+		get_method_argument_count_func: (C.GDExtensionScriptInstanceGetMethodArgumentCount)(getMethodArgumentCountFunc),
+		call_func:                      (C.GDExtensionScriptInstanceCall)(callFunc),
+		notification_func:              (C.GDExtensionScriptInstanceNotification2)(notificationFunc),
+		to_string_func:                 (C.GDExtensionScriptInstanceToString)(toStringFunc),
+		refcount_incremented_func:      (C.GDExtensionScriptInstanceRefCountIncremented)(refcountIncrementedFunc),
+		refcount_decremented_func:      (C.GDExtensionScriptInstanceRefCountDecremented)(refcountDecrementedFunc),
+		get_script_func:                (C.GDExtensionScriptInstanceGetScript)(getScriptFunc),
+		is_placeholder_func:            (C.GDExtensionScriptInstanceIsPlaceholder)(isPlaceholderFunc),
+		set_fallback_func:              (C.GDExtensionScriptInstanceSet)(setFallbackFunc),
+		get_fallback_func:              (C.GDExtensionScriptInstanceGet)(getFallbackFunc),
+		get_language_func:              (C.GDExtensionScriptInstanceGetLanguage)(getLanguageFunc),
+		free_func:                      (C.GDExtensionScriptInstanceFree)(freeFunc),
+	})
+}
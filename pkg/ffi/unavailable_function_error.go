@@ -0,0 +1,32 @@
+package ffi
+
+import "fmt"
+
+// UnavailableFunctionError is the panic value of a CallFunc_ wrapper of a
+// GDExtension interface function the running Godot version does not have.
+// Check with the Has methods of GDExtensionInterface before calling
+// functions added after the oldest Godot version the extension supports.
+type UnavailableFunctionError struct {
+	// Function is the name of the interface function, e.g. get_godot_version2.
+	Function string
+	// Since is the Godot version that added the function, e.g. 4.5.
+	Since string
+	// Major and Minor are the running Godot version.
+	Major, Minor int32
+}
+
+func newUnavailableFunctionError(function, since string) *UnavailableFunctionError {
+	e := &UnavailableFunctionError{
+		Function: function,
+		Since:    since,
+	}
+	if FFI.GodotVersion != nil {
+		e.Major = FFI.GodotVersion.GetMajor()
+		e.Minor = FFI.GodotVersion.GetMinor()
+	}
+	return e
+}
+
+func (e *UnavailableFunctionError) Error() string {
+	return fmt.Sprintf("%s requires Godot %s, running %d.%d", e.Function, e.Since, e.Major, e.Minor)
+}
@@ -1,7 +1,6 @@
 package gdclassimpl
 
 import (
-	"runtime"
 	"unsafe"
 
 	. "github.com/godot-go/godot-go/pkg/builtin"
@@ -9,14 +8,8 @@ import (
 
 var (
 	nullptr = unsafe.Pointer(nil)
-	pnr     runtime.Pinner
 )
 
-// UnpinGDClassImplPins releases pins held for the extension lifetime during shutdown.
-func UnpinGDClassImplPins() {
-	pnr.Unpin()
-}
-
 func (cx *ObjectImpl) ToGoString() string {
 	if cx == nil || cx.Owner == nil {
 		return ""
@@ -30,3 +23,18 @@ func GetInputSingleton() Input {
 	owner := (*GodotObject)(unsafe.Pointer(GetSingleton("Input")))
 	return NewInputWithGodotOwnerObject(owner)
 }
+
+func GetEngineSingleton() Engine {
+	owner := (*GodotObject)(unsafe.Pointer(GetSingleton("Engine")))
+	return NewEngineWithGodotOwnerObject(owner)
+}
+
+func GetResourceLoaderSingleton() ResourceLoader {
+	owner := (*GodotObject)(unsafe.Pointer(GetSingleton("ResourceLoader")))
+	return NewResourceLoaderWithGodotOwnerObject(owner)
+}
+
+func GetResourceSaverSingleton() ResourceSaver {
+	owner := (*GodotObject)(unsafe.Pointer(GetSingleton("ResourceSaver")))
+	return NewResourceSaverWithGodotOwnerObject(owner)
+}
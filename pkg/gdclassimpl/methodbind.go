@@ -0,0 +1,101 @@
+package gdclassimpl
+
+import (
+	"sync"
+	"sync/atomic"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+// methodBind caches the engine method bind of one engine method. The
+// generated per-class binding tables hold one methodBind per engine method;
+// the lookup through ClassDB happens on the first call only.
+type methodBind struct {
+	className  string
+	methodName string
+	// hashes lists the hash of the method in the Godot version the bindings
+	// were generated from, followed by the hashes the method had in earlier
+	// versions
+	hashes   []int64
+	once     sync.Once
+	resolved atomic.Bool
+	ptr      GDExtensionMethodBindPtr
+}
+
+// methodBindings is implemented by the generated binding tables.
+type methodBindings interface {
+	binds() []*methodBind
+}
+
+func (mb *methodBind) get() GDExtensionMethodBindPtr {
+	ptr := mb.resolve()
+	if ptr == nil {
+		log.Panic("could not find method bind",
+			zap.String("class", mb.className),
+			zap.String("method", mb.methodName),
+			zap.Int64s("hashes", mb.hashes),
+		)
+	}
+	return ptr
+}
+
+// resolve looks the method up by each of its hashes in turn, so that
+// bindings generated for one Godot version keep working on the versions
+// that kept a compatibility hash for the method.
+func (mb *methodBind) resolve() GDExtensionMethodBindPtr {
+	mb.once.Do(func() {
+		for _, hash := range mb.hashes {
+			if mb.ptr = lookupMethodBind(mb.className, mb.methodName, hash); mb.ptr != nil {
+				break
+			}
+		}
+		mb.resolved.Store(true)
+	})
+	return mb.ptr
+}
+
+// CheckMethodBinds looks up the methods of the engine classes registered
+// with ClassDB so far and returns the ones not found under any of their
+// hashes as Class.method. Calling one of them panics, so a non-empty list
+// means the running Godot version does not match the bindings.
+//
+// Methods looked up by an earlier call, or by calling them, are skipped.
+func CheckMethodBinds() []string {
+	var missing []string
+	for _, table := range methodBindingTables {
+		binds := table.binds()
+		if len(binds) == 0 || !classExists(binds[0].className) {
+			continue
+		}
+		for _, mb := range binds {
+			if mb.resolved.Load() {
+				continue
+			}
+			if mb.resolve() == nil {
+				missing = append(missing, mb.className+"."+mb.methodName)
+			}
+		}
+	}
+	return missing
+}
+
+func classExists(className string) bool {
+	cn := NewStringNameWithLatin1Chars(className)
+	defer cn.Destroy()
+	return CallFunc_GDExtensionInterfaceClassdbGetClassTag(cn.AsGDExtensionConstStringNamePtr()) != nil
+}
+
+func lookupMethodBind(className, methodName string, hash int64) GDExtensionMethodBindPtr {
+	cn := NewStringNameWithLatin1Chars(className)
+	defer cn.Destroy()
+	mn := NewStringNameWithLatin1Chars(methodName)
+	defer mn.Destroy()
+	return CallFunc_GDExtensionInterfaceClassdbGetMethodBind(
+		cn.AsGDExtensionConstStringNamePtr(),
+		mn.AsGDExtensionConstStringNamePtr(),
+		(GDExtensionInt)(hash),
+	)
+}
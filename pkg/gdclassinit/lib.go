@@ -19,10 +19,7 @@ var (
 	GDNativeConstructors        = NewSyncMap[string, GDExtensionClassGoConstructorFromOwner]()
 	GDClassRefConstructors      = NewSyncMap[string, RefCountedConstructor]()
 	GDRegisteredGDClassEncoders = NewSyncMap[string, ArgumentEncoder]()
-	pnr                         runtime.Pinner
+	// instanceBindingPinners keeps the wrappers handed out as engine instance
+	// bindings pinned until the engine frees the binding.
+	instanceBindingPinners = NewSyncMap[unsafe.Pointer, *runtime.Pinner]()
 )
-
-// UnpinGDClassInitPins releases pins held for the extension lifetime during shutdown.
-func UnpinGDClassInitPins() {
-	pnr.Unpin()
-}
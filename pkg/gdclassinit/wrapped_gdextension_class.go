@@ -3,6 +3,7 @@ package gdclassinit
 import "C"
 
 import (
+	"runtime"
 	"unsafe"
 
 	. "github.com/godot-go/godot-go/pkg/builtin"
@@ -12,6 +13,7 @@ import (
 
 //export GoCallback_GDExtensionBindingCreate
 func GoCallback_GDExtensionBindingCreate(p_type_name *C.char, p_token unsafe.Pointer, p_instance unsafe.Pointer) unsafe.Pointer {
+	defer RecoverCallback("GoCallback_GDExtensionBindingCreate")
 	typeName := C.GoString(p_type_name)
 	log.Debug("GoCallback_GDExtensionBindingCreate called",
 		zap.String("class", typeName),
@@ -26,12 +28,19 @@ func GoCallback_GDExtensionBindingCreate(p_type_name *C.char, p_token unsafe.Poi
 		log.Panic("no instance returned")
 	}
 	ptr := &inst
-	pnr.Pin(ptr)
+	pinner := &runtime.Pinner{}
+	pinner.Pin(ptr)
+	instanceBindingPinners.Set(unsafe.Pointer(ptr), pinner)
 	return (unsafe.Pointer)(ptr)
 }
 
 //export GoCallback_GDExtensionBindingFree
 func GoCallback_GDExtensionBindingFree(p_type_name *C.char, p_token unsafe.Pointer, p_instance unsafe.Pointer, p_binding unsafe.Pointer) {
+	defer RecoverCallback("GoCallback_GDExtensionBindingFree")
+	if pinner, ok := instanceBindingPinners.Get(p_binding); ok {
+		pinner.Unpin()
+		instanceBindingPinners.Delete(p_binding)
+	}
 	// typeName := C.GoString(p_type_name)
 	// log.Debug("GoCallback_GDExtensionBindingFree called",
 	// 	zap.String("class", typeName),
@@ -41,5 +50,6 @@ func GoCallback_GDExtensionBindingFree(p_type_name *C.char, p_token unsafe.Point
 
 //export GoCallback_GDExtensionBindingReference
 func GoCallback_GDExtensionBindingReference(p_type_name *C.char, p_token unsafe.Pointer, p_instance unsafe.Pointer, p_reference bool) bool {
+	defer RecoverCallback("GoCallback_GDExtensionBindingReference")
 	return true
 }
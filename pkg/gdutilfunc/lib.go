@@ -7,16 +7,33 @@ package gdutilfunc
 import "C"
 
 import (
-	"runtime"
 	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
 )
 
 var (
 	nullptr = unsafe.Pointer(nil)
-	pnr     runtime.Pinner
 )
 
-// UnpinGDUtilFuncPins releases pins held for the extension lifetime during shutdown.
-func UnpinGDUtilFuncPins() {
-	pnr.Unpin()
+// getUtilityFunction looks the utility function up by each of hashes in turn:
+// the hash in the Godot version the bindings were generated from, then the
+// hashes the function had in earlier versions.
+func getUtilityFunction(name string, hashes ...int64) GDExtensionPtrUtilityFunction {
+	fName := NewStringNameWithLatin1Chars(name)
+	defer fName.Destroy()
+	for _, hash := range hashes {
+		fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), (GDExtensionInt)(hash))
+		if fn != nil {
+			return fn
+		}
+	}
+	log.Panic("could not find utility function",
+		zap.String("function", name),
+		zap.Int64s("hashes", hashes),
+	)
+	return nil
 }
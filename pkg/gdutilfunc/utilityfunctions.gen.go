@@ -16,6 +16,7 @@ package gdutilfunc
 // #include <stdlib.h>
 import "C"
 import (
+	"runtime"
 	"unsafe"
 
 	. "github.com/godot-go/godot-go/pkg/builtin"
@@ -27,23 +28,18 @@ import (
 
 // Sin is under the category "math".
 func Sin(angle_rad float32) float32 {
-	fName := NewStringNameWithLatin1Chars("sin")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function sin (2140049587)")
-	}
+	fn := getUtilityFunction("sin", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(angle_rad))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -51,23 +47,18 @@ func Sin(angle_rad float32) float32 {
 
 // Cos is under the category "math".
 func Cos(angle_rad float32) float32 {
-	fName := NewStringNameWithLatin1Chars("cos")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function cos (2140049587)")
-	}
+	fn := getUtilityFunction("cos", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(angle_rad))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -75,23 +66,18 @@ func Cos(angle_rad float32) float32 {
 
 // Tan is under the category "math".
 func Tan(angle_rad float32) float32 {
-	fName := NewStringNameWithLatin1Chars("tan")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function tan (2140049587)")
-	}
+	fn := getUtilityFunction("tan", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(angle_rad))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -99,23 +85,18 @@ func Tan(angle_rad float32) float32 {
 
 // Sinh is under the category "math".
 func Sinh(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("sinh")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function sinh (2140049587)")
-	}
+	fn := getUtilityFunction("sinh", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -123,23 +104,18 @@ func Sinh(x float32) float32 {
 
 // Cosh is under the category "math".
 func Cosh(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("cosh")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function cosh (2140049587)")
-	}
+	fn := getUtilityFunction("cosh", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -147,23 +123,18 @@ func Cosh(x float32) float32 {
 
 // Tanh is under the category "math".
 func Tanh(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("tanh")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function tanh (2140049587)")
-	}
+	fn := getUtilityFunction("tanh", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -171,23 +142,18 @@ func Tanh(x float32) float32 {
 
 // Asin is under the category "math".
 func Asin(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("asin")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function asin (2140049587)")
-	}
+	fn := getUtilityFunction("asin", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -195,23 +161,18 @@ func Asin(x float32) float32 {
 
 // Acos is under the category "math".
 func Acos(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("acos")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function acos (2140049587)")
-	}
+	fn := getUtilityFunction("acos", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -219,23 +180,18 @@ func Acos(x float32) float32 {
 
 // Atan is under the category "math".
 func Atan(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("atan")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function atan (2140049587)")
-	}
+	fn := getUtilityFunction("atan", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -243,25 +199,19 @@ func Atan(x float32) float32 {
 
 // Atan2 is under the category "math".
 func Atan2(y float32, x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("atan2")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function atan2 (92296394)")
-	}
+	fn := getUtilityFunction("atan2", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(y))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -269,23 +219,18 @@ func Atan2(y float32, x float32) float32 {
 
 // Asinh is under the category "math".
 func Asinh(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("asinh")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function asinh (2140049587)")
-	}
+	fn := getUtilityFunction("asinh", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -293,23 +238,18 @@ func Asinh(x float32) float32 {
 
 // Acosh is under the category "math".
 func Acosh(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("acosh")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function acosh (2140049587)")
-	}
+	fn := getUtilityFunction("acosh", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -317,23 +257,18 @@ func Acosh(x float32) float32 {
 
 // Atanh is under the category "math".
 func Atanh(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("atanh")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function atanh (2140049587)")
-	}
+	fn := getUtilityFunction("atanh", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -341,23 +276,18 @@ func Atanh(x float32) float32 {
 
 // Sqrt is under the category "math".
 func Sqrt(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("sqrt")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function sqrt (2140049587)")
-	}
+	fn := getUtilityFunction("sqrt", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -365,25 +295,19 @@ func Sqrt(x float32) float32 {
 
 // Fmod is under the category "math".
 func Fmod(x float32, y float32) float32 {
-	fName := NewStringNameWithLatin1Chars("fmod")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function fmod (92296394)")
-	}
+	fn := getUtilityFunction("fmod", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(y))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -391,25 +315,19 @@ func Fmod(x float32, y float32) float32 {
 
 // Fposmod is under the category "math".
 func Fposmod(x float32, y float32) float32 {
-	fName := NewStringNameWithLatin1Chars("fposmod")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function fposmod (92296394)")
-	}
+	fn := getUtilityFunction("fposmod", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(y))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -417,25 +335,19 @@ func Fposmod(x float32, y float32) float32 {
 
 // Posmod is under the category "math".
 func Posmod(x int64, y int64) int64 {
-	fName := NewStringNameWithLatin1Chars("posmod")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3133453818)
-	if fn == nil {
-		panic("could not find utility function posmod (3133453818)")
-	}
+	fn := getUtilityFunction("posmod", 3133453818)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(x))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(y))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -443,23 +355,18 @@ func Posmod(x int64, y int64) int64 {
 
 // Floor is under the category "math".
 func Floor(x Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("floor")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 4776452)
-	if fn == nil {
-		panic("could not find utility function floor (4776452)")
-	}
+	fn := getUtilityFunction("floor", 4776452)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -467,23 +374,18 @@ func Floor(x Variant) Variant {
 
 // Floorf is under the category "math".
 func Floorf(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("floorf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function floorf (2140049587)")
-	}
+	fn := getUtilityFunction("floorf", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -491,23 +393,18 @@ func Floorf(x float32) float32 {
 
 // Floori is under the category "math".
 func Floori(x float32) int64 {
-	fName := NewStringNameWithLatin1Chars("floori")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2780425386)
-	if fn == nil {
-		panic("could not find utility function floori (2780425386)")
-	}
+	fn := getUtilityFunction("floori", 2780425386)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -515,23 +412,18 @@ func Floori(x float32) int64 {
 
 // Ceil is under the category "math".
 func Ceil(x Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("ceil")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 4776452)
-	if fn == nil {
-		panic("could not find utility function ceil (4776452)")
-	}
+	fn := getUtilityFunction("ceil", 4776452)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -539,23 +431,18 @@ func Ceil(x Variant) Variant {
 
 // Ceilf is under the category "math".
 func Ceilf(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("ceilf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function ceilf (2140049587)")
-	}
+	fn := getUtilityFunction("ceilf", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -563,23 +450,18 @@ func Ceilf(x float32) float32 {
 
 // Ceili is under the category "math".
 func Ceili(x float32) int64 {
-	fName := NewStringNameWithLatin1Chars("ceili")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2780425386)
-	if fn == nil {
-		panic("could not find utility function ceili (2780425386)")
-	}
+	fn := getUtilityFunction("ceili", 2780425386)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -587,23 +469,18 @@ func Ceili(x float32) int64 {
 
 // Round is under the category "math".
 func Round(x Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("round")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 4776452)
-	if fn == nil {
-		panic("could not find utility function round (4776452)")
-	}
+	fn := getUtilityFunction("round", 4776452)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -611,23 +488,18 @@ func Round(x Variant) Variant {
 
 // Roundf is under the category "math".
 func Roundf(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("roundf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function roundf (2140049587)")
-	}
+	fn := getUtilityFunction("roundf", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -635,23 +507,18 @@ func Roundf(x float32) float32 {
 
 // Roundi is under the category "math".
 func Roundi(x float32) int64 {
-	fName := NewStringNameWithLatin1Chars("roundi")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2780425386)
-	if fn == nil {
-		panic("could not find utility function roundi (2780425386)")
-	}
+	fn := getUtilityFunction("roundi", 2780425386)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -659,23 +526,18 @@ func Roundi(x float32) int64 {
 
 // Abs is under the category "math".
 func Abs(x Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("abs")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 4776452)
-	if fn == nil {
-		panic("could not find utility function abs (4776452)")
-	}
+	fn := getUtilityFunction("abs", 4776452)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -683,23 +545,18 @@ func Abs(x Variant) Variant {
 
 // Absf is under the category "math".
 func Absf(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("absf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function absf (2140049587)")
-	}
+	fn := getUtilityFunction("absf", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -707,23 +564,18 @@ func Absf(x float32) float32 {
 
 // Absi is under the category "math".
 func Absi(x int64) int64 {
-	fName := NewStringNameWithLatin1Chars("absi")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2157319888)
-	if fn == nil {
-		panic("could not find utility function absi (2157319888)")
-	}
+	fn := getUtilityFunction("absi", 2157319888)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -731,23 +583,18 @@ func Absi(x int64) int64 {
 
 // Sign is under the category "math".
 func Sign(x Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("sign")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 4776452)
-	if fn == nil {
-		panic("could not find utility function sign (4776452)")
-	}
+	fn := getUtilityFunction("sign", 4776452)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -755,23 +602,18 @@ func Sign(x Variant) Variant {
 
 // Signf is under the category "math".
 func Signf(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("signf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function signf (2140049587)")
-	}
+	fn := getUtilityFunction("signf", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -779,23 +621,18 @@ func Signf(x float32) float32 {
 
 // Signi is under the category "math".
 func Signi(x int64) int64 {
-	fName := NewStringNameWithLatin1Chars("signi")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2157319888)
-	if fn == nil {
-		panic("could not find utility function signi (2157319888)")
-	}
+	fn := getUtilityFunction("signi", 2157319888)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -803,25 +640,19 @@ func Signi(x int64) int64 {
 
 // Snapped is under the category "math".
 func Snapped(x Variant, step Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("snapped")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 459914704)
-	if fn == nil {
-		panic("could not find utility function snapped (459914704)")
-	}
+	fn := getUtilityFunction("snapped", 459914704)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(x))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(step))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -829,25 +660,19 @@ func Snapped(x Variant, step Variant) Variant {
 
 // Snappedf is under the category "math".
 func Snappedf(x float32, step float32) float32 {
-	fName := NewStringNameWithLatin1Chars("snappedf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function snappedf (92296394)")
-	}
+	fn := getUtilityFunction("snappedf", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(step))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -855,25 +680,19 @@ func Snappedf(x float32, step float32) float32 {
 
 // Snappedi is under the category "math".
 func Snappedi(x float32, step int64) int64 {
-	fName := NewStringNameWithLatin1Chars("snappedi")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3570758393)
-	if fn == nil {
-		panic("could not find utility function snappedi (3570758393)")
-	}
+	fn := getUtilityFunction("snappedi", 3570758393)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(step))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -881,25 +700,19 @@ func Snappedi(x float32, step int64) int64 {
 
 // Pow is under the category "math".
 func Pow(base float32, exp float32) float32 {
-	fName := NewStringNameWithLatin1Chars("pow")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function pow (92296394)")
-	}
+	fn := getUtilityFunction("pow", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(base))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(exp))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -907,23 +720,18 @@ func Pow(base float32, exp float32) float32 {
 
 // Log is under the category "math".
 func Log(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("log")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function log (2140049587)")
-	}
+	fn := getUtilityFunction("log", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -931,23 +739,18 @@ func Log(x float32) float32 {
 
 // Exp is under the category "math".
 func Exp(x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("exp")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function exp (2140049587)")
-	}
+	fn := getUtilityFunction("exp", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -955,23 +758,18 @@ func Exp(x float32) float32 {
 
 // IsNan is under the category "math".
 func IsNan(x float32) bool {
-	fName := NewStringNameWithLatin1Chars("is_nan")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3569215213)
-	if fn == nil {
-		panic("could not find utility function is_nan (3569215213)")
-	}
+	fn := getUtilityFunction("is_nan", 3569215213)
 	var ret bool
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -979,23 +777,18 @@ func IsNan(x float32) bool {
 
 // IsInf is under the category "math".
 func IsInf(x float32) bool {
-	fName := NewStringNameWithLatin1Chars("is_inf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3569215213)
-	if fn == nil {
-		panic("could not find utility function is_inf (3569215213)")
-	}
+	fn := getUtilityFunction("is_inf", 3569215213)
 	var ret bool
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1003,25 +796,19 @@ func IsInf(x float32) bool {
 
 // IsEqualApprox is under the category "math".
 func IsEqualApprox(a float32, b float32) bool {
-	fName := NewStringNameWithLatin1Chars("is_equal_approx")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1400789633)
-	if fn == nil {
-		panic("could not find utility function is_equal_approx (1400789633)")
-	}
+	fn := getUtilityFunction("is_equal_approx", 1400789633)
 	var ret bool
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(a))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(b))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1029,23 +816,18 @@ func IsEqualApprox(a float32, b float32) bool {
 
 // IsZeroApprox is under the category "math".
 func IsZeroApprox(x float32) bool {
-	fName := NewStringNameWithLatin1Chars("is_zero_approx")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3569215213)
-	if fn == nil {
-		panic("could not find utility function is_zero_approx (3569215213)")
-	}
+	fn := getUtilityFunction("is_zero_approx", 3569215213)
 	var ret bool
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1053,23 +835,18 @@ func IsZeroApprox(x float32) bool {
 
 // IsFinite is under the category "math".
 func IsFinite(x float32) bool {
-	fName := NewStringNameWithLatin1Chars("is_finite")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3569215213)
-	if fn == nil {
-		panic("could not find utility function is_finite (3569215213)")
-	}
+	fn := getUtilityFunction("is_finite", 3569215213)
 	var ret bool
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1077,25 +854,19 @@ func IsFinite(x float32) bool {
 
 // Ease is under the category "math".
 func Ease(x float32, curve float32) float32 {
-	fName := NewStringNameWithLatin1Chars("ease")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function ease (92296394)")
-	}
+	fn := getUtilityFunction("ease", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(curve))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1103,23 +874,18 @@ func Ease(x float32, curve float32) float32 {
 
 // StepDecimals is under the category "math".
 func StepDecimals(x float32) int64 {
-	fName := NewStringNameWithLatin1Chars("step_decimals")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2780425386)
-	if fn == nil {
-		panic("could not find utility function step_decimals (2780425386)")
-	}
+	fn := getUtilityFunction("step_decimals", 2780425386)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1127,27 +893,20 @@ func StepDecimals(x float32) int64 {
 
 // Lerp is under the category "math".
 func Lerp(from Variant, to Variant, weight Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("lerp")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3389874542)
-	if fn == nil {
-		panic("could not find utility function lerp (3389874542)")
-	}
+	fn := getUtilityFunction("lerp", 3389874542)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(to))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(weight))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1155,27 +914,20 @@ func Lerp(from Variant, to Variant, weight Variant) Variant {
 
 // Lerpf is under the category "math".
 func Lerpf(from float32, to float32, weight float32) float32 {
-	fName := NewStringNameWithLatin1Chars("lerpf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 998901048)
-	if fn == nil {
-		panic("could not find utility function lerpf (998901048)")
-	}
+	fn := getUtilityFunction("lerpf", 998901048)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(weight))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1183,17 +935,13 @@ func Lerpf(from float32, to float32, weight float32) float32 {
 
 // CubicInterpolate is under the category "math".
 func CubicInterpolate(from float32, to float32, pre float32, post float32, weight float32) float32 {
-	fName := NewStringNameWithLatin1Chars("cubic_interpolate")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1090965791)
-	if fn == nil {
-		panic("could not find utility function cubic_interpolate (1090965791)")
-	}
+	fn := getUtilityFunction("cubic_interpolate", 1090965791)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [5]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
@@ -1201,13 +949,8 @@ func CubicInterpolate(from float32, to float32, pre float32, post float32, weigh
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(post))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(weight))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
-	pnr.Pin(args[3])
-	pnr.Pin(args[4])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(5)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1215,17 +958,13 @@ func CubicInterpolate(from float32, to float32, pre float32, post float32, weigh
 
 // CubicInterpolateAngle is under the category "math".
 func CubicInterpolateAngle(from float32, to float32, pre float32, post float32, weight float32) float32 {
-	fName := NewStringNameWithLatin1Chars("cubic_interpolate_angle")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1090965791)
-	if fn == nil {
-		panic("could not find utility function cubic_interpolate_angle (1090965791)")
-	}
+	fn := getUtilityFunction("cubic_interpolate_angle", 1090965791)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [5]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
@@ -1233,13 +972,8 @@ func CubicInterpolateAngle(from float32, to float32, pre float32, post float32,
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(post))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(weight))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
-	pnr.Pin(args[3])
-	pnr.Pin(args[4])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(5)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1247,17 +981,13 @@ func CubicInterpolateAngle(from float32, to float32, pre float32, post float32,
 
 // CubicInterpolateInTime is under the category "math".
 func CubicInterpolateInTime(from float32, to float32, pre float32, post float32, weight float32, to_t float32, pre_t float32, post_t float32) float32 {
-	fName := NewStringNameWithLatin1Chars("cubic_interpolate_in_time")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 388121036)
-	if fn == nil {
-		panic("could not find utility function cubic_interpolate_in_time (388121036)")
-	}
+	fn := getUtilityFunction("cubic_interpolate_in_time", 388121036)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [8]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
@@ -1268,16 +998,8 @@ func CubicInterpolateInTime(from float32, to float32, pre float32, post float32,
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(pre_t))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(post_t))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
-	pnr.Pin(args[3])
-	pnr.Pin(args[4])
-	pnr.Pin(args[5])
-	pnr.Pin(args[6])
-	pnr.Pin(args[7])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(8)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1285,17 +1007,13 @@ func CubicInterpolateInTime(from float32, to float32, pre float32, post float32,
 
 // CubicInterpolateAngleInTime is under the category "math".
 func CubicInterpolateAngleInTime(from float32, to float32, pre float32, post float32, weight float32, to_t float32, pre_t float32, post_t float32) float32 {
-	fName := NewStringNameWithLatin1Chars("cubic_interpolate_angle_in_time")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 388121036)
-	if fn == nil {
-		panic("could not find utility function cubic_interpolate_angle_in_time (388121036)")
-	}
+	fn := getUtilityFunction("cubic_interpolate_angle_in_time", 388121036)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [8]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
@@ -1306,16 +1024,8 @@ func CubicInterpolateAngleInTime(from float32, to float32, pre float32, post flo
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(pre_t))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(post_t))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
-	pnr.Pin(args[3])
-	pnr.Pin(args[4])
-	pnr.Pin(args[5])
-	pnr.Pin(args[6])
-	pnr.Pin(args[7])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(8)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1323,17 +1033,13 @@ func CubicInterpolateAngleInTime(from float32, to float32, pre float32, post flo
 
 // BezierInterpolate is under the category "math".
 func BezierInterpolate(start float32, control_1 float32, control_2 float32, end float32, t float32) float32 {
-	fName := NewStringNameWithLatin1Chars("bezier_interpolate")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1090965791)
-	if fn == nil {
-		panic("could not find utility function bezier_interpolate (1090965791)")
-	}
+	fn := getUtilityFunction("bezier_interpolate", 1090965791)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [5]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(start))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(control_1))),
@@ -1341,13 +1047,8 @@ func BezierInterpolate(start float32, control_1 float32, control_2 float32, end
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(end))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(t))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
-	pnr.Pin(args[3])
-	pnr.Pin(args[4])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(5)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1355,17 +1056,13 @@ func BezierInterpolate(start float32, control_1 float32, control_2 float32, end
 
 // BezierDerivative is under the category "math".
 func BezierDerivative(start float32, control_1 float32, control_2 float32, end float32, t float32) float32 {
-	fName := NewStringNameWithLatin1Chars("bezier_derivative")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1090965791)
-	if fn == nil {
-		panic("could not find utility function bezier_derivative (1090965791)")
-	}
+	fn := getUtilityFunction("bezier_derivative", 1090965791)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [5]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(start))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(control_1))),
@@ -1373,13 +1070,8 @@ func BezierDerivative(start float32, control_1 float32, control_2 float32, end f
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(end))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(t))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
-	pnr.Pin(args[3])
-	pnr.Pin(args[4])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(5)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1387,25 +1079,19 @@ func BezierDerivative(start float32, control_1 float32, control_2 float32, end f
 
 // AngleDifference is under the category "math".
 func AngleDifference(from float32, to float32) float32 {
-	fName := NewStringNameWithLatin1Chars("angle_difference")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function angle_difference (92296394)")
-	}
+	fn := getUtilityFunction("angle_difference", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1413,27 +1099,20 @@ func AngleDifference(from float32, to float32) float32 {
 
 // LerpAngle is under the category "math".
 func LerpAngle(from float32, to float32, weight float32) float32 {
-	fName := NewStringNameWithLatin1Chars("lerp_angle")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 998901048)
-	if fn == nil {
-		panic("could not find utility function lerp_angle (998901048)")
-	}
+	fn := getUtilityFunction("lerp_angle", 998901048)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(weight))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1441,27 +1120,20 @@ func LerpAngle(from float32, to float32, weight float32) float32 {
 
 // InverseLerp is under the category "math".
 func InverseLerp(from float32, to float32, weight float32) float32 {
-	fName := NewStringNameWithLatin1Chars("inverse_lerp")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 998901048)
-	if fn == nil {
-		panic("could not find utility function inverse_lerp (998901048)")
-	}
+	fn := getUtilityFunction("inverse_lerp", 998901048)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(weight))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1469,17 +1141,13 @@ func InverseLerp(from float32, to float32, weight float32) float32 {
 
 // Remap is under the category "math".
 func Remap(value float32, istart float32, istop float32, ostart float32, ostop float32) float32 {
-	fName := NewStringNameWithLatin1Chars("remap")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1090965791)
-	if fn == nil {
-		panic("could not find utility function remap (1090965791)")
-	}
+	fn := getUtilityFunction("remap", 1090965791)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [5]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(value))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(istart))),
@@ -1487,13 +1155,8 @@ func Remap(value float32, istart float32, istop float32, ostart float32, ostop f
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(ostart))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(ostop))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
-	pnr.Pin(args[3])
-	pnr.Pin(args[4])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(5)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1501,27 +1164,20 @@ func Remap(value float32, istart float32, istop float32, ostart float32, ostop f
 
 // Smoothstep is under the category "math".
 func Smoothstep(from float32, to float32, x float32) float32 {
-	fName := NewStringNameWithLatin1Chars("smoothstep")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 998901048)
-	if fn == nil {
-		panic("could not find utility function smoothstep (998901048)")
-	}
+	fn := getUtilityFunction("smoothstep", 998901048)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(x))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1529,27 +1185,20 @@ func Smoothstep(from float32, to float32, x float32) float32 {
 
 // MoveToward is under the category "math".
 func MoveToward(from float32, to float32, delta float32) float32 {
-	fName := NewStringNameWithLatin1Chars("move_toward")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 998901048)
-	if fn == nil {
-		panic("could not find utility function move_toward (998901048)")
-	}
+	fn := getUtilityFunction("move_toward", 998901048)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(delta))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1557,27 +1206,20 @@ func MoveToward(from float32, to float32, delta float32) float32 {
 
 // RotateToward is under the category "math".
 func RotateToward(from float32, to float32, delta float32) float32 {
-	fName := NewStringNameWithLatin1Chars("rotate_toward")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 998901048)
-	if fn == nil {
-		panic("could not find utility function rotate_toward (998901048)")
-	}
+	fn := getUtilityFunction("rotate_toward", 998901048)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(delta))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1585,23 +1227,18 @@ func RotateToward(from float32, to float32, delta float32) float32 {
 
 // DegToRad is under the category "math".
 func DegToRad(deg float32) float32 {
-	fName := NewStringNameWithLatin1Chars("deg_to_rad")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function deg_to_rad (2140049587)")
-	}
+	fn := getUtilityFunction("deg_to_rad", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(deg))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1609,23 +1246,18 @@ func DegToRad(deg float32) float32 {
 
 // RadToDeg is under the category "math".
 func RadToDeg(rad float32) float32 {
-	fName := NewStringNameWithLatin1Chars("rad_to_deg")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function rad_to_deg (2140049587)")
-	}
+	fn := getUtilityFunction("rad_to_deg", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(rad))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1633,23 +1265,18 @@ func RadToDeg(rad float32) float32 {
 
 // LinearToDb is under the category "math".
 func LinearToDb(lin float32) float32 {
-	fName := NewStringNameWithLatin1Chars("linear_to_db")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function linear_to_db (2140049587)")
-	}
+	fn := getUtilityFunction("linear_to_db", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(lin))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1657,23 +1284,18 @@ func LinearToDb(lin float32) float32 {
 
 // DbToLinear is under the category "math".
 func DbToLinear(db float32) float32 {
-	fName := NewStringNameWithLatin1Chars("db_to_linear")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2140049587)
-	if fn == nil {
-		panic("could not find utility function db_to_linear (2140049587)")
-	}
+	fn := getUtilityFunction("db_to_linear", 2140049587)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(db))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1681,27 +1303,20 @@ func DbToLinear(db float32) float32 {
 
 // Wrap is under the category "math".
 func Wrap(value Variant, min Variant, max Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("wrap")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3389874542)
-	if fn == nil {
-		panic("could not find utility function wrap (3389874542)")
-	}
+	fn := getUtilityFunction("wrap", 3389874542)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(value))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(min))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(max))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1709,27 +1324,20 @@ func Wrap(value Variant, min Variant, max Variant) Variant {
 
 // Wrapi is under the category "math".
 func Wrapi(value int64, min int64, max int64) int64 {
-	fName := NewStringNameWithLatin1Chars("wrapi")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 650295447)
-	if fn == nil {
-		panic("could not find utility function wrapi (650295447)")
-	}
+	fn := getUtilityFunction("wrapi", 650295447)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(value))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(min))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(max))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1737,27 +1345,20 @@ func Wrapi(value int64, min int64, max int64) int64 {
 
 // Wrapf is under the category "math".
 func Wrapf(value float32, min float32, max float32) float32 {
-	fName := NewStringNameWithLatin1Chars("wrapf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 998901048)
-	if fn == nil {
-		panic("could not find utility function wrapf (998901048)")
-	}
+	fn := getUtilityFunction("wrapf", 998901048)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(value))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(min))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(max))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1765,29 +1366,22 @@ func Wrapf(value float32, min float32, max float32) float32 {
 
 // Max is under the category "math".
 func Max(arg1 Variant, arg2 Variant, varargs ...Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("max")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3896050336)
-	if fn == nil {
-		panic("could not find utility function max (3896050336)")
-	}
+	fn := getUtilityFunction("max", 3896050336)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 2
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg2)))
-	pnr.Pin(args[1])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1795,25 +1389,19 @@ func Max(arg1 Variant, arg2 Variant, varargs ...Variant) Variant {
 
 // Maxi is under the category "math".
 func Maxi(a int64, b int64) int64 {
-	fName := NewStringNameWithLatin1Chars("maxi")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3133453818)
-	if fn == nil {
-		panic("could not find utility function maxi (3133453818)")
-	}
+	fn := getUtilityFunction("maxi", 3133453818)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(a))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(b))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1821,25 +1409,19 @@ func Maxi(a int64, b int64) int64 {
 
 // Maxf is under the category "math".
 func Maxf(a float32, b float32) float32 {
-	fName := NewStringNameWithLatin1Chars("maxf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function maxf (92296394)")
-	}
+	fn := getUtilityFunction("maxf", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(a))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(b))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1847,29 +1429,22 @@ func Maxf(a float32, b float32) float32 {
 
 // Min is under the category "math".
 func Min(arg1 Variant, arg2 Variant, varargs ...Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("min")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3896050336)
-	if fn == nil {
-		panic("could not find utility function min (3896050336)")
-	}
+	fn := getUtilityFunction("min", 3896050336)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 2
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	args[1] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg2)))
-	pnr.Pin(args[1])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1877,25 +1452,19 @@ func Min(arg1 Variant, arg2 Variant, varargs ...Variant) Variant {
 
 // Mini is under the category "math".
 func Mini(a int64, b int64) int64 {
-	fName := NewStringNameWithLatin1Chars("mini")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3133453818)
-	if fn == nil {
-		panic("could not find utility function mini (3133453818)")
-	}
+	fn := getUtilityFunction("mini", 3133453818)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(a))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(b))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1903,25 +1472,19 @@ func Mini(a int64, b int64) int64 {
 
 // Minf is under the category "math".
 func Minf(a float32, b float32) float32 {
-	fName := NewStringNameWithLatin1Chars("minf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function minf (92296394)")
-	}
+	fn := getUtilityFunction("minf", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(a))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(b))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1929,27 +1492,20 @@ func Minf(a float32, b float32) float32 {
 
 // Clamp is under the category "math".
 func Clamp(value Variant, min Variant, max Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("clamp")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3389874542)
-	if fn == nil {
-		panic("could not find utility function clamp (3389874542)")
-	}
+	fn := getUtilityFunction("clamp", 3389874542)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(value))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(min))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(max))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1957,27 +1513,20 @@ func Clamp(value Variant, min Variant, max Variant) Variant {
 
 // Clampi is under the category "math".
 func Clampi(value int64, min int64, max int64) int64 {
-	fName := NewStringNameWithLatin1Chars("clampi")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 650295447)
-	if fn == nil {
-		panic("could not find utility function clampi (650295447)")
-	}
+	fn := getUtilityFunction("clampi", 650295447)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(value))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(min))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(max))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -1985,27 +1534,20 @@ func Clampi(value int64, min int64, max int64) int64 {
 
 // Clampf is under the category "math".
 func Clampf(value float32, min float32, max float32) float32 {
-	fName := NewStringNameWithLatin1Chars("clampf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 998901048)
-	if fn == nil {
-		panic("could not find utility function clampf (998901048)")
-	}
+	fn := getUtilityFunction("clampf", 998901048)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [3]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(value))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(min))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(max))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
-	pnr.Pin(args[2])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(3)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2013,23 +1555,18 @@ func Clampf(value float32, min float32, max float32) float32 {
 
 // NearestPo2 is under the category "math".
 func NearestPo2(value int64) int64 {
-	fName := NewStringNameWithLatin1Chars("nearest_po2")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2157319888)
-	if fn == nil {
-		panic("could not find utility function nearest_po2 (2157319888)")
-	}
+	fn := getUtilityFunction("nearest_po2", 2157319888)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(value))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2037,25 +1574,19 @@ func NearestPo2(value int64) int64 {
 
 // Pingpong is under the category "math".
 func Pingpong(value float32, length float32) float32 {
-	fName := NewStringNameWithLatin1Chars("pingpong")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function pingpong (92296394)")
-	}
+	fn := getUtilityFunction("pingpong", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(value))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(length))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2063,35 +1594,21 @@ func Pingpong(value float32, length float32) float32 {
 
 // Randomize is under the category "random".
 func Randomize() {
-	fName := NewStringNameWithLatin1Chars("randomize")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1691721052)
-	if fn == nil {
-		panic("could not find utility function randomize (1691721052)")
-	}
+	fn := getUtilityFunction("randomize", 1691721052)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
 	typePtrArgs := (*GDExtensionConstTypePtr)(nil)
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(0)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // Randi is under the category "random".
 func Randi() int64 {
-	fName := NewStringNameWithLatin1Chars("randi")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 701202648)
-	if fn == nil {
-		panic("could not find utility function randi (701202648)")
-	}
+	fn := getUtilityFunction("randi", 701202648)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
 	typePtrArgs := (*GDExtensionConstTypePtr)(nil)
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(0)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2099,19 +1616,12 @@ func Randi() int64 {
 
 // Randf is under the category "random".
 func Randf() float32 {
-	fName := NewStringNameWithLatin1Chars("randf")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2086227845)
-	if fn == nil {
-		panic("could not find utility function randf (2086227845)")
-	}
+	fn := getUtilityFunction("randf", 2086227845)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
 	typePtrArgs := (*GDExtensionConstTypePtr)(nil)
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(0)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2119,25 +1629,19 @@ func Randf() float32 {
 
 // RandiRange is under the category "random".
 func RandiRange(from int64, to int64) int64 {
-	fName := NewStringNameWithLatin1Chars("randi_range")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3133453818)
-	if fn == nil {
-		panic("could not find utility function randi_range (3133453818)")
-	}
+	fn := getUtilityFunction("randi_range", 3133453818)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(to))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2145,25 +1649,19 @@ func RandiRange(from int64, to int64) int64 {
 
 // RandfRange is under the category "random".
 func RandfRange(from float32, to float32) float32 {
-	fName := NewStringNameWithLatin1Chars("randf_range")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function randf_range (92296394)")
-	}
+	fn := getUtilityFunction("randf_range", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(from))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(to))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2171,25 +1669,19 @@ func RandfRange(from float32, to float32) float32 {
 
 // Randfn is under the category "random".
 func Randfn(mean float32, deviation float32) float32 {
-	fName := NewStringNameWithLatin1Chars("randfn")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 92296394)
-	if fn == nil {
-		panic("could not find utility function randfn (92296394)")
-	}
+	fn := getUtilityFunction("randfn", 92296394)
 	var ret float32
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(mean))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Float32Encoder.EncodeTypePtr(deviation))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2197,43 +1689,33 @@ func Randfn(mean float32, deviation float32) float32 {
 
 // Seed is under the category "random".
 func Seed(base int64) {
-	fName := NewStringNameWithLatin1Chars("seed")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 382931173)
-	if fn == nil {
-		panic("could not find utility function seed (382931173)")
-	}
+	fn := getUtilityFunction("seed", 382931173)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(base))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // RandFromSeed is under the category "random".
 func RandFromSeed(seed int64) PackedInt64Array {
-	fName := NewStringNameWithLatin1Chars("rand_from_seed")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1391063685)
-	if fn == nil {
-		panic("could not find utility function rand_from_seed (1391063685)")
-	}
+	fn := getUtilityFunction("rand_from_seed", 1391063685)
 	var ret PackedInt64Array
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(seed))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2241,23 +1723,18 @@ func RandFromSeed(seed int64) PackedInt64Array {
 
 // Weakref is under the category "general".
 func Weakref(obj Variant) Variant {
-	fName := NewStringNameWithLatin1Chars("weakref")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 4776452)
-	if fn == nil {
-		panic("could not find utility function weakref (4776452)")
-	}
+	fn := getUtilityFunction("weakref", 4776452)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(obj))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2265,23 +1742,18 @@ func Weakref(obj Variant) Variant {
 
 // Typeof is under the category "general".
 func Typeof(variable Variant) int64 {
-	fName := NewStringNameWithLatin1Chars("typeof")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 326422594)
-	if fn == nil {
-		panic("could not find utility function typeof (326422594)")
-	}
+	fn := getUtilityFunction("typeof", 326422594)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(variable))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2289,25 +1761,19 @@ func Typeof(variable Variant) int64 {
 
 // TypeConvert is under the category "general".
 func TypeConvert(variant Variant, typeName int64) Variant {
-	fName := NewStringNameWithLatin1Chars("type_convert")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2453062746)
-	if fn == nil {
-		panic("could not find utility function type_convert (2453062746)")
-	}
+	fn := getUtilityFunction("type_convert", 2453062746)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(variant))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(typeName))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2315,27 +1781,21 @@ func TypeConvert(variant Variant, typeName int64) Variant {
 
 // Str is under the category "general".
 func Str(arg1 Variant, varargs ...Variant) String {
-	fName := NewStringNameWithLatin1Chars("str")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 32569176)
-	if fn == nil {
-		panic("could not find utility function str (32569176)")
-	}
+	fn := getUtilityFunction("str", 32569176)
 	var ret String
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 1
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2343,23 +1803,18 @@ func Str(arg1 Variant, varargs ...Variant) String {
 
 // ErrorString is under the category "general".
 func ErrorString(error int64) String {
-	fName := NewStringNameWithLatin1Chars("error_string")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 942708242)
-	if fn == nil {
-		panic("could not find utility function error_string (942708242)")
-	}
+	fn := getUtilityFunction("error_string", 942708242)
 	var ret String
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(error))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2367,23 +1822,18 @@ func ErrorString(error int64) String {
 
 // TypeString is under the category "general".
 func TypeString(typeName int64) String {
-	fName := NewStringNameWithLatin1Chars("type_string")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 942708242)
-	if fn == nil {
-		panic("could not find utility function type_string (942708242)")
-	}
+	fn := getUtilityFunction("type_string", 942708242)
 	var ret String
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(typeName))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2391,239 +1841,180 @@ func TypeString(typeName int64) String {
 
 // Print is under the category "general".
 func Print(arg1 Variant, varargs ...Variant) {
-	fName := NewStringNameWithLatin1Chars("print")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2648703342)
-	if fn == nil {
-		panic("could not find utility function print (2648703342)")
-	}
+	fn := getUtilityFunction("print", 2648703342)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 1
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // PrintRich is under the category "general".
 func PrintRich(arg1 Variant, varargs ...Variant) {
-	fName := NewStringNameWithLatin1Chars("print_rich")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2648703342)
-	if fn == nil {
-		panic("could not find utility function print_rich (2648703342)")
-	}
+	fn := getUtilityFunction("print_rich", 2648703342)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 1
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // Printerr is under the category "general".
 func Printerr(arg1 Variant, varargs ...Variant) {
-	fName := NewStringNameWithLatin1Chars("printerr")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2648703342)
-	if fn == nil {
-		panic("could not find utility function printerr (2648703342)")
-	}
+	fn := getUtilityFunction("printerr", 2648703342)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 1
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // Printt is under the category "general".
 func Printt(arg1 Variant, varargs ...Variant) {
-	fName := NewStringNameWithLatin1Chars("printt")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2648703342)
-	if fn == nil {
-		panic("could not find utility function printt (2648703342)")
-	}
+	fn := getUtilityFunction("printt", 2648703342)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 1
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // Prints is under the category "general".
 func Prints(arg1 Variant, varargs ...Variant) {
-	fName := NewStringNameWithLatin1Chars("prints")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2648703342)
-	if fn == nil {
-		panic("could not find utility function prints (2648703342)")
-	}
+	fn := getUtilityFunction("prints", 2648703342)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 1
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // Printraw is under the category "general".
 func Printraw(arg1 Variant, varargs ...Variant) {
-	fName := NewStringNameWithLatin1Chars("printraw")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2648703342)
-	if fn == nil {
-		panic("could not find utility function printraw (2648703342)")
-	}
+	fn := getUtilityFunction("printraw", 2648703342)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 1
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // PrintVerbose is under the category "general".
 func PrintVerbose(arg1 Variant, varargs ...Variant) {
-	fName := NewStringNameWithLatin1Chars("print_verbose")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2648703342)
-	if fn == nil {
-		panic("could not find utility function print_verbose (2648703342)")
-	}
+	fn := getUtilityFunction("print_verbose", 2648703342)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 1
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // PushError is under the category "general".
 func PushError(arg1 Variant, varargs ...Variant) {
-	fName := NewStringNameWithLatin1Chars("push_error")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2648703342)
-	if fn == nil {
-		panic("could not find utility function push_error (2648703342)")
-	}
+	fn := getUtilityFunction("push_error", 2648703342)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 1
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // PushWarning is under the category "general".
 func PushWarning(arg1 Variant, varargs ...Variant) {
-	fName := NewStringNameWithLatin1Chars("push_warning")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2648703342)
-	if fn == nil {
-		panic("could not find utility function push_warning (2648703342)")
-	}
+	fn := getUtilityFunction("push_warning", 2648703342)
 	retPtr := (GDExtensionTypePtr)(nullptr)
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	sz := 1
 	args := make([]GDExtensionConstTypePtr, sz+len(varargs))
 	args[0] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(arg1)))
-	pnr.Pin(args[0])
 	for i := range varargs {
 		args[sz+i] = (GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(varargs[i])))
-		pnr.Pin(args[sz+i])
 	}
+	PinArgs(&pinner, args)
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.SliceData(args))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1 + len(varargs))
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 }
 
 // VarToStr is under the category "general".
 func VarToStr(variable Variant) String {
-	fName := NewStringNameWithLatin1Chars("var_to_str")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 866625479)
-	if fn == nil {
-		panic("could not find utility function var_to_str (866625479)")
-	}
+	fn := getUtilityFunction("var_to_str", 866625479)
 	var ret String
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(variable))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2631,23 +2022,18 @@ func VarToStr(variable Variant) String {
 
 // StrToVar is under the category "general".
 func StrToVar(strValue String) Variant {
-	fName := NewStringNameWithLatin1Chars("str_to_var")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1891498491)
-	if fn == nil {
-		panic("could not find utility function str_to_var (1891498491)")
-	}
+	fn := getUtilityFunction("str_to_var", 1891498491)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(StringEncoder.EncodeTypePtr(strValue))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2655,23 +2041,18 @@ func StrToVar(strValue String) Variant {
 
 // VarToBytes is under the category "general".
 func VarToBytes(variable Variant) PackedByteArray {
-	fName := NewStringNameWithLatin1Chars("var_to_bytes")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2947269930)
-	if fn == nil {
-		panic("could not find utility function var_to_bytes (2947269930)")
-	}
+	fn := getUtilityFunction("var_to_bytes", 2947269930)
 	var ret PackedByteArray
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(variable))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2679,23 +2060,18 @@ func VarToBytes(variable Variant) PackedByteArray {
 
 // BytesToVar is under the category "general".
 func BytesToVar(bytes PackedByteArray) Variant {
-	fName := NewStringNameWithLatin1Chars("bytes_to_var")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 4249819452)
-	if fn == nil {
-		panic("could not find utility function bytes_to_var (4249819452)")
-	}
+	fn := getUtilityFunction("bytes_to_var", 4249819452)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(PackedByteArrayEncoder.EncodeTypePtr(bytes))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2703,23 +2079,18 @@ func BytesToVar(bytes PackedByteArray) Variant {
 
 // VarToBytesWithObjects is under the category "general".
 func VarToBytesWithObjects(variable Variant) PackedByteArray {
-	fName := NewStringNameWithLatin1Chars("var_to_bytes_with_objects")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2947269930)
-	if fn == nil {
-		panic("could not find utility function var_to_bytes_with_objects (2947269930)")
-	}
+	fn := getUtilityFunction("var_to_bytes_with_objects", 2947269930)
 	var ret PackedByteArray
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(variable))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2727,23 +2098,18 @@ func VarToBytesWithObjects(variable Variant) PackedByteArray {
 
 // BytesToVarWithObjects is under the category "general".
 func BytesToVarWithObjects(bytes PackedByteArray) Variant {
-	fName := NewStringNameWithLatin1Chars("bytes_to_var_with_objects")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 4249819452)
-	if fn == nil {
-		panic("could not find utility function bytes_to_var_with_objects (4249819452)")
-	}
+	fn := getUtilityFunction("bytes_to_var_with_objects", 4249819452)
 	var ret Variant
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(PackedByteArrayEncoder.EncodeTypePtr(bytes))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2751,23 +2117,18 @@ func BytesToVarWithObjects(bytes PackedByteArray) Variant {
 
 // Hash is under the category "general".
 func Hash(variable Variant) int64 {
-	fName := NewStringNameWithLatin1Chars("hash")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 326422594)
-	if fn == nil {
-		panic("could not find utility function hash (326422594)")
-	}
+	fn := getUtilityFunction("hash", 326422594)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(variable))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2775,24 +2136,19 @@ func Hash(variable Variant) int64 {
 
 // InstanceFromId is under the category "general".
 func InstanceFromId(instance_id int64) Object {
-	fName := NewStringNameWithLatin1Chars("instance_from_id")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1156694636)
-	if fn == nil {
-		panic("could not find utility function instance_from_id (1156694636)")
-	}
+	fn := getUtilityFunction("instance_from_id", 1156694636)
 	var ret Object
 
 	ret = &ObjectImpl{}
 	retPtr := ret.AsGDExtensionTypePtr()
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(instance_id))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2800,23 +2156,18 @@ func InstanceFromId(instance_id int64) Object {
 
 // IsInstanceIdValid is under the category "general".
 func IsInstanceIdValid(id int64) bool {
-	fName := NewStringNameWithLatin1Chars("is_instance_id_valid")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 2232439758)
-	if fn == nil {
-		panic("could not find utility function is_instance_id_valid (2232439758)")
-	}
+	fn := getUtilityFunction("is_instance_id_valid", 2232439758)
 	var ret bool
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(id))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2824,23 +2175,18 @@ func IsInstanceIdValid(id int64) bool {
 
 // IsInstanceValid is under the category "general".
 func IsInstanceValid(instance Variant) bool {
-	fName := NewStringNameWithLatin1Chars("is_instance_valid")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 996128841)
-	if fn == nil {
-		panic("could not find utility function is_instance_valid (996128841)")
-	}
+	fn := getUtilityFunction("is_instance_valid", 996128841)
 	var ret bool
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(instance))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2848,19 +2194,12 @@ func IsInstanceValid(instance Variant) bool {
 
 // RidAllocateId is under the category "general".
 func RidAllocateId() int64 {
-	fName := NewStringNameWithLatin1Chars("rid_allocate_id")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 701202648)
-	if fn == nil {
-		panic("could not find utility function rid_allocate_id (701202648)")
-	}
+	fn := getUtilityFunction("rid_allocate_id", 701202648)
 	var ret int64
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
 	typePtrArgs := (*GDExtensionConstTypePtr)(nil)
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(0)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2868,23 +2207,18 @@ func RidAllocateId() int64 {
 
 // RidFromInt64 is under the category "general".
 func RidFromInt64(base int64) RID {
-	fName := NewStringNameWithLatin1Chars("rid_from_int64")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 3426892196)
-	if fn == nil {
-		panic("could not find utility function rid_from_int64 (3426892196)")
-	}
+	fn := getUtilityFunction("rid_from_int64", 3426892196)
 	var ret RID
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(ret.NativePtr()))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [1]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(Int64Encoder.EncodeTypePtr(base))),
 	}
-	pnr.Pin(args[0])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(1)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -2892,25 +2226,19 @@ func RidFromInt64(base int64) RID {
 
 // IsSame is under the category "general".
 func IsSame(a Variant, b Variant) bool {
-	fName := NewStringNameWithLatin1Chars("is_same")
-	defer fName.Destroy()
-	fn := CallFunc_GDExtensionInterfaceVariantGetPtrUtilityFunction(fName.AsGDExtensionConstStringNamePtr(), 1409423524)
-	if fn == nil {
-		panic("could not find utility function is_same (1409423524)")
-	}
+	fn := getUtilityFunction("is_same", 1409423524)
 	var ret bool
 
 	retPtr := (GDExtensionTypePtr)(unsafe.Pointer(&ret))
 
-	pnr.Pin(retPtr)
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
 	args := [2]GDExtensionConstTypePtr{
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(a))),
 		(GDExtensionConstTypePtr)(unsafe.Pointer(VariantEncoder.EncodeTypePtr(b))),
 	}
-	pnr.Pin(args[0])
-	pnr.Pin(args[1])
+	PinArgs(&pinner, args[:])
 	typePtrArgs := (*GDExtensionConstTypePtr)(unsafe.Pointer(&args[0]))
-	pnr.Pin(typePtrArgs)
 	argCount := (int32)(2)
 	CallFunc_GDExtensionPtrUtilityFunction(fn, retPtr, typePtrArgs, argCount)
 	return ret
@@ -0,0 +1,141 @@
+package log
+
+import (
+	"runtime"
+	"strings"
+	"sync/atomic"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// GodotPrinter reports log records to the engine, where they show up in the
+// editor's Output panel and the Debugger's Errors tab, linked to the Go source
+// that logged them. description is the log message and message holds the
+// encoded fields, or is empty if there are none.
+type GodotPrinter interface {
+	PrintWarning(description, message, function, file string, line int32)
+	PrintError(description, message, function, file string, line int32)
+	PrintScriptError(description, message, function, file string, line int32)
+}
+
+type godotPrinterBox struct {
+	printer GodotPrinter
+}
+
+var godotPrinter atomic.Pointer[godotPrinterBox]
+
+// SetGodotPrinter routes records at Warn and above to p instead of the
+// console output. pkg/core sets it once the extension interface is loaded;
+// passing nil goes back to the console.
+func SetGodotPrinter(p GodotPrinter) {
+	if p == nil {
+		godotPrinter.Store(nil)
+		return
+	}
+	godotPrinter.Store(&godotPrinterBox{printer: p})
+}
+
+func loadGodotPrinter() GodotPrinter {
+	if box := godotPrinter.Load(); box != nil {
+		return box.printer
+	}
+	return nil
+}
+
+const scriptErrorKey = "godot_script_error"
+
+// ScriptError marks an error record as an error in a script rather than in
+// the extension, for example when a script passed an invalid argument:
+//
+//	log.Error("invalid path", zap.String("path", p), log.ScriptError())
+func ScriptError() Field {
+	return zap.Bool(scriptErrorKey, true)
+}
+
+// consoleEnabled keeps the console quiet for the records forwarded to Godot,
+// which echoes them to the console itself.
+func consoleEnabled(l zapcore.Level) bool {
+	return atomicLevel.Enabled(l) && (l < WarnLevel || loadGodotPrinter() == nil)
+}
+
+// godotCore is the zapcore.Core behind SetGodotPrinter.
+type godotCore struct {
+	enc zapcore.Encoder
+}
+
+func newGodotCore() zapcore.Core {
+	// only the fields are encoded; the message, level and caller are passed
+	// to Godot separately
+	return &godotCore{
+		enc: zapcore.NewJSONEncoder(zapcore.EncoderConfig{
+			EncodeDuration: zapcore.StringDurationEncoder,
+			EncodeTime:     zapcore.ISO8601TimeEncoder,
+		}),
+	}
+}
+
+func (c *godotCore) Enabled(l zapcore.Level) bool {
+	return l >= WarnLevel && atomicLevel.Enabled(l) && loadGodotPrinter() != nil
+}
+
+func (c *godotCore) With(fields []zapcore.Field) zapcore.Core {
+	enc := c.enc.Clone()
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+	return &godotCore{enc: enc}
+}
+
+func (c *godotCore) Check(e zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(e.Level) {
+		return ce.AddCore(e, c)
+	}
+	return ce
+}
+
+func (c *godotCore) Write(e zapcore.Entry, fields []zapcore.Field) error {
+	p := loadGodotPrinter()
+	if p == nil {
+		return nil
+	}
+	isScriptError := false
+	kept := fields[:0:0]
+	for _, f := range fields {
+		if f.Key == scriptErrorKey {
+			isScriptError = true
+			continue
+		}
+		kept = append(kept, f)
+	}
+	buf, err := c.enc.EncodeEntry(zapcore.Entry{}, kept)
+	if err != nil {
+		return err
+	}
+	message := strings.TrimSpace(buf.String())
+	buf.Free()
+	if message == "{}" {
+		message = ""
+	}
+	if e.Stack != "" {
+		message = strings.TrimSpace(message + "\n" + e.Stack)
+	}
+	var function string
+	if f := runtime.FuncForPC(e.Caller.PC); f != nil {
+		function = f.Name()
+	}
+	file, line := e.Caller.File, int32(e.Caller.Line)
+	switch {
+	case e.Level == WarnLevel:
+		p.PrintWarning(e.Message, message, function, file, line)
+	case isScriptError:
+		p.PrintScriptError(e.Message, message, function, file, line)
+	default:
+		p.PrintError(e.Message, message, function, file, line)
+	}
+	return nil
+}
+
+func (c *godotCore) Sync() error {
+	return nil
+}
@@ -0,0 +1,112 @@
+package log
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+type printed struct {
+	kind, description, message, function, file string
+	line                                       int32
+}
+
+type recordingPrinter struct {
+	records []printed
+}
+
+func (p *recordingPrinter) PrintWarning(description, message, function, file string, line int32) {
+	p.records = append(p.records, printed{"warning", description, message, function, file, line})
+}
+
+func (p *recordingPrinter) PrintError(description, message, function, file string, line int32) {
+	p.records = append(p.records, printed{"error", description, message, function, file, line})
+}
+
+func (p *recordingPrinter) PrintScriptError(description, message, function, file string, line int32) {
+	p.records = append(p.records, printed{"script_error", description, message, function, file, line})
+}
+
+func withRecordingPrinter(t *testing.T) *recordingPrinter {
+	t.Helper()
+	p := &recordingPrinter{}
+	SetGodotPrinter(p)
+	t.Cleanup(func() { SetGodotPrinter(nil) })
+	return p
+}
+
+func TestGodotPrinterReceivesWarnAndAbove(t *testing.T) {
+	p := withRecordingPrinter(t)
+	Info("not forwarded")
+	Warn("low fuel", zap.Int("liters", 3))
+	Error("engine failure")
+	Error("bad argument", ScriptError())
+
+	if len(p.records) != 3 {
+		t.Fatalf("got %d records, want 3: %+v", len(p.records), p.records)
+	}
+	warn := p.records[0]
+	if warn.kind != "warning" || warn.description != "low fuel" {
+		t.Errorf("unexpected warning %+v", warn)
+	}
+	if !strings.HasPrefix(warn.message, `{"liters":3}`) {
+		t.Errorf("warning message = %q, want the encoded fields first", warn.message)
+	}
+	if !strings.HasSuffix(warn.file, "godot_test.go") || warn.line == 0 {
+		t.Errorf("warning caller = %s:%d, want this test file", warn.file, warn.line)
+	}
+	if !strings.HasSuffix(warn.function, "TestGodotPrinterReceivesWarnAndAbove") {
+		t.Errorf("warning function = %q", warn.function)
+	}
+	if got := p.records[1].kind; got != "error" {
+		t.Errorf("second record kind = %q, want error", got)
+	}
+	script := p.records[2]
+	if script.kind != "script_error" || strings.Contains(script.message, scriptErrorKey) {
+		t.Errorf("unexpected script error %+v", script)
+	}
+}
+
+func TestConsoleFallbackWithoutGodotPrinter(t *testing.T) {
+	SetGodotPrinter(nil)
+	if !consoleEnabled(WarnLevel) {
+		t.Error("warnings must go to the console before the printer is set")
+	}
+	withRecordingPrinter(t)
+	if consoleEnabled(WarnLevel) {
+		t.Error("warnings must not be printed twice once the printer is set")
+	}
+}
+
+func TestSlogHandlerForwardsToGodot(t *testing.T) {
+	p := withRecordingPrinter(t)
+	l := slog.New(NewSlogHandler()).WithGroup("ball")
+	l.Info("not forwarded")
+	l.Warn("out of bounds", "x", 12.5)
+
+	if len(p.records) != 1 {
+		t.Fatalf("got %d records, want 1: %+v", len(p.records), p.records)
+	}
+	r := p.records[0]
+	if r.description != "out of bounds" || r.message != `{"ball.x":12.5}` {
+		t.Errorf("unexpected record %+v", r)
+	}
+	if !strings.HasSuffix(r.file, "godot_test.go") {
+		t.Errorf("caller file = %q, want this test file", r.file)
+	}
+}
+
+func TestAppendAttrFlattensGroups(t *testing.T) {
+	fields := appendAttr(nil, "", slog.Group("pos", slog.Int("x", 1), slog.Int("y", 2)))
+	var buf bytes.Buffer
+	for _, f := range fields {
+		buf.WriteString(f.Key)
+		buf.WriteString(" ")
+	}
+	if got := buf.String(); got != "pos.x pos.y " {
+		t.Errorf("keys = %q", got)
+	}
+}
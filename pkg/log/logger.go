@@ -81,10 +81,13 @@ func init() {
 	options = append(options, zap.AddStacktrace(zap.WarnLevel))
 
 	logger = zap.New(
-		zapcore.NewCore(
-			zapcore.NewConsoleEncoder(encoderCfg),
-			zapcore.Lock(zapcore.AddSync(output)),
-			atomicLevel,
+		zapcore.NewTee(
+			zapcore.NewCore(
+				zapcore.NewConsoleEncoder(encoderCfg),
+				zapcore.Lock(zapcore.AddSync(output)),
+				zap.LevelEnablerFunc(consoleEnabled),
+			),
+			newGodotCore(),
 		),
 		options...,
 	)
@@ -3,8 +3,16 @@ package util
 import (
 	"reflect"
 	"strings"
+
+	"github.com/iancoleman/strcase"
 )
 
+// SnakeCase converts a Go identifier such as "TakeDamage" into the name Godot
+// uses for it ("take_damage").
+func SnakeCase(name string) string {
+	return strcase.ToSnake(name)
+}
+
 func ReflectValueSliceToString(values []reflect.Value) string {
 	var sb strings.Builder
 	sb.WriteString("(")
@@ -40,6 +40,18 @@ func TestReflectValueSliceToString(t *testing.T) {
 	}
 }
 
+func TestSnakeCase(t *testing.T) {
+	for in, want := range map[string]string{
+		"TakeDamage": "take_damage",
+		"Hp":         "hp",
+		"BallLost":   "ball_lost",
+	} {
+		if got := SnakeCase(in); got != want {
+			t.Fatalf("SnakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
 func TestSyncMap(t *testing.T) {
 	m := NewSyncMap[string, int]()
 	if m.HasKey("missing") {
@@ -34,6 +34,9 @@ func _ready():
 	if OS.has_environment("GODOT_GO_PHYSICS_BENCH"):
 		start_physics_benchmark()
 		return
+	if OS.has_environment("GODOT_GO_METHOD_BIND_BENCH"):
+		start_method_bind_benchmark()
+		return
 	schedule(0.0, Callable(self, "_run_tests"))
 	# example.group_subgroup_custom_position = Vector2(0, 0)
 	# var t = get_tree()
@@ -151,6 +154,19 @@ func _physics_benchmark_step() -> void:
 	_bench_steps -= 1
 	schedule(float(1.0 / Engine.get_physics_ticks_per_second()), Callable(self, "_physics_benchmark_step"))
 
+func start_method_bind_benchmark() -> void:
+	var iterations := get_env_int("GODOT_GO_METHOD_BIND_BENCH_ITERATIONS", 100000)
+	var bench = MethodBindBenchmark.new()
+	bench.name = "MethodBindBenchmark"
+	add_child(bench)
+	var results = bench.run(iterations)
+	print("method bind benchmark results: ", results)
+	# Timings are informational; a loaded machine can make either path slower.
+	if results.get("cached_ns_per_call", 0) > results.get("lookup_ns_per_call", 0):
+		push_warning("cached method binds were slower than ClassDB lookups: %s" % results)
+	bench.queue_free()
+	schedule(0.0, Callable(self, "_finish_tests"))
+
 func schedule(delay: float, callback: Callable) -> void:
 	var timer = get_tree().create_timer(delay)
 	timer.timeout.connect(callback)
@@ -160,20 +176,92 @@ func test_suite(i: int, example):
 	# Signal.
 	example.simple_func()
 
+	# Go errors.
+	assert_equal(example.check_positive(1), OK)
+	assert_equal(example.check_positive(-1), ERR_INVALID_PARAMETER)
+	assert_equal(example.parse_count("12"), 12)
+
+	# Notifications.
+	assert_equal(example.ready_notifications(), 1)
+
+	# Typed signal fields.
+	var custom_signal_emitted = []
+	var on_custom_signal = func(name, value): custom_signal_emitted.append_array([name, value])
+	example.custom_signal.connect(on_custom_signal)
+	example.emit_custom_signal("typed", 7)
+	assert_equal(custom_signal_emitted, ["typed", 7])
+	example.custom_signal.disconnect(on_custom_signal)
+
+	# Signals received on a Go channel.
+	assert_equal(example.test_signal_chan("chan", 3), ["chan", 3])
+
+	# Await resumes in a later frame; checked in _after_physics_test.
+	example.start_await_test()
+	assert_equal(example.await_results(), [])
+
+	# Work queued from a goroutine runs on the main thread.
+	example.start_main_thread_test()
+
+	# Virtual methods declared in Go and overridden by scripts.
+	assert_equal(example.hit(1.5), 3.0)
+	var hit_script = GDScript.new()
+	hit_script.source_code = "extends Example\n\nfunc _on_hit(strength: float) -> float:\n\treturn strength + 100.0\n"
+	assert_equal(hit_script.reload(), OK)
+	var scripted = Example.new()
+	scripted.set_script(hit_script)
+	assert_equal(scripted.hit(1.5), 101.5)
+	scripted.free()
+
+	# Classes registered with AutoRegister.
+	var auto = AutoExample.new()
+	add_child(auto)
+	assert_equal(auto.ready_count(), 1)
+	auto.speed = 2.0
+	assert_equal(auto.get_speed(), 2.0)
+	var boosted = []
+	auto.boosted.connect(func(speed): boosted.append(speed))
+	assert_equal(auto.boost(1.5), 3.0)
+	assert_equal(boosted, [3.0])
+	auto.label = "x"
+	assert_equal(auto.label, "label:x")
+	auto.queue_free()
+
+	# Go classes attached as scripts.
+	var go_script = load("res://auto_example.gogd")
+	assert_equal(go_script.get_instance_base_type(), &"Node")
+	var go_node = Node.new()
+	go_node.set_script(go_script)
+	add_child(go_node)
+	assert_equal(go_node.ready_count(), 1)
+	go_node.speed = 2.0
+	assert_equal(go_node.boost(2.0), 4.0)
+	assert_equal(go_node.speed, 4.0)
+	go_node.queue_free()
+
 	# To string.
 	assert_equal(example.to_string(),'[ GDExtension::Example <--> Instance ID:%s ]' % example.get_instance_id())
 	# It appears there's a bug with instance ids :-(
 	#assert_equal($Example/ExampleMin.to_string(), 'ExampleMin:[Wrapped:%s]' % $Example/ExampleMin.get_instance_id())
 
-	# godot-go will probably not support static methods since they don't exist in go
 	# Call static methods.
-	# assert_equal(Example.test_static(9, 100), 109);
+	assert_equal(Example.test_static(9, 100), 109);
 	# It's void and static, so all we know is that it didn't crash.
-	# Example.test_static2()
+	Example.test_static2()
 
 	# Property list.
 	example.property_from_list = Vector3(100, 200, 300)
 	assert_equal(example.property_from_list, Vector3(100, 200, 300))
+	assert_equal(example.property_can_revert("property_from_list"), true)
+	example.property_from_list = Vector3(42, 42, 42)
+	assert_equal(example.property_can_revert("property_from_list"), false)
+
+	# Dynamic property list.
+	example.set_lane_count(2)
+	var lane_names = example.get_property_list().map(func(p): return p.name)
+	assert_equal(lane_names.has("lane_1"), true)
+	assert_equal(lane_names.has("lane_2"), false)
+	example.lane_1 = 5
+	assert_equal(example.lane_1, 5)
 	var prop_list = example.get_property_list()
 	for prop_info in prop_list:
 		if prop_info['name'] == 'mouse_filter':
@@ -189,6 +277,12 @@ func test_suite(i: int, example):
 	assert_equal(example.custom_ref_func(ref1), 27)
 	ref1.id += 1
 	assert_equal(example.custom_ref_func(ref1), 28)
+	for prop_info in ref1.get_property_list():
+		if prop_info['name'] == 'group_subgroup_id':
+			assert_equal(prop_info['hint'], PROPERTY_HINT_RANGE)
+			assert_equal(prop_info['hint_string'], "0,1000,1,or_greater")
+	assert_equal(ref1.property_can_revert("group_subgroup_id"), true)
+	assert_equal(ref1.property_get_revert("group_subgroup_id"), 0)
 
 	# Pass core reference.
 	assert_equal(example.image_ref_func(null), "invalid")
@@ -229,6 +323,16 @@ func test_suite(i: int, example):
 
 	example.callable_bind()
 
+	# Go closures as Callables
+	var go_callables_before: int = example.go_callable_count()
+	var go_callable: Dictionary = example.test_callable_from_func(10)
+	assert_equal(go_callable["result"], 13)
+	assert_equal(go_callable["is_custom"], true)
+	assert_equal(go_callable["argument_count"], 2)
+	assert_equal(go_callable["equal_self"], true)
+	assert_equal(go_callable["equal_other"], false)
+	assert_equal(example.go_callable_count(), go_callables_before)
+
 	# String += operator
 	assert_equal(example.test_string_ops(), "ABCĎE")
 
@@ -423,6 +527,8 @@ func _physics_test_step(step: int) -> void:
 		schedule(float(1.0 / Engine.get_physics_ticks_per_second()), Callable(self, "_after_physics_test"))
 
 func _after_physics_test() -> void:
+	assert_equal(_example.await_results(), ["signal await 3", "timer"])
+	assert_equal(_example.main_thread_runs(), [true, true])
 	start_input_test_suite()
 
 func setup_physics_rig() -> Dictionary:
@@ -0,0 +1,54 @@
+package pkg
+
+import (
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+)
+
+// AutoExample implements GDClass evidence.
+var _ GDClass = (*AutoExample)(nil)
+
+// AutoExample is registered with AutoRegister; it has no bind function.
+type AutoExample struct {
+	NodeImpl
+	Speed      float32 `godot:"export"`
+	Label      string  `godot:"export"`
+	Boosted    Signal1[float32]
+	readyCount int64
+}
+
+func (a *AutoExample) GetClassName() string {
+	return "AutoExample"
+}
+
+func (a *AutoExample) GetParentClassName() string {
+	return "Node"
+}
+
+func (a *AutoExample) V_Ready() {
+	a.readyCount++
+}
+
+func (a *AutoExample) ReadyCount() int64 {
+	return a.readyCount
+}
+
+func (a *AutoExample) Boost(factor float32) float32 {
+	a.Speed *= factor
+	a.Boosted.Emit(a.Speed)
+	return a.Speed
+}
+
+// GetLabel replaces the accessor AutoRegister would generate for Label.
+func (a *AutoExample) GetLabel() string {
+	return "label:" + a.Label
+}
+
+func RegisterClassAutoExample() {
+	AutoRegister[*AutoExample]()
+}
+
+func UnregisterClassAutoExample() {
+	ClassDBUnregisterClass[*AutoExample]()
+}
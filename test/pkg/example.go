@@ -40,10 +40,15 @@ var _ GDClass = (*Example)(nil)
 
 type Example struct {
 	ControlImpl
+	CustomSignal     Signal2[string, int64] `signal:"custom_signal,name,value"`
 	customPosition   Vector2
 	propertyFromList Vector3
 	dprop            [3]Vector2
 	leakStart        runtime.MemStats
+	awaitResults     []string
+	mainThreadRuns   []bool
+	readyNotified    int64
+	lanes            []int64
 }
 
 func (c *Example) GetClassName() string {
@@ -116,6 +121,19 @@ func (e *Example) TestNodeArgument(node *Example) *Example {
 	return node
 }
 
+// CheckPositive returns ERR_INVALID_PARAMETER to GDScript for a negative
+// value.
+func (e *Example) CheckPositive(value int64) error {
+	if value < 0 {
+		return WithErrorCode(fmt.Errorf("negative value %d", value), ERR_INVALID_PARAMETER)
+	}
+	return nil
+}
+
+func (e *Example) ParseCount(text string) (int64, error) {
+	return strconv.ParseInt(text, 10, 64)
+}
+
 func (e *Example) DefArgs(p_a, p_b int32) int32 {
 	ret := p_a + p_b
 	log.Info("DefArgs called", zap.Int32("sum", ret))
@@ -222,10 +240,7 @@ func (e *Example) GetPropertyFromList() Vector3 {
 }
 
 func (e *Example) EmitCustomSignal(name string, value int64) {
-	log.Info("EmitCustomSignal called (signal disabled)",
-		zap.String("name", name),
-		zap.Int64("value", value),
-	)
+	e.CustomSignal.Emit(name, value)
 }
 
 // TODO: dig into why casting is important
@@ -237,11 +252,13 @@ func (e *Example) EmitCustomSignal(name string, value int64) {
 // 	log.Debug("TestCastTo called", zap.Any("class", n.GetClassName()))
 // }
 
-func (e *Example) TestStatic(p_a, p_b int32) int32 {
+// ExampleTestStatic is bound as the static method Example.test_static.
+func ExampleTestStatic(p_a, p_b int32) int32 {
 	return p_a + p_b
 }
 
-func (e *Example) TestStatic2() {
+// ExampleTestStatic2 is bound as the static method Example.test_static2.
+func ExampleTestStatic2() {
 	println("  void static")
 }
 
@@ -285,6 +302,9 @@ func (e *Example) LeakCheckStart() {
 func (e *Example) LeakCheckTick(iterations int64) {
 	for i := int64(0); i < iterations; i++ {
 		s := NewStringWithUtf8Chars("leak_test")
+		what := NewStringWithUtf8Chars("test")
+		s.Find(what, 0)
+		what.Destroy()
 		s.Destroy()
 
 		sn := NewStringNameWithUtf8Chars("leak_test")
@@ -304,6 +324,9 @@ func (e *Example) LeakCheckTick(iterations int64) {
 		dict.SetKeyed("value", dictVal)
 		dictVal.Destroy()
 		dict.Destroy()
+
+		e.SetPosition(e.GetPosition())
+		Maxi(i, 0)
 	}
 }
 
@@ -372,6 +395,10 @@ func (e *Example) V_Set(name string, value Variant) bool {
 		e.propertyFromList = value.ToVector3()
 		return true
 	}
+	if index, ok := e.laneIndex(name); ok {
+		e.lanes[index] = value.ToInt64()
+		return true
+	}
 	return false
 }
 
@@ -401,9 +428,42 @@ func (e *Example) V_Get(name string) (Variant, bool) {
 		v := NewVariantVector3(e.propertyFromList)
 		return v, true
 	}
+	if index, ok := e.laneIndex(name); ok {
+		return NewVariantInt64(e.lanes[index]), true
+	}
 	return Variant{}, false
 }
 
+// V_GetPropertyList lists one lane_N property per lane.
+func (e *Example) V_GetPropertyList() []PropertyInfo {
+	props := make([]PropertyInfo, len(e.lanes))
+	for i := range e.lanes {
+		props[i] = PropertyInfo{
+			Type:    GDEXTENSION_VARIANT_TYPE_INT,
+			Name:    fmt.Sprintf("lane_%d", i),
+			Options: HintRange(0, 100, 1),
+		}
+	}
+	return props
+}
+
+func (e *Example) laneIndex(name string) (int, bool) {
+	suffix, ok := strings.CutPrefix(name, "lane_")
+	if !ok {
+		return 0, false
+	}
+	index, err := strconv.Atoi(suffix)
+	if err != nil || index < 0 || index >= len(e.lanes) {
+		return 0, false
+	}
+	return index, true
+}
+
+func (e *Example) SetLaneCount(count int64) {
+	e.lanes = make([]int64, count)
+	e.NotifyPropertyListChanged()
+}
+
 func (e *Example) V_Ready() {
 	log.Info("Example_Ready called",
 		zap.String("inst", fmt.Sprintf("%p", e)),
@@ -455,7 +515,7 @@ func (e *Example) V_Ready() {
 	input := GetInputSingleton()
 	uiRight := NewStringNameWithUtf8Chars("ui_right")
 	defer uiRight.Destroy()
-	input.IsActionPressed(uiRight, true)
+	input.IsActionPressedOpt(uiRight, true)
 	log.Info("NearestPo2(1025)",
 		zap.Int64("result", NearestPo2(1025)),
 	)
@@ -465,6 +525,18 @@ func (e *Example) V_Ready() {
 	)
 }
 
+func (e *Example) V_Notification(what Notification) {
+	if what == NODE_NOTIFICATION_READY {
+		e.readyNotified++
+	}
+}
+
+// ReadyNotifications returns the number of NOTIFICATION_READY received by
+// V_Notification.
+func (e *Example) ReadyNotifications() int64 {
+	return e.readyNotified
+}
+
 func (e *Example) V_Input(refEvent RefInputEvent) {
 	event := refEvent.TypedPtr()
 	if event == nil {
@@ -484,8 +556,8 @@ func (e *Example) V_Input(refEvent RefInputEvent) {
 }
 
 func (e *Example) TestSetPositionAndSize(pos, size Vector2) {
-	e.SetPosition(pos, true)
-	e.SetSize(size, true)
+	e.SetPositionOpt(pos, true)
+	e.SetSizeOpt(size, true)
 }
 
 func (e *Example) TestGetChildNode(nodePath string) Node {
@@ -518,7 +590,7 @@ func (e *Example) TestCharacterBody2D(body CharacterBody2D) {
 		zap.String("body", gdStrBody.ToUtf8()),
 	)
 	motion := NewVector2WithFloat32Float32(1.0, 2.0)
-	refCollision := body.MoveAndCollide(motion, true, 0.5, true)
+	refCollision := body.MoveAndCollideOpt(motion, true, 0.5, true)
 	collision := refCollision.TypedPtr()
 	collisionV := NewVariantGodotObject(collision.GetGodotObjectOwner())
 	log.Info("collision returned",
@@ -576,6 +648,130 @@ func (e *Example) CallableBind() {
 	log.Info("CallableBind called (signal disabled)")
 }
 
+// TestCallableFromFunc exercises a Go closure through its Callable and
+// destroys it again, so GoCallableCount returns to its previous value once
+// Godot frees the callable.
+func (e *Example) TestCallableFromFunc(base int64) Dictionary {
+	callable := NewCallableFromFunc(func(a int64, b int64) int64 {
+		return base + a + b
+	})
+	defer callable.Destroy()
+	other := NewCallableFromFunc(func() {})
+	defer other.Destroy()
+
+	args := NewArray()
+	defer args.Destroy()
+	a := NewVariantInt64(1)
+	defer a.Destroy()
+	b := NewVariantInt64(2)
+	defer b.Destroy()
+	args.Append(a)
+	args.Append(b)
+	ret := callable.Callv(args)
+	defer ret.Destroy()
+
+	result := NewDictionary()
+	for _, kv := range []struct {
+		key   string
+		value Variant
+	}{
+		{"result", NewVariantInt64(ret.ToInt64())},
+		{"is_custom", NewVariantBool(callable.IsCustom())},
+		{"argument_count", NewVariantInt64(callable.GetArgumentCount())},
+		{"equal_self", NewVariantBool(callable.Equal_Callable(callable))},
+		{"equal_other", NewVariantBool(callable.Equal_Callable(other))},
+	} {
+		result.SetKeyed(kv.key, kv.value)
+		kv.value.Destroy()
+	}
+	return result
+}
+
+// TestSignalChan emits custom_signal once and returns the arguments received
+// through a SignalChan.
+func (e *Example) TestSignalChan(name string, value int64) Array {
+	emitted, cancel := SignalChan(e, "custom_signal", 1)
+	defer cancel()
+	e.CustomSignal.Emit(name, value)
+	result := NewArray()
+	select {
+	case args := <-emitted:
+		for i := range args {
+			result.Append(args[i])
+		}
+		DestroyVariants(args)
+	default:
+		log.Error("TestSignalChan: nothing received")
+	}
+	return result
+}
+
+// StartAwaitTest awaits custom_signal, a cancelled await and a zero second
+// timer. The deferred results are read back with AwaitResults a few frames
+// later.
+func (e *Example) StartAwaitTest() {
+	e.awaitResults = nil
+	Await(e, e, "custom_signal", func(args []Variant) {
+		e.awaitResults = append(e.awaitResults, fmt.Sprintf("signal %s %d", args[0].ToGoString(), args[1].ToInt64()))
+	})
+	cancel := Await(e, e, "custom_signal", func([]Variant) {
+		e.awaitResults = append(e.awaitResults, "cancelled")
+	})
+	cancel()
+	AwaitTimer(e, 0, func() {
+		e.awaitResults = append(e.awaitResults, "timer")
+	})
+	e.CustomSignal.Emit("await", 3)
+}
+
+func (e *Example) AwaitResults() Array {
+	result := NewArray()
+	for _, r := range e.awaitResults {
+		v := NewVariantGoString(r)
+		result.Append(v)
+		v.Destroy()
+	}
+	return result
+}
+
+// StartMainThreadTest hands work back to the main thread from a goroutine.
+// MainThreadRuns reports where it ran once the idle frame has passed.
+func (e *Example) StartMainThreadTest() {
+	e.mainThreadRuns = nil
+	RunOnMainThreadSync(func() {
+		e.mainThreadRuns = append(e.mainThreadRuns, IsMainThread())
+	})
+	go func() {
+		RunOnMainThreadSync(func() {
+			e.mainThreadRuns = append(e.mainThreadRuns, IsMainThread())
+		})
+	}()
+}
+
+func (e *Example) MainThreadRuns() Array {
+	result := NewArray()
+	for _, r := range e.mainThreadRuns {
+		v := NewVariantBool(r)
+		result.Append(v)
+		v.Destroy()
+	}
+	return result
+}
+
+// OnHit is the Go default of the _on_hit virtual, which scripts extending
+// Example may override.
+func (e *Example) OnHit(strength float32) float32 {
+	return strength * 2
+}
+
+func (e *Example) Hit(strength float32) float32 {
+	return CallVirtualResult[float32](e, "_on_hit", strength)
+}
+
+func (e *Example) GoCallableCount() int64 {
+	return LiveGoCallableCount()
+}
+
 func (e *Example) TestVariantVector2iConversion(v Variant) Vector2i {
 	return v.ToVector2i()
 }
@@ -600,9 +796,9 @@ func ValidateExampleProperty(property *GDExtensionPropertyInfo) {
 
 func GetExamplePropertyList() []GDExtensionPropertyInfo {
 	props := make([]GDExtensionPropertyInfo, 4)
-	props[0] = NewSimpleGDExtensionPropertyInfo("Example", GDEXTENSION_VARIANT_TYPE_VECTOR3, "property_from_list")
+	props[0] = NewSimpleGDExtensionPropertyInfo("", GDEXTENSION_VARIANT_TYPE_VECTOR3, "property_from_list")
 	for i := 0; i < 3; i++ {
-		props[i+1] = NewSimpleGDExtensionPropertyInfo("Example", GDEXTENSION_VARIANT_TYPE_VECTOR2, fmt.Sprintf("dproperty_%d", i))
+		props[i+1] = NewSimpleGDExtensionPropertyInfo("", GDEXTENSION_VARIANT_TYPE_VECTOR2, fmt.Sprintf("dproperty_%d", i))
 	}
 	return props
 }
@@ -615,9 +811,12 @@ func RegisterClassExample() {
 		ClassDBBindMethodVirtual(t, "V_Input", "_input", []string{"event"}, nil)
 		ClassDBBindMethodVirtual(t, "V_Set", "_set", []string{"name", "value"}, nil)
 		ClassDBBindMethodVirtual(t, "V_Get", "_get", []string{"name"}, nil)
-		ClassDBBindMethodVirtual(t, "V_PropertyCanRevert", "_property_can_revert", []string{"name"}, nil)
 
 		ClassDBBindMethod(t, "SimpleFunc", "simple_func", nil, nil)
+		ClassDBBindMethod(t, "ReadyNotifications", "ready_notifications", nil, nil)
+		ClassDBBindMethod(t, "SetLaneCount", "set_lane_count", []string{"count"}, nil)
+		ClassDBBindMethod(t, "CheckPositive", "check_positive", []string{"value"}, nil)
+		ClassDBBindMethod(t, "ParseCount", "parse_count", []string{"text"}, nil)
 		ClassDBBindMethod(t, "SimpleConstFunc", "simple_const_func", []string{"a"}, nil)
 		ClassDBBindMethod(t, "CustomRefFunc", "custom_ref_func", []string{"ref"}, nil)
 		ClassDBBindMethod(t, "ImageRefFunc", "image_ref_func", []string{"image"}, nil)
@@ -644,8 +843,8 @@ func RegisterClassExample() {
 		ClassDBBindMethodVarargs(t, "VarargsFuncVoid", "varargs_func_void", nil, nil)
 
 		ClassDBBindMethod(t, "DefArgs", "def_args", []string{"a", "b"}, []Variant{NewVariantInt64(100), NewVariantInt64(200)})
-		// ClassDBBindMethodStatic(t, "TestStatic", "test_static", []string{"a", "b"}, nil)
-		// ClassDBBindMethodStatic(t, "TestStatic2", "test_static2", nil, nil)
+		ClassDBBindMethodStatic(t, ExampleTestStatic, "test_static", []string{"a", "b"}, nil)
+		ClassDBBindMethodStatic(t, ExampleTestStatic2, "test_static2", nil, nil)
 
 		ClassDBBindMethod(t, "TestSetPositionAndSize", "test_set_position_and_size", nil, nil)
 		ClassDBBindMethod(t, "TestGetChildNode", "test_get_child_node", nil, nil)
@@ -661,7 +860,8 @@ func RegisterClassExample() {
 		ClassDBBindMethod(t, "SetCustomPosition", "set_custom_position", []string{"position"}, nil)
 		ClassDBAddProperty(t, GDEXTENSION_VARIANT_TYPE_VECTOR2, "group_subgroup_custom_position", "set_custom_position", "get_custom_position")
 
-		// custom_signal disabled in tests to avoid lingering StringName allocations.
+		// custom_signal is registered from the CustomSignal field
+		ClassDBBindMethod(t, "EmitCustomSignal", "emit_custom_signal", []string{"name", "value"}, nil)
 
 		// constants
 		ClassDBBindEnumConstant(t, "Example.ExampleEnum", "FIRST", int(ExampleFirst))
@@ -673,6 +873,15 @@ func RegisterClassExample() {
 		ClassDBBindMethod(t, "TestBitfield", "test_bitfield", []string{"flags"}, nil)
 
 		ClassDBBindMethod(t, "CallableBind", "callable_bind", nil, nil)
+		ClassDBBindMethod(t, "TestCallableFromFunc", "test_callable_from_func", []string{"base"}, nil)
+		ClassDBBindMethod(t, "TestSignalChan", "test_signal_chan", []string{"name", "value"}, nil)
+		ClassDBBindMethod(t, "StartAwaitTest", "start_await_test", nil, nil)
+		ClassDBBindMethod(t, "AwaitResults", "await_results", nil, nil)
+		ClassDBBindMethod(t, "StartMainThreadTest", "start_main_thread_test", nil, nil)
+		ClassDBBindMethod(t, "MainThreadRuns", "main_thread_runs", nil, nil)
+		ClassDBAddVirtualMethod(t, "OnHit", "_on_hit", []string{"strength"})
+		ClassDBBindMethod(t, "Hit", "hit", []string{"strength"}, nil)
+		ClassDBBindMethod(t, "GoCallableCount", "go_callable_count", nil, nil)
 		ClassDBBindMethod(t, "TestVariantVector2iConversion", "test_variant_vector2i_conversion", []string{"variant"}, nil)
 
 		// others
@@ -42,7 +42,9 @@ func RegisterClassExampleRef() {
 	ClassDBRegisterClass(NewExampleRefFromOwnerObject, []GDExtensionPropertyInfo{}, nil, func(t *ExampleRef) {
 		ClassDBBindMethod(t, "GetId", "get_id", nil, nil)
 		ClassDBBindMethod(t, "SetId", "set_id", []string{"id"}, nil)
-		ClassDBAddProperty(t, GDEXTENSION_VARIANT_TYPE_INT, "group_subgroup_id", "set_id", "get_id")
+		idOptions := HintRange(0, 1000, 1, "or_greater")
+		idOptions.Default = 0
+		ClassDBAddProperty(t, GDEXTENSION_VARIANT_TYPE_INT, "group_subgroup_id", "set_id", "get_id", idOptions)
 		log.Debug("ExampleRef registered")
 	})
 }
@@ -20,16 +20,22 @@ func RegisterExampleTypes() {
 	RegisterClassExample()
 	RegisterClassPhysicsValidation()
 	RegisterClassPhysicsBenchmark()
+	RegisterClassMethodBindBenchmark()
 	RegisterClassInputProbe()
+	RegisterClassAutoExample()
+	RegisterGoScriptLanguage()
 }
 
 func UnregisterExampleTypes() {
 	log.Debug("UnregisterExampleTypes called")
+	UnregisterGoScriptLanguage()
 	UnregisterClassExample()
 	UnregisterClassExampleRef()
 	UnregisterClassPhysicsValidation()
 	UnregisterClassPhysicsBenchmark()
+	UnregisterClassMethodBindBenchmark()
 	UnregisterClassInputProbe()
+	UnregisterClassAutoExample()
 }
 
 //export TestDemoInit
@@ -0,0 +1,115 @@
+package pkg
+
+import (
+	"time"
+	"unsafe"
+
+	. "github.com/godot-go/godot-go/pkg/builtin"
+	. "github.com/godot-go/godot-go/pkg/core"
+	. "github.com/godot-go/godot-go/pkg/ffi"
+	. "github.com/godot-go/godot-go/pkg/gdclassimpl"
+	"github.com/godot-go/godot-go/pkg/log"
+	"go.uber.org/zap"
+)
+
+const node2DGetGlobalPositionHash = 3341600327
+
+// MethodBindBenchmark compares a hot loop over a generated engine wrapper,
+// which reuses the cached method bind, with the same call resolving the
+// method bind through ClassDB every time.
+type MethodBindBenchmark struct {
+	Node2DImpl
+}
+
+func (b *MethodBindBenchmark) GetClassName() string {
+	return "MethodBindBenchmark"
+}
+
+func (b *MethodBindBenchmark) GetParentClassName() string {
+	return "Node2D"
+}
+
+func (b *MethodBindBenchmark) Run(iterations int32) Dictionary {
+	if iterations <= 0 {
+		iterations = 1
+	}
+
+	start := time.Now()
+	for i := int32(0); i < iterations; i++ {
+		b.GetGlobalPosition()
+	}
+	cached := time.Since(start)
+
+	start = time.Now()
+	for i := int32(0); i < iterations; i++ {
+		b.getGlobalPositionWithLookup()
+	}
+	lookup := time.Since(start)
+
+	cachedNs := cached.Nanoseconds() / int64(iterations)
+	lookupNs := lookup.Nanoseconds() / int64(iterations)
+
+	log.Info("method bind benchmark",
+		zap.Int32("iterations", iterations),
+		zap.Int64("cached_ns_per_call", cachedNs),
+		zap.Int64("lookup_ns_per_call", lookupNs),
+	)
+
+	result := NewDictionary()
+	for _, kv := range []struct {
+		key   string
+		value int64
+	}{
+		{"iterations", int64(iterations)},
+		{"cached_ns_per_call", cachedNs},
+		{"lookup_ns_per_call", lookupNs},
+	} {
+		v := NewVariantInt64(kv.value)
+		result.SetKeyed(kv.key, v)
+		v.Destroy()
+	}
+	return result
+}
+
+// getGlobalPositionWithLookup is Node2D.get_global_position the way the
+// wrappers used to call it, resolving the method bind on every call.
+func (b *MethodBindBenchmark) getGlobalPositionWithLookup() Vector2 {
+	className := NewStringNameWithLatin1Chars("Node2D")
+	defer className.Destroy()
+	methodName := NewStringNameWithLatin1Chars("get_global_position")
+	defer methodName.Destroy()
+	fn := CallFunc_GDExtensionInterfaceClassdbGetMethodBind(
+		className.AsGDExtensionConstStringNamePtr(),
+		methodName.AsGDExtensionConstStringNamePtr(),
+		node2DGetGlobalPositionHash,
+	)
+	if fn == nil {
+		log.Panic("could not find method bind Node2D.get_global_position")
+	}
+	var ret Vector2
+	CallFunc_GDExtensionInterfaceObjectMethodBindPtrcall(
+		fn,
+		b.AsGDExtensionObjectPtr(),
+		nil,
+		(GDExtensionTypePtr)(unsafe.Pointer(&ret)),
+	)
+	return ret
+}
+
+func NewMethodBindBenchmarkFromOwnerObject(owner *GodotObject) GDClass {
+	obj := &MethodBindBenchmark{}
+	obj.SetGodotObjectOwner(owner)
+	return obj
+}
+
+func RegisterClassMethodBindBenchmark() {
+	ClassDBRegisterClass(NewMethodBindBenchmarkFromOwnerObject, nil, nil, func(t *MethodBindBenchmark) {
+		ClassDBBindMethod(t, "Run", "run", []string{"iterations"}, nil)
+		log.Debug("MethodBindBenchmark registered")
+	})
+}
+
+func UnregisterClassMethodBindBenchmark() {
+	ClassDBUnregisterClass[*MethodBindBenchmark]()
+	log.Debug("MethodBindBenchmark unregistered")
+}
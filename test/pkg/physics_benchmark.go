@@ -64,8 +64,8 @@ func (p *PhysicsBenchmark) Setup(count int32, radius, spacing float32) bool {
 		}
 		shapeRef := NewRefShape2DGDExtensionIternalConstructor(circle.TypedPtr())
 		shapeNode.SetShape(shapeRef)
-		body.AddChild(shapeNode, false, NODE_INTERNAL_MODE_INTERNAL_MODE_DISABLED)
-		p.AddChild(body, false, NODE_INTERNAL_MODE_INTERNAL_MODE_DISABLED)
+		body.AddChild(shapeNode)
+		p.AddChild(body)
 		p.bodies = append(p.bodies, body)
 	}
 	return true
@@ -77,7 +77,7 @@ func (p *PhysicsBenchmark) ApplyImpulseBatch(impulse Vector2) int32 {
 		if body == nil {
 			continue
 		}
-		body.ApplyImpulse(impulse, Vector2{})
+		body.ApplyImpulse(impulse)
 		applied++
 	}
 	return applied
@@ -116,9 +116,9 @@ func (p *PhysicsBenchmark) ensureFloor(width, height, y float32) {
 	}
 	shapeRef := NewRefShape2DGDExtensionIternalConstructor(rect.TypedPtr())
 	shapeNode.SetShape(shapeRef)
-	body.AddChild(shapeNode, false, NODE_INTERNAL_MODE_INTERNAL_MODE_DISABLED)
+	body.AddChild(shapeNode)
 	body.SetPosition(NewVector2WithFloat32Float32(width/2, y))
-	p.AddChild(body, false, NODE_INTERNAL_MODE_INTERNAL_MODE_DISABLED)
+	p.AddChild(body)
 	p.floor = body
 }
 
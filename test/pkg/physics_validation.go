@@ -39,7 +39,7 @@ func (p *PhysicsValidation) ApplyFlipperImpulse(body RigidBody2D, impulse Vector
 		log.Warn("ApplyFlipperImpulse called with nil body")
 		return
 	}
-	body.ApplyImpulse(impulse, position)
+	body.ApplyImpulseOpt(impulse, position)
 }
 
 func (p *PhysicsValidation) GetLinearSpeed(body RigidBody2D) float32 {
@@ -101,8 +101,8 @@ func (p *PhysicsValidation) BindArea(area Area2D) bool {
 	callableExited := NewCallableWithObjectStringName(p, methodExited)
 	defer callableExited.Destroy()
 
-	enteredErr := area.Connect(signalEntered, callableEntered, 0)
-	exitedErr := area.Connect(signalExited, callableExited, 0)
+	enteredErr := area.Connect(signalEntered, callableEntered)
+	exitedErr := area.Connect(signalExited, callableExited)
 	if enteredErr != OK || exitedErr != OK {
 		log.Warn("BindArea connect failed",
 			zap.Any("entered_err", enteredErr),
@@ -6,7 +6,6 @@ package pkg_test
 import "C"
 
 import (
-	"runtime"
 	"unsafe"
 
 	_ "github.com/godot-go/godot-go/pkg/core"
@@ -14,8 +13,6 @@ import (
 	_ "github.com/godot-go/godot-go/pkg/log"
 )
 
-var pnr runtime.Pinner
-
 //export TestDemoInit
 func TestDemoInit(p_get_proc_address unsafe.Pointer, p_library unsafe.Pointer, r_initialization unsafe.Pointer) bool {
 	return false